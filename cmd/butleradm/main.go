@@ -30,12 +30,17 @@ import (
 
 	"github.com/butlerdotdev/butler/internal/adm/cmd"
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
 )
 
 func main() {
 	logger := log.New("butleradm")
 
 	if err := cmd.Execute(logger); err != nil {
+		if waiter.Interrupted(err) {
+			logger.Warn("interrupted", "error", err)
+			os.Exit(130)
+		}
 		logger.Error("command failed", "error", err)
 		os.Exit(1)
 	}
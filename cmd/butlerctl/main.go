@@ -24,6 +24,7 @@ import (
 	"os"
 
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
 	"github.com/butlerdotdev/butler/internal/ctl/cmd"
 )
 
@@ -31,6 +32,10 @@ func main() {
 	logger := log.New("butlerctl")
 
 	if err := cmd.Execute(logger); err != nil {
+		if waiter.Interrupted(err) {
+			logger.Warn("interrupted", "error", err)
+			os.Exit(130)
+		}
 		logger.Error("command failed", "error", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command schemagen writes a JSON Schema for a config struct, read from the
+// Go source of its package so field doc comments become descriptions.
+//
+// Struct fields are named by the given tag (mapstructure or json); fields
+// tagged "-" are skipped. A jsonschema:"enum=a|b" tag restricts a string.
+// Unknown keys are rejected at every level, which is what lets editors and
+// 'butleradm config lint' catch misspellings.
+//
+// Usage, from a go:generate directive in the package:
+//
+//	go run ../../hack/schemagen -type Config -tag json -out config.schema.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "package directory")
+	typeName := flag.String("type", "Config", "root struct type")
+	tag := flag.String("tag", "json", "struct tag naming the keys")
+	title := flag.String("title", "", "schema title")
+	profiles := flag.Bool("profiles", false, "allow a profiles map of partial configs with extends")
+	out := flag.String("out", "", "output file (default: stdout)")
+	flag.Parse()
+
+	if err := run(*dir, *typeName, *tag, *title, *profiles, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "schemagen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, typeName, tag, title string, profiles bool, out string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	g := &generator{tag: tag, types: map[string]*ast.StructType{}}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if st, ok := ts.Type.(*ast.StructType); ok {
+						g.types[ts.Name.Name] = st
+					}
+				}
+			}
+		}
+	}
+
+	root, err := g.structSchema(typeName)
+	if err != nil {
+		return err
+	}
+	if profiles {
+		profile := map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"properties":           copyProperties(root["properties"].(map[string]interface{})),
+		}
+		profile["properties"].(map[string]interface{})["extends"] = map[string]interface{}{
+			"type":        "string",
+			"description": "Profile this one builds on",
+		}
+		root["properties"].(map[string]interface{})["profiles"] = map[string]interface{}{
+			"type":                 "object",
+			"description":          "Named overrides deep-merged over the config with --profile",
+			"additionalProperties": profile,
+		}
+	}
+
+	schema := map[string]interface{}{"$schema": "https://json-schema.org/draft/2020-12/schema"}
+	if title != "" {
+		schema["title"] = title
+	}
+	for k, v := range root {
+		schema[k] = v
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if out == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(out, data, 0644)
+}
+
+type generator struct {
+	tag   string
+	types map[string]*ast.StructType
+}
+
+func (g *generator) structSchema(name string) (map[string]interface{}, error) {
+	st, ok := g.types[name]
+	if !ok {
+		return nil, fmt.Errorf("struct type %s not found", name)
+	}
+
+	props := map[string]interface{}{}
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 || !field.Names[0].IsExported() {
+			continue
+		}
+		var tags reflect.StructTag
+		if field.Tag != nil {
+			raw, _ := strconv.Unquote(field.Tag.Value)
+			tags = reflect.StructTag(raw)
+		}
+		key, _, _ := strings.Cut(tags.Get(g.tag), ",")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = field.Names[0].Name
+		}
+
+		prop, err := g.typeSchema(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", name, field.Names[0].Name, err)
+		}
+		if doc := strings.TrimSpace(field.Doc.Text()); doc != "" {
+			prop["description"] = strings.Join(strings.Fields(doc), " ")
+		}
+		if enum, ok := strings.CutPrefix(tags.Get("jsonschema"), "enum="); ok {
+			prop["enum"] = strings.Split(enum, "|")
+		}
+		props[key] = prop
+	}
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}, nil
+}
+
+func (g *generator) typeSchema(expr ast.Expr) (map[string]interface{}, error) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return g.typeSchema(t.X)
+	case *ast.ArrayType:
+		items, err := g.typeSchema(t.Elt)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "array", "items": items}, nil
+	case *ast.MapType:
+		values, err := g.typeSchema(t.Value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "object", "additionalProperties": values}, nil
+	case *ast.InterfaceType:
+		return map[string]interface{}{}, nil
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return map[string]interface{}{"type": "string"}, nil
+		case "bool":
+			return map[string]interface{}{"type": "boolean"}, nil
+		case "int", "int32", "int64", "uint", "uint32", "uint64":
+			return map[string]interface{}{"type": "integer"}, nil
+		case "float32", "float64":
+			return map[string]interface{}{"type": "number"}, nil
+		}
+		return g.structSchema(t.Name)
+	}
+	return nil, fmt.Errorf("unsupported field type %T", expr)
+}
+
+// copyProperties copies a properties map one level deep
+func copyProperties(props map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(props))
+	for k, v := range props {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package access implements butleradm access commands.
+package access
+
+import (
+	"context"
+	"os"
+	"sort"
+	"time"
+
+	issued "github.com/butlerdotdev/butler/internal/common/access"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+)
+
+// NewAccessCmd creates the access parent command
+func NewAccessCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: "Audit credentials issued for tenant clusters",
+		Long: `Audit credentials issued for tenant clusters.
+
+Time-boxed kubeconfigs minted with 'butlerctl cluster kubeconfig --expires'
+are recorded on the management cluster. Use these commands to review who
+holds access to which cluster and until when.
+
+Commands:
+  list  List issued credentials
+
+Examples:
+  # Outstanding credentials across all clusters
+  butleradm access list
+
+  # Include expired credentials for one cluster
+  butleradm access list --cluster payments --all`,
+	}
+
+	cmd.AddCommand(newListCmd(logger))
+
+	return cmd
+}
+
+type listOptions struct {
+	kubeconfig   string
+	namespace    string
+	cluster      string
+	all          bool
+	outputFormat string
+}
+
+func newListCmd(logger *log.Logger) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List issued credentials",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "only credentials for clusters in this namespace (default: all)")
+	cmd.Flags().StringVar(&opts.cluster, "cluster", "", "only credentials for this cluster")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "include expired credentials")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+func runList(ctx context.Context, logger *log.Logger, opts *listOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	records, err := issued.List(ctx, c, opts.namespace, opts.cluster)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if !opts.all {
+		outstanding := records[:0]
+		for _, r := range records {
+			if !r.Expired(now) {
+				outstanding = append(outstanding, r)
+			}
+		}
+		records = outstanding
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Cluster != records[j].Cluster {
+			return records[i].Cluster < records[j].Cluster
+		}
+		return records[i].ExpiresAt.Before(records[j].ExpiresAt)
+	})
+
+	if format == output.FormatJSON || format == output.FormatYAML {
+		return output.NewPrinter(format, os.Stdout).Print(records, nil)
+	}
+
+	if len(records) == 0 {
+		logger.Info("no outstanding credentials")
+		return nil
+	}
+
+	table := output.NewTable(os.Stdout, "CLUSTER", "NAMESPACE", "ISSUED TO", "ROLE", "ISSUED", "EXPIRES")
+	for _, r := range records {
+		expires := "in " + output.FormatDuration(r.ExpiresAt.Sub(now).Round(time.Minute))
+		if r.Expired(now) {
+			expires = output.Dim("expired")
+		}
+		table.AddRow(r.Cluster, r.Namespace, r.IssuedTo, r.Role, output.FormatAge(r.IssuedAt)+" ago", expires)
+	}
+	return table.Flush()
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
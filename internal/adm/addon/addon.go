@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package addon implements butleradm commands that configure installed
+// platform addons.
+package addon
+
+import (
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+)
+
+// NewAddonCmd creates the addon parent command
+func NewAddonCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addon",
+		Short: "Configure platform addons",
+		Long: `Configure addons installed on the management cluster or a tenant cluster.
+
+Commands:
+  configure  Set up an installed addon
+
+Examples:
+  # Let's Encrypt certificates through Cloudflare DNS
+  butleradm addon configure cert-manager --acme-email ops@example.com \
+    --dns01-provider cloudflare --dns01-secret cloudflare-api-token`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newConfigureCmd(logger))
+
+	return cmd
+}
+
+func newConfigureCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "configure",
+		Short: "Set up an installed addon",
+		Long: `Set up an addon after it is installed.
+
+Commands:
+  cert-manager  Create ACME ClusterIssuers
+
+Examples:
+  # HTTP-01 validation through the traefik ingress class
+  butleradm addon configure cert-manager --acme-email ops@example.com --ingress-class traefik`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newCertManagerCmd(logger))
+
+	return cmd
+}
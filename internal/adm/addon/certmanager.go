@@ -0,0 +1,336 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// certManagerNamespace holds the Secrets ClusterIssuers reference
+	certManagerNamespace = "cert-manager"
+
+	// fieldManager is the server-side apply field manager for issuers
+	fieldManager = "butleradm"
+
+	acmeProduction = "https://acme-v02.api.letsencrypt.org/directory"
+	acmeStaging    = "https://acme-staging-v02.api.letsencrypt.org/directory"
+)
+
+var clusterIssuerGVR = schema.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "clusterissuers",
+}
+
+// dns01Provider describes a cert-manager DNS-01 solver and the keys it
+// reads from the credentials Secret
+type dns01Provider struct {
+	keys   []string
+	solver func(secret, region string) map[string]interface{}
+}
+
+func secretRef(secret, key string) map[string]interface{} {
+	return map[string]interface{}{"name": secret, "key": key}
+}
+
+var dns01Providers = map[string]dns01Provider{
+	"cloudflare": {
+		keys: []string{"api-token"},
+		solver: func(secret, _ string) map[string]interface{} {
+			return map[string]interface{}{"cloudflare": map[string]interface{}{
+				"apiTokenSecretRef": secretRef(secret, "api-token"),
+			}}
+		},
+	},
+	"digitalocean": {
+		keys: []string{"access-token"},
+		solver: func(secret, _ string) map[string]interface{} {
+			return map[string]interface{}{"digitalocean": map[string]interface{}{
+				"tokenSecretRef": secretRef(secret, "access-token"),
+			}}
+		},
+	},
+	"route53": {
+		keys: []string{"access-key-id", "secret-access-key"},
+		solver: func(secret, region string) map[string]interface{} {
+			route53 := map[string]interface{}{"region": region}
+			if secret != "" {
+				route53["accessKeyIDSecretRef"] = secretRef(secret, "access-key-id")
+				route53["secretAccessKeySecretRef"] = secretRef(secret, "secret-access-key")
+			}
+			return map[string]interface{}{"route53": route53}
+		},
+	},
+}
+
+type certManagerOptions struct {
+	kubeconfig    string
+	cluster       string
+	namespace     string
+	name          string
+	email         string
+	staging       bool
+	server        string
+	dns01Provider string
+	dns01Secret   string
+	region        string
+	ingressClass  string
+	dryRun        bool
+}
+
+func newCertManagerCmd(logger *log.Logger) *cobra.Command {
+	opts := &certManagerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "cert-manager",
+		Short: "Create ACME ClusterIssuers",
+		Long: `Create an ACME (Let's Encrypt) ClusterIssuer for cert-manager.
+
+With --dns01-provider the issuer validates domains through the DNS
+provider's API, which works for hosts that aren't reachable from the
+internet. Credentials come from --dns01-secret, a Secret in the
+cert-manager namespace:
+
+  cloudflare    api-token
+  digitalocean  access-token
+  route53       access-key-id, secret-access-key (omit the Secret to use
+                the node's IAM role; --route53-region is required)
+
+Without --dns01-provider, HTTP-01 validation is used through
+--ingress-class. Running the command again updates the issuer.
+
+Reference the issuer from addons.console.ingress.issuer in bootstrap.yaml so
+'tls: true' gets a real certificate, or from the cert-manager.io/cluster-issuer
+annotation on any Ingress. --cluster configures cert-manager inside a tenant
+cluster instead of the management cluster.
+
+Examples:
+  # Cloudflare DNS-01
+  kubectl -n cert-manager create secret generic cloudflare-api-token --from-literal=api-token=...
+  butleradm addon configure cert-manager --acme-email ops@example.com \
+    --dns01-provider cloudflare --dns01-secret cloudflare-api-token
+
+  # Route 53 with the node's IAM role, against the staging directory
+  butleradm addon configure cert-manager --acme-email ops@example.com \
+    --dns01-provider route53 --route53-region eu-central-1 --staging
+
+  # HTTP-01 in a tenant cluster
+  butleradm addon configure cert-manager --cluster my-cluster \
+    --acme-email ops@example.com --ingress-class traefik`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCertManager(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.cluster, "cluster", "", "configure this tenant cluster instead of the management cluster")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "butler-tenants", "namespace of the --cluster TenantCluster")
+	cmd.Flags().StringVar(&opts.name, "name", "", "ClusterIssuer name (default: letsencrypt, or letsencrypt-staging with --staging)")
+	cmd.Flags().StringVar(&opts.email, "acme-email", "", "ACME account email for expiry notices (required)")
+	cmd.Flags().BoolVar(&opts.staging, "staging", false, "use the Let's Encrypt staging directory")
+	cmd.Flags().StringVar(&opts.server, "acme-server", "", "ACME directory URL (default: Let's Encrypt)")
+	cmd.Flags().StringVar(&opts.dns01Provider, "dns01-provider", "", "DNS-01 provider ("+strings.Join(dns01ProviderNames(), ", ")+")")
+	cmd.Flags().StringVar(&opts.dns01Secret, "dns01-secret", "", "Secret in cert-manager holding the DNS provider credentials")
+	cmd.Flags().StringVar(&opts.region, "route53-region", "", "AWS region for the route53 provider")
+	cmd.Flags().StringVar(&opts.ingressClass, "ingress-class", "", "ingress class for HTTP-01 validation")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "print the ClusterIssuer instead of applying it")
+	_ = cmd.MarkFlagRequired("acme-email")
+	cmd.MarkFlagsMutuallyExclusive("staging", "acme-server")
+	cmd.MarkFlagsMutuallyExclusive("dns01-provider", "ingress-class")
+
+	return cmd
+}
+
+func dns01ProviderNames() []string {
+	names := make([]string, 0, len(dns01Providers))
+	for name := range dns01Providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validate checks the flags and fills in defaults
+func (o *certManagerOptions) validate() error {
+	if _, err := mail.ParseAddress(o.email); err != nil {
+		return fmt.Errorf("invalid --acme-email %q", o.email)
+	}
+	if o.name == "" {
+		o.name = "letsencrypt"
+		if o.staging {
+			o.name = "letsencrypt-staging"
+		}
+	}
+	if o.server == "" {
+		o.server = acmeProduction
+		if o.staging {
+			o.server = acmeStaging
+		}
+	}
+
+	if o.dns01Provider == "" {
+		if o.dns01Secret != "" || o.region != "" {
+			return fmt.Errorf("--dns01-secret and --route53-region require --dns01-provider")
+		}
+		return nil
+	}
+	if _, ok := dns01Providers[o.dns01Provider]; !ok {
+		return fmt.Errorf("unknown --dns01-provider %q (valid: %s)", o.dns01Provider, strings.Join(dns01ProviderNames(), ", "))
+	}
+	switch {
+	case o.dns01Provider == "route53" && o.region == "":
+		return fmt.Errorf("--dns01-provider route53 requires --route53-region")
+	case o.dns01Provider != "route53" && o.region != "":
+		return fmt.Errorf("--route53-region only applies to --dns01-provider route53")
+	case o.dns01Provider != "route53" && o.dns01Secret == "":
+		return fmt.Errorf("--dns01-provider %s requires --dns01-secret", o.dns01Provider)
+	}
+	return nil
+}
+
+func runCertManager(ctx context.Context, logger *log.Logger, opts *certManagerOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	issuer := buildClusterIssuer(opts)
+
+	if opts.dryRun {
+		data, err := yaml.Marshal(issuer.Object)
+		if err != nil {
+			return fmt.Errorf("encoding ClusterIssuer: %w", err)
+		}
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	var c *client.Client
+	var err error
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+	target := "management cluster"
+	if opts.cluster != "" {
+		c, err = c.NewForTenant(ctx, opts.namespace, opts.cluster)
+		if err != nil {
+			return fmt.Errorf("connecting to tenant cluster %s: %w", opts.cluster, err)
+		}
+		target = "cluster " + opts.cluster
+	}
+
+	if opts.dns01Secret != "" {
+		checkCredentials(ctx, logger, c, opts)
+	}
+
+	data, err := json.Marshal(issuer.Object)
+	if err != nil {
+		return fmt.Errorf("encoding ClusterIssuer: %w", err)
+	}
+	force := true
+	_, err = c.Dynamic.Resource(clusterIssuerGVR).Patch(ctx, issuer.GetName(), types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: fieldManager, Force: &force})
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("ClusterIssuer API not found on the %s; is cert-manager installed?", target)
+	}
+	if err != nil {
+		return fmt.Errorf("applying ClusterIssuer %s: %w", issuer.GetName(), err)
+	}
+
+	logger.Success("ClusterIssuer configured", "name", issuer.GetName(), "target", target, "server", opts.server)
+	fmt.Printf("\nRequest certificates with the annotation:\n  cert-manager.io/cluster-issuer: %s\n", issuer.GetName())
+	return nil
+}
+
+// buildClusterIssuer renders the ACME ClusterIssuer
+func buildClusterIssuer(opts *certManagerOptions) *unstructured.Unstructured {
+	var solver map[string]interface{}
+	if opts.dns01Provider != "" {
+		solver = map[string]interface{}{
+			"dns01": dns01Providers[opts.dns01Provider].solver(opts.dns01Secret, opts.region),
+		}
+	} else {
+		ingress := map[string]interface{}{}
+		if opts.ingressClass != "" {
+			ingress["ingressClassName"] = opts.ingressClass
+		}
+		solver = map[string]interface{}{
+			"http01": map[string]interface{}{"ingress": ingress},
+		}
+	}
+
+	issuer := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"acme": map[string]interface{}{
+				"email":  opts.email,
+				"server": opts.server,
+				"privateKeySecretRef": map[string]interface{}{
+					"name": opts.name + "-account-key",
+				},
+				"solvers": []interface{}{solver},
+			},
+		},
+	}}
+	issuer.SetAPIVersion("cert-manager.io/v1")
+	issuer.SetKind("ClusterIssuer")
+	issuer.SetName(opts.name)
+	issuer.SetLabels(map[string]string{"app.kubernetes.io/managed-by": fieldManager})
+	return issuer
+}
+
+// checkCredentials warns when the DNS provider Secret is missing or lacks
+// the keys the solver reads. The issuer is still applied, since the Secret
+// may be created afterwards.
+func checkCredentials(ctx context.Context, logger *log.Logger, c *client.Client, opts *certManagerOptions) {
+	secret, err := c.Clientset.CoreV1().Secrets(certManagerNamespace).Get(ctx, opts.dns01Secret, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		logger.Warn("DNS credentials Secret not found; certificates won't issue until it exists",
+			"secret", certManagerNamespace+"/"+opts.dns01Secret,
+			"keys", strings.Join(dns01Providers[opts.dns01Provider].keys, ", "))
+		return
+	}
+	if err != nil {
+		logger.Debug("could not check DNS credentials Secret", "error", err)
+		return
+	}
+	for _, key := range dns01Providers[opts.dns01Provider].keys {
+		if _, ok := secret.Data[key]; !ok {
+			logger.Warn("DNS credentials Secret lacks a key", "secret", certManagerNamespace+"/"+opts.dns01Secret, "key", key)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package advisories implements the butleradm advisories command.
+package advisories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/adm/inventory"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+)
+
+// DefaultFeed is the published Butler advisory feed
+const DefaultFeed = "https://butlerlabs.dev/advisories/v1/feed.json"
+
+// Feed is the Butler advisory feed document
+type Feed struct {
+	Advisories []Advisory `json:"advisories"`
+}
+
+// Advisory describes a vulnerability in a platform component
+type Advisory struct {
+	ID       string     `json:"id"`
+	Aliases  []string   `json:"aliases,omitempty"`
+	Severity string     `json:"severity"`
+	Summary  string     `json:"summary"`
+	URL      string     `json:"url,omitempty"`
+	Affected []Affected `json:"affected"`
+}
+
+// Affected is a vulnerable version range of an image or Helm chart.
+// Versions from Introduced (inclusive) up to Fixed (exclusive) are affected.
+type Affected struct {
+	Image      string `json:"image,omitempty"`
+	Chart      string `json:"chart,omitempty"`
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+	Upgrade    string `json:"upgrade,omitempty"`
+}
+
+// Finding is a deployed component affected by an advisory
+type Finding struct {
+	Advisory  string `json:"advisory"`
+	Aliases   string `json:"aliases,omitempty"`
+	Severity  string `json:"severity"`
+	Summary   string `json:"summary"`
+	Component string `json:"component"`
+	Namespace string `json:"namespace"`
+	Version   string `json:"version"`
+	Fixed     string `json:"fixed,omitempty"`
+	Upgrade   string `json:"upgrade"`
+	Source    string `json:"source"`
+}
+
+// severityOrder sorts findings with the most severe first
+var severityOrder = map[string]int{
+	"critical": 0,
+	"high":     1,
+	"medium":   2,
+	"low":      3,
+}
+
+type advisoriesOptions struct {
+	kubeconfig   string
+	feed         string
+	osv          bool
+	timeout      time.Duration
+	outputFormat string
+}
+
+// NewAdvisoriesCmd creates the advisories command
+func NewAdvisoriesCmd(logger *log.Logger) *cobra.Command {
+	opts := &advisoriesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "advisories",
+		Short: "Check deployed components against security advisories",
+		Long: `Compare the platform component inventory against the Butler advisory feed
+and print affected components with the fixed version and the upgrade
+command to run.
+
+The feed can be a URL or a local file (for air-gapped platforms) and
+defaults to the published Butler feed, overridable with
+BUTLER_ADVISORY_FEED. With --osv, components built from GitHub sources are
+additionally checked against the OSV database as Go modules.
+
+Examples:
+  # Check against the Butler feed
+  butleradm advisories
+
+  # Include OSV results
+  butleradm advisories --osv
+
+  # Use a mirrored feed
+  butleradm advisories --feed ./butler-advisories.json -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAdvisories(cmd.Context(), logger, opts)
+		},
+	}
+
+	feed := os.Getenv("BUTLER_ADVISORY_FEED")
+	if feed == "" {
+		feed = DefaultFeed
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringVar(&opts.feed, "feed", feed, "advisory feed URL or file")
+	cmd.Flags().BoolVar(&opts.osv, "osv", false, "also query the OSV database")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 30*time.Second, "timeout for fetching advisories")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+func runAdvisories(ctx context.Context, logger *log.Logger, opts *advisoriesOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	components, err := inventory.Collect(ctx, c, nil)
+	if err != nil {
+		return err
+	}
+	logger.Debug("collected inventory", "components", len(components))
+
+	httpClient := &http.Client{Timeout: opts.timeout}
+
+	feed, err := loadFeed(ctx, httpClient, opts.feed)
+	if err != nil {
+		return err
+	}
+	logger.Debug("loaded advisory feed", "advisories", len(feed.Advisories))
+
+	findings := matchFeed(feed, components, logger)
+
+	if opts.osv {
+		osvFindings, err := queryOSV(ctx, httpClient, components, logger)
+		if err != nil {
+			return err
+		}
+		findings = append(findings, osvFindings...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		si, sj := severityRank(findings[i].Severity), severityRank(findings[j].Severity)
+		if si != sj {
+			return si < sj
+		}
+		return findings[i].Component < findings[j].Component
+	})
+
+	if format == output.FormatJSON || format == output.FormatYAML {
+		return output.NewPrinter(format, os.Stdout).Print(findings, nil)
+	}
+
+	if len(findings) == 0 {
+		logger.Success("no deployed components are affected by known advisories", "components", len(components))
+		return nil
+	}
+
+	table := output.NewTable(os.Stdout, "SEVERITY", "ADVISORY", "COMPONENT", "NAMESPACE", "VERSION", "FIXED", "SUMMARY")
+	for _, f := range findings {
+		table.AddRow(colorSeverity(f.Severity), f.Advisory, f.Component, f.Namespace, f.Version, orDash(f.Fixed), f.Summary)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println(output.Bold("Upgrade commands:"))
+	seen := map[string]bool{}
+	for _, f := range findings {
+		if f.Upgrade == "" || seen[f.Upgrade] {
+			continue
+		}
+		seen[f.Upgrade] = true
+		fmt.Printf("  %s\n", f.Upgrade)
+	}
+	fmt.Println()
+
+	return fmt.Errorf("%d advisory finding(s) affect deployed components", len(findings))
+}
+
+// loadFeed reads the advisory feed from a URL or a local file
+func loadFeed(ctx context.Context, httpClient *http.Client, location string) (*Feed, error) {
+	var data []byte
+	if strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, fmt.Errorf("creating request: %w", err)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("fetching advisory feed: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetching advisory feed: %s returned status %d", location, resp.StatusCode)
+		}
+		if data, err = io.ReadAll(resp.Body); err != nil {
+			return nil, fmt.Errorf("reading advisory feed: %w", err)
+		}
+	} else {
+		var err error
+		if data, err = os.ReadFile(location); err != nil {
+			return nil, fmt.Errorf("reading advisory feed: %w", err)
+		}
+	}
+
+	var feed Feed
+	if err := json.Unmarshal(data, &feed); err != nil {
+		return nil, fmt.Errorf("parsing advisory feed: %w", err)
+	}
+	return &feed, nil
+}
+
+// matchFeed returns the components affected by feed advisories
+func matchFeed(feed *Feed, components []inventory.Component, logger *log.Logger) []Finding {
+	var findings []Finding
+	for _, adv := range feed.Advisories {
+		for _, aff := range adv.Affected {
+			for _, comp := range components {
+				version, ok := affectedVersion(aff, comp)
+				if !ok {
+					continue
+				}
+				affected, err := inRange(version, aff.Introduced, aff.Fixed)
+				if err != nil {
+					logger.Debug("cannot compare version", "component", comp.Name, "version", version, "advisory", adv.ID, "error", err)
+					continue
+				}
+				if !affected {
+					continue
+				}
+				upgrade := aff.Upgrade
+				if upgrade == "" {
+					upgrade = upgradeCommand(comp, aff.Fixed)
+				}
+				findings = append(findings, Finding{
+					Advisory:  adv.ID,
+					Aliases:   strings.Join(adv.Aliases, ", "),
+					Severity:  strings.ToLower(adv.Severity),
+					Summary:   adv.Summary,
+					Component: comp.Name,
+					Namespace: comp.Namespace,
+					Version:   version,
+					Fixed:     aff.Fixed,
+					Upgrade:   upgrade,
+					Source:    "butler",
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// affectedVersion returns the component version an affected entry applies to
+func affectedVersion(aff Affected, comp inventory.Component) (string, bool) {
+	if aff.Chart != "" && comp.Chart == aff.Chart && comp.ChartVersion != "" {
+		return comp.ChartVersion, true
+	}
+	if aff.Image != "" && comp.Version != "" {
+		repo := comp.Repository()
+		if repo == aff.Image || strings.HasSuffix(repo, "/"+aff.Image) {
+			return comp.Version, true
+		}
+	}
+	return "", false
+}
+
+// upgradeCommand suggests how to move a component to the fixed version
+func upgradeCommand(comp inventory.Component, fixed string) string {
+	if fixed == "" {
+		return ""
+	}
+	if comp.Chart != "" && comp.Release != "" {
+		return fmt.Sprintf("helm upgrade %s %s --version %s -n %s --reuse-values", comp.Release, comp.Chart, fixed, comp.Namespace)
+	}
+	return fmt.Sprintf("kubectl -n %s set image %s/%s %s=%s:%s",
+		comp.Namespace, strings.ToLower(comp.Kind), comp.Name, comp.Container, comp.Repository(), fixed)
+}
+
+func severityRank(s string) int {
+	if rank, ok := severityOrder[s]; ok {
+		return rank
+	}
+	return len(severityOrder)
+}
+
+func colorSeverity(s string) string {
+	switch s {
+	case "critical", "high":
+		return output.Danger(s)
+	case "medium":
+		return output.Warning(s)
+	}
+	return s
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
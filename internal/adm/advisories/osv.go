@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package advisories
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/adm/inventory"
+	"github.com/butlerdotdev/butler/internal/common/log"
+)
+
+// osvQueryURL is the OSV API endpoint for single-package queries
+const osvQueryURL = "https://api.osv.dev/v1/query"
+
+type osvQuery struct {
+	Package osvPackage `json:"package"`
+	Version string     `json:"version"`
+}
+
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+type osvResponse struct {
+	Vulns []osvVuln `json:"vulns"`
+}
+
+type osvVuln struct {
+	ID               string   `json:"id"`
+	Summary          string   `json:"summary"`
+	Aliases          []string `json:"aliases"`
+	DatabaseSpecific struct {
+		Severity string `json:"severity"`
+	} `json:"database_specific"`
+	Affected []struct {
+		Package osvPackage `json:"package"`
+		Ranges  []struct {
+			Events []struct {
+				Introduced string `json:"introduced"`
+				Fixed      string `json:"fixed"`
+			} `json:"events"`
+		} `json:"ranges"`
+	} `json:"affected"`
+}
+
+// queryOSV checks components built from GitHub sources against OSV, treating
+// the source repository as a Go module
+func queryOSV(ctx context.Context, httpClient *http.Client, components []inventory.Component, logger *log.Logger) ([]Finding, error) {
+	var findings []Finding
+	queried := map[string][]osvVuln{}
+
+	for _, comp := range components {
+		module := strings.TrimPrefix(comp.Source, "https://")
+		if !strings.HasPrefix(module, "github.com/") || comp.Version == "" {
+			continue
+		}
+		if _, err := parseVersion(comp.Version); err != nil {
+			logger.Debug("skipping OSV query for unversioned image", "component", comp.Name, "version", comp.Version)
+			continue
+		}
+		goVersion := "v" + strings.TrimPrefix(comp.Version, "v")
+
+		key := module + "@" + goVersion
+		vulns, ok := queried[key]
+		if !ok {
+			var err error
+			if vulns, err = osvLookup(ctx, httpClient, module, goVersion); err != nil {
+				return nil, err
+			}
+			queried[key] = vulns
+		}
+
+		for _, vuln := range vulns {
+			fixed := osvFixed(vuln, module, goVersion)
+			if !strings.HasPrefix(comp.Version, "v") {
+				// Match the image's tag style
+				fixed = strings.TrimPrefix(fixed, "v")
+			}
+			severity := strings.ToLower(vuln.DatabaseSpecific.Severity)
+			if severity == "" {
+				severity = "unknown"
+			}
+			if severity == "moderate" {
+				severity = "medium"
+			}
+			findings = append(findings, Finding{
+				Advisory:  vuln.ID,
+				Aliases:   strings.Join(vuln.Aliases, ", "),
+				Severity:  severity,
+				Summary:   vuln.Summary,
+				Component: comp.Name,
+				Namespace: comp.Namespace,
+				Version:   comp.Version,
+				Fixed:     fixed,
+				Upgrade:   upgradeCommand(comp, fixed),
+				Source:    "osv",
+			})
+		}
+	}
+	return findings, nil
+}
+
+func osvLookup(ctx context.Context, httpClient *http.Client, module, version string) ([]osvVuln, error) {
+	body, err := json.Marshal(osvQuery{
+		Package: osvPackage{Name: module, Ecosystem: "Go"},
+		Version: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encoding OSV query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, osvQueryURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("querying OSV: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("querying OSV: status %d", resp.StatusCode)
+	}
+
+	var result osvResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("parsing OSV response: %w", err)
+	}
+	return result.Vulns, nil
+}
+
+// osvFixed returns the lowest fixed version above version for the module
+func osvFixed(vuln osvVuln, module, version string) string {
+	best := ""
+	for _, aff := range vuln.Affected {
+		if aff.Package.Name != module {
+			continue
+		}
+		for _, r := range aff.Ranges {
+			for _, e := range r.Events {
+				if e.Fixed == "" {
+					continue
+				}
+				if cmp, err := compareVersions(e.Fixed, version); err != nil || cmp <= 0 {
+					continue
+				}
+				if best == "" {
+					best = e.Fixed
+					continue
+				}
+				if cmp, err := compareVersions(e.Fixed, best); err == nil && cmp < 0 {
+					best = e.Fixed
+				}
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package advisories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// version is a parsed semantic version; build metadata is ignored
+type version struct {
+	parts      [3]int
+	prerelease string
+}
+
+func parseVersion(s string) (version, error) {
+	var v version
+	core := strings.TrimPrefix(s, "v")
+	core, _, _ = strings.Cut(core, "+")
+	core, v.prerelease, _ = strings.Cut(core, "-")
+
+	fields := strings.Split(core, ".")
+	if len(fields) == 0 || len(fields) > 3 {
+		return v, fmt.Errorf("invalid version %q", s)
+	}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return v, fmt.Errorf("invalid version %q", s)
+		}
+		v.parts[i] = n
+	}
+	return v, nil
+}
+
+// compare returns -1, 0 or 1. A prerelease sorts before its release.
+func (v version) compare(o version) int {
+	for i := range v.parts {
+		if v.parts[i] != o.parts[i] {
+			if v.parts[i] < o.parts[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case v.prerelease == o.prerelease:
+		return 0
+	case v.prerelease == "":
+		return 1
+	case o.prerelease == "":
+		return -1
+	case v.prerelease < o.prerelease:
+		return -1
+	}
+	return 1
+}
+
+// compareVersions compares two version strings
+func compareVersions(a, b string) (int, error) {
+	va, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	return va.compare(vb), nil
+}
+
+// inRange reports whether v is within [introduced, fixed); empty bounds are open
+func inRange(v, introduced, fixed string) (bool, error) {
+	if introduced != "" && introduced != "0" {
+		cmp, err := compareVersions(v, introduced)
+		if err != nil {
+			return false, err
+		}
+		if cmp < 0 {
+			return false, nil
+		}
+	}
+	if fixed != "" {
+		cmp, err := compareVersions(v, fixed)
+		if err != nil {
+			return false, err
+		}
+		if cmp >= 0 {
+			return false, nil
+		}
+	}
+	if introduced == "" && fixed == "" {
+		// Validate the version even when every version is affected
+		if _, err := parseVersion(v); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
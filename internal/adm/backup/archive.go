@@ -0,0 +1,355 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/secretcrypt"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// manifestFile is the archive entry describing the backup
+	manifestFile = "manifest.json"
+
+	// resourcesDir holds one YAML file per backed-up object
+	resourcesDir = "resources"
+
+	// secretsDir holds the referenced Secrets, encrypted, when the backup
+	// includes them
+	secretsDir = "secrets"
+
+	// clusterScopedDir stands in for the namespace of cluster-scoped objects
+	clusterScopedDir = "_cluster"
+)
+
+var configMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+// backupResource is a kind of object included in backups
+type backupResource struct {
+	kind string
+	gvr  schema.GroupVersionResource
+
+	// namespace and name narrow the backup to a single object
+	namespace string
+	name      string
+}
+
+// backupResources are backed up in this order, which is also the order a
+// restore recreates them in: configuration before the clusters using it
+var backupResources = []backupResource{
+	{kind: "ConfigMap", gvr: configMapGVR, namespace: platform.ConfigMapNamespace, name: platform.ConfigMapName},
+	{kind: "ButlerConfig", gvr: client.ButlerConfigGVR},
+	{kind: "ProviderConfig", gvr: client.ProviderConfigGVR},
+	{kind: "ClusterBootstrap", gvr: client.ClusterBootstrapGVR},
+	{kind: "Team", gvr: client.TeamGVR},
+	{kind: "User", gvr: client.UserGVR},
+	{kind: "TenantCluster", gvr: client.TenantClusterGVR},
+}
+
+// Manifest describes a backup archive
+type Manifest struct {
+	Name      string    `json:"name"`
+	Cluster   string    `json:"cluster"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Resources counts the objects backed up per kind
+	Resources map[string]int `json:"resources"`
+
+	// Secrets lists the Secrets (namespace/name) the resources reference.
+	// Their contents are only backed up with SecretsEncryption set;
+	// otherwise they must be recreated by hand.
+	Secrets []string `json:"secrets,omitempty"`
+
+	// SecretsEncryption is the format of the Secrets under secrets/,
+	// sealed or sops, or empty when they are not included
+	SecretsEncryption string `json:"secretsEncryption,omitempty"`
+}
+
+// createArchive reads the platform resources and packs them into a
+// gzipped tar archive. With an Encrypter the Secrets they reference are
+// included, encrypted.
+func createArchive(ctx context.Context, c *client.Client, logger *log.Logger, enc *secretcrypt.Encrypter, cluster string, now time.Time) ([]byte, *Manifest, error) {
+	manifest := &Manifest{
+		Name:      archiveName(cluster, now),
+		Cluster:   cluster,
+		CreatedAt: now.UTC(),
+		Resources: map[string]int{},
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	secrets := map[string]bool{}
+
+	for _, res := range backupResources {
+		objects, err := readResource(ctx, c, res)
+		if errors.IsNotFound(err) {
+			// CRD not installed or object absent; nothing to back up
+			logger.Debug("skipping resource", "kind", res.kind, "error", err)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i := range objects {
+			obj := &objects[i]
+			for _, ref := range secretRefs(obj) {
+				secrets[ref] = true
+			}
+			cleanObject(obj)
+
+			data, err := yaml.Marshal(obj.Object)
+			if err != nil {
+				return nil, nil, fmt.Errorf("encoding %s %s: %w", res.kind, obj.GetName(), err)
+			}
+			if err := writeEntry(tw, objectPath(res.gvr, obj), data, now); err != nil {
+				return nil, nil, err
+			}
+			manifest.Resources[res.kind]++
+		}
+	}
+
+	for ref := range secrets {
+		manifest.Secrets = append(manifest.Secrets, ref)
+	}
+	sort.Strings(manifest.Secrets)
+
+	if enc != nil {
+		if err := writeSecrets(ctx, c, logger, enc, tw, manifest.Secrets, now); err != nil {
+			return nil, nil, err
+		}
+		manifest.SecretsEncryption = enc.Format()
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("encoding manifest: %w", err)
+	}
+	if err := writeEntry(tw, manifestFile, data, now); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, nil, fmt.Errorf("writing archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, nil, fmt.Errorf("compressing archive: %w", err)
+	}
+	return buf.Bytes(), manifest, nil
+}
+
+// readResource lists the objects of a backed-up kind
+func readResource(ctx context.Context, c *client.Client, res backupResource) ([]unstructured.Unstructured, error) {
+	if res.name != "" {
+		obj, err := c.Dynamic.Resource(res.gvr).Namespace(res.namespace).Get(ctx, res.name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return []unstructured.Unstructured{*obj}, nil
+	}
+
+	list, err := c.Dynamic.Resource(res.gvr).Namespace(res.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing %ss: %w", res.kind, err)
+	}
+	return list.Items, nil
+}
+
+// cleanObject drops server-populated metadata that would make the object
+// fail to apply on restore
+func cleanObject(obj *unstructured.Unstructured) {
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "selfLink", "ownerReferences"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations["kubectl.kubernetes.io/last-applied-configuration"]; ok {
+		delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+		obj.SetAnnotations(annotations)
+	}
+}
+
+// secretRefs returns the Secrets an object points at, as namespace/name:
+// a provider's spec.credentialsRef and a cluster's status.kubeconfigSecretRef
+func secretRefs(obj *unstructured.Unstructured) []string {
+	var refs []string
+	for _, field := range [][]string{{"spec", "credentialsRef"}, {"status", "kubeconfigSecretRef"}} {
+		name, _, _ := unstructured.NestedString(obj.Object, append(field, "name")...)
+		if name == "" {
+			continue
+		}
+		namespace, _, _ := unstructured.NestedString(obj.Object, append(field, "namespace")...)
+		if namespace == "" {
+			namespace = obj.GetNamespace()
+		}
+		refs = append(refs, namespace+"/"+name)
+	}
+	return refs
+}
+
+// writeSecrets encrypts the referenced Secrets into the archive. Missing
+// ones are skipped.
+func writeSecrets(ctx context.Context, c *client.Client, logger *log.Logger, enc *secretcrypt.Encrypter, tw *tar.Writer, refs []string, now time.Time) error {
+	for _, ref := range refs {
+		namespace, name, _ := strings.Cut(ref, "/")
+		secret, err := c.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			logger.Warn("referenced Secret not found, skipping", "secretRef", ref)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("getting Secret %s: %w", ref, err)
+		}
+
+		data, err := enc.Encrypt(ctx, secret)
+		if err != nil {
+			return err
+		}
+		if err := writeEntry(tw, secretPath(namespace, name), data, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// secretPath is the archive entry for an encrypted Secret
+func secretPath(namespace, name string) string {
+	return path.Join(secretsDir, namespace, name+".yaml")
+}
+
+// objectPath is the archive entry for an object
+func objectPath(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string {
+	namespace := obj.GetNamespace()
+	if namespace == "" {
+		namespace = clusterScopedDir
+	}
+	return path.Join(resourcesDir, gvr.Resource, namespace, obj.GetName()+".yaml")
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	return nil
+}
+
+// archive is an unpacked backup
+type archive struct {
+	manifest *Manifest
+	entries  map[string][]byte
+}
+
+// readArchive unpacks a backup archive
+func readArchive(data []byte) (*archive, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	defer gz.Close()
+
+	a := &archive{entries: map[string][]byte{}}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", hdr.Name, err)
+		}
+		a.entries[hdr.Name] = data
+	}
+
+	raw, ok := a.entries[manifestFile]
+	if !ok {
+		return nil, fmt.Errorf("not a Butler backup: %s is missing", manifestFile)
+	}
+	a.manifest = &Manifest{}
+	if err := json.Unmarshal(raw, a.manifest); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", manifestFile, err)
+	}
+	return a, nil
+}
+
+// hasSecret reports whether the backup holds an encrypted copy of a Secret
+func (a *archive) hasSecret(ref string) bool {
+	if a.manifest.SecretsEncryption == "" {
+		return false
+	}
+	namespace, name, _ := strings.Cut(ref, "/")
+	_, ok := a.entries[secretPath(namespace, name)]
+	return ok
+}
+
+// find returns the backed-up objects of a resource with the given name. An
+// empty namespace matches every namespace.
+func (a *archive) find(gvr schema.GroupVersionResource, namespace, name string) ([]*unstructured.Unstructured, error) {
+	pattern := path.Join(resourcesDir, gvr.Resource, "*", name+".yaml")
+	if namespace != "" {
+		pattern = path.Join(resourcesDir, gvr.Resource, namespace, name+".yaml")
+	}
+
+	var keys []string
+	for key := range a.entries {
+		if ok, _ := path.Match(pattern, key); ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var objects []*unstructured.Unstructured
+	for _, key := range keys {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(a.entries[key], &obj.Object); err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", key, err)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backup implements butleradm backup commands.
+package backup
+
+import (
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+)
+
+// NewBackupCmd creates the backup parent command
+func NewBackupCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up management cluster state",
+		Long: `Back up the Butler resources on the management cluster.
+
+A backup is a compressed archive of the platform's custom resources
+(TenantClusters, ProviderConfigs, ClusterBootstraps, Teams, Users,
+ButlerConfigs) and the butler-platform ConfigMap. Secret contents are never
+included; the archive records which Secrets must be recreated.
+
+Backups are written to a backup location, by default ~/.butler/backups.
+'backup schedule' installs a CronJob that takes them automatically.
+'butleradm restore' re-creates a single TenantCluster from a backup.
+
+Commands:
+  create    Take a backup now
+  list      List restore points
+  prune     Delete old backups beyond a retention count
+  schedule  Take backups periodically from a CronJob
+
+Examples:
+  # Take a backup
+  butleradm backup create
+
+  # Every 6 hours, keeping the 14 most recent
+  butleradm backup schedule --every 6h --keep 14
+
+  # See what can be restored
+  butleradm backup list`,
+	}
+
+	cmd.AddCommand(newCreateCmd(logger))
+	cmd.AddCommand(newListCmd(logger))
+	cmd.AddCommand(newPruneCmd(logger))
+	cmd.AddCommand(newScheduleCmd(logger))
+
+	return cmd
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
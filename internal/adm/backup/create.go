@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/objectstore"
+	"github.com/butlerdotdev/butler/internal/common/secretcrypt"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultClusterName names backups when the management cluster has no
+// single ClusterBootstrap to take the name from
+const defaultClusterName = "butler"
+
+type createOptions struct {
+	kubeconfig string
+	to         string
+	cluster    string
+	keep       int
+	secrets    string
+	storage    objectstore.Options
+}
+
+func newCreateCmd(logger *log.Logger) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Take a backup now",
+		Long: `Back up the management cluster's Butler resources.
+
+The archive is named <cluster>-<timestamp>.tar.gz after the management
+cluster's ClusterBootstrap. With --keep, older backups of the same cluster
+beyond that count are pruned afterwards.
+
+--to takes a directory or an object storage location: s3://bucket/path for
+AWS S3 and MinIO (with --storage-endpoint), or gs://bucket/path for GCS with
+an HMAC key. Credentials come from the AWS_ACCESS_KEY_ID and
+AWS_SECRET_ACCESS_KEY environment variables or --storage-credentials-secret.
+
+Secrets the resources reference, such as provider credentials and adopted
+cluster kubeconfigs, are left out unless --include-secrets encrypts them:
+sealed converts them to SealedSecrets with kubeseal, which only this
+cluster's Sealed Secrets controller can open; sops encrypts them to the keys
+in secretEncryption.sops of the butler-platform ConfigMap or your own sops
+configuration. 'butleradm restore' recreates them when they are missing.
+
+Examples:
+  # Back up to ~/.butler/backups
+  butleradm backup create
+
+  # Back up to S3 with KMS encryption
+  butleradm backup create --to s3://acme-backups/butler --storage-sse aws:kms
+
+  # Back up to MinIO using credentials stored on the cluster
+  butleradm backup create --to s3://butler/backups \
+    --storage-endpoint https://minio.example.com \
+    --storage-credentials-secret butler-system/backup-storage
+
+  # Include provider credentials, encrypted with sops
+  butleradm backup create --include-secrets sops
+
+  # Back up to a mounted volume, keeping the last 7
+  butleradm backup create --to /mnt/backups --keep 7`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreate(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.to, "to", "", "backup location: a directory, s3://bucket/path or gs://bucket/path (default: ~/.butler/backups)")
+	cmd.Flags().StringVar(&opts.cluster, "cluster", "", "cluster name used in the archive name (default: from the ClusterBootstrap)")
+	cmd.Flags().IntVar(&opts.keep, "keep", 0, "prune this cluster's backups beyond the newest N (0: keep all)")
+	cmd.Flags().StringVar(&opts.secrets, "include-secrets", "", "include referenced Secrets, encrypted: sealed or sops")
+	opts.storage.AddFlags(cmd)
+
+	return cmd
+}
+
+func runCreate(ctx context.Context, logger *log.Logger, opts *createOptions) error {
+	if opts.keep < 0 {
+		return fmt.Errorf("--keep must not be negative")
+	}
+	if opts.secrets != "" {
+		if err := secretcrypt.Validate(opts.secrets); err != nil {
+			return err
+		}
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	if err := opts.storage.LoadCredentials(ctx, c); err != nil {
+		return err
+	}
+	store, err := openStore(ctx, opts.to, opts.kubeconfig, &opts.storage)
+	if err != nil {
+		return err
+	}
+
+	cluster := opts.cluster
+	if cluster == "" {
+		cluster = managementClusterName(ctx, c)
+	}
+
+	var enc *secretcrypt.Encrypter
+	if opts.secrets != "" {
+		enc, err = secretcrypt.New(ctx, c, opts.secrets)
+		if err != nil {
+			return err
+		}
+		defer enc.Close()
+	}
+
+	data, manifest, err := createArchive(ctx, c, logger, enc, cluster, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := store.Put(ctx, manifest.Name, data); err != nil {
+		return err
+	}
+
+	total := 0
+	for _, n := range manifest.Resources {
+		total += n
+	}
+	logger.Success("backup created", "name", manifest.Name, "location", store.String(), "objects", total, "size", formatSize(int64(len(data))))
+	if len(manifest.Secrets) > 0 && enc == nil {
+		logger.Info("referenced Secrets are not included; keep them backed up separately", "secrets", len(manifest.Secrets))
+	}
+
+	if opts.keep > 0 {
+		return prune(ctx, logger, store, cluster, opts.keep, false)
+	}
+	return nil
+}
+
+// managementClusterName names backups after the only ClusterBootstrap
+func managementClusterName(ctx context.Context, c *client.Client) string {
+	list, err := c.Dynamic.Resource(client.ClusterBootstrapGVR).List(ctx, metav1.ListOptions{})
+	if err != nil || len(list.Items) != 1 {
+		return defaultClusterName
+	}
+	return list.Items[0].GetName()
+}
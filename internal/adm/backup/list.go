@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/objectstore"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+)
+
+type listOptions struct {
+	kubeconfig   string
+	from         string
+	cluster      string
+	outputFormat string
+	storage      objectstore.Options
+}
+
+func newListCmd(logger *log.Logger) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List restore points",
+		Long: `List the backups in a backup location, newest first.
+
+Examples:
+  butleradm backup list
+  butleradm backup list --from /mnt/backups --cluster butler-prod -o json
+  butleradm backup list --from s3://acme-backups/butler`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig, for --storage-credentials-secret")
+	cmd.Flags().StringVar(&opts.from, "from", "", "backup location: a directory, s3://bucket/path or gs://bucket/path (default: ~/.butler/backups)")
+	cmd.Flags().StringVar(&opts.cluster, "cluster", "", "only backups of this cluster")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	opts.storage.AddFlags(cmd)
+
+	return cmd
+}
+
+func runList(ctx context.Context, logger *log.Logger, opts *listOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	store, err := openStore(ctx, opts.from, opts.kubeconfig, &opts.storage)
+	if err != nil {
+		return err
+	}
+
+	objects, err := listBackups(ctx, store)
+	if err != nil {
+		return err
+	}
+	objects = filterCluster(objects, opts.cluster)
+
+	if format == output.FormatJSON || format == output.FormatYAML {
+		if objects == nil {
+			objects = []Object{}
+		}
+		return output.NewPrinter(format, os.Stdout).Print(objects, nil)
+	}
+
+	if len(objects) == 0 {
+		logger.Info("no backups found", "location", store.String())
+		return nil
+	}
+
+	table := output.NewTable(os.Stdout, "NAME", "CLUSTER", "CREATED", "SIZE", "AGE")
+	for _, o := range objects {
+		table.AddRow(o.Name, o.Cluster, o.Created.Local().Format("2006-01-02 15:04"), formatSize(o.Size), output.FormatAge(o.Created))
+	}
+	return table.Flush()
+}
+
+type pruneOptions struct {
+	kubeconfig string
+	from       string
+	cluster    string
+	keep       int
+	dryRun     bool
+	storage    objectstore.Options
+}
+
+func newPruneCmd(logger *log.Logger) *cobra.Command {
+	opts := &pruneOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete old backups beyond a retention count",
+		Long: `Delete all but the newest --keep backups of each cluster.
+
+Only files named like Butler backups are considered, so a location shared
+with other data is safe to prune.
+
+Examples:
+  # Preview
+  butleradm backup prune --keep 14 --dry-run
+
+  # Prune one cluster's backups in a shared location
+  butleradm backup prune --from /mnt/backups --cluster butler-prod --keep 14`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.keep < 1 {
+				return fmt.Errorf("--keep must be at least 1")
+			}
+			store, err := openStore(cmd.Context(), opts.from, opts.kubeconfig, &opts.storage)
+			if err != nil {
+				return err
+			}
+			return prune(cmd.Context(), logger, store, opts.cluster, opts.keep, opts.dryRun)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig, for --storage-credentials-secret")
+	cmd.Flags().StringVar(&opts.from, "from", "", "backup location: a directory, s3://bucket/path or gs://bucket/path (default: ~/.butler/backups)")
+	cmd.Flags().StringVar(&opts.cluster, "cluster", "", "only prune backups of this cluster (default: every cluster)")
+	cmd.Flags().IntVar(&opts.keep, "keep", 0, "number of backups to keep per cluster (required)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "show what would be deleted")
+	opts.storage.AddFlags(cmd)
+	cmd.MarkFlagRequired("keep")
+
+	return cmd
+}
+
+// prune deletes each cluster's backups beyond the newest keep. An empty
+// cluster prunes every cluster in the location.
+func prune(ctx context.Context, logger *log.Logger, store objectstore.Store, cluster string, keep int, dryRun bool) error {
+	objects, err := listBackups(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	expired := expiredBackups(filterCluster(objects, cluster), keep)
+	if len(expired) == 0 {
+		logger.Info("nothing to prune", "keep", keep)
+		return nil
+	}
+
+	for _, o := range expired {
+		if dryRun {
+			logger.Info("would delete", "name", o.Name, "age", output.FormatAge(o.Created))
+			continue
+		}
+		if err := store.Delete(ctx, o.Name); err != nil {
+			return err
+		}
+		logger.Info("deleted", "name", o.Name)
+	}
+
+	if dryRun {
+		logger.Info("dry run complete", "wouldDelete", len(expired), "keep", keep)
+	} else {
+		logger.Success("pruned backups", "deleted", len(expired), "keep", keep)
+	}
+	return nil
+}
+
+// expiredBackups returns the backups beyond the newest keep of each
+// cluster. objects must be sorted newest first.
+func expiredBackups(objects []Object, keep int) []Object {
+	var expired []Object
+	seen := map[string]int{}
+	for _, o := range objects {
+		seen[o.Cluster]++
+		if seen[o.Cluster] > keep {
+			expired = append(expired, o)
+		}
+	}
+	return expired
+}
+
+func filterCluster(objects []Object, cluster string) []Object {
+	if cluster == "" {
+		return objects
+	}
+	var out []Object
+	for _, o := range objects {
+		if o.Cluster == cluster {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// formatSize renders a byte count for humans
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
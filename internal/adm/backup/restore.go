@@ -0,0 +1,419 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/objectstore"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/common/secretcrypt"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+type restoreOptions struct {
+	kubeconfig string
+	from       string
+	backup     string
+	cluster    string
+	namespace  string
+	dryRun     bool
+	yes        bool
+	storage    objectstore.Options
+}
+
+// NewRestoreCmd creates the restore command
+func NewRestoreCmd(logger *log.Logger) *cobra.Command {
+	opts := &restoreOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a tenant cluster definition from a backup",
+		Long: `Re-create one TenantCluster from a platform backup.
+
+Only the named cluster is touched. Before anything changes, the backed-up
+definition is compared with any live TenantCluster of the same name and the
+differences are shown. Status is not restored, except the kubeconfig Secret
+reference of adopted clusters, which no controller can rebuild.
+
+Restore checks that the kubeconfig Secret the cluster references exists.
+A missing one is recreated when the backup was taken with
+--include-secrets: a SealedSecret is applied for the controller to unseal,
+and a sops-encrypted Secret is decrypted locally with sops first. Otherwise
+restore warns that it has to be recreated by hand.
+
+By default the newest backup of this management cluster is used.
+
+Examples:
+  # Preview restoring a deleted cluster
+  butleradm restore --cluster my-app --dry-run
+
+  # Restore from a specific backup in S3
+  butleradm restore --cluster my-app -n team-a \\
+    --from s3://acme-backups/butler --backup butler-prod-20260101-060000.tar.gz`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRestore(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.from, "from", "", "backup location: a directory, s3://bucket/path or gs://bucket/path (default: ~/.butler/backups)")
+	cmd.Flags().StringVar(&opts.backup, "backup", "", "backup archive to restore from (default: the newest)")
+	cmd.Flags().StringVar(&opts.cluster, "cluster", "", "TenantCluster to restore (required)")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "namespace of the TenantCluster (default: wherever the backup has it)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "show the differences without restoring")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "skip the confirmation prompt")
+	opts.storage.AddFlags(cmd)
+	cmd.MarkFlagRequired("cluster")
+
+	return cmd
+}
+
+func runRestore(ctx context.Context, logger *log.Logger, opts *restoreOptions) error {
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+	if err := opts.storage.LoadCredentials(ctx, c); err != nil {
+		return err
+	}
+	store, err := openStore(ctx, opts.from, opts.kubeconfig, &opts.storage)
+	if err != nil {
+		return err
+	}
+
+	name := opts.backup
+	if name == "" {
+		name, err = latestBackup(ctx, store, managementClusterName(ctx, c))
+		if err != nil {
+			return err
+		}
+	}
+	data, err := store.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	a, err := readArchive(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	desired, err := findCluster(a, opts.namespace, opts.cluster)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	namespace := desired.GetNamespace()
+	logger.Info("restoring from backup", "backup", name, "taken", a.manifest.CreatedAt.Local().Format("2006-01-02 15:04"), "cluster", namespace+"/"+opts.cluster)
+
+	backedUpStatus, _, _ := unstructured.NestedMap(desired.Object, "status")
+	unstructured.RemoveNestedField(desired.Object, "status")
+
+	resource := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace)
+	live, err := resource.Get(ctx, opts.cluster, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		live = nil
+	} else if err != nil {
+		return fmt.Errorf("getting TenantCluster %s/%s: %w", namespace, opts.cluster, err)
+	}
+
+	changed, err := printRestoreDiff(live, desired)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		logger.Success("live cluster already matches the backup", "cluster", namespace+"/"+opts.cluster)
+		return nil
+	}
+
+	missing := missingSecrets(ctx, c, desired.GetNamespace(), backedUpStatus)
+	var recreate []string
+	for _, ref := range missing {
+		if a.hasSecret(ref) {
+			logger.Info("referenced Secret does not exist and will be recreated from the backup", "secretRef", ref, "encryption", a.manifest.SecretsEncryption)
+			recreate = append(recreate, ref)
+			continue
+		}
+		logger.Warn("referenced Secret does not exist; recreate it from your Secret backups", "secretRef", ref)
+	}
+
+	if opts.dryRun {
+		logger.Info("dry run: nothing restored")
+		return nil
+	}
+	if !opts.yes {
+		ok, err := prompt.Confirm("\nRestore " + namespace + "/" + opts.cluster + "?")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("restore cancelled")
+		}
+	}
+
+	for _, ref := range recreate {
+		if err := restoreSecret(ctx, c, a, ref); err != nil {
+			return err
+		}
+		logger.Success("Secret recreated", "secretRef", ref)
+	}
+
+	var restored *unstructured.Unstructured
+	if live == nil {
+		restored, err = resource.Create(ctx, desired, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("creating TenantCluster: %w", err)
+		}
+	} else {
+		desired.SetResourceVersion(live.GetResourceVersion())
+		desired.SetFinalizers(live.GetFinalizers())
+		restored, err = resource.Update(ctx, desired, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("updating TenantCluster: %w", err)
+		}
+	}
+
+	if lifecycle.Adopted(desired.GetAnnotations()) && len(missing) == len(recreate) {
+		if err := restoreAdoptedStatus(ctx, c, restored, backedUpStatus); err != nil {
+			return err
+		}
+	}
+
+	logger.Success("cluster restored", "cluster", namespace+"/"+opts.cluster, "backup", name)
+	return nil
+}
+
+// latestBackup returns the newest backup of a cluster
+func latestBackup(ctx context.Context, store objectstore.Store, cluster string) (string, error) {
+	backups, err := listBackups(ctx, store)
+	if err != nil {
+		return "", err
+	}
+	for _, b := range backups {
+		if b.Cluster == cluster {
+			return b.Name, nil
+		}
+	}
+	if len(backups) > 0 {
+		return "", fmt.Errorf("no backups of %s in %s; choose one with --backup (see 'butleradm backup list')", cluster, store)
+	}
+	return "", fmt.Errorf("no backups in %s", store)
+}
+
+// findCluster returns the one backed-up TenantCluster matching the name
+func findCluster(a *archive, namespace, name string) (*unstructured.Unstructured, error) {
+	found, err := a.find(client.TenantClusterGVR, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("TenantCluster %q is not in the backup", name)
+	case 1:
+		return found[0], nil
+	}
+	var namespaces []string
+	for _, obj := range found {
+		namespaces = append(namespaces, obj.GetNamespace())
+	}
+	return nil, fmt.Errorf("TenantCluster %q is in several namespaces (%s); choose one with --namespace", name, strings.Join(namespaces, ", "))
+}
+
+// printRestoreDiff shows how restoring changes the live object, and
+// reports whether it does
+func printRestoreDiff(live, desired *unstructured.Unstructured) (bool, error) {
+	after, err := yaml.Marshal(desired.Object)
+	if err != nil {
+		return false, fmt.Errorf("encoding backup: %w", err)
+	}
+
+	var before []byte
+	if live != nil {
+		current := live.DeepCopy()
+		cleanObject(current)
+		unstructured.RemoveNestedField(current.Object, "status")
+		before, err = yaml.Marshal(current.Object)
+		if err != nil {
+			return false, fmt.Errorf("encoding live cluster: %w", err)
+		}
+	}
+
+	if live == nil {
+		fmt.Fprintf(os.Stderr, "\n%s does not exist and will be created:\n\n", output.Bold(desired.GetNamespace()+"/"+desired.GetName()))
+	} else if string(before) == string(after) {
+		return false, nil
+	} else {
+		fmt.Fprintf(os.Stderr, "\nChanges to live %s:\n\n", output.Bold(desired.GetNamespace()+"/"+desired.GetName()))
+	}
+
+	for _, line := range diffLines(splitLines(before), splitLines(after)) {
+		switch line[0] {
+		case '-':
+			fmt.Fprintln(os.Stderr, output.Danger(line))
+		case '+':
+			fmt.Fprintln(os.Stderr, output.Success(line))
+		default:
+			fmt.Fprintln(os.Stderr, output.Dim(line))
+		}
+	}
+	return true, nil
+}
+
+// missingSecrets returns the Secrets referenced by a backed-up cluster's
+// status that don't exist
+func missingSecrets(ctx context.Context, c *client.Client, namespace string, status map[string]interface{}) []string {
+	name, _, _ := unstructured.NestedString(status, "kubeconfigSecretRef", "name")
+	if name == "" {
+		return nil
+	}
+	if ns, _, _ := unstructured.NestedString(status, "kubeconfigSecretRef", "namespace"); ns != "" {
+		namespace = ns
+	}
+	_, err := c.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []string{namespace + "/" + name}
+	}
+	return nil
+}
+
+// restoreSecret recreates a Secret from its encrypted copy in the backup
+func restoreSecret(ctx context.Context, c *client.Client, a *archive, ref string) error {
+	namespace, name, _ := strings.Cut(ref, "/")
+	data := a.entries[secretPath(namespace, name)]
+
+	if a.manifest.SecretsEncryption == secretcrypt.Sealed {
+		sealed := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &sealed.Object); err != nil {
+			return fmt.Errorf("decoding SealedSecret %s: %w", ref, err)
+		}
+		if _, err := c.Dynamic.Resource(client.SealedSecretGVR).Namespace(namespace).Create(ctx, sealed, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating SealedSecret %s: %w", ref, err)
+		}
+		return nil
+	}
+
+	plain, err := secretcrypt.Decrypt(ctx, data)
+	if err != nil {
+		return fmt.Errorf("decrypting Secret %s: %w", ref, err)
+	}
+	secret := &corev1.Secret{}
+	if err := yaml.Unmarshal(plain, secret); err != nil {
+		return fmt.Errorf("decoding Secret %s: %w", ref, err)
+	}
+	if _, err := c.Clientset.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("creating Secret %s: %w", ref, err)
+	}
+	return nil
+}
+
+// restoreAdoptedStatus puts back the status of an adopted cluster, whose
+// kubeconfig reference was recorded at adoption and isn't reconciled
+func restoreAdoptedStatus(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, status map[string]interface{}) error {
+	if len(status) == 0 {
+		return nil
+	}
+	if err := unstructured.SetNestedMap(tc.Object, status, "status"); err != nil {
+		return fmt.Errorf("setting status: %w", err)
+	}
+	if _, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(tc.GetNamespace()).UpdateStatus(ctx, tc, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("restoring cluster status: %w", err)
+	}
+	return nil
+}
+
+// diffContext is the number of unchanged lines shown around a change
+const diffContext = 3
+
+// diffLines returns a line diff of a and b: removed lines start with "- ",
+// added with "+ " and unchanged context with "  ". Runs of unchanged lines
+// away from any change are elided.
+func diffLines(a, b []string) []string {
+	// Longest common subsequence table
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, "  "+a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "- "+a[i])
+			i++
+		default:
+			lines = append(lines, "+ "+b[j])
+			j++
+		}
+	}
+
+	// Keep only context near changes
+	keep := make([]bool, len(lines))
+	for n, line := range lines {
+		if line[0] == ' ' {
+			continue
+		}
+		for k := max(0, n-diffContext); k <= min(len(lines)-1, n+diffContext); k++ {
+			keep[k] = true
+		}
+	}
+	var out []string
+	elided := false
+	for n, line := range lines {
+		if keep[n] {
+			out = append(out, line)
+			elided = false
+		} else if !elided {
+			out = append(out, "  ...")
+			elided = true
+		}
+	}
+	return out
+}
+
+func splitLines(data []byte) []string {
+	s := strings.TrimSuffix(string(data), "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,499 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/objectstore"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// scheduleName names the CronJob, its ServiceAccount and RBAC
+	scheduleName = "butler-backup"
+
+	// volumeName is the PersistentVolumeClaim scheduled backups are kept on
+	volumeName = "butler-backups"
+
+	// volumeMountPath is where the backup volume is mounted in the Job
+	volumeMountPath = "/backups"
+
+	// fieldManager is the server-side apply field manager for scheduled
+	// backup resources
+	fieldManager = "butleradm"
+
+	// DefaultImage runs scheduled backups
+	DefaultImage = "ghcr.io/butlerdotdev/butleradm:latest"
+
+	// backupUID is the non-root user the backup Job runs as
+	backupUID = 65532
+)
+
+type scheduleOptions struct {
+	kubeconfig   string
+	every        time.Duration
+	keep         int
+	image        string
+	size         string
+	storageClass string
+	remove       bool
+	yes          bool
+	to           string
+	storage      objectstore.Options
+}
+
+func newScheduleCmd(logger *log.Logger) *cobra.Command {
+	opts := &scheduleOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Take backups periodically from a CronJob",
+		Long: `Install a CronJob on the management cluster that takes backups.
+
+The CronJob runs 'butleradm backup create --keep N' in butler-system with a
+read-only ServiceAccount, writing to the butler-backups PersistentVolumeClaim,
+or with --to to object storage. Running it again updates the schedule.
+Without flags, shows the current schedule.
+
+Object storage credentials come from --storage-credentials-secret, a Secret
+in butler-system with accessKeyID and secretAccessKey keys, passed to the
+Job as environment variables.
+
+--every must divide evenly into an hour or a day (e.g. 15m, 6h, 24h), or be
+a whole number of days.
+
+--remove deletes the CronJob and its RBAC but keeps the volume, and the
+backups on it.
+
+Examples:
+  # Every 6 hours, keeping 14 restore points
+  butleradm backup schedule --every 6h --keep 14
+
+  # Back up to S3 instead of a volume
+  butleradm backup schedule --every 6h --keep 14 --to s3://acme-backups/butler \
+    --storage-credentials-secret butler-system/backup-storage --storage-sse AES256
+
+  # Show the current schedule
+  butleradm backup schedule
+
+  # Stop scheduled backups
+  butleradm backup schedule --remove`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSchedule(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().DurationVar(&opts.every, "every", 0, "interval between backups, e.g. 6h")
+	cmd.Flags().IntVar(&opts.keep, "keep", 14, "number of backups to retain")
+	cmd.Flags().StringVar(&opts.image, "image", DefaultImage, "butleradm image the CronJob runs")
+	cmd.Flags().StringVar(&opts.size, "size", "10Gi", "size of the backup volume")
+	cmd.Flags().StringVar(&opts.storageClass, "storage-class", "", "StorageClass of the backup volume (default: the cluster default)")
+	cmd.Flags().BoolVar(&opts.remove, "remove", false, "remove the backup schedule")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "skip the confirmation prompt for --remove")
+	cmd.Flags().StringVar(&opts.to, "to", "", "back up to s3://bucket/path or gs://bucket/path instead of a volume")
+	opts.storage.AddFlags(cmd)
+
+	return cmd
+}
+
+func runSchedule(ctx context.Context, logger *log.Logger, opts *scheduleOptions) error {
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	switch {
+	case opts.remove:
+		return removeSchedule(ctx, c, logger, opts.yes)
+	case opts.every == 0:
+		return showSchedule(ctx, c, logger)
+	}
+
+	if opts.keep < 1 {
+		return fmt.Errorf("--keep must be at least 1")
+	}
+	cron, err := cronSchedule(opts.every)
+	if err != nil {
+		return err
+	}
+	size, err := resource.ParseQuantity(opts.size)
+	if err != nil {
+		return fmt.Errorf("invalid --size %q: %w", opts.size, err)
+	}
+	if opts.to != "" {
+		if err := validateRemoteTarget(opts); err != nil {
+			return err
+		}
+	}
+
+	for _, obj := range scheduleObjects(opts, cron, size) {
+		if err := applyObject(ctx, c, obj); err != nil {
+			return err
+		}
+	}
+
+	logger.Success("backup schedule installed", "every", opts.every, "cron", cron, "keep", opts.keep, "namespace", platform.ConfigMapNamespace)
+	return nil
+}
+
+// validateRemoteTarget checks that a scheduled Job can reach --to: it must
+// be object storage, with credentials it can read from its own namespace
+func validateRemoteTarget(opts *scheduleOptions) error {
+	if !objectstore.Remote(opts.to) {
+		return fmt.Errorf("--to must be s3:// or gs:// object storage; without it backups go to the %s volume", volumeName)
+	}
+	if err := opts.storage.Validate(); err != nil {
+		return err
+	}
+	if opts.storage.CredentialsSecret == "" {
+		return fmt.Errorf("--storage-credentials-secret is required with --to")
+	}
+	namespace, _, _ := strings.Cut(opts.storage.CredentialsSecret, "/")
+	if namespace != platform.ConfigMapNamespace {
+		return fmt.Errorf("--storage-credentials-secret must be in %s, where the backup Job runs", platform.ConfigMapNamespace)
+	}
+	return nil
+}
+
+// showSchedule prints the installed CronJob's schedule and last run
+func showSchedule(ctx context.Context, c *client.Client, logger *log.Logger) error {
+	cj, err := c.Clientset.BatchV1().CronJobs(platform.ConfigMapNamespace).Get(ctx, scheduleName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		logger.Info("no backup schedule installed; set one with --every")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting CronJob %s: %w", scheduleName, err)
+	}
+
+	lastRun := "never"
+	if cj.Status.LastScheduleTime != nil {
+		lastRun = output.FormatAge(cj.Status.LastScheduleTime.Time) + " ago"
+	}
+	lastSuccess := "never"
+	if cj.Status.LastSuccessfulTime != nil {
+		lastSuccess = output.FormatAge(cj.Status.LastSuccessfulTime.Time) + " ago"
+	}
+	suspended := cj.Spec.Suspend != nil && *cj.Spec.Suspend
+
+	table := output.NewTable(os.Stdout, "SCHEDULE", "KEEP", "LAST RUN", "LAST SUCCESS", "SUSPENDED")
+	table.AddRow(cj.Spec.Schedule, orDash(cj.Annotations[keepAnnotation]), lastRun, lastSuccess, strconv.FormatBool(suspended))
+	return table.Flush()
+}
+
+// removeSchedule deletes the CronJob and its RBAC, keeping the volume
+func removeSchedule(ctx context.Context, c *client.Client, logger *log.Logger, yes bool) error {
+	if !yes {
+		ok, err := prompt.Confirm("Remove the backup schedule? Existing backups are kept.")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted")
+		}
+	}
+
+	ns := platform.ConfigMapNamespace
+	deletes := []struct {
+		kind string
+		fn   func() error
+	}{
+		{"CronJob", func() error {
+			propagation := metav1.DeletePropagationBackground
+			return c.Clientset.BatchV1().CronJobs(ns).Delete(ctx, scheduleName, metav1.DeleteOptions{PropagationPolicy: &propagation})
+		}},
+		{"ClusterRoleBinding", func() error {
+			return c.Clientset.RbacV1().ClusterRoleBindings().Delete(ctx, scheduleName, metav1.DeleteOptions{})
+		}},
+		{"ClusterRole", func() error {
+			return c.Clientset.RbacV1().ClusterRoles().Delete(ctx, scheduleName, metav1.DeleteOptions{})
+		}},
+		{"ServiceAccount", func() error {
+			return c.Clientset.CoreV1().ServiceAccounts(ns).Delete(ctx, scheduleName, metav1.DeleteOptions{})
+		}},
+	}
+	for _, d := range deletes {
+		if err := d.fn(); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("deleting %s %s: %w", d.kind, scheduleName, err)
+		}
+	}
+
+	logger.Success("backup schedule removed", "volume", ns+"/"+volumeName)
+	return nil
+}
+
+// cronSchedule converts an interval into a cron expression. Cron can only
+// express intervals that divide an hour or a day evenly, or whole days.
+func cronSchedule(every time.Duration) (string, error) {
+	const day = 24 * time.Hour
+	switch {
+	case every < time.Minute || every%time.Minute != 0:
+		return "", fmt.Errorf("invalid --every %s: must be a whole number of minutes", every)
+	case every%day == 0:
+		days := int(every / day)
+		if days == 1 {
+			return "0 0 * * *", nil
+		}
+		return fmt.Sprintf("0 0 */%d * *", days), nil
+	case every%time.Hour == 0 && day%every == 0:
+		hours := int(every / time.Hour)
+		if hours == 1 {
+			return "0 * * * *", nil
+		}
+		return fmt.Sprintf("0 */%d * * *", hours), nil
+	case every < time.Hour && time.Hour%every == 0:
+		return fmt.Sprintf("*/%d * * * *", int(every/time.Minute)), nil
+	}
+	return "", fmt.Errorf("invalid --every %s: must divide evenly into an hour or a day, or be whole days", every)
+}
+
+// keepAnnotation records --keep on the CronJob for display
+const keepAnnotation = "butler.butlerlabs.dev/backup-keep"
+
+// scheduledObject is a resource installed by backup schedule
+type scheduledObject struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+	obj       interface{ GetName() string }
+}
+
+// scheduleObjects builds the ServiceAccount, RBAC, volume and CronJob
+func scheduleObjects(opts *scheduleOptions, cron string, size resource.Quantity) []scheduledObject {
+	ns := platform.ConfigMapNamespace
+	labels := map[string]string{
+		"app.kubernetes.io/name":       scheduleName,
+		"app.kubernetes.io/managed-by": fieldManager,
+	}
+	meta := func(name, namespace string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}
+	}
+
+	sa := &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+		ObjectMeta: meta(scheduleName, ns),
+	}
+
+	role := &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+		ObjectMeta: meta(scheduleName, ""),
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{client.ButlerAPIGroup},
+				Resources: []string{"*"},
+				Verbs:     []string{"get", "list"},
+			},
+			{
+				APIGroups:     []string{""},
+				Resources:     []string{"configmaps"},
+				ResourceNames: []string{platform.ConfigMapName},
+				Verbs:         []string{"get"},
+			},
+		},
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding"},
+		ObjectMeta: meta(scheduleName, ""),
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     scheduleName,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      scheduleName,
+			Namespace: ns,
+		}},
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+		ObjectMeta: meta(volumeName, ns),
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+			},
+		},
+	}
+	if opts.storageClass != "" {
+		pvc.Spec.StorageClassName = &opts.storageClass
+	}
+
+	keep := strconv.Itoa(opts.keep)
+	cronMeta := meta(scheduleName, ns)
+	cronMeta.Annotations = map[string]string{keepAnnotation: keep}
+
+	container := corev1.Container{
+		Name:  "backup",
+		Image: opts.image,
+		Args:  []string{"backup", "create", "--to", volumeMountPath, "--keep", keep},
+		Env: []corev1.EnvVar{
+			{Name: prompt.EnvNonInteractive, Value: "true"},
+		},
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      "backups",
+			MountPath: volumeMountPath,
+		}},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptrTo(false),
+			ReadOnlyRootFilesystem:   ptrTo(true),
+			Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+		},
+	}
+	volumes := []corev1.Volume{{
+		Name: "backups",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: volumeName},
+		},
+	}}
+	if opts.to != "" {
+		container.Args = append([]string{"backup", "create", "--to", opts.to, "--keep", keep}, storageArgs(&opts.storage)...)
+		container.Env = append(container.Env, credentialsEnv(opts.storage.CredentialsSecret)...)
+		container.VolumeMounts = nil
+		volumes = nil
+	}
+
+	cronJob := &batchv1.CronJob{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+		ObjectMeta: cronMeta,
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   cron,
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: ptrTo[int32](3),
+			FailedJobsHistoryLimit:     ptrTo[int32](3),
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: batchv1.JobSpec{
+					BackoffLimit: ptrTo[int32](2),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: labels},
+						Spec: corev1.PodSpec{
+							ServiceAccountName: scheduleName,
+							RestartPolicy:      corev1.RestartPolicyOnFailure,
+							SecurityContext: &corev1.PodSecurityContext{
+								RunAsNonRoot:   ptrTo(true),
+								RunAsUser:      ptrTo[int64](backupUID),
+								FSGroup:        ptrTo[int64](backupUID),
+								SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+							},
+							Containers: []corev1.Container{container},
+							Volumes:    volumes,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	objects := []scheduledObject{
+		{gvr: corev1.SchemeGroupVersion.WithResource("serviceaccounts"), namespace: ns, obj: sa},
+		{gvr: rbacv1.SchemeGroupVersion.WithResource("clusterroles"), obj: role},
+		{gvr: rbacv1.SchemeGroupVersion.WithResource("clusterrolebindings"), obj: binding},
+	}
+	if opts.to == "" {
+		objects = append(objects, scheduledObject{gvr: corev1.SchemeGroupVersion.WithResource("persistentvolumeclaims"), namespace: ns, obj: pvc})
+	}
+	return append(objects, scheduledObject{gvr: batchv1.SchemeGroupVersion.WithResource("cronjobs"), namespace: ns, obj: cronJob})
+}
+
+// storageArgs passes the object storage settings on to the Job. Credentials
+// are passed through the environment instead.
+func storageArgs(o *objectstore.Options) []string {
+	var args []string
+	if o.Endpoint != "" {
+		args = append(args, "--storage-endpoint", o.Endpoint)
+	}
+	if o.Region != "" {
+		args = append(args, "--storage-region", o.Region)
+	}
+	if o.PathStyle {
+		args = append(args, "--storage-path-style")
+	}
+	if o.SSE != "" {
+		args = append(args, "--storage-sse", o.SSE)
+	}
+	if o.SSEKMSKeyID != "" {
+		args = append(args, "--storage-sse-kms-key-id", o.SSEKMSKeyID)
+	}
+	return args
+}
+
+// credentialsEnv maps the credentials Secret to the AWS environment
+// variables the Job reads
+func credentialsEnv(secretRef string) []corev1.EnvVar {
+	_, name, _ := strings.Cut(secretRef, "/")
+	ref := func(key string, optional bool) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  key,
+			Optional:             ptrTo(optional),
+		}}
+	}
+	return []corev1.EnvVar{
+		{Name: "AWS_ACCESS_KEY_ID", ValueFrom: ref(objectstore.SecretAccessKeyIDKey, false)},
+		{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: ref(objectstore.SecretSecretAccessKeyKey, false)},
+		{Name: "AWS_SESSION_TOKEN", ValueFrom: ref(objectstore.SecretSessionTokenKey, true)},
+	}
+}
+
+// applyObject creates or updates an object with server-side apply
+func applyObject(ctx context.Context, c *client.Client, o scheduledObject) error {
+	data, err := json.Marshal(o.obj)
+	if err != nil {
+		return fmt.Errorf("encoding %s %s: %w", o.gvr.Resource, o.obj.GetName(), err)
+	}
+	_, err = c.Dynamic.Resource(o.gvr).Namespace(o.namespace).Patch(ctx, o.obj.GetName(), types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: fieldManager, Force: ptrTo(true)})
+	if err != nil {
+		return fmt.Errorf("applying %s %s: %w", o.gvr.Resource, o.obj.GetName(), err)
+	}
+	return nil
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
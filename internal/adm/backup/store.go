@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/objectstore"
+)
+
+const (
+	// archiveSuffix is the file extension of backup archives
+	archiveSuffix = ".tar.gz"
+
+	// timestampLayout is the time format embedded in archive names
+	timestampLayout = "20060102-150405"
+)
+
+// Object is a backup archive held in a backup location
+type Object struct {
+	Name    string    `json:"name"`
+	Cluster string    `json:"cluster"`
+	Created time.Time `json:"created"`
+	Size    int64     `json:"size"`
+}
+
+// DefaultLocation is where backups go when no location is given
+func DefaultLocation() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "backups"
+	}
+	return filepath.Join(home, ".butler", "backups")
+}
+
+// openStore opens a backup location, reading object storage credentials
+// from the management cluster when a Secret is configured
+func openStore(ctx context.Context, location, kubeconfig string, storage *objectstore.Options) (objectstore.Store, error) {
+	if location == "" {
+		location = DefaultLocation()
+	}
+	if storage.CredentialsSecret != "" && storage.Credentials == nil {
+		c, err := getClient(kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to management cluster: %w", err)
+		}
+		if err := storage.LoadCredentials(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return objectstore.Open(location, storage)
+}
+
+// listBackups returns the backup archives in a location, newest first
+func listBackups(ctx context.Context, store objectstore.Store) ([]Object, error) {
+	objects, err := store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []Object
+	for _, o := range objects {
+		cluster, created, ok := parseArchiveName(o.Key)
+		if !ok {
+			continue
+		}
+		backups = append(backups, Object{Name: o.Key, Cluster: cluster, Created: created, Size: o.Size})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		if !backups[i].Created.Equal(backups[j].Created) {
+			return backups[i].Created.After(backups[j].Created)
+		}
+		return backups[i].Name < backups[j].Name
+	})
+	return backups, nil
+}
+
+// archiveName returns the archive name for a backup of cluster taken at t
+func archiveName(cluster string, t time.Time) string {
+	return cluster + "-" + t.UTC().Format(timestampLayout) + archiveSuffix
+}
+
+// parseArchiveName splits an archive name into its cluster and timestamp.
+// It returns false for files that aren't Butler backups.
+func parseArchiveName(name string) (string, time.Time, bool) {
+	base := strings.TrimSuffix(name, archiveSuffix)
+	if base == name || len(base) < len(timestampLayout)+2 {
+		return "", time.Time{}, false
+	}
+	cut := len(base) - len(timestampLayout)
+	if base[cut-1] != '-' {
+		return "", time.Time{}, false
+	}
+	t, err := time.Parse(timestampLayout, base[cut:])
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return base[:cut-1], t, true
+}
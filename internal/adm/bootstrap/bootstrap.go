@@ -18,7 +18,9 @@ limitations under the License.
 package bootstrap
 
 import (
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
 	"github.com/spf13/cobra"
 )
 
@@ -40,8 +42,23 @@ The bootstrap process:
 
 The management cluster runs on your infrastructure and becomes self-managing.
 
+--config - reads the config from stdin. The config may be followed by
+further YAML documents: Teams and ProviderConfigs (butler.butlerlabs.dev),
+created on the new management cluster once it is up, so a whole platform
+can be stood up from one file. ${VAR} and ${VAR:-fallback} references in
+the config are replaced from the environment, e.g. for credentials from CI.
+--profile deep-merges one of the config's profiles over it; see
+'butleradm config render'.
+Before provisioning, the vCPU, memory and disk to be consumed are checked
+against the provider's capacity; see 'butleradm bootstrap plan'.
+Each bootstrap gets its own KIND cluster, butler-bootstrap-<cluster>
+(shortened with a hash for long names), so bootstraps of different
+clusters can run at once; see 'butleradm bootstrap status'.
+
 Example:
-  butleradm bootstrap harvester --config bootstrap.yaml`,
+  butleradm bootstrap plan --config bootstrap.yaml
+  butleradm bootstrap harvester --config bootstrap.yaml
+  render-config | butleradm bootstrap harvester --config - --yes`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.Help()
 			return nil
@@ -51,7 +68,33 @@ Example:
 	// Register provider subcommands
 	cmd.AddCommand(NewHarvesterCmd(logger))
 	cmd.AddCommand(NewNutanixCmd(logger))
+	cmd.AddCommand(NewPlanCmd(logger))
+	cmd.AddCommand(NewStatusCmd(logger))
+	cmd.AddCommand(NewCleanupCmd(logger))
 	// TODO: Add proxmox commands
 
 	return cmd
 }
+
+// loadConfig reads the bootstrap config file, or stdin for "-", with a
+// profile applied. Stdin then carries the config rather than answers, so
+// prompts are turned off.
+func loadConfig(logger *log.Logger, configFile, profile string) (*orchestrator.Config, error) {
+	if configFile == "-" {
+		prompt.SetNonInteractive()
+	}
+	cfg, err := orchestrator.ReadConfig(configFile, profile)
+	if err != nil {
+		return nil, err
+	}
+	warnUnknownKeys(logger, cfg)
+	return cfg, nil
+}
+
+// warnUnknownKeys reports config keys that were ignored; --strict-config
+// turns them into an error before this point
+func warnUnknownKeys(logger *log.Logger, cfg *orchestrator.Config) {
+	for _, key := range cfg.UnknownKeys {
+		logger.Warn("unknown config key ignored; run 'butleradm config lint' for suggestions", "key", key)
+	}
+}
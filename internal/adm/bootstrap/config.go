@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
+	"github.com/butlerdotdev/butler/internal/common/configlint"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/paths"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/redact"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// NewConfigCmd creates the config command
+func NewConfigCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Work with bootstrap config files",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newRenderCmd(logger))
+	cmd.AddCommand(newSchemaCmd())
+	cmd.AddCommand(newLintCmd(logger))
+
+	return cmd
+}
+
+func newRenderCmd(logger *log.Logger) *cobra.Command {
+	var (
+		configFile string
+		profile    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Print the fully resolved bootstrap config",
+		Long: `Print a bootstrap config as 'butleradm bootstrap' will use it.
+
+${VAR} references are expanded from the environment and the --profile is
+deep-merged over the base config: maps merge key by key, lists and scalars
+are replaced. A profile can build on another with extends:
+
+  cluster:
+    controlPlane: {replicas: 1, cpu: 4, memoryMB: 8192}
+  profiles:
+    lab:
+      network: {vip: 10.40.0.201}
+    prod:
+      extends: lab
+      cluster:
+        controlPlane: {replicas: 3}
+
+The result is checked the same way bootstrap checks it. Credentials are
+redacted unless --show-secrets is given. Any Teams and ProviderConfigs
+that follow the config are printed after it.
+
+Examples:
+  butleradm config render --config bootstrap.yaml --profile prod
+
+  # Keep the resolved file for review
+  butleradm config render -c bootstrap.yaml --profile lab > resolved.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			values, resources, err := orchestrator.ResolveConfig(configFile, profile)
+			if err != nil {
+				return err
+			}
+			cfg, err := orchestrator.FromValues(values)
+			if err != nil {
+				return err
+			}
+			warnUnknownKeys(logger, cfg)
+
+			data, err := renderValues(redact.Value(values).(map[string]interface{}))
+			if err != nil {
+				return err
+			}
+			for _, res := range resources {
+				doc, err := yaml.Marshal(redact.Value(res.Object))
+				if err != nil {
+					return fmt.Errorf("encoding %s %s: %w", res.GetKind(), res.GetName(), err)
+				}
+				data = append(append(data, "---\n"...), doc...)
+			}
+			_, err = os.Stdout.Write(data)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "", "path to bootstrap config file, or - for stdin (required)")
+	cmd.Flags().StringVar(&profile, "profile", "", "config profile to apply over the base config")
+	cmd.MarkFlagRequired("config")
+
+	return cmd
+}
+
+// configSchemas are the JSON Schemas served by config schema and lint
+var configSchemas = map[string][]byte{
+	"bootstrap": orchestrator.Schema,
+	"platform":  platform.Schema,
+}
+
+func newSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema [bootstrap|platform]",
+		Short: "Print the JSON Schema of a config file",
+		Long: `Print the JSON Schema of the bootstrap config (the default) or of the
+platform config held in the butler-platform ConfigMap.
+
+Editors that use the YAML language server validate and complete a config
+file against the schema when it starts with a modeline:
+
+  # yaml-language-server: $schema=./bootstrap.schema.json
+
+Examples:
+  butleradm config schema > bootstrap.schema.json
+  butleradm config schema platform > platform.schema.json`,
+		Args:      cobra.MaximumNArgs(1),
+		ValidArgs: []string{"bootstrap", "platform"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := "bootstrap"
+			if len(args) > 0 {
+				name = args[0]
+			}
+			schema, err := lookupSchema(name)
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(schema)
+			return err
+		},
+	}
+
+	return cmd
+}
+
+func newLintCmd(logger *log.Logger) *cobra.Command {
+	var (
+		configFile string
+		schemaName string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check a config file for unknown or misplaced keys",
+		Long: `Check a config file against its JSON Schema.
+
+Unknown settings are otherwise ignored, so a misspelled key silently falls
+back to its default. lint reports every key the schema doesn't know, with
+the closest valid name, along with maps and lists in the wrong place and
+values outside a fixed set such as provider. Profiles are checked too.
+
+Exits non-zero if any problem is found.
+
+Examples:
+  butleradm config lint -c bootstrap.yaml
+
+  # Check the data of the butler-platform ConfigMap
+  butleradm config lint -c platform.yaml --schema platform`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schema, err := lookupSchema(schemaName)
+			if err != nil {
+				return err
+			}
+
+			var values map[string]interface{}
+			if schemaName == "bootstrap" {
+				values, _, err = orchestrator.ReadDocuments(configFile)
+			} else {
+				values, err = readPlatformConfig(configFile)
+			}
+			if err != nil {
+				return err
+			}
+
+			problems, err := configlint.Lint(schema, values)
+			if err != nil {
+				return err
+			}
+			for _, p := range problems {
+				fmt.Println(p)
+			}
+			if len(problems) > 0 {
+				return fmt.Errorf("%s: %d problem(s) found", configFile, len(problems))
+			}
+			logger.Success("config is valid", "file", configFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "", "path to the config file, or - for stdin (required)")
+	cmd.Flags().StringVar(&schemaName, "schema", "bootstrap", "schema to check against: bootstrap or platform")
+	cmd.MarkFlagRequired("config")
+
+	return cmd
+}
+
+func lookupSchema(name string) ([]byte, error) {
+	schema, ok := configSchemas[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema %q: must be bootstrap or platform", name)
+	}
+	return schema, nil
+}
+
+// readPlatformConfig reads a platform config file, the YAML stored under
+// the butler-platform ConfigMap's config.yaml key
+func readPlatformConfig(path string) (map[string]interface{}, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(paths.Expand(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("parsing config file: %w", err)
+	}
+	return values, nil
+}
+
+// renderValues writes config values in the usual bootstrap.yaml section
+// order, followed by any other keys
+func renderValues(values map[string]interface{}) ([]byte, error) {
+	order := map[string]int{}
+	for i, key := range exportSections {
+		order[key] = i + 1
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		oi, oj := order[keys[i]], order[keys[j]]
+		if oi == 0 || oj == 0 {
+			if oi != oj {
+				return oj == 0
+			}
+			return keys[i] < keys[j]
+		}
+		return oi < oj
+	})
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		out, err := yaml.Marshal(map[string]interface{}{key: values[key]})
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", key, err)
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
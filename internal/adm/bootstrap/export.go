@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// exportSections are the top-level bootstrap.yaml keys in file order
+var exportSections = []string{"provider", "cluster", "network", "talos", "addons", "providerConfig"}
+
+// providerCredentialFields are the bootstrap.yaml fields held in the
+// provider's credentials Secret rather than the ProviderConfig
+var providerCredentialFields = map[string][]string{
+	"harvester": {"kubeconfigPath"},
+	"nutanix":   {"username", "password"},
+	"proxmox":   {"username", "password"},
+}
+
+type exportConfigOptions struct {
+	kubeconfig string
+	name       string
+	outputFile string
+}
+
+// NewExportConfigCmd creates the export-config command
+func NewExportConfigCmd(logger *log.Logger) *cobra.Command {
+	opts := &exportConfigOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export-config",
+		Short: "Rebuild bootstrap.yaml from a running management cluster",
+		Long: `Generate a bootstrap config from a running management cluster.
+
+Reads the ClusterBootstrap and its ProviderConfig and writes a bootstrap.yaml
+that 'butleradm bootstrap' accepts, for disaster-recovery rebuilds when the
+original file is lost. The result is best effort: review it before use.
+
+Credentials are never exported. Fields stored in Secrets are replaced with
+a placeholder naming the Secret and key to copy them from. Settings the
+cluster doesn't record (imageVerification, hostAliases, console auth) are
+left out.
+
+Examples:
+  # Write the config for the current management cluster
+  butleradm export-config > bootstrap.yaml
+
+  # Pick a ClusterBootstrap when there are several
+  butleradm export-config --name butler-prod -f bootstrap-prod.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportConfig(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.name, "name", "", "ClusterBootstrap to export (default: the only one)")
+	cmd.Flags().StringVarP(&opts.outputFile, "file", "f", "", "write to a file instead of stdout")
+
+	return cmd
+}
+
+func runExportConfig(ctx context.Context, logger *log.Logger, opts *exportConfigOptions) error {
+	var c *client.Client
+	var err error
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	cb, err := findClusterBootstrap(ctx, c, opts.name)
+	if err != nil {
+		return err
+	}
+
+	var pc *unstructured.Unstructured
+	refName, _, _ := unstructured.NestedString(cb.Object, "spec", "providerRef", "name")
+	refNamespace, _, _ := unstructured.NestedString(cb.Object, "spec", "providerRef", "namespace")
+	if refName != "" {
+		if refNamespace == "" {
+			refNamespace = cb.GetNamespace()
+		}
+		pc, err = c.GetProviderConfig(ctx, refNamespace, refName)
+		if err != nil {
+			logger.Warn("providerConfig section left empty", "providerConfig", refNamespace+"/"+refName, "error", err)
+			pc = nil
+		}
+	}
+
+	data, err := renderBootstrapConfig(cb, pc)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if opts.outputFile != "" {
+		f, err := os.OpenFile(opts.outputFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", opts.outputFile, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("writing config: %w", err)
+	}
+
+	if opts.outputFile != "" {
+		logger.Success("bootstrap config exported", "cluster", cb.GetName(), "file", opts.outputFile)
+	}
+	return nil
+}
+
+// findClusterBootstrap returns the named ClusterBootstrap, or the only one
+func findClusterBootstrap(ctx context.Context, c *client.Client, name string) (*unstructured.Unstructured, error) {
+	list, err := c.Dynamic.Resource(client.ClusterBootstrapGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing ClusterBootstraps: %w", err)
+	}
+
+	var names []string
+	for i := range list.Items {
+		if list.Items[i].GetName() == name {
+			return &list.Items[i], nil
+		}
+		names = append(names, list.Items[i].GetName())
+	}
+
+	switch {
+	case name != "":
+		return nil, fmt.Errorf("ClusterBootstrap %q not found", name)
+	case len(list.Items) == 0:
+		return nil, fmt.Errorf("no ClusterBootstrap found; is this a Butler management cluster?")
+	case len(list.Items) > 1:
+		return nil, fmt.Errorf("multiple ClusterBootstraps (%s); choose one with --name", strings.Join(names, ", "))
+	}
+	return &list.Items[0], nil
+}
+
+// renderBootstrapConfig builds bootstrap.yaml from the ClusterBootstrap
+// spec, whose layout mirrors the config file, and the ProviderConfig
+func renderBootstrapConfig(cb, pc *unstructured.Unstructured) ([]byte, error) {
+	spec, _, _ := unstructured.NestedMap(cb.Object, "spec")
+	provider, _ := spec["provider"].(string)
+
+	sections := map[string]interface{}{
+		"provider": provider,
+		"cluster":  spec["cluster"],
+		"network":  spec["network"],
+		"talos":    spec["talos"],
+		"addons":   spec["addons"],
+	}
+	if pc != nil {
+		sections["providerConfig"] = map[string]interface{}{
+			provider: providerSection(pc, provider),
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Exported from ClusterBootstrap %s/%s on %s by 'butleradm export-config'.\n",
+		cb.GetNamespace(), cb.GetName(), time.Now().UTC().Format(time.RFC3339))
+	fmt.Fprintf(&buf, "# Best effort: review before use. Replace <from secret ...> placeholders with\n")
+	fmt.Fprintf(&buf, "# the referenced values. imageVerification and hostAliases are not recorded.\n")
+
+	for _, key := range exportSections {
+		value := pruneEmpty(sections[key])
+		if value == nil {
+			continue
+		}
+		out, err := yaml.Marshal(map[string]interface{}{key: value})
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", key, err)
+		}
+		buf.WriteString("\n")
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+// providerSection returns the provider's settings from the ProviderConfig,
+// with credential fields replaced by a reference to their Secret
+func providerSection(pc *unstructured.Unstructured, provider string) map[string]interface{} {
+	section, _, _ := unstructured.NestedMap(pc.Object, "spec", provider)
+	if section == nil {
+		section = map[string]interface{}{}
+	}
+
+	refName, _, _ := unstructured.NestedString(pc.Object, "spec", "credentialsRef", "name")
+	refNamespace, _, _ := unstructured.NestedString(pc.Object, "spec", "credentialsRef", "namespace")
+	refKey, _, _ := unstructured.NestedString(pc.Object, "spec", "credentialsRef", "key")
+	if refNamespace == "" {
+		refNamespace = pc.GetNamespace()
+	}
+
+	for _, field := range providerCredentialFields[provider] {
+		key := refKey
+		if key == "" {
+			key = field
+		}
+		if refName == "" {
+			section[field] = "<not recorded>"
+			continue
+		}
+		section[field] = fmt.Sprintf("<from secret %s/%s key %s>", refNamespace, refName, key)
+	}
+	return section
+}
+
+// pruneEmpty drops empty strings, maps and lists so the export only carries
+// settings that were actually set. It returns nil for an empty value.
+func pruneEmpty(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for k, item := range val {
+			if pruned := pruneEmpty(item); pruned != nil {
+				out[k] = pruned
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case []interface{}:
+		var out []interface{}
+		for _, item := range val {
+			if pruned := pruneEmpty(item); pruned != nil {
+				out = append(out, pruned)
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case string:
+		if val == "" {
+			return nil
+		}
+	case nil:
+		return nil
+	}
+	return v
+}
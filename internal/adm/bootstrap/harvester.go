@@ -17,27 +17,31 @@ limitations under the License.
 package bootstrap
 
 import (
-	"context"
 	"fmt"
 	"os"
-	"os/signal"
-	"syscall"
+	"path/filepath"
 	"time"
 
 	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
 	"github.com/butlerdotdev/butler/internal/common/log"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // NewHarvesterCmd creates the harvester bootstrap subcommand
 func NewHarvesterCmd(logger *log.Logger) *cobra.Command {
 	var (
-		configFile  string
-		dryRun      bool
-		skipCleanup bool
-		localDev    bool
-		repoRoot    string
+		configFile   string
+		profile      string
+		dryRun       bool
+		skipCleanup  bool
+		recreateKIND bool
+		localDev     bool
+		skipVerify   bool
+		repoRoot     string
+		output       string
+		release      string
+		bundle       string
+		plan         planOptions
 	)
 
 	cmd := &cobra.Command{
@@ -48,47 +52,41 @@ func NewHarvesterCmd(logger *log.Logger) *cobra.Command {
 Harvester is a modern open-source hyperconverged infrastructure (HCI) platform
 built on Kubernetes. Butler provisions Talos Linux VMs running Kubernetes with:
   • Cilium CNI (kube-proxy replacement)
-  • kube-vip for control plane HA
+  • kube-vip for control plane HA (or an existing load balancer,
+    network.vipMode: external)
   • Longhorn distributed storage
   • MetalLB for LoadBalancer services
   • FluxCD for GitOps
 
 Prerequisites:
   • Docker running locally
+  • cosign for image signature verification (or --skip-verify)
   • Harvester kubeconfig at ~/.butler/harvester-kubeconfig (or specified in config)
   • Talos image with qemu-guest-agent extension in Harvester
 
 Example:
   butleradm bootstrap harvester --config bootstrap.yaml
+
+Policy checks (single JSON document on stdout):
+  butleradm bootstrap harvester --config bootstrap.yaml --dry-run -o json | conftest test -
   
+Scripted run (phases, durations, credential paths and next steps as JSON):
+  butleradm bootstrap harvester --config bootstrap.yaml -o json > result.json
+
+Pinned release (CRDs and controller images from the v0.3.1 bundle):
+  butleradm bootstrap harvester --config bootstrap.yaml --platform-version v0.3.1
+
 Local Development:
   butleradm bootstrap harvester --config bootstrap.yaml --local
   butleradm bootstrap harvester --config bootstrap.yaml --local --repo-root ~/code/github.com/butlerdotdev`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Handle interrupts gracefully
-			ctx, cancel := context.WithCancel(cmd.Context())
-			defer cancel()
-
-			sigCh := make(chan os.Signal, 1)
-			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
-			go func() {
-				<-sigCh
-				logger.Warn("received interrupt, cleaning up...")
-				cancel()
-			}()
+			// Ctrl-C cancels the context and the orchestrator cleans up
+			ctx := cmd.Context()
 
 			// Load config
-			if configFile != "" {
-				viper.SetConfigFile(configFile)
-				if err := viper.ReadInConfig(); err != nil {
-					return fmt.Errorf("reading config file: %w", err)
-				}
-			}
-
-			// Parse config
-			cfg, err := orchestrator.LoadConfig()
+			cfg, err := loadConfig(logger, configFile, profile)
 			if err != nil {
-				return fmt.Errorf("parsing config: %w", err)
+				return err
 			}
 
 			// Validate provider
@@ -100,16 +98,33 @@ Local Development:
 			if localDev && repoRoot == "" {
 				// Try to find repo root automatically
 				home, _ := os.UserHomeDir()
-				repoRoot = home + "/code/github.com/butlerdotdev"
+				repoRoot = filepath.Join(home, "code", "github.com", "butlerdotdev")
+			}
+
+			// Show consumption and stop past the capacity threshold; with
+			// -o it goes to stderr so stdout carries only the summary
+			if !dryRun {
+				w := cmd.OutOrStdout()
+				if output != "" {
+					w = cmd.ErrOrStderr()
+				}
+				if err := checkPlan(ctx, w, logger, cfg, &plan); err != nil {
+					return err
+				}
 			}
 
 			// Create orchestrator
 			orch := orchestrator.New(logger, orchestrator.Options{
-				DryRun:      dryRun,
-				SkipCleanup: skipCleanup,
-				Timeout:     30 * time.Minute,
-				LocalDev:    localDev,
-				RepoRoot:    repoRoot,
+				DryRun:          dryRun,
+				SkipCleanup:     skipCleanup,
+				RecreateKIND:    recreateKIND,
+				Timeout:         30 * time.Minute,
+				LocalDev:        localDev,
+				RepoRoot:        repoRoot,
+				OutputFormat:    output,
+				SkipVerify:      skipVerify,
+				PlatformVersion: release,
+				ReleaseBundle:   bundle,
 			})
 
 			// Run bootstrap
@@ -121,12 +136,20 @@ Local Development:
 		},
 	}
 
-	cmd.Flags().StringVarP(&configFile, "config", "c", "", "path to bootstrap config file (required)")
+	cmd.Flags().StringVarP(&configFile, "config", "c", "", "path to bootstrap config file, or - for stdin (required)")
+	cmd.Flags().StringVar(&profile, "profile", "", "config profile to apply over the base config")
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "show what would be created without executing")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "format of the dry-run document or closing summary (json, yaml); default is human-readable")
 	cmd.Flags().BoolVar(&skipCleanup, "skip-cleanup", false, "don't delete KIND cluster on failure (for debugging)")
+	cmd.Flags().BoolVar(&recreateKIND, "recreate-kind", false, "delete an existing KIND cluster instead of reusing it")
+	cmd.Flags().BoolVar(&skipVerify, "skip-verify", false, "skip image signature verification (not recommended)")
+	cmd.Flags().StringVar(&release, "platform-version", "", "pin CRDs and controller images to a platform release, e.g. v0.3.1 (default: latest)")
+	cmd.Flags().StringVar(&bundle, "release-bundle", "", "URL or path template of the release bundle, e.g. a mirror with {{ .Version }} in it")
 	cmd.Flags().BoolVar(&localDev, "local", false, "local development mode - build and load images from source")
 	cmd.Flags().StringVar(&repoRoot, "repo-root", "", "path to butlerdotdev repos (default: ~/code/github.com/butlerdotdev)")
 
+	addPlanFlags(cmd, &plan)
+
 	cmd.MarkFlagRequired("config")
 
 	return cmd
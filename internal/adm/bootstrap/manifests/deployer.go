@@ -24,7 +24,9 @@ import (
 	"io"
 	"io/fs"
 	"strings"
+	"time"
 
+	"github.com/butlerdotdev/butler/internal/common/waiter"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -36,23 +38,55 @@ import (
 
 // Deployer applies embedded manifests to a Kubernetes cluster
 type Deployer struct {
-	clientset     *kubernetes.Clientset
+	clientset     kubernetes.Interface
 	dynamicClient dynamic.Interface
+	verifier      *ImageVerifier
+	imageTags     map[string]string
 }
 
 // NewDeployer creates a new manifest deployer
-func NewDeployer(clientset *kubernetes.Clientset, dynamicClient dynamic.Interface) *Deployer {
+func NewDeployer(clientset kubernetes.Interface, dynamicClient dynamic.Interface) *Deployer {
 	return &Deployer{
 		clientset:     clientset,
 		dynamicClient: dynamicClient,
 	}
 }
 
+// SetImageVerifier requires container images to pass signature verification
+// before any resource of a manifest file is applied
+func (d *Deployer) SetImageVerifier(v *ImageVerifier) {
+	d.verifier = v
+}
+
+// SetImageTags pins the images of the named Deployments to a tag instead
+// of the tag in the embedded manifests
+func (d *Deployer) SetImageTags(tags map[string]string) {
+	d.imageTags = tags
+}
+
 // DeployCRDs deploys all embedded CRD manifests
 func (d *Deployer) DeployCRDs(ctx context.Context) error {
 	return d.deployFromFS(ctx, CRDs, "crds")
 }
 
+// DeployCRDsFromYAML deploys CRDs from multi-document YAML, such as a
+// release bundle's, in place of the embedded ones
+func (d *Deployer) DeployCRDsFromYAML(ctx context.Context, data []byte) error {
+	objs, err := decodeYAML(data)
+	if err != nil {
+		return err
+	}
+	if len(objs) == 0 {
+		return fmt.Errorf("no CRDs found")
+	}
+	for _, obj := range objs {
+		if obj.GetKind() != "CustomResourceDefinition" {
+			return fmt.Errorf("%s %s is not a CustomResourceDefinition", obj.GetKind(), obj.GetName())
+		}
+	}
+	return d.applyYAML(ctx, data)
+}
+
 // DeployControllers deploys all embedded controller manifests
 func (d *Deployer) DeployControllers(ctx context.Context, provider string) error {
 	// Deploy bootstrap controller (always needed)
@@ -105,15 +139,83 @@ func (d *Deployer) deployFile(ctx context.Context, fsys fs.FS, path string) erro
 
 // applyYAML applies multi-document YAML to the cluster
 func (d *Deployer) applyYAML(ctx context.Context, data []byte) error {
+	objs, err := decodeYAML(data)
+	if err != nil {
+		return err
+	}
+
+	if d.imageTags != nil {
+		for _, obj := range objs {
+			if err := d.pinImages(obj); err != nil {
+				return fmt.Errorf("%s %s: %w", obj.GetKind(), obj.GetName(), err)
+			}
+		}
+	}
+
+	// Verify every image in the file before applying any of it
+	if d.verifier != nil {
+		for _, obj := range objs {
+			if err := d.verifier.VerifyObject(ctx, obj); err != nil {
+				return fmt.Errorf("%s %s: %w", obj.GetKind(), obj.GetName(), err)
+			}
+		}
+	}
+
+	for _, obj := range objs {
+		if err := d.applyResource(ctx, obj); err != nil {
+			return fmt.Errorf("applying %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// pinImages sets the tag of every container image of a Deployment listed
+// in imageTags
+func (d *Deployer) pinImages(obj *unstructured.Unstructured) error {
+	tag := d.imageTags[obj.GetName()]
+	if obj.GetKind() != "Deployment" || tag == "" {
+		return nil
+	}
+	containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	if err != nil {
+		return fmt.Errorf("reading containers: %w", err)
+	}
+	for _, item := range containers {
+		container, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if image, ok := container["image"].(string); ok && image != "" {
+			container["image"] = WithTag(image, tag)
+		}
+	}
+	return unstructured.SetNestedSlice(obj.Object, containers, "spec", "template", "spec", "containers")
+}
+
+// WithTag replaces the tag or digest of an image reference
+func WithTag(image, tag string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image + ":" + tag
+}
+
+// decodeYAML parses multi-document YAML, skipping empty documents
+func decodeYAML(data []byte) ([]*unstructured.Unstructured, error) {
 	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
 
+	var objs []*unstructured.Unstructured
 	for {
 		doc, err := reader.Read()
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("reading YAML document: %w", err)
+			return nil, fmt.Errorf("reading YAML document: %w", err)
 		}
 
 		// Skip empty documents
@@ -124,7 +226,7 @@ func (d *Deployer) applyYAML(ctx context.Context, data []byte) error {
 		// Parse the document
 		obj := &unstructured.Unstructured{}
 		if err := yaml.Unmarshal(doc, &obj.Object); err != nil {
-			return fmt.Errorf("unmarshaling YAML: %w", err)
+			return nil, fmt.Errorf("unmarshaling YAML: %w", err)
 		}
 
 		// Skip if no kind (comments-only documents)
@@ -132,12 +234,34 @@ func (d *Deployer) applyYAML(ctx context.Context, data []byte) error {
 			continue
 		}
 
-		if err := d.applyResource(ctx, obj); err != nil {
-			return fmt.Errorf("applying %s %s: %w", obj.GetKind(), obj.GetName(), err)
-		}
+		objs = append(objs, obj)
 	}
+	return objs, nil
+}
 
-	return nil
+// LoadCRDs returns the embedded CRD manifests
+func LoadCRDs() ([]*unstructured.Unstructured, error) {
+	entries, err := fs.ReadDir(CRDs, "crds")
+	if err != nil {
+		return nil, fmt.Errorf("reading directory crds: %w", err)
+	}
+
+	var crds []*unstructured.Unstructured
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+		data, err := fs.ReadFile(CRDs, "crds/"+entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", entry.Name(), err)
+		}
+		objs, err := decodeYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", entry.Name(), err)
+		}
+		crds = append(crds, objs...)
+	}
+	return crds, nil
 }
 
 // applyResource creates or updates a single resource
@@ -217,39 +341,27 @@ func (d *Deployer) WaitForCRDs(ctx context.Context, names []string) error {
 	}
 
 	for _, name := range names {
-		for {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			default:
-			}
-
+		err := waiter.Until(ctx, waiter.Options{
+			Description: fmt.Sprintf("CRD %s to be established", name),
+			Interval:    time.Second,
+		}, func(ctx context.Context) (bool, string, error) {
 			crd, err := d.dynamicClient.Resource(crdGVR).Get(ctx, name, metav1.GetOptions{})
 			if err != nil {
-				continue
+				return false, "not found", nil
 			}
 
 			// Check if established
-			conditions, found, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
-			if !found {
-				continue
-			}
-
-			established := false
+			conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
 			for _, c := range conditions {
 				cond, ok := c.(map[string]interface{})
-				if !ok {
-					continue
-				}
-				if cond["type"] == "Established" && cond["status"] == "True" {
-					established = true
-					break
+				if ok && cond["type"] == "Established" && cond["status"] == "True" {
+					return true, "", nil
 				}
 			}
-
-			if established {
-				break
-			}
+			return false, "not established", nil
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -264,23 +376,19 @@ func (d *Deployer) WaitForDeployment(ctx context.Context, namespace, name string
 		Resource: "deployments",
 	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
-
+	return waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("deployment %s/%s to be ready", namespace, name),
+		Interval:    2 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
 		deploy, err := d.dynamicClient.Resource(deployGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
-			continue
+			return false, "not found", nil
 		}
 
 		replicas, _, _ := unstructured.NestedInt64(deploy.Object, "spec", "replicas")
 		readyReplicas, _, _ := unstructured.NestedInt64(deploy.Object, "status", "readyReplicas")
 
-		if readyReplicas >= replicas && replicas > 0 {
-			return nil
-		}
-	}
+		status := fmt.Sprintf("%d/%d ready", readyReplicas, replicas)
+		return readyReplicas >= replicas && replicas > 0, status, nil
+	})
 }
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Identity is a keyless signing identity accepted for Butler images
+type Identity struct {
+	// Issuer is the OIDC issuer of the signing certificate
+	Issuer string
+
+	// Subject is the exact certificate identity
+	Subject string
+
+	// SubjectRegexp matches the certificate identity when Subject is empty
+	SubjectRegexp string
+}
+
+// DefaultIdentities accepts images signed by butlerdotdev GitHub Actions workflows
+var DefaultIdentities = []Identity{{
+	Issuer:        "https://token.actions.githubusercontent.com",
+	SubjectRegexp: `^https://github\.com/butlerdotdev/`,
+}}
+
+// ImageVerifier verifies image signatures with cosign before they are deployed
+type ImageVerifier struct {
+	publicKey  string
+	identities []Identity
+	verified   map[string]bool
+}
+
+// NewImageVerifier creates a verifier. With a public key, signatures must be made
+// with that key; otherwise keyless signatures from one of identities are required.
+func NewImageVerifier(publicKey string, identities []Identity) (*ImageVerifier, error) {
+	if _, err := exec.LookPath("cosign"); err != nil {
+		return nil, fmt.Errorf("image signature verification requires cosign in PATH (https://docs.sigstore.dev/cosign/system_config/installation/); pass --skip-verify to bypass")
+	}
+	if publicKey == "" && len(identities) == 0 {
+		identities = DefaultIdentities
+	}
+	return &ImageVerifier{
+		publicKey:  publicKey,
+		identities: identities,
+		verified:   map[string]bool{},
+	}, nil
+}
+
+// Verify checks the signature of an image. Images are verified once per run.
+func (v *ImageVerifier) Verify(ctx context.Context, image string) error {
+	if v.verified[image] {
+		return nil
+	}
+
+	if v.publicKey != "" {
+		if err := cosignVerify(ctx, image, "--key", v.publicKey); err != nil {
+			return fmt.Errorf("verifying signature of %s: %w", image, err)
+		}
+		v.verified[image] = true
+		return nil
+	}
+
+	var errs []string
+	for _, id := range v.identities {
+		args := []string{"--certificate-oidc-issuer", id.Issuer}
+		if id.Subject != "" {
+			args = append(args, "--certificate-identity", id.Subject)
+		} else {
+			args = append(args, "--certificate-identity-regexp", id.SubjectRegexp)
+		}
+		err := cosignVerify(ctx, image, args...)
+		if err == nil {
+			v.verified[image] = true
+			return nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return fmt.Errorf("no trusted signature for %s: %s", image, strings.Join(errs, "; "))
+}
+
+// VerifyObject verifies every container image in a workload
+func (v *ImageVerifier) VerifyObject(ctx context.Context, obj *unstructured.Unstructured) error {
+	for _, image := range Images(obj) {
+		if err := v.Verify(ctx, image); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cosignVerify(ctx context.Context, image string, args ...string) error {
+	args = append(append([]string{"verify", "--output", "json"}, args...), image)
+	cmd := exec.CommandContext(ctx, "cosign", args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if _, err := cmd.Output(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// Images returns the container images referenced by a Pod or pod template
+func Images(obj *unstructured.Unstructured) []string {
+	podSpec := []string{"spec", "template", "spec"}
+	switch obj.GetKind() {
+	case "Pod":
+		podSpec = []string{"spec"}
+	case "CronJob":
+		podSpec = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	}
+
+	var images []string
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, _ := unstructured.NestedSlice(obj.Object, append(podSpec, field)...)
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if image, ok := container["image"].(string); ok && image != "" {
+				images = append(images, image)
+			}
+		}
+	}
+	return images
+}
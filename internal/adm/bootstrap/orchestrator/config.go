@@ -17,17 +17,30 @@ limitations under the License.
 package orchestrator
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
+	"net/netip"
 	"os"
-	"path/filepath"
+	"sort"
+	"strings"
 
+	"github.com/butlerdotdev/butler/internal/common/envsubst"
+	"github.com/butlerdotdev/butler/internal/common/netcheck"
+	"github.com/butlerdotdev/butler/internal/common/paths"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
 )
 
 // Config represents the bootstrap configuration
 type Config struct {
 	// Provider is the infrastructure provider (harvester, nutanix, proxmox)
-	Provider string `mapstructure:"provider"`
+	Provider string `mapstructure:"provider" jsonschema:"enum=harvester|nutanix|proxmox"`
 
 	// Cluster defines the management cluster configuration
 	Cluster ClusterConfig `mapstructure:"cluster"`
@@ -43,6 +56,30 @@ type Config struct {
 
 	// ProviderConfig contains provider-specific settings
 	ProviderConfig ProviderConfig `mapstructure:"providerConfig"`
+
+	// ImageVerification configures signature checks for controller and addon images
+	ImageVerification ImageVerificationConfig `mapstructure:"imageVerification"`
+
+	// InitialResources are the Teams and ProviderConfigs that follow the
+	// config in a multi-document file, created once the cluster is up
+	InitialResources []*unstructured.Unstructured `mapstructure:"-"`
+
+	// UnknownKeys are the config keys that match no setting, lowercased
+	// dotted paths such as cluster.controlplne
+	UnknownKeys []string `mapstructure:"-"`
+
+	// Values is the config document as resolved by ReadConfig, kept for
+	// the bootstrap record on the new cluster
+	Values map[string]interface{} `mapstructure:"-"`
+}
+
+// strictConfig is set by SetStrictConfig for the lifetime of the process
+var strictConfig bool
+
+// SetStrictConfig makes unknown config keys an error instead of a warning,
+// e.g. for --strict-config
+func SetStrictConfig() {
+	strictConfig = true
 }
 
 // ClusterConfig defines cluster specifications
@@ -53,7 +90,7 @@ type ClusterConfig struct {
 	// Topology defines the cluster topology
 	// - "single-node": Single control plane node that also runs workloads
 	// - "ha": High-availability with separate control plane and worker nodes (default)
-	Topology string `mapstructure:"topology"`
+	Topology string `mapstructure:"topology" jsonschema:"enum=single-node|ha"`
 
 	// ControlPlane defines control plane node configuration
 	ControlPlane NodePoolConfig `mapstructure:"controlPlane"`
@@ -100,6 +137,28 @@ type NetworkConfig struct {
 
 	// VIP is the control plane VIP address
 	VIP string `mapstructure:"vip"`
+
+	// VIPMode selects what serves the VIP: kube-vip on the control plane
+	// nodes (default), or an existing external load balancer such as an
+	// F5 or HAProxy pair, in which case kube-vip is not installed.
+	VIPMode string `mapstructure:"vipMode" jsonschema:"enum=kube-vip|external"`
+
+	// HealthCheck is how the external load balancer checks control plane
+	// nodes; bootstrap probes the nodes and the VIP the same way
+	HealthCheck HealthCheckConfig `mapstructure:"healthCheck"`
+}
+
+// HealthCheckConfig describes the control plane health check of an
+// external load balancer
+type HealthCheckConfig struct {
+	// Port is the API server port on the nodes (default 6443)
+	Port int `mapstructure:"port"`
+
+	// Path is the HTTPS path checked (default /readyz)
+	Path string `mapstructure:"path"`
+
+	// TimeoutSeconds bounds each probe (default 5)
+	TimeoutSeconds int `mapstructure:"timeoutSeconds"`
 }
 
 // TalosConfig defines Talos OS configuration
@@ -135,6 +194,29 @@ type AddonsConfig struct {
 	Console ConsoleConfig `mapstructure:"console"`
 }
 
+// ImageVerificationConfig defines how image signatures are verified.
+// With neither a public key nor identities, images must carry a keyless
+// signature from a butlerdotdev GitHub Actions workflow.
+type ImageVerificationConfig struct {
+	// PublicKey is a cosign public key (path, URL or KMS reference)
+	PublicKey string `mapstructure:"publicKey"`
+
+	// Identities are the keyless signing identities accepted
+	Identities []SigningIdentityConfig `mapstructure:"identities"`
+}
+
+// SigningIdentityConfig defines a keyless signing identity
+type SigningIdentityConfig struct {
+	// Issuer is the OIDC issuer (e.g., https://token.actions.githubusercontent.com)
+	Issuer string `mapstructure:"issuer"`
+
+	// Subject is the exact certificate identity
+	Subject string `mapstructure:"subject"`
+
+	// SubjectRegexp matches the certificate identity
+	SubjectRegexp string `mapstructure:"subjectRegexp"`
+}
+
 // CNIConfig defines CNI configuration
 type CNIConfig struct {
 	// Type is the CNI type (cilium)
@@ -208,6 +290,10 @@ type ConsoleIngressConfig struct {
 
 	// TLSSecretName is the name of the TLS secret (auto-generated if empty and TLS enabled)
 	TLSSecretName string `mapstructure:"tlsSecretName"`
+
+	// Issuer is a cert-manager ClusterIssuer that issues the TLS certificate
+	// into TLSSecretName (see 'butleradm addon configure cert-manager').
+	Issuer string `mapstructure:"issuer"`
 }
 
 // ConsoleAuthConfig defines authentication configuration
@@ -314,13 +400,245 @@ type ProxmoxProviderConfig struct {
 	HostAliases []string `mapstructure:"hostAliases,omitempty"`
 }
 
+// initialResourceOrder lists the kinds a multi-document config may create
+// after bootstrap, in creation order
+var initialResourceOrder = map[string]int{"Team": 0, "ProviderConfig": 1}
+
+// ReadConfig loads the bootstrap configuration from a file, or from stdin
+// when path is "-"; see ResolveConfig.
+func ReadConfig(path, profile string) (*Config, error) {
+	values, resources, err := ResolveConfig(path, profile)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := FromValues(values)
+	if err != nil {
+		return nil, err
+	}
+	cfg.InitialResources = resources
+	cfg.Values = values
+	return cfg, nil
+}
+
+// ResolveConfig reads a config file with ReadDocuments and deep-merges a
+// non-empty profile over it; see applyProfile.
+func ResolveConfig(path, profile string) (map[string]interface{}, []*unstructured.Unstructured, error) {
+	values, resources, err := ReadDocuments(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err = applyProfile(values, profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return values, resources, nil
+}
+
+// ReadDocuments reads a config file, or stdin when path is "-", after
+// expanding ${VAR} references. The file may hold several YAML documents:
+// the one without a kind is the bootstrap config, returned as is, the
+// others Teams and ProviderConfigs to create on the new management cluster.
+func ReadDocuments(path string) (map[string]interface{}, []*unstructured.Unstructured, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(paths.Expand(path))
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading config file: %w", err)
+	}
+	data, err = envsubst.Expand(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("config file: %w", err)
+	}
+
+	var values map[string]interface{}
+	var resources []*unstructured.Unstructured
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for i := 1; ; i++ {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading config document %d: %w", i, err)
+		}
+		var obj map[string]interface{}
+		if err := yaml.Unmarshal(doc, &obj); err != nil {
+			return nil, nil, fmt.Errorf("parsing config document %d: %w", i, err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+
+		if _, ok := obj["kind"]; !ok {
+			if values != nil {
+				return nil, nil, fmt.Errorf("config document %d: only one document may be the bootstrap config (the others need a kind)", i)
+			}
+			values = obj
+			continue
+		}
+		res, err := initialResource(obj)
+		if err != nil {
+			return nil, nil, fmt.Errorf("config document %d: %w", i, err)
+		}
+		resources = append(resources, res)
+	}
+	if values == nil {
+		return nil, nil, fmt.Errorf("no bootstrap config found: one document must be the config itself, without a kind")
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return initialResourceOrder[resources[i].GetKind()] < initialResourceOrder[resources[j].GetKind()]
+	})
+	return values, resources, nil
+}
+
+// FromValues parses resolved config values, applying defaults and
+// validation as LoadConfig does
+func FromValues(values map[string]interface{}) (*Config, error) {
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("encoding config: %w", err)
+	}
+	viper.SetConfigType("yaml")
+	if err := viper.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+	cfg, err := LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+	return cfg, nil
+}
+
+// applyProfile returns the config without its profiles section, with the
+// named profile deep-merged over it. A profile may name another in extends
+// to build on it; maps merge key by key while lists and scalars replace.
+//
+// Example:
+//
+//	cluster:
+//	  controlPlane: {replicas: 1, cpu: 4}
+//	profiles:
+//	  lab:
+//	    network: {vip: 10.0.0.10}
+//	  prod:
+//	    extends: lab
+//	    cluster:
+//	      controlPlane: {replicas: 3}
+func applyProfile(values map[string]interface{}, profile string) (map[string]interface{}, error) {
+	profiles, _ := values["profiles"].(map[string]interface{})
+	base := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if k != "profiles" {
+			base[k] = v
+		}
+	}
+	if profile == "" {
+		return base, nil
+	}
+
+	// Walk extends up to the root profile, then merge back down
+	var chain []map[string]interface{}
+	seen := map[string]bool{}
+	for name := profile; name != ""; {
+		if seen[name] {
+			return nil, fmt.Errorf("profile %q: extends loops back to %q", profile, name)
+		}
+		seen[name] = true
+		p, ok := profiles[name].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("profile %q not found in config (available: %s)", name, strings.Join(profileNames(profiles), ", "))
+		}
+		chain = append(chain, p)
+		name, _ = p["extends"].(string)
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		overlay := make(map[string]interface{}, len(chain[i]))
+		for k, v := range chain[i] {
+			if k != "extends" {
+				overlay[k] = v
+			}
+		}
+		base = mergeValues(base, overlay)
+	}
+	return base, nil
+}
+
+// mergeValues deep-merges overlay into a copy of base
+func mergeValues(base, overlay map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range overlay {
+		if om, ok := v.(map[string]interface{}); ok {
+			if bm, ok := out[k].(map[string]interface{}); ok {
+				out[k] = mergeValues(bm, om)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+func profileNames(profiles map[string]interface{}) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return []string{"none"}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// initialResource checks a resource document from a multi-document config
+func initialResource(obj map[string]interface{}) (*unstructured.Unstructured, error) {
+	res := &unstructured.Unstructured{Object: obj}
+	if _, ok := initialResourceOrder[res.GetKind()]; !ok {
+		return nil, fmt.Errorf("unsupported kind %q: only Team and ProviderConfig can be created after bootstrap", res.GetKind())
+	}
+	gv, err := schema.ParseGroupVersion(res.GetAPIVersion())
+	if err != nil || gv.Group != butlerAPIGroup {
+		return nil, fmt.Errorf("unexpected apiVersion %q for kind %s", res.GetAPIVersion(), res.GetKind())
+	}
+	if res.GetName() == "" {
+		return nil, fmt.Errorf("%s is missing metadata.name", res.GetKind())
+	}
+	if res.GetKind() == "ProviderConfig" && res.GetNamespace() == "" {
+		res.SetNamespace(butlerNamespace)
+	}
+	return res, nil
+}
+
 // LoadConfig loads the bootstrap configuration from viper
 func LoadConfig() (*Config, error) {
 	var cfg Config
-	if err := viper.Unmarshal(&cfg); err != nil {
+	var md mapstructure.Metadata
+	if err := viper.Unmarshal(&cfg, func(dc *mapstructure.DecoderConfig) {
+		dc.Metadata = &md
+	}); err != nil {
 		return nil, fmt.Errorf("unmarshaling config: %w", err)
 	}
 
+	// Unknown keys are otherwise dropped silently, so a typo falls back to
+	// the default. The config key is the --config flag bound in viper.
+	for _, key := range md.Unused {
+		if key != "config" {
+			cfg.UnknownKeys = append(cfg.UnknownKeys, key)
+		}
+	}
+	sort.Strings(cfg.UnknownKeys)
+	if strictConfig && len(cfg.UnknownKeys) > 0 {
+		return nil, fmt.Errorf("unknown config keys: %s", strings.Join(cfg.UnknownKeys, ", "))
+	}
+
 	// Set defaults
 	if cfg.Network.PodCIDR == "" {
 		cfg.Network.PodCIDR = "10.244.0.0/16"
@@ -328,6 +646,9 @@ func LoadConfig() (*Config, error) {
 	if cfg.Network.ServiceCIDR == "" {
 		cfg.Network.ServiceCIDR = "10.96.0.0/12"
 	}
+	if err := defaultVIPMode(&cfg.Network); err != nil {
+		return nil, err
+	}
 	if cfg.Talos.Version == "" {
 		cfg.Talos.Version = "v1.9.0"
 	}
@@ -344,6 +665,10 @@ func LoadConfig() (*Config, error) {
 		cfg.Addons.GitOps.Type = "flux"
 	}
 
+	if err := validateNetwork(&cfg); err != nil {
+		return nil, err
+	}
+
 	// Topology defaults and validation
 	if cfg.Cluster.Topology == "" {
 		cfg.Cluster.Topology = "ha" // Default to HA
@@ -359,7 +684,7 @@ func LoadConfig() (*Config, error) {
 		// Force control plane replicas to 1
 		if cfg.Cluster.ControlPlane.Replicas != 1 {
 			if cfg.Cluster.ControlPlane.Replicas > 1 {
-				fmt.Printf("Warning: single-node topology forces controlPlane.replicas=1 (was %d)\n",
+				fmt.Fprintf(os.Stderr, "Warning: single-node topology forces controlPlane.replicas=1 (was %d)\n",
 					cfg.Cluster.ControlPlane.Replicas)
 			}
 			cfg.Cluster.ControlPlane.Replicas = 1
@@ -377,6 +702,15 @@ func LoadConfig() (*Config, error) {
 		if cfg.Addons.Console.Ingress.Enabled && cfg.Addons.Console.Ingress.Host == "" {
 			cfg.Addons.Console.Ingress.Host = fmt.Sprintf("butler.%s.local", cfg.Cluster.Name)
 		}
+		// An issuer needs a Secret name to write the certificate to
+		if ingress := &cfg.Addons.Console.Ingress; ingress.Issuer != "" {
+			if !ingress.TLS {
+				return nil, fmt.Errorf("addons.console.ingress.issuer requires addons.console.ingress.tls: true")
+			}
+			if ingress.TLSSecretName == "" {
+				ingress.TLSSecretName = "butler-console-tls"
+			}
+		}
 	}
 
 	// Provider-specific defaults
@@ -386,24 +720,56 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	// Every keyless identity needs an issuer and a subject to match
+	for i, id := range cfg.ImageVerification.Identities {
+		if id.Issuer == "" || (id.Subject == "" && id.SubjectRegexp == "") {
+			return nil, fmt.Errorf("imageVerification.identities[%d] requires issuer and subject or subjectRegexp", i)
+		}
+	}
+	cfg.ImageVerification.PublicKey = paths.Expand(cfg.ImageVerification.PublicKey)
+
 	// Expand home directory in paths
 	if cfg.ProviderConfig.Harvester != nil && cfg.ProviderConfig.Harvester.KubeconfigPath != "" {
-		cfg.ProviderConfig.Harvester.KubeconfigPath = expandPath(cfg.ProviderConfig.Harvester.KubeconfigPath)
+		cfg.ProviderConfig.Harvester.KubeconfigPath = paths.Expand(cfg.ProviderConfig.Harvester.KubeconfigPath)
 	}
 
 	return &cfg, nil
 }
 
-// expandPath expands ~ to home directory
-func expandPath(path string) string {
-	if len(path) > 0 && path[0] == '~' {
-		home, err := os.UserHomeDir()
+// validateNetwork checks the network section and the load balancer pool:
+// addresses and CIDRs must parse, and the VIP and pool must stay clear of
+// the pod and service networks
+func validateNetwork(cfg *Config) error {
+	pods, services, err := netcheck.ValidateClusterNetworks(cfg.Network.PodCIDR, cfg.Network.ServiceCIDR)
+	if err != nil {
+		return fmt.Errorf("network: %w", err)
+	}
+	networks := []netip.Prefix{pods, services}
+
+	if cfg.Network.VIP != "" {
+		vip, err := netcheck.ParseIP(cfg.Network.VIP)
 		if err != nil {
-			return path
+			return fmt.Errorf("network.vip: %w", err)
+		}
+		for _, network := range networks {
+			if network.Contains(vip) {
+				return fmt.Errorf("network.vip %s is inside cluster network %s", vip, network)
+			}
+		}
+	}
+
+	if cfg.Addons.LoadBalancer.AddressPool != "" {
+		pool, err := netcheck.ParsePool(cfg.Addons.LoadBalancer.AddressPool)
+		if err != nil {
+			return fmt.Errorf("addons.loadBalancer.addressPool: %w", err)
+		}
+		for _, network := range networks {
+			if pool.Overlaps(network) {
+				return fmt.Errorf("addons.loadBalancer.addressPool %s overlaps cluster network %s", pool, network)
+			}
 		}
-		return filepath.Join(home, path[1:])
 	}
-	return path
+	return nil
 }
 
 // IsSingleNode returns true if this is a single-node topology
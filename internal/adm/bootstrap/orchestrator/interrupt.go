@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// interruptCleanupTimeout bounds how long an interrupted bootstrap waits for
+// the provider to release the machines it started
+const interruptCleanupTimeout = 5 * time.Minute
+
+// createdResources records what a bootstrap has created so far
+type createdResources struct {
+	kindCluster      bool
+	kubeconfigPath   string
+	dynamic          dynamic.Interface
+	namespace        bool
+	providerConfig   string
+	clusterBootstrap string
+}
+
+// machine is a MachineRequest the provider was working on when interrupted
+type machine struct {
+	name       string
+	phase      string
+	providerID string
+}
+
+// keepAfterInterrupt handles a Ctrl-C during Run: it lists what was created,
+// asks whether to destroy or keep it and prints the follow-up commands.
+// It reports whether the KIND cluster must be kept. Failures and timeouts
+// other than an interrupt go through the usual cleanup.
+func (o *Orchestrator) keepAfterInterrupt(ctx context.Context, cfg *Config, err error) bool {
+	if err == nil || !errors.Is(ctx.Err(), context.Canceled) {
+		return false
+	}
+
+	// ctx is cancelled; cleanup gets its own deadline
+	cleanupCtx, cancel := context.WithTimeout(context.Background(), interruptCleanupTimeout)
+	defer cancel()
+
+	machines := o.createdMachines(cleanupCtx)
+	o.printInventory(cfg, machines)
+
+	destroy := !o.options.SkipCleanup
+	if prompt.Interactive() {
+		answer, perr := prompt.Confirm("Destroy these resources?")
+		if perr != nil {
+			o.logger.Warn("could not read answer, keeping resources", "error", perr)
+		}
+		destroy = answer
+	}
+
+	if !destroy {
+		o.printKeepCommands(cfg)
+		return true
+	}
+
+	remaining := o.destroyCreated(cleanupCtx)
+	if len(remaining) > 0 {
+		o.printLeftoverMachines(cfg, remaining)
+	}
+	return false
+}
+
+// createdMachines lists the MachineRequests in the KIND cluster
+func (o *Orchestrator) createdMachines(ctx context.Context) []machine {
+	if o.created.dynamic == nil || o.created.clusterBootstrap == "" {
+		return nil
+	}
+	list, err := o.created.dynamic.Resource(client.MachineRequestGVR).Namespace(butlerNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		o.logger.Warn("could not list MachineRequests", "error", err)
+		return nil
+	}
+
+	var machines []machine
+	for _, mr := range list.Items {
+		phase, _, _ := unstructured.NestedString(mr.Object, "status", "phase")
+		providerID, _, _ := unstructured.NestedString(mr.Object, "status", "providerID")
+		machines = append(machines, machine{name: mr.GetName(), phase: phase, providerID: providerID})
+	}
+	return machines
+}
+
+// printInventory lists the created resources, most expensive first
+func (o *Orchestrator) printInventory(cfg *Config, machines []machine) {
+	o.logger.Info("")
+	o.logger.Info("Resources created before the interrupt:")
+	for _, m := range machines {
+		line := fmt.Sprintf("  %s machine  %s (%s)", cfg.Provider, m.name, orUnknown(m.phase))
+		if m.providerID != "" {
+			line += " " + m.providerID
+		}
+		o.logger.Info(line)
+	}
+	if o.created.clusterBootstrap != "" {
+		o.logger.Info("  ClusterBootstrap  " + butlerNamespace + "/" + o.created.clusterBootstrap)
+	}
+	if o.created.providerConfig != "" {
+		o.logger.Info("  ProviderConfig    " + butlerNamespace + "/" + o.created.providerConfig)
+	}
+	if o.created.namespace {
+		o.logger.Info("  Namespace         " + butlerNamespace + " (with provider credentials)")
+	}
+	if o.created.kindCluster {
+		o.logger.Info("  KIND cluster      " + o.kindName)
+	}
+	if o.created.clusterBootstrap != "" && len(machines) == 0 {
+		o.logger.Info("  (no MachineRequests yet; the provider may still be starting VMs)")
+	}
+	o.logger.Info("")
+}
+
+// destroyCreated deletes the ClusterBootstrap and MachineRequests and waits
+// for the provider to remove their VMs while it still runs in KIND. It
+// returns the machines still present when it gave up.
+func (o *Orchestrator) destroyCreated(ctx context.Context) []machine {
+	dyn := o.created.dynamic
+	if dyn == nil || o.created.clusterBootstrap == "" {
+		return nil
+	}
+
+	o.logger.Phase("Destroying provisioned machines")
+	err := dyn.Resource(clusterBootstrapGVR).Namespace(butlerNamespace).Delete(ctx, o.created.clusterBootstrap, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		o.logger.Warn("deleting ClusterBootstrap failed", "error", err)
+	}
+	err = dyn.Resource(client.MachineRequestGVR).Namespace(butlerNamespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		o.logger.Warn("deleting MachineRequests failed", "error", err)
+	}
+
+	var remaining []machine
+	err = waiter.Until(ctx, waiter.Options{
+		Description: "provider to delete its machines",
+		Interval:    5 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		remaining = o.createdMachines(ctx)
+		return len(remaining) == 0, fmt.Sprintf("%d remaining", len(remaining)), nil
+	})
+	if err != nil {
+		o.logger.Warn("machines were not deleted in time", "error", err)
+		return remaining
+	}
+	o.logger.Success("Provisioned machines deleted")
+	return nil
+}
+
+// printKeepCommands tells the user how to inspect, resume or destroy what
+// was kept
+func (o *Orchestrator) printKeepCommands(cfg *Config) {
+	kubectl := "kubectl --kubeconfig " + o.created.kubeconfigPath + " -n " + butlerNamespace
+
+	o.logger.Info("Resources kept.")
+	if o.created.kubeconfigPath != "" {
+		o.logger.Info("To inspect them:")
+		o.logger.Info("  " + kubectl + " get clusterbootstraps,machinerequests")
+	}
+	o.logger.Info("To continue, re-run the bootstrap; it reuses the KIND cluster:")
+	o.logger.Info(fmt.Sprintf("  butleradm bootstrap %s --config <file>", cfg.Provider))
+	if o.created.kubeconfigPath != "" && o.created.clusterBootstrap != "" {
+		o.logger.Info("To destroy them, delete the machines while the provider still runs, then KIND:")
+		o.logger.Info("  " + kubectl + " delete clusterbootstrap " + o.created.clusterBootstrap)
+		o.logger.Info("  " + kubectl + " delete machinerequests --all --wait")
+	} else {
+		o.logger.Info("To destroy them:")
+	}
+	o.logger.Info("  kind delete cluster --name " + o.kindName)
+}
+
+// printLeftoverMachines tells the user which VMs to remove by hand
+func (o *Orchestrator) printLeftoverMachines(cfg *Config, machines []machine) {
+	names := make([]string, len(machines))
+	for i, m := range machines {
+		names[i] = m.name
+	}
+	o.logger.Warn("these machines may still exist in " + cfg.Provider + "; delete them there: " + strings.Join(names, ", "))
+	if cfg.Provider == "harvester" && cfg.ProviderConfig.Harvester != nil {
+		h := cfg.ProviderConfig.Harvester
+		o.logger.Info("  kubectl --kubeconfig " + h.KubeconfigPath + " -n " + h.Namespace + " delete virtualmachines " + strings.Join(names, " "))
+	}
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/version"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// kindRunConfigMap records which run created the KIND cluster, so a
+	// later run only reuses a cluster it would have built the same way
+	kindRunConfigMap = "butler-bootstrap-run"
+
+	// kindRunNamespace holds kindRunConfigMap; butler-system may not exist yet
+	kindRunNamespace = "kube-system"
+)
+
+// kindRun describes the current run as it is recorded in kindRunConfigMap
+func (o *Orchestrator) kindRun(cfg *Config) (map[string]string, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("hashing config: %w", err)
+	}
+	sum := sha256.Sum256(data)
+
+	controllers := "embedded (butleradm " + version.Version + ")"
+	switch {
+	case o.release != nil:
+		controllers = o.release.version
+	case o.options.LocalDev:
+		controllers = "local"
+	}
+
+	return map[string]string{
+		"provider":    cfg.Provider,
+		"cluster":     cfg.Cluster.Name,
+		"configHash":  hex.EncodeToString(sum[:8]),
+		"controllers": controllers,
+	}, nil
+}
+
+// checkKINDRun verifies that a reused KIND cluster was created by a run with
+// the same provider, cluster, config and CRD/controller versions
+func (o *Orchestrator) checkKINDRun(ctx context.Context, clientset kubernetes.Interface, cfg *Config) error {
+	want, err := o.kindRun(cfg)
+	if err != nil {
+		return err
+	}
+
+	cm, err := clientset.CoreV1().ConfigMaps(kindRunNamespace).Get(ctx, kindRunConfigMap, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("KIND cluster %s already exists but has no record of the run that created it; pass --recreate-kind to start from scratch", o.kindName)
+	}
+	if err != nil {
+		return fmt.Errorf("reading %s/%s from KIND cluster: %w", kindRunNamespace, kindRunConfigMap, err)
+	}
+
+	var diffs []string
+	for key, value := range want {
+		if got := cm.Data[key]; got != value {
+			diffs = append(diffs, fmt.Sprintf("%s %q, this run %q", key, got, value))
+		}
+	}
+	if len(diffs) > 0 {
+		sort.Strings(diffs)
+		return fmt.Errorf("KIND cluster %s was created by a different run (%s); pass --recreate-kind to start from scratch", o.kindName, strings.Join(diffs, "; "))
+	}
+	return nil
+}
+
+// recordKINDRun stores the current run in a newly created KIND cluster
+func (o *Orchestrator) recordKINDRun(ctx context.Context, clientset kubernetes.Interface, cfg *Config) error {
+	data, err := o.kindRun(cfg)
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kindRunConfigMap,
+			Namespace: kindRunNamespace,
+		},
+		Data: data,
+	}
+	_, err = clientset.CoreV1().ConfigMaps(kindRunNamespace).Create(ctx, cm, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("recording run in KIND cluster: %w", err)
+	}
+	return nil
+}
@@ -19,17 +19,31 @@ package orchestrator
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/butlerdotdev/butler/internal/adm/bootstrap/manifests"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/credstore"
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/paths"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/redact"
+	"github.com/butlerdotdev/butler/internal/common/summary"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -44,9 +58,6 @@ const (
 	// Namespace for Butler resources in KIND cluster
 	butlerNamespace = "butler-system"
 
-	// KIND cluster name
-	kindClusterName = "butler-bootstrap"
-
 	// API Group for Butler CRDs
 	butlerAPIGroup   = "butler.butlerlabs.dev"
 	butlerAPIVersion = "v1alpha1"
@@ -80,6 +91,9 @@ type Options struct {
 	// SkipCleanup prevents KIND cluster deletion on failure
 	SkipCleanup bool
 
+	// RecreateKIND deletes an existing KIND cluster instead of reusing it
+	RecreateKIND bool
+
 	// Timeout is the maximum time to wait for bootstrap
 	Timeout time.Duration
 
@@ -88,12 +102,50 @@ type Options struct {
 
 	// RepoRoot is the path to butlerdotdev repos (for LocalDev mode)
 	RepoRoot string
+
+	// OutputFormat selects the dry-run output format and that of the
+	// summary printed when the run ends.
+	// Empty for the human-readable summary, "json" or "yaml" for a single
+	// machine-readable document suitable for policy engines and scripts.
+	OutputFormat string
+
+	// SkipVerify disables image signature verification
+	SkipVerify bool
+
+	// PlatformVersion pins the CRDs and controller images to a platform
+	// release instead of the embedded manifests' latest tags
+	PlatformVersion string
+
+	// ReleaseBundle is a URL or path template of the release bundle;
+	// defaults to platform.DefaultReleaseBundle
+	ReleaseBundle string
+
+	// Simulation runs the bootstrap against an in-memory cluster and mock
+	// provider instead of KIND and real infrastructure
+	Simulation *Simulation
 }
 
 // Orchestrator manages the bootstrap process
 type Orchestrator struct {
 	logger  *log.Logger
 	options Options
+
+	// release is set when bootstrapping a pinned platform release
+	release *pinnedRelease
+
+	// created records what Run has created, for cleanup after an interrupt
+	created createdResources
+
+	// kindName is the KIND cluster of this run, see KINDClusterName
+	kindName string
+
+	// reusedKIND is set when Run continues in a KIND cluster left by an
+	// earlier run of the same config
+	reusedKIND bool
+
+	// result records the phases, resources and credentials of the run for
+	// the summary printed at the end
+	result *summary.Summary
 }
 
 // New creates a new orchestrator
@@ -112,107 +164,231 @@ type clusterCredentials struct {
 	consoleURL      string
 }
 
-// Run executes the bootstrap process
+// Run executes the bootstrap process and prints its summary on stdout
 func (o *Orchestrator) Run(ctx context.Context, cfg *Config) error {
+	switch o.options.OutputFormat {
+	case "", "json", "yaml":
+	default:
+		return fmt.Errorf("unknown output format %q (valid: json, yaml)", o.options.OutputFormat)
+	}
 	if o.options.DryRun {
 		return o.dryRun(cfg)
 	}
 
-	o.logger.Phase("Initializing bootstrap")
+	command := "butleradm bootstrap " + cfg.Provider
+	if o.options.Simulation != nil {
+		command = "butleradm simulate bootstrap"
+	}
+	o.result = summary.New(command)
+
+	err := o.run(ctx, cfg)
+	o.result.Finish(err)
+	if printErr := o.result.Print(os.Stdout, output.Format(o.options.OutputFormat)); printErr != nil && err == nil {
+		return printErr
+	}
+	return err
+}
+
+// phase logs the start of a bootstrap phase and times it for the summary
+func (o *Orchestrator) phase(name string) {
+	o.logger.Phase(name)
+	o.result.Phase(name)
+}
+
+// run goes through the bootstrap phases; the deferred cleanup runs before
+// Run prints the summary
+func (o *Orchestrator) run(ctx context.Context, cfg *Config) (err error) {
+	o.phase("Initializing bootstrap")
+	o.kindName = KINDClusterName(cfg.Cluster.Name)
+
+	// Reach the OS keychain now rather than after the cluster is built;
+	// simulations save nothing
+	if o.options.Simulation == nil {
+		if keychain, err := credstore.UseKeyring(); err != nil {
+			return err
+		} else if keychain {
+			if _, err := credstore.Cipher(true); err != nil {
+				return err
+			}
+		}
+	}
 
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(ctx, o.options.Timeout)
 	defer cancel()
 
+	if err := o.resolveRelease(ctx, cfg); err != nil {
+		return err
+	}
+
 	// Phase 1: Create KIND cluster
-	o.logger.Phase("Creating temporary KIND cluster")
-	kindProvider := cluster.NewProvider()
+	var kubeconfigPath string
+	sim := o.options.Simulation
+	if sim != nil {
+		o.phase("Creating simulated KIND cluster")
+		sim.start(ctx, o.logger, cfg)
+		o.created.kindCluster = true
+		o.result.Created("KIND cluster", "", o.kindName)
+		defer func() {
+			if o.keepAfterInterrupt(ctx, cfg, err) {
+				return
+			}
+			if !o.options.SkipCleanup {
+				o.phase("Cleaning up simulated KIND cluster")
+				o.result.Deleted("KIND cluster", "", o.kindName)
+			}
+		}()
+	} else {
+		// Bootstraps of different clusters get their own KIND cluster; two
+		// of the same cluster would fight over it, so claim it first
+		run := &RunState{
+			Cluster:     cfg.Cluster.Name,
+			Provider:    cfg.Provider,
+			KINDCluster: o.kindName,
+			StartedAt:   time.Now().UTC(),
+			PID:         os.Getpid(),
+		}
+		var stale *RunState
+		stale, err = claimRun(run)
+		if err != nil {
+			return err
+		}
 
-	kubeconfigPath, err := o.createKINDCluster(ctx, kindProvider)
-	if err != nil {
-		return fmt.Errorf("creating KIND cluster: %w", err)
-	}
-	defer func() {
-		if !o.options.SkipCleanup {
-			o.logger.Phase("Cleaning up KIND cluster")
-			if err := kindProvider.Delete(kindClusterName, ""); err != nil {
-				o.logger.Error("failed to delete KIND cluster", "error", err)
+		o.phase("Creating temporary KIND cluster " + o.kindName)
+		kindProvider := cluster.NewProvider()
+
+		kubeconfigPath, err = o.createKINDCluster(ctx, kindProvider, cfg)
+		if err != nil {
+			if err := releaseRun(run.Cluster, stale); err != nil {
+				o.logger.Warn("could not release bootstrap run record", "error", err)
 			}
+			return fmt.Errorf("creating KIND cluster: %w", err)
+		}
+		o.created.kindCluster = true
+		o.created.kubeconfigPath = kubeconfigPath
+		if !o.reusedKIND {
+			o.result.Created("KIND cluster", "", o.kindName)
 		}
-	}()
 
-	// Inject host aliases for corporate DNS resolution (must be after KIND cluster creation)
-	hostAliases := o.getHostAliases(cfg)
-	if len(hostAliases) > 0 {
-		if err := o.injectHostAliases(ctx, hostAliases); err != nil {
-			o.logger.Warn("Failed to inject host aliases", "error", err)
+		run.Kubeconfig = kubeconfigPath
+		if err := saveRun(run); err != nil {
+			o.logger.Warn("could not record bootstrap run", "error", err)
+		}
+		defer func() {
+			if o.keepAfterInterrupt(ctx, cfg, err) || o.options.SkipCleanup {
+				o.leaveRun(run)
+				return
+			}
+			o.phase("Cleaning up KIND cluster")
+			if err := kindProvider.Delete(o.kindName, ""); err != nil {
+				o.logger.Error("failed to delete KIND cluster", "error", err)
+				o.leaveRun(run)
+				return
+			}
+			o.result.Deleted("KIND cluster", "", o.kindName)
+			if err := removeRun(run.Cluster); err != nil {
+				o.logger.Warn("could not remove bootstrap run record", "error", err)
+			}
+		}()
+
+		// Inject host aliases for corporate DNS resolution (must be after KIND cluster creation)
+		hostAliases := o.getHostAliases(cfg)
+		if len(hostAliases) > 0 {
+			if err := o.injectHostAliases(ctx, hostAliases); err != nil {
+				o.logger.Warn("Failed to inject host aliases", "error", err)
+			}
 		}
 	}
 
 	// Build and load images in local dev mode
-	if o.options.LocalDev {
-		o.logger.Phase("Building and loading controller images (local dev mode)")
+	if o.options.LocalDev && sim == nil {
+		o.phase("Building and loading controller images (local dev mode)")
 		if err := o.buildAndLoadImages(ctx, cfg.Provider); err != nil {
 			return fmt.Errorf("building/loading images: %w", err)
 		}
 	}
 
 	// Create Kubernetes clients
-	o.logger.Phase("Connecting to KIND cluster")
+	o.phase("Connecting to KIND cluster")
 	clientset, dynamicClient, err := o.createClients(kubeconfigPath)
 	if err != nil {
 		return fmt.Errorf("creating clients: %w", err)
 	}
+	o.created.dynamic = dynamicClient
+
+	if sim == nil && !o.reusedKIND {
+		if err := o.recordKINDRun(ctx, clientset, cfg); err != nil {
+			return err
+		}
+	}
 
 	// Deploy Butler CRDs
-	o.logger.Phase("Deploying Butler CRDs")
+	o.phase("Deploying Butler CRDs")
 	if err := o.deployCRDs(ctx, clientset, dynamicClient); err != nil {
 		return fmt.Errorf("deploying CRDs: %w", err)
 	}
 
 	// Create namespace and provider secret
-	o.logger.Phase("Creating namespace and secrets")
+	o.phase("Creating namespace and secrets")
 	if err := o.createNamespaceAndSecrets(ctx, clientset, cfg); err != nil {
 		return fmt.Errorf("creating namespace/secrets: %w", err)
 	}
+	o.created.namespace = true
 
 	// Deploy controllers
-	o.logger.Phase("Deploying Butler controllers")
+	o.phase("Deploying Butler controllers")
 	if err := o.deployControllers(ctx, clientset, dynamicClient, cfg); err != nil {
 		return fmt.Errorf("deploying controllers: %w", err)
 	}
 
 	// Create ProviderConfig CR
-	o.logger.Phase("Creating ProviderConfig")
+	o.phase("Creating ProviderConfig")
 	if err := o.createProviderConfig(ctx, dynamicClient, cfg); err != nil {
 		return fmt.Errorf("creating ProviderConfig: %w", err)
 	}
+	o.created.providerConfig = cfg.Cluster.Name + "-provider"
+	o.result.Created("ProviderConfig", butlerNamespace, o.created.providerConfig)
 
 	// Create ClusterBootstrap CR
-	o.logger.Phase("Creating ClusterBootstrap")
+	o.phase("Creating ClusterBootstrap")
 	if err := o.createClusterBootstrap(ctx, dynamicClient, cfg); err != nil {
 		return fmt.Errorf("creating ClusterBootstrap: %w", err)
 	}
+	o.created.clusterBootstrap = cfg.Cluster.Name
+	o.result.Created("ClusterBootstrap", butlerNamespace, o.created.clusterBootstrap)
 
 	// Watch for completion
-	o.logger.Phase("Waiting for cluster bootstrap")
+	o.phase("Waiting for cluster bootstrap")
 	creds, err := o.watchBootstrap(ctx, dynamicClient, cfg)
 	if err != nil {
 		return fmt.Errorf("watching bootstrap: %w", err)
 	}
 
+	if sim != nil {
+		o.logger.Success("Simulated bootstrap complete; nothing was provisioned or saved")
+		return nil
+	}
+
 	// Save cluster credentials
-	o.logger.Phase("Saving cluster credentials")
-	if err := o.saveClusterCredentials(cfg.Cluster.Name, creds); err != nil {
+	o.phase("Saving cluster credentials")
+	savedKubeconfig, savedTalosconfig, err := o.saveClusterCredentials(cfg.Cluster.Name, creds)
+	if err != nil {
 		return fmt.Errorf("saving cluster credentials: %w", err)
 	}
 
+	o.result.Artifact("kubeconfig", savedKubeconfig)
+	o.result.Artifact("talosconfig", savedTalosconfig)
+
+	o.recordPlatform(ctx, savedKubeconfig, cfg)
+
+	var initialErr error
+	if len(cfg.InitialResources) > 0 {
+		o.phase("Creating initial resources")
+		initialErr = o.createInitialResources(ctx, savedKubeconfig, cfg.InitialResources)
+	}
+
 	o.logger.Success("Bootstrap complete!")
 	o.logger.Info("")
-	o.logger.Info("Cluster credentials saved to:")
-	o.logger.Info("  Kubeconfig:   ~/.butler/" + cfg.Cluster.Name + "-kubeconfig")
-	o.logger.Info("  Talosconfig:  ~/.butler/" + cfg.Cluster.Name + "-talosconfig")
-	o.logger.Info("")
 
 	if creds.consoleURL != "" {
 		o.logger.Info("Butler Console:")
@@ -227,18 +403,67 @@ func (o *Orchestrator) Run(ctx context.Context, cfg *Config) error {
 		o.logger.Info("")
 	}
 
-	o.logger.Info("Usage:")
-	o.logger.Info("  export KUBECONFIG=~/.butler/" + cfg.Cluster.Name + "-kubeconfig")
-	o.logger.Info("  export TALOSCONFIG=~/.butler/" + cfg.Cluster.Name + "-talosconfig")
-	o.logger.Info("")
-	o.logger.Info("  kubectl get nodes")
-	o.logger.Info("  talosctl health --nodes <CONTROL_PLANE_IP>")
+	if credstore.IsSealed(savedKubeconfig) {
+		o.logger.Info("Credentials are sealed with a key in the OS keychain. butleradm and")
+		o.logger.Info("butlerctl read them directly; kubectl and talosctl need plain copies.")
+		o.logger.Info("")
+		o.result.Next("butleradm status")
+		return initialErr
+	}
+
+	o.result.Next(paths.ExportHint("KUBECONFIG", savedKubeconfig))
+	o.result.Next(paths.ExportHint("TALOSCONFIG", savedTalosconfig))
+	o.result.Next("kubectl get nodes")
+	o.result.Next("talosctl health --nodes <CONTROL_PLANE_IP>")
+
+	return initialErr
+}
 
+// createInitialResources creates the Teams and ProviderConfigs from a
+// multi-document config on the new management cluster. Failures don't undo
+// the bootstrap; they are reported together at the end.
+func (o *Orchestrator) createInitialResources(ctx context.Context, kubeconfigPath string, resources []*unstructured.Unstructured) error {
+	c, err := client.NewFromKubeconfig(kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster to create initial resources: %w", err)
+	}
+
+	failed := 0
+	for _, res := range resources {
+		gvr := client.TeamGVR
+		if res.GetKind() == "ProviderConfig" {
+			gvr = client.ProviderConfigGVR
+		}
+		var ri dynamic.ResourceInterface = c.Dynamic.Resource(gvr)
+		if res.GetNamespace() != "" {
+			ri = c.Dynamic.Resource(gvr).Namespace(res.GetNamespace())
+		}
+
+		_, err := ri.Create(ctx, res, metav1.CreateOptions{})
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			o.logger.Warn("already exists, left unchanged", "kind", res.GetKind(), "name", res.GetName())
+		case err != nil:
+			o.logger.Error("creating initial resource failed", "kind", res.GetKind(), "name", res.GetName(), "error", err)
+			failed++
+		default:
+			o.logger.Success(res.GetKind()+" created", "name", res.GetName())
+			o.result.Created(res.GetKind(), res.GetNamespace(), res.GetName())
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d initial resources could not be created; the cluster is up, create them with 'butlerctl apply'", failed, len(resources))
+	}
 	return nil
 }
 
 // dryRun shows what would be created
 func (o *Orchestrator) dryRun(cfg *Config) error {
+	if o.options.OutputFormat != "" {
+		return o.dryRunDocument(cfg)
+	}
+
 	o.logger.Info("DRY RUN - showing what would be created")
 
 	// Show topology information
@@ -253,16 +478,23 @@ func (o *Orchestrator) dryRun(cfg *Config) error {
 
 	// Show ProviderConfig
 	pc := o.buildProviderConfigUnstructured(cfg)
-	pcYAML, _ := yaml.Marshal(pc.Object)
+	pcYAML, _ := yaml.Marshal(redact.Value(pc.Object))
 	fmt.Println("\n--- ProviderConfig ---")
 	fmt.Println(string(pcYAML))
 
 	// Show ClusterBootstrap
 	cb := o.buildClusterBootstrapUnstructured(cfg)
-	cbYAML, _ := yaml.Marshal(cb.Object)
+	cbYAML, _ := yaml.Marshal(redact.Value(cb.Object))
 	fmt.Println("\n--- ClusterBootstrap ---")
 	fmt.Println(string(cbYAML))
 
+	// Show resources created after bootstrap
+	for _, res := range cfg.InitialResources {
+		resYAML, _ := yaml.Marshal(redact.Value(res.Object))
+		fmt.Printf("\n--- %s (created after bootstrap) ---\n", res.GetKind())
+		fmt.Println(string(resYAML))
+	}
+
 	// Show MachineRequests that would be created (topology-aware)
 	fmt.Println("\n--- MachineRequests (created by controller) ---")
 	for i := int32(0); i < cfg.Cluster.ControlPlane.Replicas; i++ {
@@ -279,8 +511,17 @@ func (o *Orchestrator) dryRun(cfg *Config) error {
 		fmt.Println("(no workers - single-node topology)")
 	}
 
+	// Show the pinned release; the bundle is only fetched for a real run
+	if o.options.PlatformVersion != "" {
+		fmt.Println("\n--- Platform Release ---")
+		fmt.Printf("Version: %s\n", o.options.PlatformVersion)
+		if location, err := platform.BundleLocation(o.options.ReleaseBundle, o.options.PlatformVersion); err == nil {
+			fmt.Printf("Bundle: %s\n", location)
+		}
+	}
+
 	// Show CA certificates that would be injected
-	caCerts := o.findCACertificates()
+	caCerts := FindCACertificates()
 	if len(caCerts) > 0 {
 		fmt.Println("\n--- CA Certificates (will be injected into KIND) ---")
 		for _, cert := range caCerts {
@@ -310,6 +551,9 @@ func (o *Orchestrator) dryRun(cfg *Config) error {
 			if cfg.Addons.Console.Ingress.ClassName != "" {
 				fmt.Printf("Ingress Class: %s\n", cfg.Addons.Console.Ingress.ClassName)
 			}
+			if cfg.Addons.Console.Ingress.Issuer != "" {
+				fmt.Printf("Certificate Issuer: %s\n", cfg.Addons.Console.Ingress.Issuer)
+			}
 		} else {
 			fmt.Println("Access: via port-forward (no ingress configured)")
 		}
@@ -318,11 +562,47 @@ func (o *Orchestrator) dryRun(cfg *Config) error {
 	return nil
 }
 
-// findCACertificates discovers CA certificates from standard locations.
+// dryRunDocument writes the ProviderConfig and ClusterBootstrap as a single
+// v1 List document on stdout so it can be evaluated by OPA/Conftest in CI.
+func (o *Orchestrator) dryRunDocument(cfg *Config) error {
+	list := redact.Value(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items": append([]interface{}{
+			o.buildProviderConfigUnstructured(cfg).Object,
+			o.buildClusterBootstrapUnstructured(cfg).Object,
+		}, o.initialResources(cfg)...),
+	})
+
+	if o.options.OutputFormat == "yaml" {
+		data, err := yaml.Marshal(list)
+		if err != nil {
+			return fmt.Errorf("marshaling to YAML: %w", err)
+		}
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(list)
+}
+
+// initialResources returns the objects of the resources created after
+// bootstrap
+func (o *Orchestrator) initialResources(cfg *Config) []interface{} {
+	var objects []interface{}
+	for _, res := range cfg.InitialResources {
+		objects = append(objects, res.Object)
+	}
+	return objects
+}
+
+// FindCACertificates discovers CA certificates from standard locations.
 // Priority order:
 // 1. BUTLER_CA_CERT_PATH environment variable (single file or directory)
 // 2. ~/.butler/certificates/ directory (all .crt and .pem files)
-func (o *Orchestrator) findCACertificates() []string {
+func FindCACertificates() []string {
 	var certs []string
 
 	// Check environment variable first
@@ -331,7 +611,7 @@ func (o *Orchestrator) findCACertificates() []string {
 		if err == nil {
 			if info.IsDir() {
 				// It's a directory, scan for cert files
-				dirCerts := o.scanCertDirectory(envPath)
+				dirCerts := scanCertDirectory(envPath)
 				certs = append(certs, dirCerts...)
 			} else {
 				// It's a file
@@ -345,7 +625,7 @@ func (o *Orchestrator) findCACertificates() []string {
 	if err == nil {
 		certDir := filepath.Join(home, defaultCACertDir)
 		if info, err := os.Stat(certDir); err == nil && info.IsDir() {
-			dirCerts := o.scanCertDirectory(certDir)
+			dirCerts := scanCertDirectory(certDir)
 			certs = append(certs, dirCerts...)
 		}
 	}
@@ -354,7 +634,7 @@ func (o *Orchestrator) findCACertificates() []string {
 }
 
 // scanCertDirectory scans a directory for certificate files (.crt, .pem)
-func (o *Orchestrator) scanCertDirectory(dir string) []string {
+func scanCertDirectory(dir string) []string {
 	var certs []string
 
 	entries, err := os.ReadDir(dir)
@@ -367,7 +647,7 @@ func (o *Orchestrator) scanCertDirectory(dir string) []string {
 			continue
 		}
 		name := entry.Name()
-		if strings.HasSuffix(name, ".crt") || strings.HasSuffix(name, ".pem") {
+		if ext := strings.ToLower(filepath.Ext(name)); ext == ".crt" || ext == ".pem" {
 			certs = append(certs, filepath.Join(dir, name))
 		}
 	}
@@ -393,7 +673,7 @@ nodes:
 		mounts.WriteString(fmt.Sprintf(`      - hostPath: %s
         containerPath: %s
         readOnly: true
-`, certPath, containerPath))
+`, filepath.ToSlash(certPath), containerPath))
 	}
 
 	return fmt.Sprintf(`kind: Cluster
@@ -410,7 +690,7 @@ func (o *Orchestrator) installCACertificates(ctx context.Context) error {
 
 	// Run update-ca-certificates inside the KIND container
 	cmd := exec.CommandContext(ctx, "docker", "exec",
-		kindClusterName+"-control-plane",
+		o.kindName+"-control-plane",
 		"update-ca-certificates")
 
 	output, err := cmd.CombinedOutput()
@@ -422,6 +702,37 @@ func (o *Orchestrator) installCACertificates(ctx context.Context) error {
 	return nil
 }
 
+// syncCACertificates copies certificates into a running KIND node and
+// installs them. Each is named after its content, so repeating the sync
+// copies nothing new.
+func (o *Orchestrator) syncCACertificates(ctx context.Context, caCerts []string) error {
+	node := o.kindName + "-control-plane"
+
+	added := 0
+	for _, cert := range caCerts {
+		data, err := os.ReadFile(cert)
+		if err != nil {
+			return fmt.Errorf("reading CA certificate: %w", err)
+		}
+		sum := sha256.Sum256(data)
+		target := fmt.Sprintf("/usr/local/share/ca-certificates/butler-%s.crt", hex.EncodeToString(sum[:8]))
+
+		if exec.CommandContext(ctx, "docker", "exec", node, "test", "-e", target).Run() == nil {
+			continue
+		}
+		if output, err := exec.CommandContext(ctx, "docker", "cp", cert, node+":"+target).CombinedOutput(); err != nil {
+			return fmt.Errorf("copying %s into KIND node: %w, output: %s", cert, err, string(output))
+		}
+		o.logger.Debug("CA certificate copied", "path", cert)
+		added++
+	}
+
+	if added == 0 {
+		return nil
+	}
+	return o.installCACertificates(ctx)
+}
+
 // getHostAliases returns host aliases from the provider config
 func (o *Orchestrator) getHostAliases(cfg *Config) []string {
 	switch cfg.Provider {
@@ -437,7 +748,8 @@ func (o *Orchestrator) getHostAliases(cfg *Config) []string {
 	return nil
 }
 
-// injectHostAliases adds /etc/hosts entries to the KIND container
+// injectHostAliases adds /etc/hosts entries to the KIND container, skipping
+// those a reused cluster already has
 func (o *Orchestrator) injectHostAliases(ctx context.Context, hostAliases []string) error {
 	if len(hostAliases) == 0 {
 		return nil
@@ -447,8 +759,8 @@ func (o *Orchestrator) injectHostAliases(ctx context.Context, hostAliases []stri
 
 	for _, alias := range hostAliases {
 		cmd := exec.CommandContext(ctx, "docker", "exec",
-			kindClusterName+"-control-plane",
-			"sh", "-c", fmt.Sprintf("echo '%s' >> /etc/hosts", alias))
+			o.kindName+"-control-plane",
+			"sh", "-c", `grep -qxF "$1" /etc/hosts || echo "$1" >> /etc/hosts`, "sh", alias)
 
 		if output, err := cmd.CombinedOutput(); err != nil {
 			return fmt.Errorf("failed to inject host alias %q: %w, output: %s", alias, err, string(output))
@@ -460,28 +772,68 @@ func (o *Orchestrator) injectHostAliases(ctx context.Context, hostAliases []stri
 	return nil
 }
 
-// createKINDCluster creates a KIND cluster with the specified configuration
-func (o *Orchestrator) createKINDCluster(ctx context.Context, provider *cluster.Provider) (string, error) {
+// leaveRun records that the bootstrap has exited and kept its KIND cluster
+func (o *Orchestrator) leaveRun(run *RunState) {
+	run.PID = 0
+	if err := saveRun(run); err != nil {
+		o.logger.Warn("could not record bootstrap run", "error", err)
+		return
+	}
+	o.logger.Info("KIND cluster kept; remove it with 'butleradm bootstrap cleanup "+run.Cluster+"'", "name", run.KINDCluster)
+}
+
+// createKINDCluster creates a KIND cluster with the specified configuration,
+// or reuses one left by an earlier run of the same config
+func (o *Orchestrator) createKINDCluster(ctx context.Context, provider *cluster.Provider, cfg *Config) (string, error) {
+	// KIND and the node tweaks below all go through the docker CLI
+	if err := checkDocker(); err != nil {
+		return "", err
+	}
+
 	// Check if cluster already exists
 	clusters, err := provider.List()
 	if err != nil {
 		return "", fmt.Errorf("listing clusters: %w", err)
 	}
 	for _, c := range clusters {
-		if c == kindClusterName {
-			o.logger.Warn("KIND cluster already exists, reusing")
-			kubeconfigPath, err := o.getKINDKubeconfig(provider)
-			if err != nil {
-				return "", err
+		if c != o.kindName {
+			continue
+		}
+		if o.options.RecreateKIND {
+			o.logger.Warn("deleting existing KIND cluster (--recreate-kind)", "name", o.kindName)
+			if err := provider.Delete(o.kindName, ""); err != nil {
+				return "", fmt.Errorf("deleting existing KIND cluster: %w", err)
 			}
-			// Ensure CoreDNS is patched even for existing cluster
-			o.patchCoreDNS(kubeconfigPath)
-			return kubeconfigPath, nil
+			break
+		}
+
+		kubeconfigPath, err := o.getKINDKubeconfig(provider)
+		if err != nil {
+			return "", err
+		}
+		clientset, _, err := o.createClients(kubeconfigPath)
+		if err != nil {
+			return "", fmt.Errorf("connecting to existing KIND cluster: %w", err)
+		}
+		if err := o.checkKINDRun(ctx, clientset, cfg); err != nil {
+			return "", err
 		}
+		o.logger.Warn("KIND cluster already exists from an earlier run of this config, reusing")
+		o.reusedKIND = true
+
+		// The node's CA mounts are fixed at creation; copy in certificates
+		// added since
+		if err := o.syncCACertificates(ctx, FindCACertificates()); err != nil {
+			o.logger.Warn("Failed to sync CA certificates", "error", err)
+		}
+
+		// Ensure CoreDNS is patched even for existing cluster
+		o.patchCoreDNS(kubeconfigPath)
+		return kubeconfigPath, nil
 	}
 
 	// Discover CA certificates
-	caCerts := o.findCACertificates()
+	caCerts := FindCACertificates()
 	if len(caCerts) > 0 {
 		o.logger.Info("Found CA certificates to inject", "count", len(caCerts))
 		for _, cert := range caCerts {
@@ -505,7 +857,7 @@ func (o *Orchestrator) createKINDCluster(ctx context.Context, provider *cluster.
 	configFile.Close()
 
 	// Create cluster with config
-	if err := provider.Create(kindClusterName, cluster.CreateWithConfigFile(configFile.Name())); err != nil {
+	if err := provider.Create(o.kindName, cluster.CreateWithConfigFile(configFile.Name())); err != nil {
 		return "", fmt.Errorf("creating cluster: %w", err)
 	}
 	o.logger.Success("KIND cluster created")
@@ -536,10 +888,24 @@ func (o *Orchestrator) createKINDCluster(ctx context.Context, provider *cluster.
 	return kubeconfigPath, nil
 }
 
+// checkDocker makes sure the docker CLI is on PATH, with a hint suited to
+// the host: Docker Desktop on macOS and Windows, the engine on Linux
+func checkDocker() error {
+	if _, err := exec.LookPath("docker"); err == nil {
+		return nil
+	}
+	switch runtime.GOOS {
+	case "darwin", "windows":
+		return fmt.Errorf("docker not found in PATH; install and start Docker Desktop (https://docs.docker.com/desktop/)")
+	default:
+		return fmt.Errorf("docker not found in PATH; install Docker Engine (https://docs.docker.com/engine/install/)")
+	}
+}
+
 // tuneKINDNode adjusts kernel parameters inside the KIND node
 // to handle controller-runtime's heavy use of inotify watches
 func (o *Orchestrator) tuneKINDNode(ctx context.Context) error {
-	nodeName := kindClusterName + "-control-plane"
+	nodeName := o.kindName + "-control-plane"
 
 	// Increase inotify instances (default 128 is too low for multiple controllers)
 	cmd := exec.CommandContext(ctx, "docker", "exec", nodeName,
@@ -609,13 +975,13 @@ func (o *Orchestrator) patchCoreDNS(kubeconfigPath string) error {
 
 // getKINDKubeconfig retrieves the kubeconfig for the KIND cluster
 func (o *Orchestrator) getKINDKubeconfig(provider *cluster.Provider) (string, error) {
-	kubeconfig, err := provider.KubeConfig(kindClusterName, false)
+	kubeconfig, err := provider.KubeConfig(o.kindName, false)
 	if err != nil {
 		return "", fmt.Errorf("getting kubeconfig: %w", err)
 	}
 
 	// Write to temp file
-	kubeconfigPath := "/tmp/kind-kubeconfig"
+	kubeconfigPath := filepath.Join(os.TempDir(), o.kindName+"-kubeconfig")
 	if err := os.WriteFile(kubeconfigPath, []byte(kubeconfig), 0600); err != nil {
 		return "", fmt.Errorf("writing kubeconfig: %w", err)
 	}
@@ -624,7 +990,11 @@ func (o *Orchestrator) getKINDKubeconfig(provider *cluster.Provider) (string, er
 }
 
 // createClients creates Kubernetes clients for the KIND cluster
-func (o *Orchestrator) createClients(kubeconfigPath string) (*kubernetes.Clientset, dynamic.Interface, error) {
+func (o *Orchestrator) createClients(kubeconfigPath string) (kubernetes.Interface, dynamic.Interface, error) {
+	if sim := o.options.Simulation; sim != nil {
+		return sim.clientset, sim.dynamic, nil
+	}
+
 	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
 	if err != nil {
 		return nil, nil, fmt.Errorf("building config: %w", err)
@@ -644,12 +1014,19 @@ func (o *Orchestrator) createClients(kubeconfigPath string) (*kubernetes.Clients
 }
 
 // deployCRDs deploys Butler CRDs to the KIND cluster
-func (o *Orchestrator) deployCRDs(ctx context.Context, clientset *kubernetes.Clientset, dynamicClient dynamic.Interface) error {
+func (o *Orchestrator) deployCRDs(ctx context.Context, clientset kubernetes.Interface, dynamicClient dynamic.Interface) error {
 	deployer := manifests.NewDeployer(clientset, dynamicClient)
 
-	o.logger.Debug("deploying Butler CRDs from embedded manifests")
-	if err := deployer.DeployCRDs(ctx); err != nil {
-		return fmt.Errorf("deploying CRDs: %w", err)
+	if o.release != nil && o.release.crds != nil {
+		o.logger.Debug("deploying Butler CRDs from release bundle", "version", o.release.version)
+		if err := deployer.DeployCRDsFromYAML(ctx, o.release.crds); err != nil {
+			return fmt.Errorf("deploying CRDs: %w", err)
+		}
+	} else {
+		o.logger.Debug("deploying Butler CRDs from embedded manifests")
+		if err := deployer.DeployCRDs(ctx); err != nil {
+			return fmt.Errorf("deploying CRDs: %w", err)
+		}
 	}
 
 	// Wait for CRDs to be established
@@ -673,7 +1050,7 @@ func (o *Orchestrator) deployCRDs(ctx context.Context, clientset *kubernetes.Cli
 }
 
 // createNamespaceAndSecrets creates the Butler namespace and provider credentials secrets
-func (o *Orchestrator) createNamespaceAndSecrets(ctx context.Context, clientset *kubernetes.Clientset, cfg *Config) error {
+func (o *Orchestrator) createNamespaceAndSecrets(ctx context.Context, clientset kubernetes.Interface, cfg *Config) error {
 	// Create namespace
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -690,6 +1067,10 @@ func (o *Orchestrator) createNamespaceAndSecrets(ctx context.Context, clientset
 	case "harvester":
 		// Read kubeconfig file for Harvester
 		kubeconfigData, err := os.ReadFile(cfg.ProviderConfig.Harvester.KubeconfigPath)
+		if err != nil && o.options.Simulation != nil {
+			o.logger.Warn("Harvester kubeconfig not readable, simulating with a placeholder", "error", err)
+			kubeconfigData, err = []byte("simulated"), nil
+		}
 		if err != nil {
 			return fmt.Errorf("reading Harvester kubeconfig: %w", err)
 		}
@@ -737,8 +1118,28 @@ func (o *Orchestrator) createNamespaceAndSecrets(ctx context.Context, clientset
 }
 
 // deployControllers deploys Butler controllers
-func (o *Orchestrator) deployControllers(ctx context.Context, clientset *kubernetes.Clientset, dynamicClient dynamic.Interface, cfg *Config) error {
+func (o *Orchestrator) deployControllers(ctx context.Context, clientset kubernetes.Interface, dynamicClient dynamic.Interface, cfg *Config) error {
 	deployer := manifests.NewDeployer(clientset, dynamicClient)
+	if o.release != nil {
+		deployer.SetImageTags(o.release.bundle.Controllers)
+	}
+
+	verifier, err := o.imageVerifier(cfg)
+	if err != nil {
+		return err
+	}
+	if verifier != nil {
+		deployer.SetImageVerifier(verifier)
+		if bc := cfg.Addons.ButlerController; bc.Enabled && bc.Image != "" {
+			image := bc.Image
+			if bc.Version != "" && !strings.Contains(image[strings.LastIndex(image, "/")+1:], ":") {
+				image += ":" + bc.Version
+			}
+			if err := verifier.Verify(ctx, image); err != nil {
+				return fmt.Errorf("butler controller image: %w", err)
+			}
+		}
+	}
 
 	o.logger.Debug("deploying Butler controllers from embedded manifests", "provider", cfg.Provider)
 	if err := deployer.DeployControllers(ctx, cfg.Provider); err != nil {
@@ -768,12 +1169,50 @@ func (o *Orchestrator) deployControllers(ctx context.Context, clientset *kuberne
 	return nil
 }
 
+// imageVerifier returns the verifier for controller and addon images, or nil
+// when verification is disabled
+func (o *Orchestrator) imageVerifier(cfg *Config) (*manifests.ImageVerifier, error) {
+	if o.options.SkipVerify {
+		o.logger.Warn("image signature verification disabled (--skip-verify)")
+		return nil, nil
+	}
+	if o.options.LocalDev {
+		// Locally built images are unsigned
+		o.logger.Debug("skipping image signature verification in local dev mode")
+		return nil, nil
+	}
+	if o.options.Simulation != nil {
+		o.logger.Debug("skipping image signature verification in simulation")
+		return nil, nil
+	}
+
+	var identities []manifests.Identity
+	for _, id := range cfg.ImageVerification.Identities {
+		identities = append(identities, manifests.Identity{
+			Issuer:        id.Issuer,
+			Subject:       id.Subject,
+			SubjectRegexp: id.SubjectRegexp,
+		})
+	}
+
+	verifier, err := manifests.NewImageVerifier(cfg.ImageVerification.PublicKey, identities)
+	if err != nil {
+		return nil, err
+	}
+	o.logger.Info("verifying image signatures before deployment")
+	return verifier, nil
+}
+
 // createProviderConfig creates the ProviderConfig CR using unstructured
 func (o *Orchestrator) createProviderConfig(ctx context.Context, client dynamic.Interface, cfg *Config) error {
 	pc := o.buildProviderConfigUnstructured(cfg)
 
 	_, err := client.Resource(providerConfigGVR).Namespace(butlerNamespace).Create(
 		ctx, pc, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) && o.reusedKIND {
+		o.logger.Info("ProviderConfig exists from the earlier run, reusing", "name", pc.GetName())
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("creating ProviderConfig: %w", err)
 	}
@@ -837,16 +1276,54 @@ func (o *Orchestrator) buildProviderConfigUnstructured(cfg *Config) *unstructure
 func (o *Orchestrator) createClusterBootstrap(ctx context.Context, client dynamic.Interface, cfg *Config) error {
 	cb := o.buildClusterBootstrapUnstructured(cfg)
 
+	// Strict field validation fails settings the installed controller
+	// doesn't serve instead of dropping them
 	_, err := client.Resource(clusterBootstrapGVR).Namespace(butlerNamespace).Create(
-		ctx, cb, metav1.CreateOptions{})
+		ctx, cb, metav1.CreateOptions{FieldValidation: metav1.FieldValidationStrict})
+	if apierrors.IsAlreadyExists(err) && o.reusedKIND {
+		o.logger.Info("ClusterBootstrap exists from the earlier run, resuming", "name", cb.GetName())
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("creating ClusterBootstrap: %w", err)
+		return fmt.Errorf("creating ClusterBootstrap: %w", unsupportedConfigError(err))
 	}
 
 	o.logger.Success("ClusterBootstrap created", "name", cb.GetName())
 	return nil
 }
 
+// configFields maps ClusterBootstrap fields that only newer controllers
+// serve to the bootstrap config keys that set them
+var configFields = map[string]string{
+	"spec.addons.console.ingress.issuer": "addons.console.ingress.issuer",
+	"spec.network.vipMode":               "network.vipMode",
+	"spec.network.healthCheck":           "network.healthCheck",
+}
+
+// unsupportedConfigError explains a ClusterBootstrap the CRD rejected in
+// terms of the config keys that set the rejected fields
+func unsupportedConfigError(err error) error {
+	rejectedKeys := map[string]bool{}
+	for _, rejected := range client.RejectedFields(err) {
+		for field, key := range configFields {
+			if rejected == field || strings.HasPrefix(rejected, field+".") {
+				rejectedKeys[key] = true
+			}
+		}
+	}
+	if len(rejectedKeys) == 0 {
+		return err
+	}
+
+	keys := make([]string, 0, len(rejectedKeys))
+	for key := range rejectedKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return fmt.Errorf("the ClusterBootstrap CRD of this release doesn't support %s; remove it from the config or use a release that does: %w",
+		strings.Join(keys, ", "), err)
+}
+
 // buildClusterBootstrapUnstructured builds a ClusterBootstrap as unstructured
 func (o *Orchestrator) buildClusterBootstrapUnstructured(cfg *Config) *unstructured.Unstructured {
 	// Build cluster spec based on topology
@@ -854,10 +1331,10 @@ func (o *Orchestrator) buildClusterBootstrapUnstructured(cfg *Config) *unstructu
 		"name":     cfg.Cluster.Name,
 		"topology": cfg.Cluster.Topology, // Include topology field
 		"controlPlane": map[string]interface{}{
-			"replicas": cfg.Cluster.ControlPlane.Replicas,
-			"cpu":      cfg.Cluster.ControlPlane.CPU,
-			"memoryMB": cfg.Cluster.ControlPlane.MemoryMB,
-			"diskGB":   cfg.Cluster.ControlPlane.DiskGB,
+			"replicas": int64(cfg.Cluster.ControlPlane.Replicas),
+			"cpu":      int64(cfg.Cluster.ControlPlane.CPU),
+			"memoryMB": int64(cfg.Cluster.ControlPlane.MemoryMB),
+			"diskGB":   int64(cfg.Cluster.ControlPlane.DiskGB),
 		},
 	}
 
@@ -867,7 +1344,7 @@ func (o *Orchestrator) buildClusterBootstrapUnstructured(cfg *Config) *unstructu
 		var extraDisks []interface{}
 		for _, disk := range cfg.Cluster.Workers.ExtraDisks {
 			d := map[string]interface{}{
-				"sizeGB": disk.SizeGB,
+				"sizeGB": int64(disk.SizeGB),
 			}
 			if disk.StorageClass != "" {
 				d["storageClass"] = disk.StorageClass
@@ -876,10 +1353,10 @@ func (o *Orchestrator) buildClusterBootstrapUnstructured(cfg *Config) *unstructu
 		}
 
 		workersSpec := map[string]interface{}{
-			"replicas": cfg.Cluster.Workers.Replicas,
-			"cpu":      cfg.Cluster.Workers.CPU,
-			"memoryMB": cfg.Cluster.Workers.MemoryMB,
-			"diskGB":   cfg.Cluster.Workers.DiskGB,
+			"replicas": int64(cfg.Cluster.Workers.Replicas),
+			"cpu":      int64(cfg.Cluster.Workers.CPU),
+			"memoryMB": int64(cfg.Cluster.Workers.MemoryMB),
+			"diskGB":   int64(cfg.Cluster.Workers.DiskGB),
 		}
 		if len(extraDisks) > 0 {
 			workersSpec["extraDisks"] = extraDisks
@@ -902,11 +1379,7 @@ func (o *Orchestrator) buildClusterBootstrapUnstructured(cfg *Config) *unstructu
 					"namespace": butlerNamespace,
 				},
 				"cluster": clusterSpec,
-				"network": map[string]interface{}{
-					"podCIDR":     cfg.Network.PodCIDR,
-					"serviceCIDR": cfg.Network.ServiceCIDR,
-					"vip":         cfg.Network.VIP,
-				},
+				"network": buildNetworkConfig(cfg.Network),
 				"talos": map[string]interface{}{
 					"version":   cfg.Talos.Version,
 					"schematic": cfg.Talos.Schematic,
@@ -945,118 +1418,134 @@ func (o *Orchestrator) buildClusterBootstrapUnstructured(cfg *Config) *unstructu
 
 // watchBootstrap watches the ClusterBootstrap CR for completion
 func (o *Orchestrator) watchBootstrap(ctx context.Context, client dynamic.Interface, cfg *Config) (*clusterCredentials, error) {
-	// Poll for status updates
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
-
-	lastPhase := ""
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-ticker.C:
-			cb, err := client.Resource(clusterBootstrapGVR).Namespace(butlerNamespace).Get(
-				ctx, cfg.Cluster.Name, metav1.GetOptions{})
-			if err != nil {
-				o.logger.Warn("failed to get ClusterBootstrap", "error", err)
-				continue
-			}
+	var creds *clusterCredentials
+	var vipState externalVIPState
 
-			// Extract status
-			status, ok := cb.Object["status"].(map[string]interface{})
-			if !ok {
-				o.logger.Debug("no status yet")
-				continue
-			}
+	interval := 5 * time.Second
+	if o.options.Simulation != nil {
+		interval = time.Second
+	}
 
-			phase, _ := status["phase"].(string)
-			if phase != lastPhase {
-				o.logger.Info("phase changed", "phase", phase)
-				lastPhase = phase
-			}
+	// Poll for status updates
+	err := waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("ClusterBootstrap %s to be Ready", cfg.Cluster.Name),
+		Interval:    interval,
+		Progress: func(phase string, elapsed time.Duration) {
+			o.logger.Info("phase changed", "phase", phase, "elapsed", elapsed)
+		},
+	}, func(ctx context.Context) (bool, string, error) {
+		cb, err := client.Resource(clusterBootstrapGVR).Namespace(butlerNamespace).Get(
+			ctx, cfg.Cluster.Name, metav1.GetOptions{})
+		if err != nil {
+			o.logger.Warn("failed to get ClusterBootstrap", "error", err)
+			return false, "", nil
+		}
 
-			// Collect control plane IPs from machine status
-			var controlPlaneIPs []string
-			if machines, ok := status["machines"].([]interface{}); ok {
-				for _, m := range machines {
-					if machine, ok := m.(map[string]interface{}); ok {
-						o.logger.Debug("machine status",
-							"name", machine["name"],
-							"phase", machine["phase"],
-							"ip", machine["ipAddress"],
-							"ready", machine["ready"],
-						)
-						// Collect control plane IPs for talosconfig endpoints
-						if role, _ := machine["role"].(string); role == "control-plane" {
-							if ip, _ := machine["ipAddress"].(string); ip != "" {
-								controlPlaneIPs = append(controlPlaneIPs, ip)
-							}
+		// Extract status
+		status, ok := cb.Object["status"].(map[string]interface{})
+		if !ok {
+			o.logger.Debug("no status yet")
+			return false, "", nil
+		}
+
+		phase, _ := status["phase"].(string)
+
+		// Collect control plane IPs from machine status
+		var controlPlaneIPs []string
+		if machines, ok := status["machines"].([]interface{}); ok {
+			for _, m := range machines {
+				if machine, ok := m.(map[string]interface{}); ok {
+					o.logger.Debug("machine status",
+						"name", machine["name"],
+						"phase", machine["phase"],
+						"ip", machine["ipAddress"],
+						"ready", machine["ready"],
+					)
+					// Collect control plane IPs for talosconfig endpoints
+					if role, _ := machine["role"].(string); role == "control-plane" {
+						if ip, _ := machine["ipAddress"].(string); ip != "" {
+							controlPlaneIPs = append(controlPlaneIPs, ip)
 						}
 					}
 				}
 			}
+		}
 
-			switch phase {
-			case "Ready":
-				o.logger.Success("Cluster is ready!")
+		simulated := o.options.Simulation != nil
+		if cfg.IsExternalVIP() && !simulated && phase != "Ready" && len(controlPlaneIPs) > 0 {
+			o.observeExternalVIP(ctx, cfg, &vipState, controlPlaneIPs)
+		}
 
-				// Decode kubeconfig
-				kubeconfig, _ := status["kubeconfig"].(string)
-				kubeconfigBytes, err := base64.StdEncoding.DecodeString(kubeconfig)
-				if err != nil {
-					return nil, fmt.Errorf("decoding kubeconfig: %w", err)
-				}
+		switch phase {
+		case "Ready":
+			o.logger.Success("Cluster is ready!")
+
+			// Decode kubeconfig
+			kubeconfig, _ := status["kubeconfig"].(string)
+			kubeconfigBytes, err := base64.StdEncoding.DecodeString(kubeconfig)
+			if err != nil {
+				return false, phase, fmt.Errorf("decoding kubeconfig: %w", err)
+			}
 
-				// Decode talosconfig - NOTE: JSON field is lowercase "talosconfig"
-				talosconfig, _ := status["talosconfig"].(string)
-				talosconfigBytes, err := base64.StdEncoding.DecodeString(talosconfig)
-				if err != nil {
-					return nil, fmt.Errorf("decoding talosconfig: %w", err)
+			// Decode talosconfig - NOTE: JSON field is lowercase "talosconfig"
+			talosconfig, _ := status["talosconfig"].(string)
+			talosconfigBytes, err := base64.StdEncoding.DecodeString(talosconfig)
+			if err != nil {
+				return false, phase, fmt.Errorf("decoding talosconfig: %w", err)
+			}
+
+			if cfg.IsExternalVIP() && !simulated {
+				if err := verifyExternalVIP(ctx, cfg, kubeconfigBytes); err != nil {
+					return false, phase, err
 				}
+				o.logger.Success("External VIP verified", "vip", cfg.Network.VIP)
+			}
+
+			consoleURL, _ := status["consoleURL"].(string)
 
-				consoleURL, _ := status["consoleURL"].(string)
-
-				return &clusterCredentials{
-					kubeconfig:      kubeconfigBytes,
-					talosconfig:     talosconfigBytes,
-					controlPlaneIPs: controlPlaneIPs,
-					consoleURL:      consoleURL,
-				}, nil
-			case "Failed":
-				reason, _ := status["failureReason"].(string)
-				message, _ := status["failureMessage"].(string)
-				return nil, fmt.Errorf("bootstrap failed: %s - %s", reason, message)
+			creds = &clusterCredentials{
+				kubeconfig:      kubeconfigBytes,
+				talosconfig:     talosconfigBytes,
+				controlPlaneIPs: controlPlaneIPs,
+				consoleURL:      consoleURL,
 			}
+			return true, phase, nil
+		case "Failed":
+			reason, _ := status["failureReason"].(string)
+			message, _ := status["failureMessage"].(string)
+			return false, phase, fmt.Errorf("bootstrap failed: %s - %s", reason, message)
 		}
+		return false, phase, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	return creds, nil
 }
 
-// saveClusterCredentials saves the kubeconfig and talosconfig to ~/.butler/
-func (o *Orchestrator) saveClusterCredentials(clusterName string, creds *clusterCredentials) error {
-	home, err := os.UserHomeDir()
+// saveClusterCredentials saves the kubeconfig and talosconfig to ~/.butler/,
+// sealed when BUTLER_CREDENTIAL_STORE=keyring, and returns their paths
+func (o *Orchestrator) saveClusterCredentials(clusterName string, creds *clusterCredentials) (string, string, error) {
+	butlerDir, err := paths.ButlerDir()
 	if err != nil {
-		return fmt.Errorf("getting home directory: %w", err)
-	}
-
-	butlerDir := filepath.Join(home, ".butler")
-	if err := os.MkdirAll(butlerDir, 0700); err != nil {
-		return fmt.Errorf("creating .butler directory: %w", err)
+		return "", "", err
 	}
 
 	// Save kubeconfig
-	kubeconfigPath := filepath.Join(butlerDir, clusterName+"-kubeconfig")
-	if err := os.WriteFile(kubeconfigPath, creds.kubeconfig, 0600); err != nil {
-		return fmt.Errorf("writing kubeconfig: %w", err)
+	kubeconfigPath, err := credstore.Save(butlerDir, clusterName+"-kubeconfig", creds.kubeconfig)
+	if err != nil {
+		return "", "", fmt.Errorf("writing kubeconfig: %w", err)
 	}
 
 	// Fix talosconfig endpoints and save
 	talosconfig := o.fixTalosconfigEndpoints(creds.talosconfig, clusterName, creds.controlPlaneIPs)
-	talosconfigPath := filepath.Join(butlerDir, clusterName+"-talosconfig")
-	if err := os.WriteFile(talosconfigPath, talosconfig, 0600); err != nil {
-		return fmt.Errorf("writing talosconfig: %w", err)
+	talosconfigPath, err := credstore.Save(butlerDir, clusterName+"-talosconfig", talosconfig)
+	if err != nil {
+		return "", "", fmt.Errorf("writing talosconfig: %w", err)
 	}
 
-	return nil
+	return kubeconfigPath, talosconfigPath, nil
 }
 
 // fixTalosconfigEndpoints adds endpoints to the talosconfig if they're empty
@@ -1156,7 +1645,7 @@ func (o *Orchestrator) buildAndLoadImages(ctx context.Context, provider string)
 
 		// Load into KIND
 		o.logger.Info("loading image into KIND", "image", img.image)
-		loadCmd := exec.CommandContext(ctx, "kind", "load", "docker-image", img.image, "--name", kindClusterName)
+		loadCmd := exec.CommandContext(ctx, "kind", "load", "docker-image", img.image, "--name", o.kindName)
 		loadCmd.Stdout = os.Stdout
 		loadCmd.Stderr = os.Stderr
 
@@ -1169,6 +1658,27 @@ func (o *Orchestrator) buildAndLoadImages(ctx context.Context, provider string)
 	return nil
 }
 
+// buildNetworkConfig builds the ClusterBootstrap network section. The
+// health check is only passed on for an external VIP.
+func buildNetworkConfig(cfg NetworkConfig) map[string]interface{} {
+	network := map[string]interface{}{
+		"podCIDR":     cfg.PodCIDR,
+		"serviceCIDR": cfg.ServiceCIDR,
+		"vip":         cfg.VIP,
+	}
+	// The default kube-vip mode is left out, so only external VIPs need a
+	// controller whose ClusterBootstrap CRD defines vipMode
+	if cfg.VIPMode == VIPModeExternal {
+		network["vipMode"] = cfg.VIPMode
+		network["healthCheck"] = map[string]interface{}{
+			"port":           int64(cfg.HealthCheck.Port),
+			"path":           cfg.HealthCheck.Path,
+			"timeoutSeconds": int64(cfg.HealthCheck.TimeoutSeconds),
+		}
+	}
+	return network
+}
+
 // buildConsoleConfig builds the console addon config for the ClusterBootstrap CR
 func buildConsoleConfig(cfg ConsoleConfig) map[string]interface{} {
 	if !cfg.Enabled {
@@ -1183,13 +1693,17 @@ func buildConsoleConfig(cfg ConsoleConfig) map[string]interface{} {
 	}
 
 	if cfg.Ingress.Enabled {
-		result["ingress"] = map[string]interface{}{
+		ingress := map[string]interface{}{
 			"enabled":       true,
 			"host":          cfg.Ingress.Host,
 			"className":     cfg.Ingress.ClassName,
 			"tls":           cfg.Ingress.TLS,
 			"tlsSecretName": cfg.Ingress.TLSSecretName,
 		}
+		if cfg.Ingress.Issuer != "" {
+			ingress["issuer"] = cfg.Ingress.Issuer
+		}
+		result["ingress"] = ingress
 	}
 
 	return result
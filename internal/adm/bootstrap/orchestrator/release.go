@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/redact"
+	"github.com/butlerdotdev/butler/internal/common/version"
+	"sigs.k8s.io/yaml"
+)
+
+// pinnedRelease is the platform release selected with --platform-version
+type pinnedRelease struct {
+	version  string
+	location string
+	bundle   *platform.ReleaseBundle
+
+	// crds holds the bundle's CRD manifests, or nil for the embedded ones
+	crds []byte
+}
+
+// resolveRelease loads the release bundle before anything is created, so
+// a bad version fails fast
+func (o *Orchestrator) resolveRelease(ctx context.Context, cfg *Config) error {
+	version := o.options.PlatformVersion
+	if version == "" {
+		return nil
+	}
+	if !platform.ValidReleaseVersion(version) {
+		return fmt.Errorf("invalid --platform-version %q: expected a release tag such as v0.3.1", version)
+	}
+	if o.options.LocalDev {
+		return fmt.Errorf("--platform-version can't be combined with local development mode")
+	}
+
+	location, err := platform.BundleLocation(o.options.ReleaseBundle, version)
+	if err != nil {
+		return err
+	}
+	bundle, err := platform.LoadReleaseBundle(ctx, location, version)
+	if err != nil {
+		return err
+	}
+	release := &pinnedRelease{version: version, location: location, bundle: bundle}
+
+	crdLocation, err := bundle.CRDLocation(location)
+	if err != nil {
+		return err
+	}
+	if crdLocation != "" {
+		if release.crds, err = platform.Fetch(ctx, crdLocation); err != nil {
+			return fmt.Errorf("loading release CRDs: %w", err)
+		}
+	}
+
+	// The management cluster's butler-controller follows the release
+	// unless the config pins its own version
+	bc := &cfg.Addons.ButlerController
+	if tag := bundle.Controllers["butler-controller"]; tag != "" {
+		if bc.Version == "" {
+			bc.Version = tag
+		} else if bc.Version != tag {
+			o.logger.Warn("addons.butlerController.version overrides the release", "version", bc.Version, "release", tag)
+		}
+	}
+
+	o.release = release
+	o.logger.Info("pinned platform release", "version", version, "bundle", location)
+	return nil
+}
+
+// recordPlatform stores the pinned release and the bootstrap record on the
+// new management cluster for status, info and upgrade. Failing to record
+// them doesn't fail the bootstrap.
+func (o *Orchestrator) recordPlatform(ctx context.Context, kubeconfigPath string, cfg *Config) {
+	c, err := client.NewFromKubeconfig(kubeconfigPath)
+	if err != nil {
+		o.logger.Warn("could not record bootstrap info", "error", err)
+		return
+	}
+
+	if o.release != nil {
+		if err := platform.RecordInstalledVersion(ctx, c.Clientset, o.release.version, o.release.location); err != nil {
+			o.logger.Warn("could not record the platform version", "version", o.release.version, "error", err)
+		} else {
+			o.logger.Success("platform version recorded", "version", o.release.version, "configmap", platform.VersionConfigMapName)
+		}
+	}
+
+	info := &platform.BootstrapInfo{
+		CLIVersion:     version.Version,
+		GitCommit:      version.GitCommit(),
+		BootstrappedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if cfg.Values != nil {
+		// Always redacted: the record outlives --show-secrets
+		data, err := yaml.Marshal(redact.Mask(cfg.Values))
+		if err != nil {
+			o.logger.Warn("could not encode the bootstrap config", "error", err)
+		} else {
+			info.Config = string(data)
+		}
+	}
+	if err := platform.RecordBootstrapInfo(ctx, c.Clientset, info); err != nil {
+		o.logger.Warn("could not record bootstrap info", "error", err)
+		return
+	}
+	o.logger.Debug("bootstrap info recorded", "configmap", platform.BootstrapInfoConfigMapName)
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/paths"
+	"sigs.k8s.io/kind/pkg/cluster"
+)
+
+// RunState records a bootstrap and its KIND cluster in
+// ~/.butler/bootstrap/<cluster>.json. It is claimed before the KIND cluster
+// is created and removed when the cluster is deleted, so it lists both
+// running bootstraps and KIND clusters they left behind.
+type RunState struct {
+	Cluster     string    `json:"cluster"`
+	Provider    string    `json:"provider"`
+	KINDCluster string    `json:"kindCluster"`
+	Kubeconfig  string    `json:"kubeconfig"`
+	StartedAt   time.Time `json:"startedAt"`
+
+	// PID is the butleradm process running the bootstrap, or 0 once it has
+	// exited and kept its KIND cluster
+	PID int `json:"pid,omitempty"`
+}
+
+const (
+	// kindNamePrefix starts every bootstrap KIND cluster name
+	kindNamePrefix = "butler-bootstrap-"
+
+	// kindNodeSuffix is what KIND appends to the cluster name to name its
+	// control plane container, which is also the node's hostname
+	kindNodeSuffix = "-control-plane"
+
+	// maxHostnameLength is the longest hostname, a single DNS label
+	maxHostnameLength = 63
+
+	// kindNameHashLength is how many hex digits of the cluster name's hash
+	// keep shortened names apart
+	kindNameHashLength = 8
+)
+
+// KINDClusterName returns the KIND cluster that bootstraps cluster, so
+// bootstraps of different clusters can run side by side. Names too long
+// for the KIND node's hostname are shortened and suffixed with a hash of
+// the full name.
+func KINDClusterName(cluster string) string {
+	maxName := maxHostnameLength - len(kindNamePrefix) - len(kindNodeSuffix)
+	if len(cluster) <= maxName {
+		return kindNamePrefix + cluster
+	}
+	sum := sha256.Sum256([]byte(cluster))
+	short := strings.TrimRight(cluster[:maxName-kindNameHashLength-1], "-")
+	return kindNamePrefix + short + "-" + hex.EncodeToString(sum[:])[:kindNameHashLength]
+}
+
+// Running reports whether the bootstrap's process is still alive
+func (r *RunState) Running() bool {
+	if r.PID == 0 {
+		return false
+	}
+	p, err := os.FindProcess(r.PID)
+	if err != nil {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		// FindProcess already fails for processes that have exited
+		return true
+	}
+	return p.Signal(syscall.Signal(0)) == nil
+}
+
+// runStateDir returns ~/.butler/bootstrap
+func runStateDir() (string, error) {
+	dir, err := paths.ButlerDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "bootstrap"), nil
+}
+
+// ListRuns returns the recorded bootstraps, by cluster name
+func ListRuns() ([]RunState, error) {
+	dir, err := runStateDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", dir, err)
+	}
+
+	var runs []RunState
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		run, err := LoadRun(name)
+		if err != nil {
+			return nil, err
+		}
+		if run != nil {
+			runs = append(runs, *run)
+		}
+	}
+	sort.Slice(runs, func(i, j int) bool { return runs[i].Cluster < runs[j].Cluster })
+	return runs, nil
+}
+
+// LoadRun returns the recorded bootstrap of cluster, or nil if there is none
+func LoadRun(cluster string) (*RunState, error) {
+	dir, err := runStateDir()
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, cluster+".json")
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var run RunState
+	if err := json.Unmarshal(data, &run); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &run, nil
+}
+
+// saveRun writes the state file of run
+func saveRun(run *RunState) error {
+	dir, err := runStateDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("creating %s: %w", dir, err)
+	}
+	data, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, run.Cluster+".json"), data, 0600)
+}
+
+// claimRun records run before its KIND cluster is created. The state file
+// is created exclusively, so of two bootstraps of the same cluster only one
+// proceeds. A record left by a bootstrap that is no longer running is taken
+// over and returned, to be restored by releaseRun.
+func claimRun(run *RunState) (*RunState, error) {
+	dir, err := runStateDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("creating %s: %w", dir, err)
+	}
+	data, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, run.Cluster+".json")
+
+	var stale *RunState
+	for {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err == nil {
+			_, err = f.Write(data)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(path)
+				return nil, fmt.Errorf("writing %s: %w", path, err)
+			}
+			return stale, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("creating %s: %w", path, err)
+		}
+
+		prev, err := LoadRun(run.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		if prev == nil {
+			// Removed since the create failed
+			continue
+		}
+		if prev.Running() {
+			return nil, fmt.Errorf("a bootstrap of %s is already running (pid %d, started %s); see 'butleradm bootstrap status'",
+				run.Cluster, prev.PID, prev.StartedAt.Format(time.RFC3339))
+		}
+		if err := removeRun(run.Cluster); err != nil {
+			return nil, fmt.Errorf("removing stale bootstrap record of %s: %w", run.Cluster, err)
+		}
+		stale = prev
+	}
+}
+
+// releaseRun gives up a run claimed with claimRun before its KIND cluster
+// was created, restoring the record it took over, if any
+func releaseRun(cluster string, stale *RunState) error {
+	if stale != nil {
+		return saveRun(stale)
+	}
+	return removeRun(cluster)
+}
+
+// removeRun deletes the state file of cluster
+func removeRun(cluster string) error {
+	dir, err := runStateDir()
+	if err != nil {
+		return err
+	}
+	err = os.Remove(filepath.Join(dir, cluster+".json"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// CleanupRun deletes the KIND cluster a stopped bootstrap left behind and
+// forgets the run
+func CleanupRun(run *RunState) error {
+	if run.Running() {
+		return fmt.Errorf("bootstrap of %s is still running (pid %d); stop it first", run.Cluster, run.PID)
+	}
+	if err := cluster.NewProvider().Delete(run.KINDCluster, ""); err != nil {
+		return fmt.Errorf("deleting KIND cluster %s: %w", run.KINDCluster, err)
+	}
+	if run.Kubeconfig != "" {
+		os.Remove(run.Kubeconfig)
+	}
+	return removeRun(run.Cluster)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKINDClusterName(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		cluster string
+		want    string
+	}{
+		{"short", "prod", kindNamePrefix + "prod"},
+		{"longest kept whole", strings.Repeat("a", 32), kindNamePrefix + strings.Repeat("a", 32)},
+		{"shortened", strings.Repeat("a", 33), ""},
+		{"trailing dash trimmed", strings.Repeat("a", 22) + "-bbbbbbbbbbbbbb", ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := KINDClusterName(tt.cluster)
+			if tt.want != "" && got != tt.want {
+				t.Errorf("KINDClusterName(%q) = %q, want %q", tt.cluster, got, tt.want)
+			}
+			if node := got + kindNodeSuffix; len(node) > maxHostnameLength {
+				t.Errorf("KIND node %q is longer than a hostname allows", node)
+			}
+			if strings.Contains(got, "--") {
+				t.Errorf("KINDClusterName(%q) = %q has a double dash", tt.cluster, got)
+			}
+		})
+	}
+}
+
+func TestKINDClusterNameDistinct(t *testing.T) {
+	base := strings.Repeat("management-cluster-", 3)
+	a, b := KINDClusterName(base+"eu-west"), KINDClusterName(base+"us-east")
+	if a == b {
+		t.Errorf("long names sharing a prefix both map to %q", a)
+	}
+	if KINDClusterName(base+"eu-west") != a {
+		t.Errorf("KINDClusterName is not stable")
+	}
+}
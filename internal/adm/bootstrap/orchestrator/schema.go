@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import _ "embed"
+
+//go:generate go run ../../../../hack/schemagen -type Config -tag mapstructure -profiles -title "Butler bootstrap config" -out bootstrap.schema.json
+
+// Schema is the JSON Schema of the bootstrap config, generated from Config.
+// Run 'make generate' after changing the config types.
+//
+//go:embed bootstrap.schema.json
+var Schema []byte
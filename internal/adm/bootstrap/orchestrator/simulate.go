@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// Fault is a failure a Simulation injects
+type Fault string
+
+// Faults a Simulation can inject
+const (
+	// FaultCRDApply makes the API server reject the Butler CRDs
+	FaultCRDApply Fault = "crd-apply"
+
+	// FaultCredentialsRejected makes the provider fail the bootstrap
+	// because it doesn't accept the credentials Secret
+	FaultCredentialsRejected Fault = "credentials-rejected"
+
+	// FaultMachineTimeout leaves the machines provisioning until the
+	// bootstrap times out
+	FaultMachineTimeout Fault = "machine-timeout"
+)
+
+// FaultDescriptions explains each Fault, for help text
+var FaultDescriptions = map[Fault]string{
+	FaultCRDApply:            "the API server rejects the Butler CRDs",
+	FaultCredentialsRejected: "the provider rejects the credentials Secret and fails the bootstrap",
+	FaultMachineTimeout:      "machines never finish provisioning, so the bootstrap times out",
+}
+
+// ParseFault validates a fault name; "" means no fault
+func ParseFault(name string) (Fault, error) {
+	if name == "" {
+		return "", nil
+	}
+	f := Fault(name)
+	if _, ok := FaultDescriptions[f]; !ok {
+		var names []string
+		for known := range FaultDescriptions {
+			names = append(names, string(known))
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("unknown fault %q (valid: %s)", name, strings.Join(names, ", "))
+	}
+	return f, nil
+}
+
+// simulatedPhases are the ClusterBootstrap phases the mock provider walks
+// through, one per step
+var simulatedPhases = []string{"Pending", "ProvisioningMachines", "ConfiguringTalos", "BootstrappingCluster", "InstallingAddons", "Ready"}
+
+// Simulation stands in for the KIND cluster and the provider. The
+// orchestrator talks to in-memory clients, and a mock cluster marks CRDs
+// established, controllers ready and walks the ClusterBootstrap through its
+// phases, failing it as the Fault asks. Nothing is provisioned or saved.
+type Simulation struct {
+	// Fault is the failure to inject, or "" for a clean run
+	Fault Fault
+
+	// Step is how often the mock cluster advances
+	Step time.Duration
+
+	clientset *kubefake.Clientset
+	dynamic   *dynamicfake.FakeDynamicClient
+	logger    *log.Logger
+}
+
+// NewSimulation creates a Simulation injecting fault
+func NewSimulation(fault Fault) *Simulation {
+	listKinds := map[schema.GroupVersionResource]string{
+		crdGVR:                   "CustomResourceDefinitionList",
+		deploymentGVR:            "DeploymentList",
+		clusterBootstrapGVR:      "ClusterBootstrapList",
+		providerConfigGVR:        "ProviderConfigList",
+		client.MachineRequestGVR: "MachineRequestList",
+	}
+	return &Simulation{
+		Fault:     fault,
+		Step:      time.Second,
+		clientset: kubefake.NewSimpleClientset(),
+		dynamic:   dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds),
+	}
+}
+
+var (
+	crdGVR        = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	deploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+)
+
+// start injects API faults and runs the mock cluster until ctx is done
+func (s *Simulation) start(ctx context.Context, logger *log.Logger, cfg *Config) {
+	s.logger = logger
+	if s.Fault != "" {
+		logger.Warn("simulating failure", "fault", s.Fault, "effect", FaultDescriptions[s.Fault])
+	}
+	if s.Fault == FaultCRDApply {
+		s.dynamic.PrependReactor("create", "customresourcedefinitions", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("admission webhook denied the request (simulated %s fault)", FaultCRDApply)
+		})
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.Step)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.establishCRDs(ctx)
+				s.readyDeployments(ctx)
+				s.advanceBootstrap(ctx, cfg)
+			}
+		}
+	}()
+}
+
+// establishCRDs marks every CRD Established, as the API server would
+func (s *Simulation) establishCRDs(ctx context.Context) {
+	list, err := s.dynamic.Resource(crdGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return
+	}
+	for i := range list.Items {
+		crd := &list.Items[i]
+		conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+		if len(conditions) > 0 {
+			continue
+		}
+		_ = unstructured.SetNestedSlice(crd.Object, []interface{}{
+			map[string]interface{}{"type": "Established", "status": "True"},
+		}, "status", "conditions")
+		_, _ = s.dynamic.Resource(crdGVR).Update(ctx, crd, metav1.UpdateOptions{})
+	}
+}
+
+// readyDeployments marks every controller Deployment's replicas ready
+func (s *Simulation) readyDeployments(ctx context.Context) {
+	list, err := s.dynamic.Resource(deploymentGVR).Namespace(butlerNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return
+	}
+	for i := range list.Items {
+		deploy := &list.Items[i]
+		replicas, found, _ := unstructured.NestedInt64(deploy.Object, "spec", "replicas")
+		if !found {
+			replicas = 1
+		}
+		ready, _, _ := unstructured.NestedInt64(deploy.Object, "status", "readyReplicas")
+		if ready == replicas {
+			continue
+		}
+		_ = unstructured.SetNestedField(deploy.Object, replicas, "status", "readyReplicas")
+		_, _ = s.dynamic.Resource(deploymentGVR).Namespace(butlerNamespace).Update(ctx, deploy, metav1.UpdateOptions{})
+	}
+}
+
+// advanceBootstrap plays the provider: each step moves the ClusterBootstrap
+// to its next phase, unless the Fault stops it
+func (s *Simulation) advanceBootstrap(ctx context.Context, cfg *Config) {
+	resource := s.dynamic.Resource(clusterBootstrapGVR).Namespace(butlerNamespace)
+	cb, err := resource.Get(ctx, cfg.Cluster.Name, metav1.GetOptions{})
+	if err != nil {
+		return
+	}
+
+	phase, _, _ := unstructured.NestedString(cb.Object, "status", "phase")
+	next := 0
+	for i, p := range simulatedPhases {
+		if p == phase {
+			next = i + 1
+		}
+	}
+	if phase == "Failed" || next >= len(simulatedPhases) {
+		return
+	}
+
+	status := map[string]interface{}{"phase": simulatedPhases[next]}
+	machinePhase := "Provisioning"
+	switch {
+	case s.Fault == FaultCredentialsRejected && simulatedPhases[next] == "ProvisioningMachines":
+		status = map[string]interface{}{
+			"phase":          "Failed",
+			"failureReason":  "CredentialsRejected",
+			"failureMessage": fmt.Sprintf("%s rejected the credentials in Secret %s/%s-%s-credentials (simulated)", cfg.Provider, butlerNamespace, cfg.Cluster.Name, cfg.Provider),
+		}
+	case s.Fault == FaultMachineTimeout && next > 1:
+		// Stuck provisioning until the orchestrator gives up
+		status["phase"] = "ProvisioningMachines"
+	case next > 1:
+		machinePhase = "Running"
+	}
+	if next >= 1 && status["phase"] != "Failed" {
+		status["machines"] = simulatedMachines(cfg, machinePhase)
+	}
+	if status["phase"] == "Ready" {
+		status["kubeconfig"] = base64.StdEncoding.EncodeToString([]byte("# simulated kubeconfig\n"))
+		status["talosconfig"] = base64.StdEncoding.EncodeToString([]byte("# simulated talosconfig\n"))
+	}
+
+	if status["phase"] == phase {
+		return
+	}
+	cb.Object["status"] = status
+	if _, err := resource.Update(ctx, cb, metav1.UpdateOptions{}); err == nil {
+		s.logger.Debug("simulated provider", "phase", status["phase"])
+	}
+}
+
+// simulatedMachines lists the machines of cfg with documentation-range IPs
+func simulatedMachines(cfg *Config, phase string) []interface{} {
+	var machines []interface{}
+	add := func(role, prefix string, count int32) {
+		for i := int32(0); i < count; i++ {
+			machines = append(machines, map[string]interface{}{
+				"name":      fmt.Sprintf("%s-%s-%d", cfg.Cluster.Name, prefix, i),
+				"role":      role,
+				"phase":     phase,
+				"ipAddress": fmt.Sprintf("192.0.2.%d", len(machines)+10),
+				"ready":     phase == "Running",
+			})
+		}
+	}
+	add("control-plane", "cp", max(cfg.Cluster.ControlPlane.Replicas, 1))
+	add("worker", "worker", cfg.Cluster.Workers.Replicas)
+	return machines
+}
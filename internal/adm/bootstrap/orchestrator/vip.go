@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// VIP modes for network.vipMode
+const (
+	VIPModeKubeVIP  = "kube-vip"
+	VIPModeExternal = "external"
+)
+
+// defaultVIPMode validates network.vipMode and fills in the health check
+// defaults for an external load balancer
+func defaultVIPMode(n *NetworkConfig) error {
+	switch n.VIPMode {
+	case "":
+		n.VIPMode = VIPModeKubeVIP
+	case VIPModeKubeVIP:
+	case VIPModeExternal:
+		if n.VIP == "" {
+			return fmt.Errorf("network.vipMode external requires network.vip, the load balancer's address")
+		}
+	default:
+		return fmt.Errorf("invalid network.vipMode %q, must be %q or %q", n.VIPMode, VIPModeKubeVIP, VIPModeExternal)
+	}
+
+	hc := &n.HealthCheck
+	if hc.Port == 0 {
+		hc.Port = 6443
+	}
+	if hc.Port < 1 || hc.Port > 65535 {
+		return fmt.Errorf("network.healthCheck.port %d is out of range", hc.Port)
+	}
+	if hc.Path == "" {
+		hc.Path = "/readyz"
+	}
+	if !strings.HasPrefix(hc.Path, "/") {
+		return fmt.Errorf("network.healthCheck.path %q must start with /", hc.Path)
+	}
+	if hc.TimeoutSeconds == 0 {
+		hc.TimeoutSeconds = 5
+	}
+	return nil
+}
+
+// IsExternalVIP reports whether an external load balancer serves the VIP
+func (c *Config) IsExternalVIP() bool {
+	return c.Network.VIPMode == VIPModeExternal
+}
+
+// externalVIPState remembers what was last reported about the external
+// load balancer while waiting, so each change is logged once
+type externalVIPState struct {
+	reported string
+}
+
+// observeExternalVIP probes the control plane nodes and the VIP while the
+// bootstrap runs and reports when the nodes pass the health check but the
+// load balancer doesn't route to them. Certificates aren't verified yet,
+// since the cluster CA is only known once bootstrap completes.
+func (o *Orchestrator) observeExternalVIP(ctx context.Context, cfg *Config, state *externalVIPState, controlPlaneIPs []string) {
+	hc := cfg.Network.HealthCheck
+	var healthy []string
+	for _, ip := range controlPlaneIPs {
+		if probeHealth(ctx, ip, hc, nil) == nil {
+			healthy = append(healthy, ip)
+		}
+	}
+	vipErr := probeHealth(ctx, cfg.Network.VIP, hc, nil)
+
+	var report string
+	switch {
+	case len(healthy) == 0:
+		report = "waiting"
+	case vipErr != nil:
+		report = "unrouted"
+		if state.reported != report {
+			o.logger.Warn("control plane nodes pass the health check but the external VIP does not; check the load balancer's pool members and health monitor",
+				"vip", cfg.Network.VIP, "healthyNodes", strings.Join(healthy, ","), "error", vipErr)
+		}
+	default:
+		report = "routed"
+		if state.reported != report {
+			o.logger.Info("external load balancer is routing to the control plane", "vip", cfg.Network.VIP, "healthyNodes", strings.Join(healthy, ","))
+		}
+	}
+	state.reported = report
+}
+
+// verifyExternalVIP checks that the VIP serves the new cluster's API
+// server, verified against the cluster CA from its kubeconfig
+func verifyExternalVIP(ctx context.Context, cfg *Config, kubeconfig []byte) error {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("reading kubeconfig: %w", err)
+	}
+	pool := x509.NewCertPool()
+	for _, cluster := range config.Clusters {
+		pool.AppendCertsFromPEM(cluster.CertificateAuthorityData)
+	}
+
+	if err := probeHealth(ctx, cfg.Network.VIP, cfg.Network.HealthCheck, pool); err != nil {
+		return fmt.Errorf("the external load balancer at %s does not reach the control plane: %w", cfg.Network.VIP, err)
+	}
+	return nil
+}
+
+// probeHealth runs the health check against host. With a nil pool the
+// server certificate is not verified.
+func probeHealth(ctx context.Context, host string, hc HealthCheckConfig, pool *x509.CertPool) error {
+	timeout := time.Duration(hc.TimeoutSeconds) * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	httpClient := &http.Client{Transport: &http.Transport{
+		TLSClientConfig:   &tls.Config{RootCAs: pool, InsecureSkipVerify: pool == nil},
+		DisableKeepAlives: true,
+	}}
+
+	url := "https://" + net.JoinHostPort(host, strconv.Itoa(hc.Port)) + hc.Path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultCapacityThreshold is the share of provider capacity (percent) a
+// bootstrap may consume before it needs --yes
+const defaultCapacityThreshold = 80
+
+// Resources is an amount of compute
+type Resources struct {
+	CPU      int64 `json:"cpu"`
+	MemoryMB int64 `json:"memoryMB"`
+	DiskGB   int64 `json:"diskGB,omitempty"`
+}
+
+// PoolPlan is the consumption of one node pool
+type PoolPlan struct {
+	Name     string    `json:"name"`
+	Replicas int32     `json:"replicas"`
+	PerNode  Resources `json:"perNode"`
+	Total    Resources `json:"total"`
+}
+
+// Plan summarizes what a bootstrap consumes on the provider
+type Plan struct {
+	Cluster  string     `json:"cluster"`
+	Provider string     `json:"provider"`
+	Pools    []PoolPlan `json:"pools"`
+	Total    Resources  `json:"total"`
+	// Capacity is what the provider reports as available, if it could be
+	// queried. DiskGB is zero when the provider doesn't report storage.
+	Capacity *Resources `json:"capacity,omitempty"`
+	// Utilization is the highest share of capacity (percent) consumed
+	Utilization int64 `json:"utilization,omitempty"`
+}
+
+// planOptions are the capacity check flags shared by the bootstrap commands
+type planOptions struct {
+	threshold int64
+	yes       bool
+}
+
+func addPlanFlags(cmd *cobra.Command, opts *planOptions) {
+	cmd.Flags().Int64Var(&opts.threshold, "capacity-threshold", defaultCapacityThreshold, "percent of provider capacity that requires --yes to consume")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "proceed even if the bootstrap exceeds --capacity-threshold")
+	cmd.Flags().BoolVar(&opts.yes, "confirm", false, "same as --yes")
+}
+
+// NewPlanCmd creates the bootstrap plan subcommand
+func NewPlanCmd(logger *log.Logger) *cobra.Command {
+	var (
+		configFile   string
+		profile      string
+		outputFormat string
+		opts         planOptions
+	)
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the resources a bootstrap will consume",
+		Long: `Show the vCPU, memory and disk a bootstrap will consume on the provider.
+
+The totals are compared with the capacity reported by the provider API
+(Harvester node allocatable, Nutanix host capacity, Proxmox free node
+resources). 'butleradm bootstrap <provider>' runs the same check before
+provisioning and requires --yes when consumption exceeds
+--capacity-threshold percent of capacity.
+
+Examples:
+  butleradm bootstrap plan --config bootstrap.yaml
+
+  # JSON for review tooling
+  butleradm bootstrap plan --config bootstrap.yaml -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := output.ParseFormat(outputFormat)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := loadConfig(logger, configFile, profile)
+			if err != nil {
+				return err
+			}
+
+			plan := buildPlan(cfg)
+			queryPlanCapacity(cmd.Context(), logger, cfg, plan)
+
+			return output.NewPrinter(format, os.Stdout).Print(plan, func(w io.Writer) error {
+				return printPlan(w, plan, opts.threshold)
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "", "path to bootstrap config file, or - for stdin (required)")
+	cmd.Flags().StringVar(&profile, "profile", "", "config profile to apply over the base config")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.Flags().Int64Var(&opts.threshold, "capacity-threshold", defaultCapacityThreshold, "percent of provider capacity to flag")
+	cmd.MarkFlagRequired("config")
+
+	return cmd
+}
+
+// checkPlan prints the plan to w and refuses to continue past the capacity
+// threshold without --yes. An unreachable provider API only warns.
+func checkPlan(ctx context.Context, w io.Writer, logger *log.Logger, cfg *orchestrator.Config, opts *planOptions) error {
+	plan := buildPlan(cfg)
+	queryPlanCapacity(ctx, logger, cfg, plan)
+
+	if err := printPlan(w, plan, opts.threshold); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+
+	if plan.Capacity == nil || plan.Utilization <= opts.threshold {
+		return nil
+	}
+	if !opts.yes {
+		return fmt.Errorf("bootstrap would consume %d%% of provider capacity (threshold %d%%); re-run with --yes to proceed",
+			plan.Utilization, opts.threshold)
+	}
+	logger.Warn("proceeding above capacity threshold", "utilization", fmt.Sprintf("%d%%", plan.Utilization))
+	return nil
+}
+
+func buildPlan(cfg *orchestrator.Config) *Plan {
+	plan := &Plan{Cluster: cfg.Cluster.Name, Provider: cfg.Provider}
+
+	type namedPool struct {
+		name string
+		pool orchestrator.NodePoolConfig
+	}
+	pools := []namedPool{{"control-plane", cfg.Cluster.ControlPlane}}
+	if !cfg.IsSingleNode() {
+		pools = append(pools, namedPool{"workers", cfg.Cluster.Workers})
+	}
+
+	for _, p := range pools {
+		disk := int64(p.pool.DiskGB)
+		for _, d := range p.pool.ExtraDisks {
+			disk += int64(d.SizeGB)
+		}
+		n := int64(p.pool.Replicas)
+		pp := PoolPlan{
+			Name:     p.name,
+			Replicas: p.pool.Replicas,
+			PerNode:  Resources{CPU: int64(p.pool.CPU), MemoryMB: int64(p.pool.MemoryMB), DiskGB: disk},
+		}
+		pp.Total = Resources{CPU: n * pp.PerNode.CPU, MemoryMB: n * pp.PerNode.MemoryMB, DiskGB: n * disk}
+		plan.Pools = append(plan.Pools, pp)
+
+		plan.Total.CPU += pp.Total.CPU
+		plan.Total.MemoryMB += pp.Total.MemoryMB
+		plan.Total.DiskGB += pp.Total.DiskGB
+	}
+	return plan
+}
+
+// queryPlanCapacity fills in provider capacity and utilization
+func queryPlanCapacity(ctx context.Context, logger *log.Logger, cfg *orchestrator.Config, plan *Plan) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var capacity *Resources
+	var err error
+	switch {
+	case cfg.Provider == "harvester" && cfg.ProviderConfig.Harvester != nil:
+		capacity, err = harvesterCapacity(ctx, cfg.ProviderConfig.Harvester)
+	case cfg.Provider == "nutanix" && cfg.ProviderConfig.Nutanix != nil:
+		capacity, err = nutanixCapacity(ctx, cfg.ProviderConfig.Nutanix)
+	case cfg.Provider == "proxmox" && cfg.ProviderConfig.Proxmox != nil:
+		capacity, err = proxmoxCapacity(ctx, cfg.ProviderConfig.Proxmox)
+	default:
+		err = fmt.Errorf("no provider configuration for %q", cfg.Provider)
+	}
+	if err != nil {
+		logger.Warn("could not query provider capacity", "provider", cfg.Provider, "error", err)
+		return
+	}
+
+	plan.Capacity = capacity
+	plan.Utilization = max(percent(plan.Total.CPU, capacity.CPU), percent(plan.Total.MemoryMB, capacity.MemoryMB))
+	if capacity.DiskGB > 0 {
+		plan.Utilization = max(plan.Utilization, percent(plan.Total.DiskGB, capacity.DiskGB))
+	}
+}
+
+func percent(used, available int64) int64 {
+	if available <= 0 {
+		return 100
+	}
+	return used * 100 / available
+}
+
+func printPlan(w io.Writer, plan *Plan, threshold int64) error {
+	fmt.Fprintf(w, "%s\n", output.Bold(fmt.Sprintf("Bootstrap plan for %s on %s", plan.Cluster, plan.Provider)))
+
+	table := output.NewTable(w, "POOL", "NODES", "CPU/NODE", "MEMORY/NODE", "DISK/NODE", "CPU", "MEMORY", "DISK")
+	for _, p := range plan.Pools {
+		table.AddRow(p.Name, fmt.Sprintf("%d", p.Replicas),
+			fmt.Sprintf("%d", p.PerNode.CPU), formatMB(p.PerNode.MemoryMB), formatGB(p.PerNode.DiskGB),
+			fmt.Sprintf("%d", p.Total.CPU), formatMB(p.Total.MemoryMB), formatGB(p.Total.DiskGB))
+	}
+	table.AddRow(output.Bold("total"), "", "", "", "",
+		fmt.Sprintf("%d", plan.Total.CPU), formatMB(plan.Total.MemoryMB), formatGB(plan.Total.DiskGB))
+	if plan.Capacity != nil {
+		disk := "-"
+		if plan.Capacity.DiskGB > 0 {
+			disk = formatGB(plan.Capacity.DiskGB)
+		}
+		table.AddRow("available", "", "", "", "",
+			fmt.Sprintf("%d", plan.Capacity.CPU), formatMB(plan.Capacity.MemoryMB), disk)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	if plan.Capacity == nil {
+		return nil
+	}
+	usage := fmt.Sprintf("%d%% of provider capacity", plan.Utilization)
+	switch {
+	case plan.Utilization > 100:
+		fmt.Fprintf(w, "\n%s\n", output.Danger("Consumes "+usage+"; the provider does not have enough resources"))
+	case plan.Utilization > threshold:
+		fmt.Fprintf(w, "\n%s\n", output.Warning(fmt.Sprintf("Consumes %s, above the %d%% threshold", usage, threshold)))
+	default:
+		fmt.Fprintf(w, "\nConsumes %s\n", usage)
+	}
+	return nil
+}
+
+func formatMB(mb int64) string {
+	if mb >= 1024 && mb%1024 == 0 {
+		return fmt.Sprintf("%dGi", mb/1024)
+	}
+	return fmt.Sprintf("%dMi", mb)
+}
+
+func formatGB(gb int64) string {
+	return fmt.Sprintf("%dGi", gb)
+}
+
+// harvesterCapacity sums the allocatable resources of Harvester's nodes
+func harvesterCapacity(ctx context.Context, cfg *orchestrator.HarvesterProviderConfig) (*Resources, error) {
+	c, err := client.NewFromKubeconfigOnly(cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Harvester nodes: %w", err)
+	}
+
+	capacity := &Resources{}
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		capacity.CPU += node.Status.Allocatable.Cpu().Value()
+		capacity.MemoryMB += node.Status.Allocatable.Memory().Value() / (1024 * 1024)
+	}
+	return capacity, nil
+}
+
+// nutanixCapacity sums the capacity of the hosts in the target cluster
+func nutanixCapacity(ctx context.Context, cfg *orchestrator.NutanixProviderConfig) (*Resources, error) {
+	endpoint := strings.TrimSuffix(cfg.Endpoint, "/")
+	if !strings.Contains(strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://"), ":") {
+		endpoint = fmt.Sprintf("%s:%d", endpoint, cfg.Port)
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{"kind": "host", "length": 500})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/api/nutanix/v3/hosts/list", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.SetBasicAuth(cfg.Username, cfg.Password)
+	req.Header.Set("Content-Type", "application/json")
+
+	var hosts struct {
+		Entities []struct {
+			Status struct {
+				ClusterReference struct {
+					UUID string `json:"uuid"`
+				} `json:"cluster_reference"`
+				Resources struct {
+					NumCPUCores       int64 `json:"num_cpu_cores"`
+					MemoryCapacityMib int64 `json:"memory_capacity_mib"`
+				} `json:"resources"`
+			} `json:"status"`
+		} `json:"entities"`
+	}
+	if err := doJSON(providerHTTPClient(cfg.Insecure), req, &hosts); err != nil {
+		return nil, fmt.Errorf("listing Nutanix hosts: %w", err)
+	}
+
+	capacity := &Resources{}
+	for _, h := range hosts.Entities {
+		if h.Status.ClusterReference.UUID != cfg.ClusterUUID {
+			continue
+		}
+		capacity.CPU += h.Status.Resources.NumCPUCores
+		capacity.MemoryMB += h.Status.Resources.MemoryCapacityMib
+	}
+	if capacity.CPU == 0 {
+		return nil, fmt.Errorf("no hosts found in cluster %s", cfg.ClusterUUID)
+	}
+	return capacity, nil
+}
+
+// proxmoxCapacity sums the unused resources of the configured Proxmox nodes
+func proxmoxCapacity(ctx context.Context, cfg *orchestrator.ProxmoxProviderConfig) (*Resources, error) {
+	httpClient := providerHTTPClient(cfg.Insecure)
+	endpoint := strings.TrimSuffix(cfg.Endpoint, "/")
+
+	form := url.Values{"username": {cfg.Username}, "password": {cfg.Password}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/api2/json/access/ticket", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var ticket struct {
+		Data struct {
+			Ticket string `json:"ticket"`
+		} `json:"data"`
+	}
+	if err := doJSON(httpClient, req, &ticket); err != nil {
+		return nil, fmt.Errorf("authenticating to Proxmox: %w", err)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/api2/json/nodes", nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.AddCookie(&http.Cookie{Name: "PVEAuthCookie", Value: ticket.Data.Ticket})
+	var nodes struct {
+		Data []struct {
+			Node    string  `json:"node"`
+			MaxCPU  int64   `json:"maxcpu"`
+			CPU     float64 `json:"cpu"`
+			MaxMem  int64   `json:"maxmem"`
+			Mem     int64   `json:"mem"`
+			MaxDisk int64   `json:"maxdisk"`
+			Disk    int64   `json:"disk"`
+		} `json:"data"`
+	}
+	if err := doJSON(httpClient, req, &nodes); err != nil {
+		return nil, fmt.Errorf("listing Proxmox nodes: %w", err)
+	}
+
+	wanted := map[string]bool{}
+	for _, n := range cfg.Nodes {
+		wanted[n] = true
+	}
+	capacity := &Resources{}
+	for _, n := range nodes.Data {
+		if len(wanted) > 0 && !wanted[n.Node] {
+			continue
+		}
+		capacity.CPU += n.MaxCPU - int64(n.CPU*float64(n.MaxCPU))
+		capacity.MemoryMB += (n.MaxMem - n.Mem) / (1024 * 1024)
+		capacity.DiskGB += (n.MaxDisk - n.Disk) / (1024 * 1024 * 1024)
+	}
+	return capacity, nil
+}
+
+func providerHTTPClient(insecure bool) *http.Client {
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+		},
+	}
+}
+
+func doJSON(httpClient *http.Client, req *http.Request, out interface{}) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 401 {
+		return fmt.Errorf("authentication failed - check credentials")
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
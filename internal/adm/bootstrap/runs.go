@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+)
+
+// NewStatusCmd creates the bootstrap status subcommand
+func NewStatusCmd(logger *log.Logger) *cobra.Command {
+	var outputFormat string
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "List running bootstraps and the KIND clusters they left",
+		Long: `List the bootstraps on this workstation.
+
+Each bootstrap runs in its own KIND cluster, butler-bootstrap-<cluster>
+(shortened with a hash for long names), so bootstraps of different
+clusters can run side by side. A bootstrap that
+failed with --skip-cleanup, or was interrupted and kept, leaves its KIND
+cluster behind; 'butleradm bootstrap cleanup' removes it.
+
+Examples:
+  butleradm bootstrap status
+  butleradm bootstrap status -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := output.ParseFormat(outputFormat)
+			if err != nil {
+				return err
+			}
+
+			runs, err := orchestrator.ListRuns()
+			if err != nil {
+				return err
+			}
+			if runs == nil {
+				runs = []orchestrator.RunState{}
+			}
+
+			if format == output.FormatTable && len(runs) == 0 {
+				logger.Info("no bootstraps in flight")
+				return nil
+			}
+
+			return output.NewPrinter(format, os.Stdout).Print(runs, func(w io.Writer) error {
+				table := output.NewTable(w, "CLUSTER", "PROVIDER", "KIND CLUSTER", "STATE", "AGE")
+				for _, run := range runs {
+					table.AddRow(run.Cluster, run.Provider, run.KINDCluster, runState(&run), output.FormatAge(run.StartedAt))
+				}
+				return table.Flush()
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+// NewCleanupCmd creates the bootstrap cleanup subcommand
+func NewCleanupCmd(logger *log.Logger) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "cleanup [CLUSTER]",
+		Short: "Delete the KIND cluster a stopped bootstrap left behind",
+		Long: `Delete the KIND cluster a stopped bootstrap left behind.
+
+Running bootstraps are never touched. Machines the bootstrap already
+provisioned are not deleted; remove them from the KIND cluster first (see
+'butleradm bootstrap status') or in the provider.
+
+Examples:
+  butleradm bootstrap cleanup butler-alpha
+  butleradm bootstrap cleanup --all`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all == (len(args) == 1) {
+				return fmt.Errorf("specify a cluster or --all")
+			}
+
+			var runs []orchestrator.RunState
+			if all {
+				var err error
+				if runs, err = orchestrator.ListRuns(); err != nil {
+					return err
+				}
+			} else {
+				run, err := orchestrator.LoadRun(args[0])
+				if err != nil {
+					return err
+				}
+				if run == nil {
+					return fmt.Errorf("no bootstrap of %s recorded; see 'butleradm bootstrap status'", args[0])
+				}
+				runs = append(runs, *run)
+			}
+
+			failed := 0
+			for _, run := range runs {
+				if all && run.Running() {
+					logger.Info("skipping running bootstrap", "cluster", run.Cluster, "pid", run.PID)
+					continue
+				}
+				if err := orchestrator.CleanupRun(&run); err != nil {
+					logger.Error("cleanup failed", "cluster", run.Cluster, "error", err)
+					failed++
+					continue
+				}
+				logger.Success("KIND cluster deleted", "name", run.KINDCluster)
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d bootstraps could not be cleaned up", failed, len(runs))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "clean up every stopped bootstrap")
+
+	return cmd
+}
+
+// runState describes whether a bootstrap is still running
+func runState(run *orchestrator.RunState) string {
+	if run.Running() {
+		return fmt.Sprintf("Running (pid %d)", run.PID)
+	}
+	return "Stopped, KIND kept"
+}
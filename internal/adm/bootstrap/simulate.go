@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+)
+
+// NewSimulateCmd creates the simulate parent command
+func NewSimulateCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Rehearse operations against a simulated cluster",
+		Long: `Rehearse butleradm operations without touching real infrastructure.
+
+Simulations run the real orchestration code against an in-memory cluster and
+a mock provider, so no KIND cluster is created, no VMs are provisioned and
+nothing is written to ~/.butler.
+
+Commands:
+  bootstrap  Run a simulated bootstrap, optionally injecting a failure`,
+	}
+
+	cmd.AddCommand(newSimulateBootstrapCmd(logger))
+
+	return cmd
+}
+
+func newSimulateBootstrapCmd(logger *log.Logger) *cobra.Command {
+	var (
+		configFile  string
+		profile     string
+		fail        string
+		timeout     time.Duration
+		skipCleanup bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Short: "Run a simulated bootstrap, optionally injecting a failure",
+		Long: fmt.Sprintf(`Run a bootstrap against a simulated KIND cluster and mock provider.
+
+The orchestrator goes through the same phases as a real bootstrap: it applies
+the CRDs, deploys the controllers, creates the credentials Secret and
+ClusterBootstrap, then watches the mock provider bring the machines up. Use
+--fail to inject a failure and check cleanup and error reporting:
+
+%s
+Examples:
+  # Clean run
+  butleradm simulate bootstrap --config bootstrap.yaml
+
+  # Machines never come up; the bootstrap times out after 30s
+  butleradm simulate bootstrap --config bootstrap.yaml --fail machine-timeout --timeout 30s`, faultHelp()),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fault, err := orchestrator.ParseFault(fail)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := loadConfig(logger, configFile, profile)
+			if err != nil {
+				return err
+			}
+
+			orch := orchestrator.New(logger, orchestrator.Options{
+				SkipCleanup: skipCleanup,
+				Timeout:     timeout,
+				Simulation:  orchestrator.NewSimulation(fault),
+			})
+			return orch.Run(cmd.Context(), cfg)
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "", "path to bootstrap config file, or - for stdin (required)")
+	cmd.Flags().StringVar(&profile, "profile", "", "config profile to apply over the base config")
+	cmd.Flags().StringVar(&fail, "fail", "", "failure to inject (crd-apply, credentials-rejected, machine-timeout)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 2*time.Minute, "how long to wait for the simulated bootstrap")
+	cmd.Flags().BoolVar(&skipCleanup, "skip-cleanup", false, "skip the simulated cleanup on failure")
+
+	cmd.MarkFlagRequired("config")
+
+	return cmd
+}
+
+// faultHelp lists the injectable faults for the help text
+func faultHelp() string {
+	var names []string
+	for f := range orchestrator.FaultDescriptions {
+		names = append(names, string(f))
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %-22s %s\n", name, orchestrator.FaultDescriptions[orchestrator.Fault(name)])
+	}
+	return b.String()
+}
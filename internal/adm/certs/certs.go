@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certs implements butleradm commands that manage the certificates
+// trusted by the management cluster nodes.
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/talosctl"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// trustedRootsName names the TrustedRootsConfig document butleradm manages
+const trustedRootsName = "butler-custom"
+
+// NewCertsCmd creates the certs parent command
+func NewCertsCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certs",
+		Short: "Manage certificates trusted by the management cluster",
+		Long: `Manage certificates trusted by the management cluster nodes.
+
+Commands:
+  trust sync  Install corporate CA certificates on every node
+
+Examples:
+  butleradm certs trust sync`,
+	}
+
+	trust := &cobra.Command{
+		Use:   "trust",
+		Short: "Manage trusted CA certificates",
+	}
+	trust.AddCommand(newTrustSyncCmd(logger))
+	cmd.AddCommand(trust)
+
+	return cmd
+}
+
+func newTrustSyncCmd(logger *log.Logger) *cobra.Command {
+	var kubeconfig, talosconfig string
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Install corporate CA certificates on every node",
+		Long: `Install corporate CA certificates on every management cluster node.
+
+The certificates are the ones bootstrap mounts into its KIND cluster:
+$BUTLER_CA_CERT_PATH (a file or directory) and ~/.butler/certificates/*.crt
+and *.pem. They are written to each node as a Talos TrustedRootsConfig named
+butler-custom, which replaces the previous sync, so removing a file and
+syncing again stops trusting it. Nodes already trusting exactly these
+certificates are left alone.
+
+Requires Talos v1.7 or later.
+
+Examples:
+  cp corp-root.crt ~/.butler/certificates/
+  butleradm certs trust sync`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTrustSync(cmd.Context(), logger, kubeconfig, talosconfig)
+		},
+	}
+
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&talosconfig, "talosconfig", "", "path to talosconfig (default: $TALOSCONFIG or ~/.butler/<cluster>-talosconfig)")
+
+	return cmd
+}
+
+func runTrustSync(ctx context.Context, logger *log.Logger, kubeconfig, talosconfig string) error {
+	paths := orchestrator.FindCACertificates()
+	if len(paths) == 0 {
+		return fmt.Errorf("no CA certificates found in $BUTLER_CA_CERT_PATH or ~/.butler/certificates")
+	}
+	bundle, err := readBundle(paths)
+	if err != nil {
+		return err
+	}
+	logger.Info("syncing CA certificates", "files", len(paths))
+
+	var c *client.Client
+	if kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	talos, err := talosctl.New(talosconfig)
+	if err != nil {
+		return err
+	}
+	defer talos.Close()
+
+	nodes, err := c.NodeInternalIPs(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	patch := trustedRootsPatch(bundle)
+	for _, node := range nodes {
+		docs, err := talos.ReadMachineConfigDocuments(ctx, node)
+		if err != nil {
+			return err
+		}
+		if trustedRoots(docs) == bundle {
+			logger.Info("CA certificates already trusted", "node", node)
+			continue
+		}
+
+		if err := talos.PatchMachineConfig(ctx, node, patch); err != nil {
+			return fmt.Errorf("patching node %s: %w", node, err)
+		}
+		logger.Success("CA certificates trusted", "node", node)
+	}
+	return nil
+}
+
+// readBundle concatenates the PEM certificates in paths, rejecting files
+// that contain none
+func readBundle(paths []string) (string, error) {
+	var bundle bytes.Buffer
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("reading CA certificate: %w", err)
+		}
+
+		found := 0
+		for rest := data; ; {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+				return "", fmt.Errorf("parsing %s: %w", path, err)
+			}
+			if err := pem.Encode(&bundle, block); err != nil {
+				return "", err
+			}
+			found++
+		}
+		if found == 0 {
+			return "", fmt.Errorf("%s contains no PEM certificates", path)
+		}
+	}
+	return bundle.String(), nil
+}
+
+// trustedRootsPatch builds a Talos config patch holding bundle
+func trustedRootsPatch(bundle string) string {
+	indented := "    " + strings.ReplaceAll(strings.TrimSuffix(bundle, "\n"), "\n", "\n    ")
+	return fmt.Sprintf("apiVersion: v1alpha1\nkind: TrustedRootsConfig\nname: %s\ncertificates: |\n%s\n", trustedRootsName, indented)
+}
+
+// trustedRoots returns the certificates of the butler-custom
+// TrustedRootsConfig in a node's machine config, or ""
+func trustedRoots(docs [][]byte) string {
+	for _, doc := range docs {
+		var config struct {
+			Kind         string `json:"kind"`
+			Name         string `json:"name"`
+			Certificates string `json:"certificates"`
+		}
+		if yaml.Unmarshal(doc, &config) != nil {
+			continue
+		}
+		if config.Kind == "TrustedRootsConfig" && config.Name == trustedRootsName {
+			return config.Certificates
+		}
+	}
+	return ""
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package check implements butleradm check commands.
+package check
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+)
+
+// Result statuses
+const (
+	StatusPass = "PASS"
+	StatusWarn = "WARN"
+	StatusFail = "FAIL"
+	StatusSkip = "SKIP"
+)
+
+// Result is the outcome of one diagnostic
+type Result struct {
+	Check  string `json:"check"`
+	Target string `json:"target"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Report is the outcome of a check command
+type Report struct {
+	Source   string   `json:"source"`
+	Provider string   `json:"provider"`
+	Results  []Result `json:"results"`
+}
+
+// Failed returns the number of failed results
+func (r *Report) Failed() int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Status == StatusFail {
+			n++
+		}
+	}
+	return n
+}
+
+// NewCheckCmd creates the check parent command
+func NewCheckCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Troubleshoot the environment around the platform",
+		Long: `Run diagnostics on the environment Butler depends on.
+
+Commands:
+  connectivity  Test provider API, DNS, addresses, clock and MTU
+
+Examples:
+  # Before bootstrapping
+  butleradm check connectivity --config bootstrap.yaml
+
+  # Against a provider of a running platform
+  butleradm check connectivity --provider nutanix`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newConnectivityCmd(logger))
+
+	return cmd
+}
+
+func printReport(w io.Writer, report *Report) error {
+	fmt.Fprintf(w, "%s provider from %s\n\n", report.Provider, report.Source)
+
+	table := output.NewTable(w, "CHECK", "TARGET", "STATUS", "DETAIL")
+	for _, r := range report.Results {
+		status := r.Status
+		switch r.Status {
+		case StatusPass:
+			status = output.Success(status)
+		case StatusWarn:
+			status = output.Warning(status)
+		case StatusFail:
+			status = output.Danger(status)
+		case StatusSkip:
+			status = output.Dim(status)
+		}
+		table.AddRow(r.Check, orDash(r.Target), status, orDash(r.Detail))
+	}
+	return table.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,623 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/netcheck"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/providerapi"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// defaultNTPServer is queried for clock skew unless --ntp-server is set
+	defaultNTPServer = "pool.ntp.org"
+
+	// maxPoolProbes caps the load balancer pool addresses probed for conflicts
+	maxPoolProbes = 16
+)
+
+type connectivityOptions struct {
+	configFile   string
+	profile      string
+	provider     string
+	kubeconfig   string
+	ntpServer    string
+	mtuTarget    string
+	timeout      time.Duration
+	outputFormat string
+}
+
+// target is what the checks run against, gathered from a bootstrap config
+// or a live ProviderConfig
+type target struct {
+	provider string
+	source   string
+
+	// endpoint is the provider API URL
+	endpoint string
+	insecure bool
+
+	// rest is set for Harvester, whose API is reached with its kubeconfig
+	rest *rest.Config
+
+	// hostAliases maps hostnames to addresses as the KIND node sees them
+	hostAliases map[string]string
+
+	vip  string
+	pool string
+
+	// externalVIP is set when an existing load balancer serves the VIP,
+	// so it is expected to be in use
+	externalVIP bool
+}
+
+func newConnectivityCmd(logger *log.Logger) *cobra.Command {
+	opts := &connectivityOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "connectivity",
+		Short: "Test provider API, DNS, addresses, clock and MTU",
+		Long: `Diagnose the network between this machine, the provider and the node subnet.
+
+Takes the provider from a bootstrap config (--config) or from a
+ProviderConfig of a running platform (--provider) and checks:
+
+  dns      The provider hostname resolves. hostAliases from the config are
+           honored, and flagged when they disagree with DNS since only the
+           bootstrap KIND node gets them.
+  api      The provider API answers over TCP and TLS.
+  address  The control plane VIP and load balancer pool addresses are free:
+           nothing accepts or refuses a TCP connection and no ARP entry
+           appears for them. Only checked with --config. A VIP served by
+           an external load balancer is skipped.
+  clock    Local clock skew against an NTP server (--ntp-server).
+  mtu      The path MTU to the node subnet, probed with don't-fragment
+           pings to the provider host or --mtu-target.
+
+Exits non-zero if any check fails.
+
+Examples:
+  # Before bootstrapping
+  butleradm check connectivity --config bootstrap.yaml
+
+  # Check a provider of a running platform, probing the MTU to a node
+  butleradm check connectivity --provider nutanix --mtu-target 10.40.0.11
+
+  # Air-gapped site with its own time source
+  butleradm check connectivity -c bootstrap.yaml --ntp-server ntp.corp.example.com`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConnectivity(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.configFile, "config", "c", "", "path to bootstrap config file, or - for stdin")
+	cmd.Flags().StringVar(&opts.profile, "profile", "", "config profile to apply over the base config")
+	cmd.Flags().StringVar(&opts.provider, "provider", "", "ProviderConfig to check instead of a config file")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig (with --provider)")
+	cmd.Flags().StringVar(&opts.ntpServer, "ntp-server", defaultNTPServer, "NTP server to measure clock skew against")
+	cmd.Flags().StringVar(&opts.mtuTarget, "mtu-target", "", "host on the node subnet to probe the path MTU to (default: the provider host)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 5*time.Second, "timeout for each probe")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.MarkFlagsMutuallyExclusive("config", "provider")
+	cmd.MarkFlagsOneRequired("config", "provider")
+
+	return cmd
+}
+
+func runConnectivity(ctx context.Context, logger *log.Logger, opts *connectivityOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+	if opts.timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+
+	var t *target
+	if opts.configFile != "" {
+		t, err = targetFromConfig(logger, opts.configFile, opts.profile)
+	} else {
+		t, err = targetFromProviderConfig(ctx, opts.kubeconfig, opts.provider)
+	}
+	if err != nil {
+		return err
+	}
+
+	report := &Report{Source: t.source, Provider: t.provider}
+	host := t.host()
+
+	logger.Debug("checking dns", "host", host)
+	report.Results = append(report.Results, checkDNS(ctx, t, host))
+
+	logger.Debug("checking api", "endpoint", t.endpoint)
+	report.Results = append(report.Results, checkAPI(ctx, t, opts.timeout))
+
+	if opts.configFile != "" {
+		logger.Debug("checking addresses", "vip", t.vip, "pool", t.pool)
+		report.Results = append(report.Results, checkAddresses(ctx, t, opts.timeout)...)
+	} else {
+		report.Results = append(report.Results, Result{
+			Check:  "address",
+			Status: StatusSkip,
+			Detail: "addresses are in use once bootstrapped; check with --config beforehand",
+		})
+	}
+
+	logger.Debug("checking clock", "server", opts.ntpServer)
+	report.Results = append(report.Results, checkClock(opts.ntpServer, opts.timeout))
+
+	mtuHost := opts.mtuTarget
+	if mtuHost == "" {
+		mtuHost = host
+	}
+	logger.Debug("checking mtu", "host", mtuHost)
+	report.Results = append(report.Results, checkMTU(ctx, t, mtuHost))
+
+	if err := output.NewPrinter(format, os.Stdout).Print(report, func(w io.Writer) error {
+		return printReport(w, report)
+	}); err != nil {
+		return err
+	}
+
+	if n := report.Failed(); n > 0 {
+		return fmt.Errorf("%d connectivity check(s) failed", n)
+	}
+	return nil
+}
+
+// targetFromConfig reads the provider settings from a bootstrap config
+func targetFromConfig(logger *log.Logger, configFile, profile string) (*target, error) {
+	cfg, err := orchestrator.ReadConfig(configFile, profile)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range cfg.UnknownKeys {
+		logger.Warn("unknown config key ignored", "key", key)
+	}
+
+	t := &target{
+		provider:    cfg.Provider,
+		source:      configFile,
+		vip:         cfg.Network.VIP,
+		pool:        cfg.Addons.LoadBalancer.AddressPool,
+		externalVIP: cfg.IsExternalVIP(),
+	}
+	if configFile == "-" {
+		t.source = "stdin"
+	}
+
+	var aliases []string
+	switch cfg.Provider {
+	case "harvester":
+		if cfg.ProviderConfig.Harvester == nil || cfg.ProviderConfig.Harvester.KubeconfigPath == "" {
+			return nil, fmt.Errorf("providerConfig.harvester.kubeconfigPath is required")
+		}
+		t.rest, err = clientcmd.BuildConfigFromFlags("", cfg.ProviderConfig.Harvester.KubeconfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("loading Harvester kubeconfig: %w", err)
+		}
+		t.endpoint = t.rest.Host
+	case "nutanix":
+		if cfg.ProviderConfig.Nutanix == nil {
+			return nil, fmt.Errorf("providerConfig.nutanix is required")
+		}
+		t.endpoint = nutanixURL(cfg.ProviderConfig.Nutanix.Endpoint, int64(cfg.ProviderConfig.Nutanix.Port))
+		t.insecure = cfg.ProviderConfig.Nutanix.Insecure
+		aliases = cfg.ProviderConfig.Nutanix.HostAliases
+	case "proxmox":
+		if cfg.ProviderConfig.Proxmox == nil {
+			return nil, fmt.Errorf("providerConfig.proxmox is required")
+		}
+		t.endpoint = cfg.ProviderConfig.Proxmox.Endpoint
+		t.insecure = cfg.ProviderConfig.Proxmox.Insecure
+		aliases = cfg.ProviderConfig.Proxmox.HostAliases
+	default:
+		return nil, fmt.Errorf("unsupported provider %q", cfg.Provider)
+	}
+
+	t.hostAliases, err = parseHostAliases(aliases)
+	if err != nil {
+		return nil, err
+	}
+	return t, t.validate()
+}
+
+// targetFromProviderConfig reads the provider settings from a ProviderConfig
+// in butler-system
+func targetFromProviderConfig(ctx context.Context, kubeconfig, name string) (*target, error) {
+	var c *client.Client
+	var err error
+	if kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	pc, err := c.GetProviderConfig(ctx, providerapi.Namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("getting ProviderConfig %s: %w", name, err)
+	}
+
+	provider, _, _ := unstructured.NestedString(pc.Object, "spec", "provider")
+	t := &target{
+		provider: provider,
+		source:   "ProviderConfig " + providerapi.Namespace + "/" + name,
+	}
+	t.insecure, _, _ = unstructured.NestedBool(pc.Object, "spec", provider, "insecure")
+
+	switch provider {
+	case "harvester":
+		t.endpoint, _, _ = unstructured.NestedString(pc.Object, "spec", "harvester", "endpoint")
+		if t.endpoint == "" {
+			t.rest, err = harvesterRESTConfig(ctx, c, pc)
+			if err != nil {
+				return nil, err
+			}
+			t.endpoint = t.rest.Host
+		}
+	case "nutanix":
+		endpoint, _, _ := unstructured.NestedString(pc.Object, "spec", "nutanix", "endpoint")
+		port, _, _ := unstructured.NestedInt64(pc.Object, "spec", "nutanix", "port")
+		t.endpoint = nutanixURL(endpoint, port)
+	case "proxmox":
+		t.endpoint, _, _ = unstructured.NestedString(pc.Object, "spec", "proxmox", "endpoint")
+	default:
+		return nil, fmt.Errorf("unknown provider type: %s", provider)
+	}
+	return t, t.validate()
+}
+
+// harvesterRESTConfig builds a client config from the kubeconfig in the
+// ProviderConfig's credentials Secret
+func harvesterRESTConfig(ctx context.Context, c *client.Client, pc *unstructured.Unstructured) (*rest.Config, error) {
+	name, _, _ := unstructured.NestedString(pc.Object, "spec", "credentialsRef", "name")
+	namespace, _, _ := unstructured.NestedString(pc.Object, "spec", "credentialsRef", "namespace")
+	key, _, _ := unstructured.NestedString(pc.Object, "spec", "credentialsRef", "key")
+	if name == "" {
+		return nil, fmt.Errorf("ProviderConfig %s has neither spec.harvester.endpoint nor spec.credentialsRef", pc.GetName())
+	}
+	if namespace == "" {
+		namespace = pc.GetNamespace()
+	}
+	if key == "" {
+		key = "kubeconfig"
+	}
+
+	secret, err := c.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting credentials secret %s/%s: %w", namespace, name, err)
+	}
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(secret.Data[key])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Harvester kubeconfig from secret %s/%s key %s: %w", namespace, name, key, err)
+	}
+	return cfg, nil
+}
+
+// nutanixURL adds the Prism Central port to an endpoint that has none
+func nutanixURL(endpoint string, port int64) string {
+	if port == 0 {
+		port = 9440
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	if endpoint == "" {
+		return ""
+	}
+	if !strings.Contains(strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://"), ":") {
+		endpoint = fmt.Sprintf("%s:%d", endpoint, port)
+	}
+	return endpoint
+}
+
+// parseHostAliases reads "IP hostname..." entries into a hostname lookup
+func parseHostAliases(aliases []string) (map[string]string, error) {
+	hosts := map[string]string{}
+	for _, alias := range aliases {
+		fields := strings.Fields(alias)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("hostAliases entry %q: want \"IP hostname\"", alias)
+		}
+		if _, err := netcheck.ParseIP(fields[0]); err != nil {
+			return nil, fmt.Errorf("hostAliases entry %q: %w", alias, err)
+		}
+		for _, name := range fields[1:] {
+			hosts[strings.ToLower(name)] = fields[0]
+		}
+	}
+	return hosts, nil
+}
+
+func (t *target) validate() error {
+	if t.endpoint == "" {
+		return fmt.Errorf("%s: no %s endpoint configured", t.source, t.provider)
+	}
+	if !strings.Contains(t.endpoint, "://") {
+		t.endpoint = "https://" + t.endpoint
+	}
+	u, err := url.Parse(t.endpoint)
+	if err != nil || u.Hostname() == "" {
+		return fmt.Errorf("%s: invalid %s endpoint %q", t.source, t.provider, t.endpoint)
+	}
+	return nil
+}
+
+// host returns the provider API hostname
+func (t *target) host() string {
+	u, _ := url.Parse(t.endpoint)
+	return u.Hostname()
+}
+
+// resolve returns the address of host as the bootstrap sees it: from
+// hostAliases first, then DNS
+func (t *target) resolve(ctx context.Context, host string) (string, error) {
+	if ip, ok := t.hostAliases[strings.ToLower(host)]; ok {
+		return ip, nil
+	}
+	if _, err := netip.ParseAddr(host); err == nil {
+		return host, nil
+	}
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return "", err
+	}
+	return addrs[0], nil
+}
+
+// dialContext dials through hostAliases
+func (t *target) dialContext(timeout time.Duration) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err == nil {
+			if ip, ok := t.hostAliases[strings.ToLower(host)]; ok {
+				addr = net.JoinHostPort(ip, port)
+			}
+		}
+		return dialer.DialContext(ctx, network, addr)
+	}
+}
+
+func checkDNS(ctx context.Context, t *target, host string) Result {
+	r := Result{Check: "dns", Target: host}
+
+	if _, err := netip.ParseAddr(host); err == nil {
+		r.Status, r.Detail = StatusPass, "address literal, no lookup needed"
+		return r
+	}
+
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	alias, aliased := t.hostAliases[strings.ToLower(host)]
+	switch {
+	case aliased && err != nil:
+		r.Status = StatusWarn
+		r.Detail = fmt.Sprintf("only resolvable through hostAliases (%s); nodes need DNS for it", alias)
+	case aliased && !containsString(addrs, alias):
+		r.Status = StatusWarn
+		r.Detail = fmt.Sprintf("hostAliases says %s but DNS returns %s", alias, strings.Join(addrs, ", "))
+	case aliased:
+		r.Status, r.Detail = StatusPass, alias+" (hostAliases and DNS agree)"
+	case err != nil:
+		r.Status, r.Detail = StatusFail, err.Error()
+	default:
+		r.Status, r.Detail = StatusPass, strings.Join(addrs, ", ")
+	}
+	return r
+}
+
+func checkAPI(ctx context.Context, t *target, timeout time.Duration) Result {
+	r := Result{Check: "api", Target: t.endpoint}
+
+	httpClient, err := t.httpClient(timeout)
+	if err != nil {
+		r.Status, r.Detail = StatusFail, err.Error()
+		return r
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.endpoint, nil)
+	if err != nil {
+		r.Status, r.Detail = StatusFail, err.Error()
+		return r
+	}
+
+	start := time.Now()
+	resp, err := httpClient.Do(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		var certErr *tls.CertificateVerificationError
+		if errors.As(err, &certErr) {
+			r.Status = StatusWarn
+			r.Detail = "reachable, but the TLS certificate is not trusted: " + certErr.Err.Error()
+			return r
+		}
+		r.Status, r.Detail = StatusFail, err.Error()
+		return r
+	}
+	resp.Body.Close()
+
+	// Any HTTP answer, even 401, proves the API is reachable
+	r.Status = StatusPass
+	r.Detail = fmt.Sprintf("HTTP %d in %s", resp.StatusCode, elapsed)
+	if t.insecure {
+		r.Detail += ", TLS verification disabled"
+	}
+	return r
+}
+
+// httpClient returns a client for the provider API that honors hostAliases
+func (t *target) httpClient(timeout time.Duration) (*http.Client, error) {
+	if t.rest != nil {
+		cfg := rest.CopyConfig(t.rest)
+		cfg.Dial = t.dialContext(timeout)
+		cfg.Timeout = timeout
+		return rest.HTTPClientFor(cfg)
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:     t.dialContext(timeout),
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: t.insecure},
+		},
+	}, nil
+}
+
+// checkAddresses probes the VIP and the first addresses of the load
+// balancer pool, which must be unused before bootstrap
+func checkAddresses(ctx context.Context, t *target, timeout time.Duration) []Result {
+	type probe struct {
+		label string
+		addr  netip.Addr
+	}
+	var probes []probe
+	var results []Result
+
+	if t.vip != "" && t.externalVIP {
+		results = append(results, Result{Check: "address", Target: "vip " + t.vip, Status: StatusSkip, Detail: "served by an external load balancer (network.vipMode: external)"})
+	} else if t.vip != "" {
+		if vip, err := netcheck.ParseIP(t.vip); err == nil {
+			probes = append(probes, probe{"vip", vip})
+		}
+	}
+	if t.pool != "" {
+		pool, err := netcheck.ParsePool(t.pool)
+		if err == nil {
+			addr := pool.Start
+			for i := 0; i < maxPoolProbes && pool.Contains(addr); i++ {
+				probes = append(probes, probe{"pool", addr})
+				addr = addr.Next()
+			}
+			if pool.Size() > maxPoolProbes {
+				results = append(results, Result{
+					Check:  "address",
+					Target: t.pool,
+					Status: StatusSkip,
+					Detail: fmt.Sprintf("only the first %d of %d pool addresses probed", maxPoolProbes, pool.Size()),
+				})
+			}
+		}
+	}
+	if len(probes) == 0 && len(results) == 0 {
+		return []Result{{Check: "address", Status: StatusSkip, Detail: "no network.vip or addons.loadBalancer.addressPool set"}}
+	}
+
+	probed := make([]Result, len(probes))
+	var wg sync.WaitGroup
+	for i, p := range probes {
+		wg.Add(1)
+		go func(i int, p probe) {
+			defer wg.Done()
+			r := Result{Check: "address", Target: p.label + " " + p.addr.String(), Status: StatusPass, Detail: "free"}
+			if inUse, detail := probeAddress(ctx, p.addr, timeout); inUse {
+				r.Status, r.Detail = StatusFail, "already in use: "+detail
+			}
+			probed[i] = r
+		}(i, p)
+	}
+	wg.Wait()
+
+	return append(probed, results...)
+}
+
+func checkClock(server string, timeout time.Duration) Result {
+	r := Result{Check: "clock", Target: server}
+
+	offset, err := ntpOffset(server, timeout)
+	if err != nil {
+		r.Status, r.Detail = StatusSkip, err.Error()
+		return r
+	}
+
+	// A positive offset means the server is ahead of the local clock
+	abs, direction := offset, "behind"
+	if offset < 0 {
+		abs, direction = -offset, "ahead"
+	}
+	r.Detail = fmt.Sprintf("local clock is %s %s", abs.Round(time.Millisecond), direction)
+	switch {
+	case abs < maxClockSkewWarn:
+		r.Status = StatusPass
+	case abs < maxClockSkewFail:
+		r.Status = StatusWarn
+	default:
+		r.Status = StatusFail
+		r.Detail += "; certificates issued here may not be valid yet on the nodes"
+	}
+	return r
+}
+
+func checkMTU(ctx context.Context, t *target, host string) Result {
+	r := Result{Check: "mtu", Target: host}
+
+	addr, err := t.resolve(ctx, host)
+	if err != nil {
+		r.Status, r.Detail = StatusSkip, "cannot resolve: "+err.Error()
+		return r
+	}
+
+	mtu, err := pathMTU(ctx, addr)
+	if err != nil {
+		r.Status, r.Detail = StatusSkip, err.Error()
+		return r
+	}
+
+	switch {
+	case mtu >= jumboMTU:
+		r.Status, r.Detail = StatusPass, fmt.Sprintf("%d or more (jumbo frames)", mtu)
+	case mtu >= standardMTU:
+		r.Status, r.Detail = StatusPass, fmt.Sprintf("%d", mtu)
+	default:
+		r.Status = StatusWarn
+		r.Detail = fmt.Sprintf("%d, below %d; lower the CNI MTU to match", mtu, standardMTU)
+	}
+	return r
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// ntpEpochOffset is the number of seconds between 1900 and 1970
+	ntpEpochOffset = 2208988800
+
+	// Clock skew thresholds
+	maxClockSkewWarn = 2 * time.Second
+	maxClockSkewFail = time.Minute
+
+	standardMTU = 1500
+	jumboMTU    = 9000
+
+	// minPingPayload is the payload of the probe that checks the host answers
+	// ping at all
+	minPingPayload = 56
+)
+
+// probePorts are tried to see whether an address is taken: any answer,
+// including a refused connection, means a host owns it
+var probePorts = []int{22, 80, 443, 6443}
+
+// ntpOffset queries an NTP server with SNTP and returns how far its clock
+// is ahead of the local one
+func ntpOffset(server string, timeout time.Duration) (time.Duration, error) {
+	conn, err := net.DialTimeout("udp", net.JoinHostPort(server, "123"), timeout)
+	if err != nil {
+		return 0, fmt.Errorf("contacting NTP server: %w", err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	// LI 0, version 3, mode 3 (client)
+	req := make([]byte, 48)
+	req[0] = 0x1B
+
+	sent := time.Now()
+	if _, err := conn.Write(req); err != nil {
+		return 0, fmt.Errorf("querying NTP server: %w", err)
+	}
+	resp := make([]byte, 48)
+	if _, err := io.ReadFull(conn, resp); err != nil {
+		return 0, fmt.Errorf("reading NTP response: %w", err)
+	}
+	received := time.Now()
+
+	if resp[1] == 0 {
+		return 0, fmt.Errorf("NTP server %s refused the query", server)
+	}
+	serverReceived := ntpTime(resp[32:40])
+	serverSent := ntpTime(resp[40:48])
+	return (serverReceived.Sub(sent) + serverSent.Sub(received)) / 2, nil
+}
+
+// ntpTime decodes a 64-bit NTP timestamp
+func ntpTime(b []byte) time.Time {
+	secs := int64(binary.BigEndian.Uint32(b[0:4])) - ntpEpochOffset
+	frac := uint64(binary.BigEndian.Uint32(b[4:8]))
+	return time.Unix(secs, int64(frac*1e9>>32))
+}
+
+// pathMTU finds the largest packet that reaches addr without fragmenting,
+// up to jumboMTU, with the system ping
+func pathMTU(ctx context.Context, addr string) (int, error) {
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return 0, err
+	}
+	// IP and ICMP headers
+	overhead := 28
+	if ip.Is6() && !ip.Is4In6() {
+		overhead = 48
+	}
+
+	if _, err := exec.LookPath("ping"); err != nil {
+		return 0, fmt.Errorf("ping not found in PATH")
+	}
+	if !pingDF(ctx, addr, minPingPayload) {
+		return 0, fmt.Errorf("%s does not answer ping", addr)
+	}
+	if pingDF(ctx, addr, standardMTU-overhead) {
+		if pingDF(ctx, addr, jumboMTU-overhead) {
+			return jumboMTU, nil
+		}
+		return standardMTU, nil
+	}
+
+	// Binary search for the largest payload that gets through
+	low, high := minPingPayload, standardMTU-overhead
+	for high-low > 1 {
+		mid := (low + high) / 2
+		if pingDF(ctx, addr, mid) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return low + overhead, nil
+}
+
+// pingDF sends one ping with the don't-fragment bit set and reports whether
+// it was answered
+func pingDF(ctx context.Context, addr string, payload int) bool {
+	size := strconv.Itoa(payload)
+	var args []string
+	switch runtime.GOOS {
+	case "windows":
+		args = []string{"-n", "1", "-w", "1000", "-f", "-l", size, addr}
+	case "darwin":
+		args = []string{"-c", "1", "-t", "1", "-D", "-s", size, addr}
+	default:
+		args = []string{"-c", "1", "-W", "1", "-M", "do", "-s", size, addr}
+	}
+	return exec.CommandContext(ctx, "ping", args...).Run() == nil
+}
+
+// probeAddress reports whether a host already owns addr, and how it showed
+func probeAddress(ctx context.Context, addr netip.Addr, timeout time.Duration) (bool, string) {
+	dialer := &net.Dialer{Timeout: timeout}
+	for _, port := range probePorts {
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(addr.String(), strconv.Itoa(port)))
+		if err == nil {
+			conn.Close()
+			return true, fmt.Sprintf("accepts tcp/%d", port)
+		}
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return true, fmt.Sprintf("refuses tcp/%d, so a host is up", port)
+		}
+	}
+	// The dials made the kernel resolve the address on a local subnet
+	if mac := neighborMAC(addr); mac != "" {
+		return true, "answers ARP as " + mac
+	}
+	return false, ""
+}
+
+// neighborMAC returns the hardware address the kernel has learned for addr,
+// or "" when it has none or the platform doesn't expose its ARP table
+func neighborMAC(addr netip.Addr) string {
+	data, err := os.ReadFile("/proc/net/arp")
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n")[1:] {
+		// IP address, HW type, Flags, HW address, Mask, Device
+		fields := strings.Fields(line)
+		if len(fields) >= 4 && fields[0] == addr.String() && fields[2] == "0x2" {
+			return fields[3]
+		}
+	}
+	return ""
+}
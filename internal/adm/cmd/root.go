@@ -18,24 +18,65 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"github.com/butlerdotdev/butler/internal/adm/access"
+	"github.com/butlerdotdev/butler/internal/adm/addon"
+	"github.com/butlerdotdev/butler/internal/adm/advisories"
+	"github.com/butlerdotdev/butler/internal/adm/backup"
 	"github.com/butlerdotdev/butler/internal/adm/bootstrap"
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/orchestrator"
+	"github.com/butlerdotdev/butler/internal/adm/certs"
+	"github.com/butlerdotdev/butler/internal/adm/check"
+	"github.com/butlerdotdev/butler/internal/adm/controller"
+	"github.com/butlerdotdev/butler/internal/adm/diagnose"
+	"github.com/butlerdotdev/butler/internal/adm/dns"
+	"github.com/butlerdotdev/butler/internal/adm/gc"
+	"github.com/butlerdotdev/butler/internal/adm/info"
+	"github.com/butlerdotdev/butler/internal/adm/inventory"
+	"github.com/butlerdotdev/butler/internal/adm/maintenance"
+	"github.com/butlerdotdev/butler/internal/adm/network"
 	"github.com/butlerdotdev/butler/internal/adm/provider"
+	"github.com/butlerdotdev/butler/internal/adm/reconcile"
+	"github.com/butlerdotdev/butler/internal/adm/replicate"
+	"github.com/butlerdotdev/butler/internal/adm/security"
 	"github.com/butlerdotdev/butler/internal/adm/status"
+	"github.com/butlerdotdev/butler/internal/adm/tenants"
+	"github.com/butlerdotdev/butler/internal/adm/upgrade"
+	"github.com/butlerdotdev/butler/internal/common/client"
 	"github.com/butlerdotdev/butler/internal/common/log"
 	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/common/redact"
+	"github.com/butlerdotdev/butler/internal/common/suggest"
+	"github.com/butlerdotdev/butler/internal/common/version"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	cfgFile string
-	verbose bool
+	cfgFile        string
+	verbose        int
+	nonInteractive bool
+	showSecrets    bool
+	noTruncate     bool
+	plainLogs      bool
+	strictConfig   bool
 )
 
 // Execute runs the butleradm CLI
 func Execute(logger *log.Logger) error {
+	ctx, cancel := waiter.InterruptContext(context.Background(), func() {
+		logger.Warn("interrupted, stopping (press Ctrl-C again to force)")
+	})
+	defer cancel()
+
 	rootCmd := NewRootCmd(logger)
-	return rootCmd.Execute()
+	err := rootCmd.ExecuteContext(ctx)
+	if stats := client.RequestStats(); stats.Calls > 0 {
+		logger.Debug(stats.String())
+	}
+	return err
 }
 
 // NewRootCmd creates the root command for butleradm
@@ -50,6 +91,8 @@ It is designed for Platform Operators who manage the Butler infrastructure:
   • Bootstrap new management clusters
   • Check platform health and status
   • Manage infrastructure providers
+  • Schedule rolling maintenance windows
+  • Back up management cluster state
   • Upgrade Butler platform components
 
 Butler follows CNCF best practices with a Kubernetes-native, controller-based architecture.
@@ -68,8 +111,30 @@ Examples:
   # Validate provider connectivity
   butleradm provider validate nutanix`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			if verbose {
-				logger.SetVerbose(true)
+			logger.SetVerbosity(verbose)
+			if f := client.FactoryFrom(cmd.Context()); f != nil {
+				client.UseFactory(f)
+			}
+			if verbose >= 2 {
+				client.TraceRequests(logger)
+			}
+			if nonInteractive {
+				prompt.SetNonInteractive()
+			}
+			if showSecrets {
+				redact.ShowSecrets()
+			}
+			if noTruncate {
+				output.DisableTruncation()
+			}
+			if plainLogs {
+				log.SetPlain()
+			}
+			if client.Flags.As != "" {
+				logger.Debug("impersonating", "user", client.Flags.As, "groups", client.Flags.AsGroups)
+			}
+			if strictConfig {
+				orchestrator.SetStrictConfig()
 			}
 			return initConfig(logger)
 		},
@@ -82,18 +147,49 @@ Examples:
 
 	// Global flags
 	cmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default: ./bootstrap.yaml or ~/.butler/config.yaml)")
-	cmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose output")
+	cmd.PersistentFlags().CountVarP(&verbose, "verbose", "v", "enable verbose output; -vv also logs every API request")
+	cmd.PersistentFlags().BoolVar(&nonInteractive, "non-interactive", false, "fail instead of prompting; confirmations need --yes (env: "+prompt.EnvNonInteractive+")")
+	cmd.PersistentFlags().BoolVar(&showSecrets, "show-secrets", false, "print passwords, tokens and other credentials instead of redacting them")
+	cmd.PersistentFlags().BoolVar(&noTruncate, "no-truncate", false, "print table cells in full instead of fitting tables to the terminal width")
+	cmd.PersistentFlags().BoolVar(&plainLogs, "plain-logs", false, "write logs as plain text without colors (env: "+log.EnvPlain+")")
+	client.Flags.AddFlags(cmd.PersistentFlags())
+	cmd.PersistentFlags().BoolVar(&strictConfig, "strict-config", false, "fail on unknown bootstrap config keys instead of warning")
 
 	// Bind to viper
 	viper.BindPFlag("config", cmd.PersistentFlags().Lookup("config"))
 
 	// Register subcommands
 	cmd.AddCommand(bootstrap.NewBootstrapCmd(logger))
+	cmd.AddCommand(bootstrap.NewExportConfigCmd(logger))
+	cmd.AddCommand(bootstrap.NewConfigCmd(logger))
+	cmd.AddCommand(bootstrap.NewSimulateCmd(logger))
 	cmd.AddCommand(status.NewStatusCmd(logger))
+	cmd.AddCommand(check.NewCheckCmd(logger))
+	cmd.AddCommand(diagnose.NewDiagnoseCmd(logger))
+	cmd.AddCommand(controller.NewControllerCmd(logger))
+	cmd.AddCommand(reconcile.NewReconcileCmd(logger))
+	cmd.AddCommand(info.NewInfoCmd(logger))
 	cmd.AddCommand(provider.NewProviderCmd(logger))
+	cmd.AddCommand(addon.NewAddonCmd(logger))
+	cmd.AddCommand(maintenance.NewMaintenanceCmd(logger))
+	cmd.AddCommand(access.NewAccessCmd(logger))
+	cmd.AddCommand(security.NewSecurityCmd(logger))
+	cmd.AddCommand(inventory.NewInventoryCmd(logger))
+	cmd.AddCommand(tenants.NewTenantsCmd(logger))
+	cmd.AddCommand(advisories.NewAdvisoriesCmd(logger))
+	cmd.AddCommand(gc.NewGCCmd(logger))
+	cmd.AddCommand(dns.NewDNSCmd(logger))
+	cmd.AddCommand(network.NewNetworkCmd(logger))
+	cmd.AddCommand(certs.NewCertsCmd(logger))
+	cmd.AddCommand(backup.NewBackupCmd(logger))
+	cmd.AddCommand(backup.NewRestoreCmd(logger))
+	cmd.AddCommand(replicate.NewReplicateCmd(logger))
+	cmd.AddCommand(replicate.NewFailoverCmd(logger))
+	cmd.AddCommand(upgrade.NewUpgradeCmd(logger))
 	cmd.AddCommand(NewVersionCmd())
 
-	// TODO: Add upgrade, backup, restore commands
+	// Suggest near matches for mistyped subcommands at every level
+	suggest.RegisterCommands(cmd)
 
 	return cmd
 }
@@ -128,7 +224,7 @@ func NewVersionCmd() *cobra.Command {
 		Use:   "version",
 		Short: "Print version information",
 		Run: func(cmd *cobra.Command, args []string) {
-			cmd.Println(output.Binary("butleradm") + " version v0.1.0-dev")
+			cmd.Println(output.Binary("butleradm") + " version " + version.Version + output.Dim(" ("+version.GitCommit()+")"))
 			cmd.Println("Butler Platform Administration")
 			cmd.Println(output.Dim("https://github.com/butlerdotdev/butler"))
 		},
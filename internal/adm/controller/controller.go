@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package controller implements butleradm commands for operating the
+// Butler controllers in butler-system during incidents.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// controllerNamespace holds the Butler controllers
+	controllerNamespace = "butler-system"
+
+	// providerPrefix starts the name of every provider controller
+	providerPrefix = "butler-provider-"
+)
+
+// controllerNames are the controllers besides the providers
+var controllerNames = []string{"butler-controller", "butler-bootstrap"}
+
+// NewControllerCmd creates the controller parent command
+func NewControllerCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "controller",
+		Short: "Read logs of and restart the Butler controllers",
+		Long: `Read logs of and restart the Butler controllers.
+
+The controllers are the butler-controller, butler-bootstrap and
+butler-provider-* Deployments in butler-system. Name them in full or
+without the butler- prefix, e.g. "controller" or "provider-nutanix".
+
+Commands:
+  logs     Show controller logs
+  restart  Restart controllers and wait for the rollout
+
+Examples:
+  # Follow the tenant cluster controller
+  butleradm controller logs controller -f
+
+  # Logs of the provider controller before its last crash
+  butleradm controller logs provider-nutanix --previous
+
+  # Restart every controller
+  butleradm controller restart --all`,
+	}
+
+	cmd.AddCommand(newLogsCmd(logger))
+	cmd.AddCommand(newRestartCmd(logger))
+
+	return cmd
+}
+
+// controllerDeployments returns the Deployments named by args, or every
+// controller if args is empty, sorted by name
+func controllerDeployments(ctx context.Context, c *client.Client, args []string) ([]appsv1.Deployment, error) {
+	list, err := c.Clientset.AppsV1().Deployments(controllerNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Deployments in %s: %w", controllerNamespace, err)
+	}
+
+	byName := map[string]appsv1.Deployment{}
+	var available []string
+	for _, d := range list.Items {
+		if isController(d.Name) {
+			byName[d.Name] = d
+			available = append(available, d.Name)
+		}
+	}
+	sort.Strings(available)
+
+	if len(args) == 0 {
+		if len(available) == 0 {
+			return nil, fmt.Errorf("no Butler controllers found in %s", controllerNamespace)
+		}
+		out := make([]appsv1.Deployment, 0, len(available))
+		for _, name := range available {
+			out = append(out, byName[name])
+		}
+		return out, nil
+	}
+
+	seen := map[string]bool{}
+	var out []appsv1.Deployment
+	for _, arg := range args {
+		d, ok := byName[arg]
+		if !ok {
+			d, ok = byName["butler-"+arg]
+		}
+		if !ok {
+			d, ok = byName[providerPrefix+arg]
+		}
+		if !ok {
+			return nil, fmt.Errorf("controller %q not found in %s (available: %s)", arg, controllerNamespace, strings.Join(available, ", "))
+		}
+		if !seen[d.Name] {
+			seen[d.Name] = true
+			out = append(out, d)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func isController(name string) bool {
+	for _, n := range controllerNames {
+		if name == n {
+			return true
+		}
+	}
+	return strings.HasPrefix(name, providerPrefix)
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
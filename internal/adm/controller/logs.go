@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// managerContainer is the controller container in each Deployment,
+// following the kubebuilder layout they are generated from
+const managerContainer = "manager"
+
+type logsOptions struct {
+	kubeconfig string
+	container  string
+	since      time.Duration
+	tail       int64
+	follow     bool
+	previous   bool
+	timestamps bool
+}
+
+// logSource is one controller container to read logs from
+type logSource struct {
+	pod       string
+	container string
+}
+
+func newLogsCmd(logger *log.Logger) *cobra.Command {
+	opts := &logsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "logs [CONTROLLER...]",
+		Short: "Show controller logs",
+		Long: `Show logs of Butler controllers, all of them if none are named.
+
+Pods are found through each Deployment's label selector, so every replica
+is shown, including pods of a rollout in progress. Each line is prefixed
+with the pod it came from.
+
+Examples:
+  # Last 100 lines of every controller
+  butleradm controller logs
+
+  # Follow the bootstrap controller
+  butleradm controller logs bootstrap -f
+
+  # Why did the Nutanix provider crash?
+  butleradm controller logs provider-nutanix --previous
+
+  # Everything the tenant cluster controller logged in the last 15 minutes
+  butleradm controller logs controller --since 15m --tail -1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.previous && opts.follow {
+				return fmt.Errorf("--previous and --follow cannot be used together")
+			}
+			return runLogs(cmd.Context(), logger, args, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVarP(&opts.container, "container", "c", managerContainer, "container to show")
+	cmd.Flags().DurationVar(&opts.since, "since", 0, "only logs newer than this, e.g. 10m")
+	cmd.Flags().Int64Var(&opts.tail, "tail", 100, "lines to show per pod before following (-1: all)")
+	cmd.Flags().BoolVarP(&opts.follow, "follow", "f", false, "stream new log lines")
+	cmd.Flags().BoolVarP(&opts.previous, "previous", "p", false, "show logs of the previous container instance, e.g. after a crash")
+	cmd.Flags().BoolVar(&opts.timestamps, "timestamps", false, "include timestamps")
+
+	return cmd
+}
+
+func runLogs(ctx context.Context, logger *log.Logger, args []string, opts *logsOptions) error {
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	deployments, err := controllerDeployments(ctx, c, args)
+	if err != nil {
+		return err
+	}
+
+	var sources []logSource
+	for i := range deployments {
+		found, err := podSources(ctx, c, &deployments[i], opts.container)
+		if err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			logger.Warn("no pods found", "controller", deployments[i].Name)
+		}
+		sources = append(sources, found...)
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+
+	logOpts := &corev1.PodLogOptions{
+		Follow:     opts.follow,
+		Previous:   opts.previous,
+		Timestamps: opts.timestamps,
+	}
+	if opts.tail >= 0 {
+		logOpts.TailLines = &opts.tail
+	}
+	if opts.since > 0 {
+		seconds := int64(opts.since.Seconds())
+		logOpts.SinceSeconds = &seconds
+	}
+
+	// Without --follow, print each pod in turn; with it, interleave the
+	// streams as lines arrive
+	w := &prefixWriter{out: os.Stdout}
+	if !opts.follow {
+		for _, src := range sources {
+			if err := streamLogs(ctx, c, src, logOpts, w); err != nil {
+				logger.Warn("could not read logs", "pod", src.pod, "error", err)
+			}
+		}
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for _, src := range sources {
+		wg.Add(1)
+		go func(src logSource) {
+			defer wg.Done()
+			if err := streamLogs(ctx, c, src, logOpts, w); err != nil && ctx.Err() == nil {
+				logger.Warn("log stream ended", "pod", src.pod, "error", err)
+			}
+		}(src)
+	}
+	wg.Wait()
+	return nil
+}
+
+// podSources finds the pods of a controller Deployment by its selector
+func podSources(ctx context.Context, c *client.Client, d *appsv1.Deployment, container string) ([]logSource, error) {
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing selector of %s: %w", d.Name, err)
+	}
+	pods, err := c.Clientset.CoreV1().Pods(controllerNamespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods of %s: %w", d.Name, err)
+	}
+
+	var sources []logSource
+	for _, pod := range pods.Items {
+		for _, ctr := range pod.Spec.Containers {
+			if ctr.Name == container {
+				sources = append(sources, logSource{pod: pod.Name, container: ctr.Name})
+			}
+		}
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].pod < sources[j].pod })
+	return sources, nil
+}
+
+// streamLogs copies one container's logs to w, line by line
+func streamLogs(ctx context.Context, c *client.Client, src logSource, opts *corev1.PodLogOptions, w *prefixWriter) error {
+	podOpts := *opts
+	podOpts.Container = src.container
+
+	stream, err := c.Clientset.CoreV1().Pods(controllerNamespace).GetLogs(src.pod, &podOpts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	prefix := output.Dim(fmt.Sprintf("[%s]", src.pod)) + " "
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		w.writeLine(prefix, scanner.Text())
+	}
+	return scanner.Err()
+}
+
+// prefixWriter serializes lines from concurrent log streams
+type prefixWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *prefixWriter) writeLine(prefix, line string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	fmt.Fprintln(w.out, prefix+line)
+}
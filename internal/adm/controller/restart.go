@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// restartedAtAnnotation is set on the pod template to roll the pods, as
+// kubectl rollout restart does
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+type restartOptions struct {
+	kubeconfig string
+	all        bool
+	wait       bool
+	timeout    time.Duration
+}
+
+func newRestartCmd(logger *log.Logger) *cobra.Command {
+	opts := &restartOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "restart CONTROLLER... | --all",
+		Short: "Restart controllers and wait for the rollout",
+		Long: `Restart Butler controllers with a rolling update, as kubectl rollout
+restart does, then wait until every replica is replaced and available.
+
+Examples:
+  butleradm controller restart controller
+  butleradm controller restart provider-harvester bootstrap
+  butleradm controller restart --all --wait=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.all == (len(args) > 0) {
+				return fmt.Errorf("name controllers to restart, or use --all")
+			}
+			return runRestart(cmd.Context(), logger, args, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "restart every controller")
+	cmd.Flags().BoolVar(&opts.wait, "wait", true, "wait for the rollouts to finish")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 5*time.Minute, "how long to wait for each rollout")
+
+	return cmd
+}
+
+func runRestart(ctx context.Context, logger *log.Logger, args []string, opts *restartOptions) error {
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	deployments, err := controllerDeployments(ctx, c, args)
+	if err != nil {
+		return err
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		restartedAtAnnotation, time.Now().Format(time.RFC3339)))
+	for _, d := range deployments {
+		if _, err := c.Clientset.AppsV1().Deployments(controllerNamespace).Patch(ctx, d.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return fmt.Errorf("restarting %s: %w", d.Name, err)
+		}
+		logger.Info("restarting", "controller", d.Name)
+	}
+	if !opts.wait {
+		return nil
+	}
+
+	for _, d := range deployments {
+		if err := waitForRollout(ctx, c, d.Name, opts.timeout); err != nil {
+			return err
+		}
+		logger.Success("restarted", "controller", d.Name)
+	}
+	return nil
+}
+
+// waitForRollout waits until every replica runs the current template
+func waitForRollout(ctx context.Context, c *client.Client, name string, timeout time.Duration) error {
+	return waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("rollout of %s", name),
+		Interval:    3 * time.Second,
+		Timeout:     timeout,
+	}, func(ctx context.Context) (bool, string, error) {
+		d, err := c.Clientset.AppsV1().Deployments(controllerNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("error: %v", err), nil
+		}
+		replicas := int32(1)
+		if d.Spec.Replicas != nil {
+			replicas = *d.Spec.Replicas
+		}
+		status := fmt.Sprintf("%d/%d updated", d.Status.UpdatedReplicas, replicas)
+		done := d.Status.ObservedGeneration >= d.Generation &&
+			d.Status.UpdatedReplicas == replicas &&
+			d.Status.AvailableReplicas == replicas &&
+			d.Status.Replicas == replicas
+		return done, status, nil
+	})
+}
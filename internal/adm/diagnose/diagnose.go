@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diagnose implements butleradm diagnose commands.
+package diagnose
+
+import (
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+)
+
+// NewDiagnoseCmd creates the diagnose parent command
+func NewDiagnoseCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Find out why platform resources are stuck",
+		Long: `Gather state from Kubernetes, the provider and Talos about a stuck
+resource and rank the likely causes.
+
+Commands:
+  machine  Diagnose a MachineRequest whose node doesn't come up
+
+Examples:
+  butleradm diagnose machine payments-worker-7xk2p`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newMachineCmd(logger))
+
+	return cmd
+}
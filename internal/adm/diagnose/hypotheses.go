@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnose
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/output"
+)
+
+// Hypothesis confidence levels, most likely first
+const (
+	ConfidenceHigh   = "high"
+	ConfidenceMedium = "medium"
+	ConfidenceLow    = "low"
+)
+
+// dhcpGrace is how long a running VM may go without an address before
+// DHCP is suspected
+const dhcpGrace = 5 * time.Minute
+
+var confidenceRank = map[string]int{
+	ConfidenceHigh:   0,
+	ConfidenceMedium: 1,
+	ConfidenceLow:    2,
+}
+
+// Hypothesis is a likely cause of a stuck machine
+type Hypothesis struct {
+	Confidence string `json:"confidence"`
+	Cause      string `json:"cause"`
+	Evidence   string `json:"evidence,omitempty"`
+	Remedy     string `json:"remedy,omitempty"`
+}
+
+// messagePattern is a known cause recognized in failure messages, events
+// and controller log lines
+type messagePattern struct {
+	pattern *regexp.Regexp
+	cause   string
+	remedy  string
+}
+
+// sanMismatch is also looked for in Talos API errors
+var sanMismatch = messagePattern{
+	regexp.MustCompile(`(?i)x509: certificate is (valid for|not valid for)|certificate is valid for .* not `),
+	"Certificate SAN mismatch: the node is reached by a name or address its certificate doesn't cover",
+	"add the VIP or hostname to the certificate SANs, or reach the node by an address it was issued for",
+}
+
+// messagePatterns starts with the missing image, which is also detected by
+// listing the provider's images
+var messagePatterns = []messagePattern{
+	{
+		regexp.MustCompile(`(?i)image.{0,40}(not found|does not exist|missing|no such)|(not found|unknown).{0,20}image`),
+		"The OS image does not exist on the provider",
+		"check the image with 'butlerctl images list' and the ProviderConfig default image",
+	},
+	sanMismatch,
+	{
+		regexp.MustCompile(`(?i)insufficient|quota|out of (memory|capacity|space)|no space left|not enough (memory|cpu|resources)`),
+		"The provider is out of capacity",
+		"free capacity or lower the machine size; 'butleradm bootstrap plan' shows what is available",
+	},
+	{
+		regexp.MustCompile(`(?i)unauthori[sz]ed|forbidden|authentication failed|status 401|status 403`),
+		"The provider rejected the credentials",
+		"check the ProviderConfig credentials Secret, then run 'butleradm provider validate'",
+	},
+	{
+		regexp.MustCompile(`(?i)subnet.{0,40}(not found|does not exist)|network.{0,40}(not found|does not exist)`),
+		"The VM network or subnet does not exist on the provider",
+		"check the network settings in the ProviderConfig",
+	},
+}
+
+// rankHypotheses matches what was gathered against known causes, most
+// likely first
+func rankHypotheses(d *Diagnosis) []Hypothesis {
+	var hs []Hypothesis
+	add := func(confidence, cause, evidence, remedy string) {
+		hs = append(hs, Hypothesis{Confidence: confidence, Cause: cause, Evidence: evidence, Remedy: remedy})
+	}
+
+	// Causes named in messages count once, with their first evidence
+	seen := map[string]bool{}
+
+	if d.imageMissing {
+		p := messagePatterns[0]
+		seen[p.cause] = true
+		add(ConfidenceHigh, p.cause, fmt.Sprintf("image %q is not in the provider's image list", d.Image), p.remedy)
+	}
+
+	for _, msg := range d.messages() {
+		for _, p := range messagePatterns {
+			if !seen[p.cause] && p.pattern.MatchString(msg) {
+				seen[p.cause] = true
+				add(ConfidenceHigh, p.cause, truncate(msg, 160), p.remedy)
+			}
+		}
+	}
+
+	if d.controllerDown {
+		add(ConfidenceHigh, "The "+d.Provider+" provider controller is not running",
+			"no running butler-provider-"+d.Provider+" pod in butler-system",
+			"kubectl -n butler-system describe deployment butler-provider-"+d.Provider)
+	}
+
+	if vm := d.VM; vm != nil {
+		switch {
+		case !vm.Found && (d.Phase == "Pending" || d.Phase == "Creating"):
+			confidence := ConfidenceLow
+			if d.age > dhcpGrace {
+				confidence = ConfidenceMedium
+			}
+			add(confidence, "The provider has not created the VM",
+				fmt.Sprintf("no VM named %s after %s in phase %s", d.Machine, d.Age, d.Phase),
+				"check the controller log and events for errors creating the VM")
+		case !vm.Found:
+			add(ConfidenceMedium, "The VM was deleted outside Butler",
+				fmt.Sprintf("no VM named %s although the MachineRequest is %s", d.Machine, d.Phase),
+				"'butleradm provider reconcile' lists missing VMs")
+		case !vm.Running:
+			add(ConfidenceHigh, "The VM is not running",
+				"provider reports state "+vm.State,
+				"power the VM on and watch its console: "+vm.Console)
+		case d.IPAddress == "" && len(vm.IPs) == 0:
+			confidence := ConfidenceLow
+			if d.age > dhcpGrace {
+				confidence = ConfidenceHigh
+			}
+			add(confidence, "The VM got no IP address, most likely a DHCP failure",
+				fmt.Sprintf("VM running for up to %s without an address", d.Age),
+				"check DHCP on the VM network and the boot output on the console: "+vm.Console)
+		}
+	}
+
+	if t := d.Talos; t != nil {
+		switch {
+		case t.Error != "" && strings.Contains(t.Error, "certificate required"),
+			t.Error != "" && strings.Contains(strings.ToLower(t.Error), "maintenance"):
+			add(ConfidenceMedium, "Talos is in maintenance mode: the machine config was never applied",
+				truncate(t.Error, 160),
+				"check the MachineRequest userData and the bootstrap controller log")
+		case t.Error != "" && sanMismatch.pattern.MatchString(t.Error):
+			if !seen[sanMismatch.cause] {
+				add(ConfidenceHigh, sanMismatch.cause, truncate(t.Error, 160), sanMismatch.remedy)
+			}
+		case t.Error != "":
+			add(ConfidenceLow, "The Talos API is unreachable", truncate(t.Error, 160),
+				"check that the node booted and port 50000 is reachable from here")
+		}
+
+		var unhealthy []string
+		for _, s := range t.Services {
+			if (s.State != "Running" && s.State != "Finished") || s.Health == "Fail" {
+				unhealthy = append(unhealthy, fmt.Sprintf("%s %s/%s", s.ID, s.State, s.Health))
+			}
+		}
+		if len(unhealthy) > 0 {
+			add(ConfidenceMedium, "Talos services are not healthy",
+				strings.Join(unhealthy, ", "),
+				fmt.Sprintf("talosctl -n %s logs %s", t.Node, strings.Fields(unhealthy[0])[0]))
+		}
+	}
+
+	if d.Phase == "Failed" && len(hs) == 0 {
+		add(ConfidenceMedium, "The provider controller gave up on the machine",
+			strings.TrimPrefix(d.FailureReason+": "+d.FailureMessage, ": "),
+			"fix the cause, then delete the MachineRequest so it is recreated")
+	}
+	if len(hs) == 0 {
+		add(ConfidenceLow, "No known cause matched", "", "read the events and controller log above")
+	}
+
+	sort.SliceStable(hs, func(i, j int) bool {
+		return confidenceRank[hs[i].Confidence] < confidenceRank[hs[j].Confidence]
+	})
+	return hs
+}
+
+// messages returns the failure message, event messages and controller log
+// lines, newest last
+func (d *Diagnosis) messages() []string {
+	var msgs []string
+	if d.FailureMessage != "" {
+		msgs = append(msgs, d.FailureMessage)
+	}
+	for _, e := range d.Events {
+		msgs = append(msgs, e.Message)
+	}
+	return append(msgs, d.ControllerLog...)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-3] + "..."
+}
+
+func colorizeConfidence(c string) string {
+	switch c {
+	case ConfidenceHigh:
+		return output.Danger(c)
+	case ConfidenceMedium:
+		return output.Warning(c)
+	}
+	return output.Dim(c)
+}
@@ -0,0 +1,668 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnose
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/access"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/providerapi"
+	"github.com/butlerdotdev/butler/internal/common/talosctl"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// maxEvents and maxLogLines cap what is shown of each
+	maxEvents   = 10
+	maxLogLines = 10
+
+	// controllerLogTail is how much of the provider controller log is searched
+	controllerLogTail = int64(2000)
+
+	// consoleLines is how much of the node's kernel log is shown
+	consoleLines = 20
+)
+
+var (
+	// virtualMachineGVR and virtualMachineInstanceGVR are the KubeVirt
+	// resources Harvester machines run as
+	virtualMachineGVR = schema.GroupVersionResource{
+		Group:    "kubevirt.io",
+		Version:  "v1",
+		Resource: "virtualmachines",
+	}
+	virtualMachineInstanceGVR = schema.GroupVersionResource{
+		Group:    "kubevirt.io",
+		Version:  "v1",
+		Resource: "virtualmachineinstances",
+	}
+)
+
+type machineOptions struct {
+	namespace    string
+	kubeconfig   string
+	talosconfig  string
+	timeout      time.Duration
+	outputFormat string
+}
+
+// Diagnosis is what was gathered about a MachineRequest and the causes it
+// points to
+type Diagnosis struct {
+	Name           string        `json:"name"`
+	Namespace      string        `json:"namespace"`
+	Machine        string        `json:"machine"`
+	Role           string        `json:"role,omitempty"`
+	Phase          string        `json:"phase"`
+	Age            string        `json:"age"`
+	Provider       string        `json:"provider"`
+	ProviderConfig string        `json:"providerConfig"`
+	Image          string        `json:"image,omitempty"`
+	IPAddress      string        `json:"ipAddress,omitempty"`
+	FailureReason  string        `json:"failureReason,omitempty"`
+	FailureMessage string        `json:"failureMessage,omitempty"`
+	VM             *VMState      `json:"vm,omitempty"`
+	Talos          *TalosState   `json:"talos,omitempty"`
+	Events         []Event       `json:"events,omitempty"`
+	ControllerLog  []string      `json:"controllerLog,omitempty"`
+	Hypotheses     []Hypothesis  `json:"hypotheses"`
+	Notes          []string      `json:"notes,omitempty"`
+	age            time.Duration `json:"-"`
+	imageMissing   bool          `json:"-"`
+	controllerDown bool          `json:"-"`
+}
+
+// VMState is the machine's VM as the provider reports it
+type VMState struct {
+	Found bool   `json:"found"`
+	State string `json:"state,omitempty"`
+	// Running is true when the provider reports the VM powered on
+	Running bool     `json:"running"`
+	IPs     []string `json:"ips,omitempty"`
+	// Console says where to read the VM console
+	Console string `json:"console,omitempty"`
+}
+
+// TalosState is what the Talos API reports for the node
+type TalosState struct {
+	Node     string         `json:"node"`
+	Error    string         `json:"error,omitempty"`
+	Services []TalosService `json:"services,omitempty"`
+	Console  []string       `json:"console,omitempty"`
+}
+
+// TalosService is one Talos service's state
+type TalosService struct {
+	ID     string `json:"id"`
+	State  string `json:"state"`
+	Health string `json:"health"`
+}
+
+// Event is a Kubernetes event about the machine
+type Event struct {
+	Time    string `json:"time"`
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func newMachineCmd(logger *log.Logger) *cobra.Command {
+	opts := &machineOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "machine NAME",
+		Short: "Diagnose a MachineRequest whose node doesn't come up",
+		Long: `Diagnose a stuck MachineRequest and rank the likely causes.
+
+Gathers the MachineRequest status, the VM state from the provider API,
+Talos service health and kernel log through the Talos API, recent events
+and the provider controller's log lines about the machine. These are
+matched against common causes such as a missing image, a VM that never
+got a DHCP lease, a certificate SAN mismatch, exhausted capacity or
+rejected credentials, and printed as ranked hypotheses.
+
+NAME is the MachineRequest or VM name. Without --namespace all namespaces
+are searched. The talosconfig comes from --talosconfig, the cluster's
+<cluster>-talosconfig Secret or the one saved by bootstrap; Talos checks
+are skipped when none is found or talosctl isn't installed.
+
+Examples:
+  butleradm diagnose machine payments-worker-7xk2p
+
+  # JSON for a support ticket
+  butleradm diagnose machine butler-mgmt-cp-0 -n butler-system -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMachine(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "namespace of the MachineRequest (default: search all)")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.talosconfig, "talosconfig", "", "talosconfig for the machine's cluster")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 30*time.Second, "timeout for provider and Talos API calls")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+func runMachine(ctx context.Context, logger *log.Logger, name string, opts *machineOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var c *client.Client
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	mr, err := findMachineRequest(ctx, c, opts.namespace, name)
+	if err != nil {
+		return err
+	}
+
+	d := newDiagnosis(mr)
+	logger.Debug("diagnosing machine", "machineRequest", d.Namespace+"/"+d.Name, "phase", d.Phase)
+
+	pcNamespace, _, _ := unstructured.NestedString(mr.Object, "spec", "providerRef", "namespace")
+	if pcNamespace == "" {
+		pcNamespace = providerapi.Namespace
+	}
+	pc, err := c.GetProviderConfig(ctx, pcNamespace, d.ProviderConfig)
+	if err != nil {
+		d.note("ProviderConfig %s/%s: %v", pcNamespace, d.ProviderConfig, err)
+	} else {
+		d.Provider, _, _ = unstructured.NestedString(pc.Object, "spec", "provider")
+		if d.Image == "" {
+			d.Image = defaultImage(pc, d.Provider)
+		}
+		d.VM = gatherVM(ctx, c, pc, d, opts.timeout)
+		if d.Phase != "Running" {
+			checkImage(ctx, c, pc, d, opts.timeout)
+		}
+	}
+
+	d.Events = gatherEvents(ctx, c, d)
+	d.ControllerLog = gatherControllerLog(ctx, c, d)
+	d.Talos = gatherTalos(ctx, c, mr, d, opts)
+	d.Hypotheses = rankHypotheses(d)
+
+	return output.NewPrinter(format, os.Stdout).Print(d, func(w io.Writer) error {
+		return printDiagnosis(w, d)
+	})
+}
+
+// findMachineRequest looks a MachineRequest up by its name or VM name
+func findMachineRequest(ctx context.Context, c *client.Client, namespace, name string) (*unstructured.Unstructured, error) {
+	list, err := c.Dynamic.Resource(client.MachineRequestGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing MachineRequests: %w", err)
+	}
+
+	var matches []*unstructured.Unstructured
+	for i := range list.Items {
+		mr := &list.Items[i]
+		machine, _, _ := unstructured.NestedString(mr.Object, "spec", "machineName")
+		if mr.GetName() == name || machine == name {
+			matches = append(matches, mr)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		if namespace != "" {
+			return nil, fmt.Errorf("MachineRequest %q not found in namespace %s", name, namespace)
+		}
+		return nil, fmt.Errorf("MachineRequest %q not found", name)
+	case 1:
+		return matches[0], nil
+	}
+	var found []string
+	for _, mr := range matches {
+		found = append(found, mr.GetNamespace()+"/"+mr.GetName())
+	}
+	return nil, fmt.Errorf("%q matches several MachineRequests (%s); choose one with --namespace", name, strings.Join(found, ", "))
+}
+
+func newDiagnosis(mr *unstructured.Unstructured) *Diagnosis {
+	d := &Diagnosis{
+		Name:      mr.GetName(),
+		Namespace: mr.GetNamespace(),
+		age:       time.Since(mr.GetCreationTimestamp().Time),
+	}
+	d.Age = d.age.Round(time.Second).String()
+	d.Machine, _, _ = unstructured.NestedString(mr.Object, "spec", "machineName")
+	d.Role, _, _ = unstructured.NestedString(mr.Object, "spec", "role")
+	d.Image, _, _ = unstructured.NestedString(mr.Object, "spec", "image")
+	d.ProviderConfig, _, _ = unstructured.NestedString(mr.Object, "spec", "providerRef", "name")
+	d.Phase, _, _ = unstructured.NestedString(mr.Object, "status", "phase")
+	d.IPAddress, _, _ = unstructured.NestedString(mr.Object, "status", "ipAddress")
+	d.FailureReason, _, _ = unstructured.NestedString(mr.Object, "status", "failureReason")
+	d.FailureMessage, _, _ = unstructured.NestedString(mr.Object, "status", "failureMessage")
+	if d.Phase == "" {
+		d.Phase = "Pending"
+	}
+	if d.Machine == "" {
+		d.Machine = d.Name
+	}
+	return d
+}
+
+// note records information that could not be gathered
+func (d *Diagnosis) note(format string, args ...interface{}) {
+	d.Notes = append(d.Notes, fmt.Sprintf(format, args...))
+}
+
+// defaultImage returns the ProviderConfig's image for machines that don't
+// set one
+func defaultImage(pc *unstructured.Unstructured, provider string) string {
+	field := map[string]string{
+		"harvester": "imageName",
+		"nutanix":   "imageUUID",
+		"proxmox":   "templateID",
+	}[provider]
+	if field == "" {
+		return ""
+	}
+	image, _, _ := unstructured.NestedFieldNoCopy(pc.Object, "spec", provider, field)
+	if image == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", image)
+}
+
+// gatherVM asks the provider about the machine's VM
+func gatherVM(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, d *Diagnosis, timeout time.Duration) *VMState {
+	var vm *VMState
+	var err error
+	switch d.Provider {
+	case "nutanix":
+		vm, err = nutanixVM(ctx, c, pc, d.Machine, timeout)
+	case "proxmox":
+		vm, err = proxmoxVM(ctx, c, pc, d.Machine, timeout)
+	case "harvester":
+		vm, err = harvesterVM(ctx, c, pc, d.Machine)
+	default:
+		err = fmt.Errorf("VM lookup is not supported for %s providers", d.Provider)
+	}
+	if err != nil {
+		d.note("provider VM: %v", err)
+		return nil
+	}
+	return vm
+}
+
+func nutanixVM(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, machine string, timeout time.Duration) (*VMState, error) {
+	nc, err := providerapi.NewNutanix(ctx, c, pc, timeout, false)
+	if err != nil {
+		return nil, err
+	}
+	vms, err := nc.ListVMs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, vm := range vms {
+		if vm.Spec.Name == machine {
+			state := vm.Status.Resources.PowerState
+			return &VMState{
+				Found:   true,
+				State:   state,
+				Running: state == "ON",
+				Console: "Prism Central > VMs > " + machine + " > Launch Console",
+			}, nil
+		}
+	}
+	return &VMState{}, nil
+}
+
+func proxmoxVM(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, machine string, timeout time.Duration) (*VMState, error) {
+	px, err := providerapi.NewProxmox(ctx, c, pc, timeout, false)
+	if err != nil {
+		return nil, err
+	}
+	var resources []struct {
+		VMID   int    `json:"vmid"`
+		Name   string `json:"name"`
+		Node   string `json:"node"`
+		Status string `json:"status"`
+	}
+	if err := px.Get(ctx, "/cluster/resources?type=vm", &resources); err != nil {
+		return nil, fmt.Errorf("listing VMs: %w", err)
+	}
+	for _, r := range resources {
+		if r.Name == machine {
+			return &VMState{
+				Found:   true,
+				State:   r.Status,
+				Running: r.Status == "running",
+				Console: fmt.Sprintf("qm terminal %d (on node %s)", r.VMID, r.Node),
+			}, nil
+		}
+	}
+	return &VMState{}, nil
+}
+
+// harvesterVM reads the KubeVirt VM and its instance, served in-cluster
+func harvesterVM(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, machine string) (*VMState, error) {
+	namespace, _, _ := unstructured.NestedString(pc.Object, "spec", "harvester", "namespace")
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	vm, err := c.Dynamic.Resource(virtualMachineGVR).Namespace(namespace).Get(ctx, machine, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return &VMState{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting VirtualMachine %s/%s: %w", namespace, machine, err)
+	}
+
+	state := &VMState{Found: true, Console: fmt.Sprintf("virtctl console %s -n %s", machine, namespace)}
+	state.State, _, _ = unstructured.NestedString(vm.Object, "status", "printableStatus")
+
+	vmi, err := c.Dynamic.Resource(virtualMachineInstanceGVR).Namespace(namespace).Get(ctx, machine, metav1.GetOptions{})
+	if err != nil {
+		return state, nil
+	}
+	phase, _, _ := unstructured.NestedString(vmi.Object, "status", "phase")
+	state.Running = phase == "Running"
+	if state.State == "" {
+		state.State = phase
+	}
+	interfaces, _, _ := unstructured.NestedSlice(vmi.Object, "status", "interfaces")
+	for _, i := range interfaces {
+		if iface, ok := i.(map[string]interface{}); ok {
+			if ip, _ := iface["ipAddress"].(string); ip != "" {
+				state.IPs = append(state.IPs, ip)
+			}
+		}
+	}
+	return state, nil
+}
+
+// checkImage records whether the machine's image exists on the provider
+func checkImage(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, d *Diagnosis, timeout time.Duration) {
+	if d.Image == "" {
+		return
+	}
+	images, err := providerapi.ListImages(ctx, c, pc, timeout)
+	if err != nil {
+		d.note("images: %v", err)
+		return
+	}
+	for _, img := range images {
+		if img.Ref == d.Image || img.Name == d.Image {
+			return
+		}
+	}
+	d.imageMissing = true
+}
+
+// gatherEvents returns the latest events about the MachineRequest or its VM
+func gatherEvents(ctx context.Context, c *client.Client, d *Diagnosis) []Event {
+	list, err := c.Clientset.CoreV1().Events(d.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		d.note("events: %v", err)
+		return nil
+	}
+
+	var matched []corev1.Event
+	for _, e := range list.Items {
+		if e.InvolvedObject.Name == d.Name || e.InvolvedObject.Name == d.Machine {
+			matched = append(matched, e)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return eventTime(matched[i]).Before(eventTime(matched[j]))
+	})
+	if len(matched) > maxEvents {
+		matched = matched[len(matched)-maxEvents:]
+	}
+
+	events := make([]Event, 0, len(matched))
+	for _, e := range matched {
+		events = append(events, Event{
+			Time:    eventTime(e).UTC().Format(time.RFC3339),
+			Type:    e.Type,
+			Reason:  e.Reason,
+			Message: strings.TrimSpace(e.Message),
+		})
+	}
+	return events
+}
+
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+// gatherControllerLog returns the provider controller's latest log lines
+// that mention the machine
+func gatherControllerLog(ctx context.Context, c *client.Client, d *Diagnosis) []string {
+	if d.Provider == "" {
+		return nil
+	}
+	selector := "app.kubernetes.io/name=butler-provider-" + d.Provider
+	pods, err := c.Clientset.CoreV1().Pods(providerapi.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		d.note("controller log: %v", err)
+		return nil
+	}
+
+	d.controllerDown = true
+	var lines []string
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning {
+			d.controllerDown = false
+		}
+		tail := controllerLogTail
+		stream, err := c.Clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{TailLines: &tail}).Stream(ctx)
+		if err != nil {
+			d.note("controller log %s: %v", pod.Name, err)
+			continue
+		}
+		scanner := bufio.NewScanner(stream)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.Contains(line, d.Name) || strings.Contains(line, d.Machine) {
+				lines = append(lines, line)
+			}
+		}
+		stream.Close()
+	}
+	if len(lines) > maxLogLines {
+		lines = lines[len(lines)-maxLogLines:]
+	}
+	return lines
+}
+
+// gatherTalos reads service health and the kernel log from the node's
+// Talos API
+func gatherTalos(ctx context.Context, c *client.Client, mr *unstructured.Unstructured, d *Diagnosis, opts *machineOptions) *TalosState {
+	node := d.IPAddress
+	if node == "" && d.VM != nil && len(d.VM.IPs) > 0 {
+		node = d.VM.IPs[0]
+	}
+	if node == "" {
+		return nil
+	}
+
+	talos, err := machineTalosctl(ctx, c, mr, opts.talosconfig)
+	if err != nil {
+		d.note("talos: %v", err)
+		return nil
+	}
+	defer talos.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	state := &TalosState{Node: node}
+	out, err := talos.Run(ctx, node, "services")
+	if err != nil {
+		state.Error = err.Error()
+		return state
+	}
+	state.Services = parseServices(out)
+
+	if out, err := talos.Run(ctx, node, "dmesg", "--tail", fmt.Sprint(consoleLines)); err == nil {
+		state.Console = strings.Split(strings.TrimSpace(string(out)), "\n")
+	}
+	return state
+}
+
+// machineTalosctl prefers the flag, then the cluster's talosconfig Secret,
+// then the talosconfig saved by bootstrap
+func machineTalosctl(ctx context.Context, c *client.Client, mr *unstructured.Unstructured, path string) (*talosctl.Talosctl, error) {
+	if path == "" {
+		if cluster := mr.GetLabels()[access.ClusterLabel]; cluster != "" {
+			secret, err := c.Clientset.CoreV1().Secrets(mr.GetNamespace()).Get(ctx, cluster+"-talosconfig", metav1.GetOptions{})
+			if err == nil && len(secret.Data["talosconfig"]) > 0 {
+				return talosctl.FromData(secret.Data["talosconfig"])
+			}
+		}
+	}
+	return talosctl.New(path)
+}
+
+// parseServices reads the table printed by talosctl services
+func parseServices(out []byte) []TalosService {
+	var services []TalosService
+	for i, line := range strings.Split(string(out), "\n") {
+		// NODE SERVICE STATE HEALTH LAST-CHANGE LAST-EVENT
+		fields := strings.Fields(line)
+		if i == 0 || len(fields) < 4 {
+			continue
+		}
+		services = append(services, TalosService{ID: fields[1], State: fields[2], Health: fields[3]})
+	}
+	return services
+}
+
+func printDiagnosis(w io.Writer, d *Diagnosis) error {
+	fmt.Fprintf(w, "%s %s/%s\n", output.Bold("MachineRequest"), d.Namespace, d.Name)
+	fmt.Fprintf(w, "  Machine:   %s (%s)\n", d.Machine, orDash(d.Role))
+	fmt.Fprintf(w, "  Phase:     %s for %s\n", d.Phase, d.Age)
+	fmt.Fprintf(w, "  Provider:  %s (ProviderConfig %s)\n", orDash(d.Provider), d.ProviderConfig)
+	fmt.Fprintf(w, "  Image:     %s\n", orDash(d.Image))
+	fmt.Fprintf(w, "  IP:        %s\n", orDash(d.IPAddress))
+	if d.FailureReason != "" || d.FailureMessage != "" {
+		fmt.Fprintf(w, "  Failure:   %s\n", strings.TrimPrefix(d.FailureReason+": "+d.FailureMessage, ": "))
+	}
+
+	if d.VM != nil {
+		fmt.Fprintf(w, "\n%s\n", output.Bold("Provider VM"))
+		if !d.VM.Found {
+			fmt.Fprintf(w, "  not found on the provider\n")
+		} else {
+			fmt.Fprintf(w, "  State:     %s\n", orDash(d.VM.State))
+			if len(d.VM.IPs) > 0 {
+				fmt.Fprintf(w, "  IPs:       %s\n", strings.Join(d.VM.IPs, ", "))
+			}
+			fmt.Fprintf(w, "  Console:   %s\n", d.VM.Console)
+		}
+	}
+
+	if d.Talos != nil {
+		fmt.Fprintf(w, "\n%s (%s)\n", output.Bold("Talos"), d.Talos.Node)
+		if d.Talos.Error != "" {
+			fmt.Fprintf(w, "  %s\n", output.Danger(d.Talos.Error))
+		} else {
+			table := output.NewTable(w, "SERVICE", "STATE", "HEALTH")
+			for _, s := range d.Talos.Services {
+				table.AddRow(s.ID, s.State, s.Health)
+			}
+			if err := table.Flush(); err != nil {
+				return err
+			}
+			for _, line := range d.Talos.Console {
+				fmt.Fprintf(w, "  %s\n", output.Dim(line))
+			}
+		}
+	}
+
+	if len(d.Events) > 0 {
+		fmt.Fprintf(w, "\n%s\n", output.Bold("Recent events"))
+		table := output.NewTable(w, "TIME", "TYPE", "REASON", "MESSAGE")
+		for _, e := range d.Events {
+			table.AddRow(e.Time, e.Type, e.Reason, e.Message)
+		}
+		if err := table.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(d.ControllerLog) > 0 {
+		fmt.Fprintf(w, "\n%s\n", output.Bold("Controller log"))
+		for _, line := range d.ControllerLog {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+
+	fmt.Fprintf(w, "\n%s\n", output.Bold("Likely causes"))
+	for i, h := range d.Hypotheses {
+		fmt.Fprintf(w, "  %d. [%s] %s\n", i+1, colorizeConfidence(h.Confidence), h.Cause)
+		if h.Evidence != "" {
+			fmt.Fprintf(w, "     evidence: %s\n", h.Evidence)
+		}
+		if h.Remedy != "" {
+			fmt.Fprintf(w, "     try: %s\n", h.Remedy)
+		}
+	}
+
+	if len(d.Notes) > 0 {
+		fmt.Fprintf(w, "\n%s\n", output.Dim("Not checked:"))
+		for _, n := range d.Notes {
+			fmt.Fprintf(w, "  %s\n", output.Dim(n))
+		}
+	}
+	return nil
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
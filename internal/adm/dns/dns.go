@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dns implements butleradm commands for tenant API DNS names.
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/apidns"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewDNSCmd creates the dns parent command
+func NewDNSCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dns",
+		Short: "Manage DNS names of tenant API endpoints",
+		Long: `Manage DNS names of tenant API endpoints.
+
+When dns.zone is set in the butler-platform ConfigMap, 'butlerctl cluster
+create' names each cluster's API endpoint in that zone and records the name
+on the TenantCluster. The record itself is created by external-dns from an
+annotation on the hosted control plane Service, which only exists once the
+control plane is up.
+
+Commands:
+  sync  Annotate control plane Services for external-dns
+
+Examples:
+  # Publish names for clusters created since the last run
+  butleradm dns sync`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newSyncCmd(logger))
+
+	return cmd
+}
+
+type syncOptions struct {
+	kubeconfig string
+	namespace  string
+}
+
+func newSyncCmd(logger *log.Logger) *cobra.Command {
+	opts := &syncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Annotate control plane Services for external-dns",
+		Long: `Publish the API DNS names of tenant clusters.
+
+Every TenantCluster carrying the butler.butlerlabs.dev/api-hostname
+annotation has its hosted control plane Service annotated for external-dns.
+Clusters whose control plane isn't up yet are skipped and picked up on a
+later run, so this is meant to run from cron or a CronJob.
+
+Examples:
+  # All namespaces
+  butleradm dns sync
+
+  # One namespace
+  butleradm dns sync -n team-payments`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "only clusters in this namespace (default: all)")
+
+	return cmd
+}
+
+func runSync(ctx context.Context, logger *log.Logger, opts *syncOptions) error {
+	var c *client.Client
+	var err error
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing TenantClusters: %w", err)
+	}
+
+	var failures []string
+	published, pending := 0, 0
+	for i := range list.Items {
+		tc := &list.Items[i]
+		hostname := apidns.Hostname(tc)
+		if hostname == "" || tc.GetDeletionTimestamp() != nil {
+			continue
+		}
+		ok, err := apidns.Publish(ctx, c, tc)
+		switch {
+		case err != nil:
+			failures = append(failures, fmt.Sprintf("%s/%s: %v", tc.GetNamespace(), tc.GetName(), err))
+		case !ok:
+			logger.Debug("control plane Service not found yet", "cluster", tc.GetName(), "namespace", tc.GetNamespace())
+			pending++
+		default:
+			logger.Info("published", "cluster", tc.GetName(), "namespace", tc.GetNamespace(), "hostname", hostname)
+			published++
+		}
+	}
+
+	logger.Info("dns sync complete", "published", published, "pending", pending)
+	if len(failures) > 0 {
+		return fmt.Errorf("dns sync finished with %d error(s):\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+	return nil
+}
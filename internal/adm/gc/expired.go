@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	eventExpiring = "expiring"
+	eventExpired  = "expired"
+)
+
+type runOptions struct {
+	kubeconfig string
+	notice     time.Duration
+	webhook    string
+	dryRun     bool
+}
+
+// Notification is posted to the webhook for expiring and expired clusters.
+// Text makes it directly usable with Slack-compatible incoming webhooks.
+type Notification struct {
+	Text      string `json:"text"`
+	Event     string `json:"event"`
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Owner     string `json:"owner,omitempty"`
+	Contact   string `json:"contact,omitempty"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+func newRunCmd(logger *log.Logger) *cobra.Command {
+	opts := &runOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Notify owners of expiring clusters and destroy expired ones",
+		Long: `Notify owners of expiring clusters and destroy expired ones.
+
+Clusters within --notice of their expiry get a single warning. Expired
+clusters are announced and then destroyed by deleting their TenantCluster.
+Notifications are recorded as Kubernetes Events on the TenantCluster and,
+with --webhook (or BUTLER_GC_WEBHOOK), posted as JSON to a chat or alerting
+webhook.
+
+Owners can postpone destruction by moving the butler.butlerlabs.dev/expires-at
+annotation (RFC 3339) forward.
+
+Examples:
+  # Preview
+  butleradm gc run --dry-run
+
+  # Warn two days ahead
+  butleradm gc run --notice 48h --webhook https://hooks.slack.com/services/...`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRun(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().DurationVar(&opts.notice, "notice", 24*time.Hour, "warn owners this long before expiry")
+	cmd.Flags().StringVar(&opts.webhook, "webhook", os.Getenv("BUTLER_GC_WEBHOOK"), "URL to POST notifications to")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "show what would happen without notifying or deleting")
+
+	return cmd
+}
+
+func runRun(ctx context.Context, logger *log.Logger, opts *runOptions) error {
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing TenantClusters: %w", err)
+	}
+
+	now := time.Now()
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	var failures []string
+	destroyed, warned := 0, 0
+
+	for i := range list.Items {
+		tc := &list.Items[i]
+		annotations := tc.GetAnnotations()
+		expiresAt, ok, err := lifecycle.ExpiresAt(annotations)
+		if err != nil {
+			logger.Warn("skipping cluster with invalid expiry", "cluster", tc.GetName(), "namespace", tc.GetNamespace(), "error", err)
+			continue
+		}
+		if !ok || tc.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		switch {
+		case !now.Before(expiresAt):
+			logger.Info("cluster expired", "cluster", tc.GetName(), "namespace", tc.GetNamespace(), "expired", expiresAt.Format(time.RFC3339))
+			if opts.dryRun {
+				destroyed++
+				continue
+			}
+			notify(ctx, c, httpClient, logger, opts.webhook, tc, eventExpired, expiresAt)
+			err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(tc.GetNamespace()).Delete(ctx, tc.GetName(), metav1.DeleteOptions{})
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s/%s: deleting: %v", tc.GetNamespace(), tc.GetName(), err))
+				continue
+			}
+			logger.Success("expired cluster destroyed", "cluster", tc.GetName(), "namespace", tc.GetNamespace())
+			destroyed++
+
+		case expiresAt.Sub(now) <= opts.notice && annotations[lifecycle.ExpiryNoticeAnnotation] == "":
+			logger.Info("cluster expiring soon", "cluster", tc.GetName(), "namespace", tc.GetNamespace(), "in", expiresAt.Sub(now).Round(time.Minute))
+			warned++
+			if opts.dryRun {
+				continue
+			}
+			notify(ctx, c, httpClient, logger, opts.webhook, tc, eventExpiring, expiresAt)
+			if err := markNoticeSent(ctx, c, tc, now); err != nil {
+				failures = append(failures, fmt.Sprintf("%s/%s: %v", tc.GetNamespace(), tc.GetName(), err))
+			}
+		}
+	}
+
+	if opts.dryRun {
+		logger.Info("dry run complete", "wouldDestroy", destroyed, "wouldWarn", warned)
+	} else {
+		logger.Info("gc complete", "destroyed", destroyed, "warned", warned)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("gc finished with %d error(s):\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+// notify records an Event on the cluster and posts to the webhook. Failures
+// are logged rather than returned so a broken webhook doesn't block cleanup.
+func notify(ctx context.Context, c *client.Client, httpClient *http.Client, logger *log.Logger, webhook string, tc *unstructured.Unstructured, event string, expiresAt time.Time) {
+	annotations := tc.GetAnnotations()
+	n := Notification{
+		Event:     event,
+		Cluster:   tc.GetName(),
+		Namespace: tc.GetNamespace(),
+		Owner:     annotations[lifecycle.OwnerAnnotation],
+		Contact:   annotations[lifecycle.ContactAnnotation],
+		ExpiresAt: lifecycle.FormatExpiry(expiresAt),
+	}
+
+	reason := "ClusterExpiring"
+	n.Text = fmt.Sprintf("Cluster %s/%s expires at %s and will then be destroyed. Move the %s annotation forward to keep it.",
+		n.Namespace, n.Cluster, n.ExpiresAt, lifecycle.ExpiresAtAnnotation)
+	if event == eventExpired {
+		reason = "ClusterExpired"
+		n.Text = fmt.Sprintf("Cluster %s/%s expired at %s and is being destroyed.", n.Namespace, n.Cluster, n.ExpiresAt)
+	}
+	if n.Owner != "" {
+		n.Text += " Owner: " + n.Owner + "."
+	}
+
+	if err := recordEvent(ctx, c, tc, reason, n.Text); err != nil {
+		logger.Warn("could not record event", "cluster", n.Cluster, "error", err)
+	}
+
+	if webhook == "" {
+		return
+	}
+	if err := postWebhook(ctx, httpClient, webhook, &n); err != nil {
+		logger.Warn("could not send notification", "cluster", n.Cluster, "error", err)
+	}
+}
+
+func recordEvent(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, reason, message string) error {
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: tc.GetName() + "-",
+			Namespace:    tc.GetNamespace(),
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: tc.GetAPIVersion(),
+			Kind:       tc.GetKind(),
+			Name:       tc.GetName(),
+			Namespace:  tc.GetNamespace(),
+			UID:        tc.GetUID(),
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           corev1.EventTypeWarning,
+		Source:         corev1.EventSource{Component: "butleradm-gc"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	_, err := c.Clientset.CoreV1().Events(tc.GetNamespace()).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
+
+func postWebhook(ctx context.Context, httpClient *http.Client, url string, n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("encoding notification: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting notification: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("posting notification: status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// markNoticeSent records that the expiry warning went out
+func markNoticeSent(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, now time.Time) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				lifecycle.ExpiryNoticeAnnotation: now.UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling patch: %w", err)
+	}
+	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(tc.GetNamespace()).Patch(ctx, tc.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("recording expiry notice: %w", err)
+	}
+	return nil
+}
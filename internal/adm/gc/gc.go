@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gc implements butleradm garbage collection commands.
+package gc
+
+import (
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+)
+
+// NewGCCmd creates the gc parent command
+func NewGCCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Clean up expired clusters and leaked resources",
+		Long: `Garbage-collect platform resources.
+
+Clusters created with 'butlerctl cluster create --ttl' carry an expiry
+annotation. 'gc run' warns their owners ahead of time and destroys them once
+expired. It is meant to be driven from cron or a CronJob running butleradm.
+
+'gc leaks' finds resources left behind by deleted clusters, such as
+MachineRequests, provider VMs and tenant namespaces.
+
+Commands:
+  run    Notify owners of expiring clusters and destroy expired ones
+  leaks  Find resources left behind by deleted clusters
+
+Examples:
+  # Preview what would be destroyed
+  butleradm gc run --dry-run
+
+  # Run hourly from cron, posting notifications to a chat webhook
+  butleradm gc run --webhook https://hooks.slack.com/services/...
+
+  # Report, then remove, leaked resources
+  butleradm gc leaks
+  butleradm gc leaks --delete`,
+	}
+
+	cmd.AddCommand(newRunCmd(logger))
+	cmd.AddCommand(newLeaksCmd(logger))
+
+	return cmd
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
@@ -0,0 +1,456 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/access"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/credstore"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	kindMachineRequest = "MachineRequest"
+	kindVirtualMachine = "VirtualMachine"
+	kindSecret         = "Secret"
+	kindNamespace      = "Namespace"
+	kindLocalFile      = "LocalFile"
+
+	// butlerLabelPrefix marks provider VMs created for MachineRequests
+	butlerLabelPrefix = "butler.butlerlabs.dev/"
+
+	adminKubeconfigSuffix = "-admin-kubeconfig"
+)
+
+// virtualMachineGVR is the KubeVirt VM resource Harvester machines run as
+var virtualMachineGVR = schema.GroupVersionResource{
+	Group:    "kubevirt.io",
+	Version:  "v1",
+	Resource: "virtualmachines",
+}
+
+type leaksOptions struct {
+	kubeconfig   string
+	outputFormat string
+	maxAge       time.Duration
+	delete       bool
+}
+
+// Leak is a resource left behind by a cluster that no longer exists
+type Leak struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Reason    string `json:"reason"`
+}
+
+func (l *Leak) String() string {
+	if l.Namespace == "" {
+		return l.Kind + " " + l.Name
+	}
+	return l.Kind + " " + l.Namespace + "/" + l.Name
+}
+
+func newLeaksCmd(logger *log.Logger) *cobra.Command {
+	opts := &leaksOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "leaks",
+		Short: "Find resources left behind by deleted clusters",
+		Long: `Find resources left behind by deleted clusters.
+
+Reports, and with --delete removes:
+  - MachineRequests whose parent cluster no longer exists
+  - Harvester VMs labelled by Butler whose MachineRequest is gone
+  - <name>-admin-kubeconfig Secrets for TenantClusters that no longer exist
+  - Tenant namespaces no TenantCluster refers to any more
+  - ~/.butler kubeconfigs and talosconfigs for unknown clusters, older
+    than --max-age
+
+Nothing is deleted without --delete. Nutanix and Proxmox VMs are not
+listed; check those providers' consoles for VMs without a MachineRequest.
+
+Examples:
+  # Report leaked resources
+  butleradm gc leaks
+
+  # Clean them up
+  butleradm gc leaks --delete
+
+  # JSON for review or automation
+  butleradm gc leaks -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLeaks(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.Flags().DurationVar(&opts.maxAge, "max-age", 7*24*time.Hour, "only report local credentials unused for this long")
+	cmd.Flags().BoolVar(&opts.delete, "delete", false, "delete the leaked resources")
+
+	return cmd
+}
+
+func runLeaks(ctx context.Context, logger *log.Logger, opts *leaksOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := loadClusters(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	var leaks []Leak
+	mrLeaks, machineNames, err := findMachineRequestLeaks(ctx, c, clusters)
+	if err != nil {
+		return err
+	}
+	leaks = append(leaks, mrLeaks...)
+
+	vmLeaks, err := findVMLeaks(ctx, c, logger, machineNames)
+	if err != nil {
+		return err
+	}
+	leaks = append(leaks, vmLeaks...)
+
+	nsLeaks, err := findTenantNamespaceLeaks(ctx, c, clusters)
+	if err != nil {
+		return err
+	}
+	leaks = append(leaks, nsLeaks...)
+
+	fileLeaks, err := findLocalCredentialLeaks(clusters, opts.maxAge)
+	if err != nil {
+		return err
+	}
+	leaks = append(leaks, fileLeaks...)
+
+	if leaks == nil {
+		leaks = []Leak{}
+	}
+
+	if opts.delete {
+		return deleteLeaks(ctx, c, logger, leaks)
+	}
+
+	if format == output.FormatTable && len(leaks) == 0 {
+		logger.Success("no leaked resources found")
+		return nil
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(leaks, func(w io.Writer) error {
+		table := output.NewTable(w, "KIND", "NAMESPACE", "NAME", "REASON")
+		for _, l := range leaks {
+			table.AddRow(l.Kind, orDash(l.Namespace), l.Name, l.Reason)
+		}
+		if err := table.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "\nRun with --delete to remove %d leaked resource(s).\n", len(leaks))
+		return nil
+	})
+}
+
+// clusterIndex holds the clusters that can own platform resources
+type clusterIndex struct {
+	// names maps "namespace/name" of TenantClusters, ClusterBootstraps and
+	// CAPI Clusters
+	names map[string]bool
+	// clusterNames holds bare TenantCluster and ClusterBootstrap names
+	clusterNames map[string]bool
+	uids         map[string]bool
+	// tenantNamespaces maps a tenant namespace to its TenantCluster's name
+	tenantNamespaces map[string]string
+	// homeNamespaces hold TenantClusters or ClusterBootstraps themselves
+	homeNamespaces map[string]bool
+}
+
+func loadClusters(ctx context.Context, c *client.Client) (*clusterIndex, error) {
+	idx := &clusterIndex{
+		names:            map[string]bool{},
+		clusterNames:     map[string]bool{},
+		uids:             map[string]bool{},
+		tenantNamespaces: map[string]string{},
+		homeNamespaces:   map[string]bool{},
+	}
+
+	for _, gvr := range []schema.GroupVersionResource{client.TenantClusterGVR, client.ClusterBootstrapGVR, client.ClusterGVR} {
+		list, err := c.Dynamic.Resource(gvr).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			// CAPI is only installed once tenant clusters are supported
+			if gvr == client.ClusterGVR && apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("listing %s: %w", gvr.Resource, err)
+		}
+		for _, item := range list.Items {
+			idx.names[item.GetNamespace()+"/"+item.GetName()] = true
+			idx.uids[string(item.GetUID())] = true
+			if gvr == client.ClusterGVR {
+				continue
+			}
+			idx.clusterNames[item.GetName()] = true
+			idx.homeNamespaces[item.GetNamespace()] = true
+			if tenantNS, _, _ := unstructured.NestedString(item.Object, "status", "tenantNamespace"); tenantNS != "" {
+				idx.tenantNamespaces[tenantNS] = item.GetName()
+			}
+		}
+	}
+
+	return idx, nil
+}
+
+// findMachineRequestLeaks reports MachineRequests whose owner or cluster
+// label points at a cluster that is gone. It also returns the machine names
+// of all MachineRequests for matching provider VMs.
+func findMachineRequestLeaks(ctx context.Context, c *client.Client, clusters *clusterIndex) ([]Leak, map[string]bool, error) {
+	list, err := c.Dynamic.Resource(client.MachineRequestGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing MachineRequests: %w", err)
+	}
+
+	var leaks []Leak
+	machineNames := map[string]bool{}
+	for _, mr := range list.Items {
+		if name, _, _ := unstructured.NestedString(mr.Object, "spec", "machineName"); name != "" {
+			machineNames[name] = true
+		}
+		if mr.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		reason := ""
+		for _, ref := range mr.GetOwnerReferences() {
+			switch ref.Kind {
+			case "TenantCluster", "ClusterBootstrap", "Cluster":
+				if !clusters.uids[string(ref.UID)] {
+					reason = fmt.Sprintf("owner %s %s not found", ref.Kind, ref.Name)
+				}
+			}
+		}
+		if cluster := mr.GetLabels()[access.ClusterLabel]; reason == "" && cluster != "" {
+			if !clusters.names[mr.GetNamespace()+"/"+cluster] && !clusters.clusterNames[cluster] {
+				reason = fmt.Sprintf("cluster %s not found", cluster)
+			}
+		}
+		if reason != "" {
+			leaks = append(leaks, Leak{Kind: kindMachineRequest, Namespace: mr.GetNamespace(), Name: mr.GetName(), Reason: reason})
+		}
+	}
+	return leaks, machineNames, nil
+}
+
+// findVMLeaks reports Harvester VMs created by Butler without a matching
+// MachineRequest. Other providers have no in-cluster API to list VMs.
+func findVMLeaks(ctx context.Context, c *client.Client, logger *log.Logger, machineNames map[string]bool) ([]Leak, error) {
+	providers, err := c.Dynamic.Resource(client.ProviderConfigGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing ProviderConfigs: %w", err)
+	}
+	harvester := false
+	for _, pc := range providers.Items {
+		provider, _, _ := unstructured.NestedString(pc.Object, "spec", "provider")
+		if provider == "harvester" {
+			harvester = true
+			continue
+		}
+		logger.Info("provider VMs not checked", "providerConfig", pc.GetName(), "provider", provider)
+	}
+	if !harvester {
+		return nil, nil
+	}
+
+	if _, err := c.Clientset.Discovery().ServerResourcesForGroupVersion("kubevirt.io/v1"); err != nil {
+		logger.Warn("Harvester VMs not checked: KubeVirt API not available", "error", err)
+		return nil, nil
+	}
+
+	list, err := c.Dynamic.Resource(virtualMachineGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing VirtualMachines: %w", err)
+	}
+
+	var leaks []Leak
+	for _, vm := range list.Items {
+		if !hasButlerLabel(vm.GetLabels()) || machineNames[vm.GetName()] || vm.GetDeletionTimestamp() != nil {
+			continue
+		}
+		leaks = append(leaks, Leak{Kind: kindVirtualMachine, Namespace: vm.GetNamespace(), Name: vm.GetName(), Reason: "MachineRequest not found"})
+	}
+	return leaks, nil
+}
+
+func hasButlerLabel(labels map[string]string) bool {
+	for k := range labels {
+		if strings.HasPrefix(k, butlerLabelPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// findTenantNamespaceLeaks reports admin kubeconfig Secrets of deleted
+// TenantClusters and the tenant namespaces they were left in
+func findTenantNamespaceLeaks(ctx context.Context, c *client.Client, clusters *clusterIndex) ([]Leak, error) {
+	secrets, err := c.Clientset.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing secrets: %w", err)
+	}
+
+	var leaks []Leak
+	staleNamespaces := map[string]bool{}
+	for _, s := range secrets.Items {
+		if !strings.HasSuffix(s.Name, adminKubeconfigSuffix) || s.DeletionTimestamp != nil {
+			continue
+		}
+		cluster := strings.TrimSuffix(s.Name, adminKubeconfigSuffix)
+		owner, claimed := clusters.tenantNamespaces[s.Namespace]
+		if claimed && owner == cluster {
+			continue
+		}
+		leaks = append(leaks, Leak{Kind: kindSecret, Namespace: s.Namespace, Name: s.Name, Reason: fmt.Sprintf("TenantCluster %s not found", cluster)})
+		if !claimed && !clusters.homeNamespaces[s.Namespace] {
+			staleNamespaces[s.Namespace] = true
+		}
+	}
+
+	// Namespaces still holding CAPI Clusters are in use even without a
+	// TenantCluster, e.g. while Steward finishes tearing one down
+	for key := range clusters.names {
+		ns, _, _ := strings.Cut(key, "/")
+		delete(staleNamespaces, ns)
+	}
+
+	names := make([]string, 0, len(staleNamespaces))
+	for ns := range staleNamespaces {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+	for _, ns := range names {
+		leaks = append(leaks, Leak{Kind: kindNamespace, Name: ns, Reason: "no TenantCluster uses this tenant namespace"})
+	}
+	return leaks, nil
+}
+
+// findLocalCredentialLeaks reports ~/.butler kubeconfigs and talosconfigs
+// for clusters that no longer exist
+func findLocalCredentialLeaks(clusters *clusterIndex, maxAge time.Duration) ([]Leak, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("getting home directory: %w", err)
+	}
+	butlerDir := filepath.Join(home, ".butler")
+
+	entries, err := os.ReadDir(butlerDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", butlerDir, err)
+	}
+
+	var leaks []Leak
+	cutoff := time.Now().Add(-maxAge)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		base := strings.TrimSuffix(name, credstore.SealedExt)
+		cluster := strings.TrimSuffix(strings.TrimSuffix(base, "-kubeconfig"), "-talosconfig")
+		if cluster == base || clusters.clusterNames[cluster] {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		leaks = append(leaks, Leak{
+			Kind:   kindLocalFile,
+			Name:   filepath.Join(butlerDir, name),
+			Reason: fmt.Sprintf("cluster %s not found, unchanged for %s", cluster, output.FormatAge(info.ModTime())),
+		})
+	}
+	return leaks, nil
+}
+
+func deleteLeaks(ctx context.Context, c *client.Client, logger *log.Logger, leaks []Leak) error {
+	var failures []string
+	deleted := 0
+	for i := range leaks {
+		l := &leaks[i]
+		if err := deleteLeak(ctx, c, l); err != nil && !apierrors.IsNotFound(err) {
+			failures = append(failures, fmt.Sprintf("%s: %v", l, err))
+			continue
+		}
+		logger.Success("deleted", "resource", l.String())
+		deleted++
+	}
+
+	logger.Info("gc complete", "deleted", deleted)
+	if len(failures) > 0 {
+		return fmt.Errorf("gc finished with %d error(s):\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+func deleteLeak(ctx context.Context, c *client.Client, l *Leak) error {
+	switch l.Kind {
+	case kindMachineRequest:
+		return c.Dynamic.Resource(client.MachineRequestGVR).Namespace(l.Namespace).Delete(ctx, l.Name, metav1.DeleteOptions{})
+	case kindVirtualMachine:
+		return c.Dynamic.Resource(virtualMachineGVR).Namespace(l.Namespace).Delete(ctx, l.Name, metav1.DeleteOptions{})
+	case kindSecret:
+		return c.Clientset.CoreV1().Secrets(l.Namespace).Delete(ctx, l.Name, metav1.DeleteOptions{})
+	case kindNamespace:
+		return c.Clientset.CoreV1().Namespaces().Delete(ctx, l.Name, metav1.DeleteOptions{})
+	case kindLocalFile:
+		return os.Remove(l.Name)
+	}
+	return fmt.Errorf("unknown kind %s", l.Kind)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,497 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package info implements the butleradm info command.
+package info
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/adm/inventory"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	crdGVR = schema.GroupVersionResource{
+		Group:    "apiextensions.k8s.io",
+		Version:  "v1",
+		Resource: "customresourcedefinitions",
+	}
+	ipAddressPoolGVR = schema.GroupVersionResource{
+		Group:    "metallb.io",
+		Version:  "v1beta1",
+		Resource: "ipaddresspools",
+	}
+)
+
+// crdGroups are the API groups whose CRDs are reported
+var crdGroups = []string{client.ButlerAPIGroup, "cluster.x-k8s.io", "infrastructure.cluster.x-k8s.io", "controlplane.cluster.x-k8s.io", "bootstrap.cluster.x-k8s.io"}
+
+// Info describes a management cluster
+type Info struct {
+	Cluster           string       `json:"cluster"`
+	PlatformVersion   string       `json:"platformVersion,omitempty"`
+	KubernetesVersion string       `json:"kubernetesVersion"`
+	TalosVersion      string       `json:"talosVersion,omitempty"`
+	ConsoleURL        string       `json:"consoleURL,omitempty"`
+	Network           Network      `json:"network"`
+	Providers         []Provider   `json:"providers"`
+	CRDs              []CRD        `json:"crds"`
+	Controllers       []Controller `json:"controllers"`
+	Nodes             []Node       `json:"nodes"`
+
+	// Bootstrap records the butleradm build and config that created the
+	// cluster
+	Bootstrap *platform.BootstrapInfo `json:"bootstrap,omitempty"`
+}
+
+// Network is the management cluster's addressing
+type Network struct {
+	VIP         string   `json:"vip,omitempty"`
+	PodCIDR     string   `json:"podCIDR,omitempty"`
+	ServiceCIDR string   `json:"serviceCIDR,omitempty"`
+	LBPools     []string `json:"lbPools,omitempty"`
+}
+
+// Provider is a configured infrastructure provider
+type Provider struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Endpoint  string `json:"endpoint,omitempty"`
+	Validated bool   `json:"validated"`
+}
+
+// CRD is an installed CustomResourceDefinition
+type CRD struct {
+	Name    string   `json:"name"`
+	Served  []string `json:"served"`
+	Storage string   `json:"storage"`
+}
+
+// Controller is a platform controller and the image it runs
+type Controller struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Image     string `json:"image"`
+	Version   string `json:"version,omitempty"`
+}
+
+// Node is a management cluster node
+type Node struct {
+	Name           string `json:"name"`
+	Roles          string `json:"roles"`
+	Ready          bool   `json:"ready"`
+	InternalIP     string `json:"internalIP,omitempty"`
+	KubeletVersion string `json:"kubeletVersion"`
+	OSImage        string `json:"osImage"`
+	CPU            string `json:"cpu"`
+	Memory         string `json:"memory"`
+}
+
+type infoOptions struct {
+	kubeconfig   string
+	outputFormat string
+}
+
+// NewInfoCmd creates the info command
+func NewInfoCmd(logger *log.Logger) *cobra.Command {
+	opts := &infoOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Show management cluster details for support",
+		Long: `Show everything about the management cluster in one place.
+
+Prints the platform and Kubernetes versions, Talos version, console URL,
+networking (VIP, CIDRs, LoadBalancer pools), configured providers,
+installed Butler and CAPI CRD versions, controller images and the node
+inventory. Sections that can't be read are skipped with a warning. The
+json and yaml output also carry the redacted bootstrap config the cluster
+was created with.
+
+Attach the output to support requests.
+
+Examples:
+  # Summary for the current management cluster
+  butleradm info
+
+  # Full details for a support ticket
+  butleradm info -o yaml > butler-info.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInfo(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+func runInfo(ctx context.Context, logger *log.Logger, opts *infoOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	info, err := Collect(ctx, c, logger)
+	if err != nil {
+		return err
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(info, func(w io.Writer) error {
+		return printInfo(w, info)
+	})
+}
+
+// Collect gathers Info from the management cluster. Only an unreachable
+// API server is an error; other sections are logged and left empty.
+func Collect(ctx context.Context, c *client.Client, logger *log.Logger) (*Info, error) {
+	serverVersion, err := c.Clientset.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("getting server version: %w", err)
+	}
+
+	info := &Info{
+		KubernetesVersion: serverVersion.GitVersion,
+		Providers:         []Provider{},
+		CRDs:              []CRD{},
+		Controllers:       []Controller{},
+		Nodes:             []Node{},
+	}
+
+	if err := collectBootstrap(ctx, c, info); err != nil {
+		logger.Warn("skipping bootstrap settings", "error", err)
+	}
+	if info.Bootstrap, err = platform.LoadBootstrapInfo(ctx, c); err != nil {
+		logger.Warn("skipping bootstrap info", "error", err)
+	}
+	if err := collectLBPools(ctx, c, info); err != nil {
+		logger.Debug("skipping MetalLB pools", "error", err)
+	}
+	if err := collectProviders(ctx, c, info); err != nil {
+		logger.Warn("skipping providers", "error", err)
+	}
+	if err := collectCRDs(ctx, c, info); err != nil {
+		logger.Warn("skipping CRDs", "error", err)
+	}
+	if err := collectControllers(ctx, c, info); err != nil {
+		logger.Warn("skipping controllers", "error", err)
+	}
+	if err := collectNodes(ctx, c, info); err != nil {
+		logger.Warn("skipping nodes", "error", err)
+	}
+
+	return info, nil
+}
+
+// collectBootstrap reads the cluster name, network, Talos version and
+// console URL recorded on the ClusterBootstrap
+func collectBootstrap(ctx context.Context, c *client.Client, info *Info) error {
+	list, err := c.Dynamic.Resource(client.ClusterBootstrapGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing ClusterBootstraps: %w", err)
+	}
+	if len(list.Items) == 0 {
+		return nil
+	}
+
+	cb := list.Items[0].Object
+	info.Cluster = list.Items[0].GetName()
+	info.ConsoleURL, _, _ = unstructured.NestedString(cb, "status", "consoleURL")
+	info.Network.VIP, _, _ = unstructured.NestedString(cb, "spec", "network", "vip")
+	info.Network.PodCIDR, _, _ = unstructured.NestedString(cb, "spec", "network", "podCIDR")
+	info.Network.ServiceCIDR, _, _ = unstructured.NestedString(cb, "spec", "network", "serviceCIDR")
+	info.TalosVersion, _, _ = unstructured.NestedString(cb, "spec", "talos", "version")
+	if pool, _, _ := unstructured.NestedString(cb, "spec", "addons", "loadBalancer", "addressPool"); pool != "" {
+		info.Network.LBPools = append(info.Network.LBPools, pool)
+	}
+	return nil
+}
+
+// collectLBPools replaces the bootstrap address pool with the live MetalLB
+// pools when MetalLB is installed
+func collectLBPools(ctx context.Context, c *client.Client, info *Info) error {
+	list, err := c.Dynamic.Resource(ipAddressPoolGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing IPAddressPools: %w", err)
+	}
+
+	var pools []string
+	for _, pool := range list.Items {
+		addresses, _, _ := unstructured.NestedStringSlice(pool.Object, "spec", "addresses")
+		for _, a := range addresses {
+			pools = append(pools, fmt.Sprintf("%s (%s)", a, pool.GetName()))
+		}
+	}
+	if len(pools) > 0 {
+		info.Network.LBPools = pools
+	}
+	return nil
+}
+
+func collectProviders(ctx context.Context, c *client.Client, info *Info) error {
+	list, err := c.Dynamic.Resource(client.ProviderConfigGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing ProviderConfigs: %w", err)
+	}
+
+	for _, pc := range list.Items {
+		provider, _, _ := unstructured.NestedString(pc.Object, "spec", "provider")
+		endpoint, _, _ := unstructured.NestedString(pc.Object, "spec", provider, "endpoint")
+		validated, _, _ := unstructured.NestedBool(pc.Object, "status", "validated")
+		info.Providers = append(info.Providers, Provider{
+			Name:      pc.GetName(),
+			Type:      provider,
+			Endpoint:  endpoint,
+			Validated: validated,
+		})
+	}
+	return nil
+}
+
+func collectCRDs(ctx context.Context, c *client.Client, info *Info) error {
+	list, err := c.Dynamic.Resource(crdGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing CRDs: %w", err)
+	}
+
+	for _, crd := range list.Items {
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		if !reportedGroup(group) {
+			continue
+		}
+
+		entry := CRD{Name: crd.GetName(), Served: []string{}}
+		versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+		for _, v := range versions {
+			version, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := version["name"].(string)
+			if served, _ := version["served"].(bool); served {
+				entry.Served = append(entry.Served, name)
+			}
+			if storage, _ := version["storage"].(bool); storage {
+				entry.Storage = name
+			}
+		}
+		info.CRDs = append(info.CRDs, entry)
+	}
+
+	sort.Slice(info.CRDs, func(i, j int) bool { return info.CRDs[i].Name < info.CRDs[j].Name })
+	return nil
+}
+
+func reportedGroup(group string) bool {
+	for _, g := range crdGroups {
+		if group == g {
+			return true
+		}
+	}
+	return false
+}
+
+// collectControllers reports the Butler, Steward and CAPI controller images
+// and takes the platform version from butler-controller
+func collectControllers(ctx context.Context, c *client.Client, info *Info) error {
+	components, err := inventory.Collect(ctx, c, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, comp := range components {
+		if comp.Kind != "Deployment" || !controllerNamespace(comp.Namespace) {
+			continue
+		}
+		info.Controllers = append(info.Controllers, Controller{
+			Namespace: comp.Namespace,
+			Name:      comp.Name,
+			Image:     comp.Image,
+			Version:   comp.Version,
+		})
+		if comp.Namespace == "butler-system" && comp.Name == "butler-controller" {
+			info.PlatformVersion = comp.Version
+		}
+	}
+	return nil
+}
+
+func controllerNamespace(namespace string) bool {
+	return namespace == "butler-system" || namespace == "steward-system" || strings.HasPrefix(namespace, "cap")
+}
+
+func collectNodes(ctx context.Context, c *client.Client, info *Info) error {
+	list, err := c.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing nodes: %w", err)
+	}
+
+	for _, n := range list.Items {
+		node := Node{
+			Name:           n.Name,
+			Roles:          nodeRoles(n.Labels),
+			KubeletVersion: n.Status.NodeInfo.KubeletVersion,
+			OSImage:        n.Status.NodeInfo.OSImage,
+			CPU:            n.Status.Capacity.Cpu().String(),
+			Memory:         n.Status.Capacity.Memory().String(),
+		}
+		for _, cond := range n.Status.Conditions {
+			if cond.Type == corev1.NodeReady {
+				node.Ready = cond.Status == corev1.ConditionTrue
+			}
+		}
+		for _, addr := range n.Status.Addresses {
+			if addr.Type == corev1.NodeInternalIP {
+				node.InternalIP = addr.Address
+				break
+			}
+		}
+		info.Nodes = append(info.Nodes, node)
+
+		// Talos reports its version in the OS image, e.g. "Talos (v1.7.5)"
+		if v, ok := talosVersion(node.OSImage); ok {
+			info.TalosVersion = v
+		}
+	}
+
+	sort.Slice(info.Nodes, func(i, j int) bool { return info.Nodes[i].Name < info.Nodes[j].Name })
+	return nil
+}
+
+func nodeRoles(labels map[string]string) string {
+	var roles []string
+	for key := range labels {
+		if role, ok := strings.CutPrefix(key, "node-role.kubernetes.io/"); ok && role != "" {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		return "worker"
+	}
+	sort.Strings(roles)
+	return strings.Join(roles, ",")
+}
+
+func talosVersion(osImage string) (string, bool) {
+	if !strings.HasPrefix(osImage, "Talos") {
+		return "", false
+	}
+	_, rest, ok := strings.Cut(osImage, "(")
+	if !ok {
+		return "", false
+	}
+	version, _, _ := strings.Cut(rest, ")")
+	return version, version != ""
+}
+
+func printInfo(w io.Writer, info *Info) error {
+	fmt.Fprintln(w, output.Bold("Management Cluster"))
+	fmt.Fprintf(w, "  %-20s %s\n", "Name:", orDash(info.Cluster))
+	fmt.Fprintf(w, "  %-20s %s\n", "Platform version:", orDash(info.PlatformVersion))
+	fmt.Fprintf(w, "  %-20s %s\n", "Kubernetes version:", info.KubernetesVersion)
+	fmt.Fprintf(w, "  %-20s %s\n", "Talos version:", orDash(info.TalosVersion))
+	fmt.Fprintf(w, "  %-20s %s\n", "Console:", orDash(info.ConsoleURL))
+	if b := info.Bootstrap; b != nil {
+		fmt.Fprintf(w, "  %-20s butleradm %s (%s) at %s\n", "Bootstrapped by:", b.CLIVersion, orDash(b.GitCommit), orDash(b.BootstrappedAt))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, output.Bold("Networking"))
+	fmt.Fprintf(w, "  %-20s %s\n", "VIP:", orDash(info.Network.VIP))
+	fmt.Fprintf(w, "  %-20s %s\n", "Pod CIDR:", orDash(info.Network.PodCIDR))
+	fmt.Fprintf(w, "  %-20s %s\n", "Service CIDR:", orDash(info.Network.ServiceCIDR))
+	fmt.Fprintf(w, "  %-20s %s\n", "LB pools:", orDash(strings.Join(info.Network.LBPools, ", ")))
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, output.Bold("Providers"))
+	if len(info.Providers) == 0 {
+		fmt.Fprintln(w, "  none")
+	} else {
+		table := output.NewTable(w, "NAME", "TYPE", "ENDPOINT", "VALIDATED")
+		for _, p := range info.Providers {
+			table.AddRow(p.Name, p.Type, orDash(p.Endpoint), fmt.Sprintf("%t", p.Validated))
+		}
+		if err := table.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, output.Bold("CRDs"))
+	table := output.NewTable(w, "NAME", "SERVED", "STORAGE")
+	for _, crd := range info.CRDs {
+		table.AddRow(crd.Name, strings.Join(crd.Served, ","), orDash(crd.Storage))
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, output.Bold("Controllers"))
+	table = output.NewTable(w, "NAMESPACE", "NAME", "VERSION", "IMAGE")
+	for _, ctrl := range info.Controllers {
+		table.AddRow(ctrl.Namespace, ctrl.Name, orDash(ctrl.Version), ctrl.Image)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, output.Bold("Nodes"))
+	table = output.NewTable(w, "NAME", "ROLES", "STATUS", "IP", "VERSION", "OS", "CPU", "MEMORY")
+	for _, n := range info.Nodes {
+		status := output.Success("Ready")
+		if !n.Ready {
+			status = output.Danger("NotReady")
+		}
+		table.AddRow(n.Name, n.Roles, status, orDash(n.InternalIP), n.KubeletVersion, n.OSImage, n.CPU, n.Memory)
+	}
+	return table.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inventory implements the butleradm inventory command.
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// platformNamespaces always belong to the platform; any other namespace
+// ending in "-system" is included as well
+var platformNamespaces = []string{
+	"butler-system",
+	"cert-manager",
+	"flux-system",
+	"kube-system",
+}
+
+// Component is one container image deployed by a platform workload
+type Component struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	Kind         string `json:"kind"`
+	Container    string `json:"container"`
+	Image        string `json:"image"`
+	Version      string `json:"version,omitempty"`
+	Digest       string `json:"digest,omitempty"`
+	Chart        string `json:"chart,omitempty"`
+	ChartVersion string `json:"chartVersion,omitempty"`
+	Release      string `json:"release,omitempty"`
+	Source       string `json:"source,omitempty"`
+}
+
+type inventoryOptions struct {
+	kubeconfig   string
+	namespaces   []string
+	component    string
+	outputFormat string
+}
+
+// NewInventoryCmd creates the inventory command
+func NewInventoryCmd(logger *log.Logger) *cobra.Command {
+	opts := &inventoryOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "inventory",
+		Short: "List deployed platform components and their versions",
+		Long: `List every deployed platform component with its image digest, Helm chart
+version and source repository.
+
+Components are the Deployments, DaemonSets and StatefulSets in platform
+namespaces (butler-system, kube-system, cert-manager, flux-system and any
+namespace ending in -system). Digests are taken from running pods, so they
+reflect what is actually running rather than what was requested.
+
+Output formats:
+  table      Component summary (default)
+  wide       Adds container, digest and source columns
+  json/yaml  Structured inventory
+  spdx       SPDX 2.3 JSON SBOM
+  cyclonedx  CycloneDX 1.5 JSON SBOM
+
+Examples:
+  # What is running on the platform
+  butleradm inventory
+
+  # Are we running the vulnerable MetalLB?
+  butleradm inventory --component metallb -o wide
+
+  # SBOM for the security team
+  butleradm inventory -o cyclonedx > platform.cdx.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInventory(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringSliceVarP(&opts.namespaces, "namespace", "n", nil, "namespaces to inventory (default: platform namespaces)")
+	cmd.Flags().StringVar(&opts.component, "component", "", "only components whose name or image contains this string")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, wide, json, yaml, spdx, cyclonedx)")
+
+	return cmd
+}
+
+func runInventory(ctx context.Context, logger *log.Logger, opts *inventoryOptions) error {
+	sbomFormat := ""
+	var format output.Format
+	switch opts.outputFormat {
+	case "spdx", "cyclonedx":
+		sbomFormat = opts.outputFormat
+	default:
+		var err error
+		if format, err = output.ParseFormat(opts.outputFormat); err != nil {
+			return fmt.Errorf("%w (or spdx, cyclonedx)", err)
+		}
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	components, err := Collect(ctx, c, opts.namespaces)
+	if err != nil {
+		return err
+	}
+
+	if opts.component != "" {
+		filtered := components[:0]
+		for _, comp := range components {
+			if strings.Contains(comp.Name, opts.component) || strings.Contains(comp.Image, opts.component) {
+				filtered = append(filtered, comp)
+			}
+		}
+		components = filtered
+	}
+
+	switch sbomFormat {
+	case "spdx":
+		return output.PrintJSON(os.Stdout, toSPDX(components))
+	case "cyclonedx":
+		return output.PrintJSON(os.Stdout, toCycloneDX(components))
+	}
+
+	if format == output.FormatJSON || format == output.FormatYAML {
+		return output.NewPrinter(format, os.Stdout).Print(components, nil)
+	}
+
+	if len(components) == 0 {
+		logger.Info("no platform components found")
+		return nil
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(components, func(w io.Writer) error {
+		return printTable(w, components, format == output.FormatWide)
+	})
+}
+
+func printTable(w io.Writer, components []Component, wide bool) error {
+	headers := []string{"NAMESPACE", "COMPONENT", "KIND", "VERSION", "CHART"}
+	if wide {
+		headers = append(headers, "CONTAINER", "DIGEST", "SOURCE")
+	}
+	table := output.NewTable(w, headers...)
+
+	for _, comp := range components {
+		chart := "-"
+		if comp.Chart != "" {
+			chart = comp.Chart + "-" + comp.ChartVersion
+		}
+		row := []string{comp.Namespace, comp.Name, comp.Kind, orDash(comp.Version), chart}
+		if wide {
+			row = append(row, comp.Container, orDash(shortDigest(comp.Digest)), orDash(comp.Source))
+		}
+		table.AddRow(row...)
+	}
+	return table.Flush()
+}
+
+// Collect returns the components deployed in namespaces, or in the platform
+// namespaces when none are given
+func Collect(ctx context.Context, c *client.Client, namespaces []string) ([]Component, error) {
+	if len(namespaces) == 0 {
+		var err error
+		if namespaces, err = discoverNamespaces(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+
+	var components []Component
+	for _, ns := range namespaces {
+		found, err := collectNamespace(ctx, c, ns)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, found...)
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		if components[i].Namespace != components[j].Namespace {
+			return components[i].Namespace < components[j].Namespace
+		}
+		if components[i].Name != components[j].Name {
+			return components[i].Name < components[j].Name
+		}
+		return components[i].Container < components[j].Container
+	})
+	return components, nil
+}
+
+func discoverNamespaces(ctx context.Context, c *client.Client) ([]string, error) {
+	list, err := c.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing namespaces: %w", err)
+	}
+
+	var namespaces []string
+	for _, ns := range list.Items {
+		name := ns.Name
+		if strings.HasSuffix(name, "-system") {
+			namespaces = append(namespaces, name)
+			continue
+		}
+		for _, p := range platformNamespaces {
+			if name == p {
+				namespaces = append(namespaces, name)
+				break
+			}
+		}
+	}
+	return namespaces, nil
+}
+
+// workload is the subset of a Deployment, DaemonSet or StatefulSet used here
+type workload struct {
+	kind     string
+	meta     metav1.ObjectMeta
+	selector *metav1.LabelSelector
+	template corev1.PodTemplateSpec
+}
+
+func collectNamespace(ctx context.Context, c *client.Client, namespace string) ([]Component, error) {
+	apps := c.Clientset.AppsV1()
+	var workloads []workload
+
+	deployments, err := apps.Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing deployments in %s: %w", namespace, err)
+	}
+	for _, d := range deployments.Items {
+		workloads = append(workloads, workload{"Deployment", d.ObjectMeta, d.Spec.Selector, d.Spec.Template})
+	}
+
+	daemonSets, err := apps.DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing daemonsets in %s: %w", namespace, err)
+	}
+	for _, d := range daemonSets.Items {
+		workloads = append(workloads, workload{"DaemonSet", d.ObjectMeta, d.Spec.Selector, d.Spec.Template})
+	}
+
+	statefulSets, err := apps.StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing statefulsets in %s: %w", namespace, err)
+	}
+	for _, s := range statefulSets.Items {
+		workloads = append(workloads, workload{"StatefulSet", s.ObjectMeta, s.Spec.Selector, s.Spec.Template})
+	}
+
+	var components []Component
+	for _, w := range workloads {
+		digests := runningDigests(ctx, c, namespace, w.selector)
+		chart, chartVersion := splitChart(w.meta.Labels["helm.sh/chart"])
+		release := ""
+		if chart != "" {
+			release = w.meta.Labels["app.kubernetes.io/instance"]
+		}
+
+		for _, ctr := range w.template.Spec.Containers {
+			components = append(components, Component{
+				Name:         w.meta.Name,
+				Namespace:    namespace,
+				Kind:         w.kind,
+				Container:    ctr.Name,
+				Image:        ctr.Image,
+				Version:      imageVersion(ctr.Image, w.meta.Labels["app.kubernetes.io/version"]),
+				Digest:       digests[ctr.Name],
+				Chart:        chart,
+				ChartVersion: chartVersion,
+				Release:      release,
+				Source:       sourceRepo(ctr.Image, w.template.Annotations),
+			})
+		}
+	}
+	return components, nil
+}
+
+// runningDigests maps container names to the image digest reported by a running pod
+func runningDigests(ctx context.Context, c *client.Client, namespace string, selector *metav1.LabelSelector) map[string]string {
+	digests := map[string]string{}
+	if selector == nil {
+		return digests
+	}
+	sel, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return digests
+	}
+
+	pods, err := c.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return digests
+	}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if _, ok := digests[status.Name]; ok {
+				continue
+			}
+			if i := strings.Index(status.ImageID, "sha256:"); i >= 0 {
+				digests[status.Name] = status.ImageID[i:]
+			}
+		}
+	}
+	return digests
+}
+
+// splitChart splits a helm.sh/chart label such as "metallb-0.14.5"
+func splitChart(label string) (string, string) {
+	i := strings.LastIndex(label, "-")
+	for i > 0 {
+		if next := label[i+1:]; next != "" && (next[0] >= '0' && next[0] <= '9' || next[0] == 'v') {
+			return label[:i], label[i+1:]
+		}
+		i = strings.LastIndex(label[:i], "-")
+	}
+	return label, ""
+}
+
+// Repository returns the image reference without tag or digest
+func (c Component) Repository() string {
+	repo, _ := splitImage(c.Image)
+	return repo
+}
+
+// splitImage splits an image reference into repository and tag
+func splitImage(image string) (repo, tag string) {
+	ref, _, _ := strings.Cut(image, "@")
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		return ref[:i], ref[i+1:]
+	}
+	return ref, ""
+}
+
+// imageVersion returns the image tag, falling back to the version label
+func imageVersion(image, label string) string {
+	if _, tag := splitImage(image); tag != "" {
+		return tag
+	}
+	return label
+}
+
+// sourceRepo returns the source repository of an image, from the OCI source
+// annotation when present, otherwise derived from well-known registries
+func sourceRepo(image string, annotations map[string]string) string {
+	if src := annotations["org.opencontainers.image.source"]; src != "" {
+		return src
+	}
+
+	repo, _ := splitImage(image)
+	parts := strings.Split(repo, "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	switch parts[0] {
+	case "ghcr.io":
+		return "https://github.com/" + parts[1] + "/" + parts[2]
+	case "quay.io":
+		return "https://quay.io/repository/" + strings.Join(parts[1:], "/")
+	}
+	return ""
+}
+
+func shortDigest(digest string) string {
+	if len(digest) > len("sha256:")+12 {
+		return digest[:len("sha256:")+12]
+	}
+	return digest
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
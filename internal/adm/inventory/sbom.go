@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const toolName = "butleradm"
+
+// spdxDocument is the subset of SPDX 2.3 JSON produced by inventory
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+	Comment          string            `json:"comment,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func toSPDX(components []Component) *spdxDocument {
+	doc := &spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              "butler-platform",
+		DocumentNamespace: "https://butlerlabs.dev/spdx/butler-platform-" + newUUID(),
+		CreationInfo: spdxCreationInfo{
+			Created:  time.Now().UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + toolName},
+		},
+		Packages:      []spdxPackage{},
+		Relationships: []spdxRelationship{},
+	}
+
+	for i, comp := range components {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i+1)
+		pkg := spdxPackage{
+			Name:             comp.Image,
+			SPDXID:           id,
+			VersionInfo:      comp.Version,
+			DownloadLocation: "NOASSERTION",
+			Comment:          fmt.Sprintf("%s %s/%s container %s", comp.Kind, comp.Namespace, comp.Name, comp.Container),
+		}
+		if comp.Source != "" {
+			pkg.DownloadLocation = comp.Source
+		}
+		if comp.Digest != "" {
+			pkg.Checksums = []spdxChecksum{{
+				Algorithm:     "SHA256",
+				ChecksumValue: strings.TrimPrefix(comp.Digest, "sha256:"),
+			}}
+		}
+		if purl := imagePURL(comp); purl != "" {
+			pkg.ExternalRefs = []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  purl,
+			}}
+		}
+		doc.Packages = append(doc.Packages, pkg)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: id,
+		})
+	}
+	return doc
+}
+
+// cdxBOM is the subset of CycloneDX 1.5 JSON produced by inventory
+type cdxBOM struct {
+	BOMFormat    string         `json:"bomFormat"`
+	SpecVersion  string         `json:"specVersion"`
+	SerialNumber string         `json:"serialNumber"`
+	Version      int            `json:"version"`
+	Metadata     cdxMetadata    `json:"metadata"`
+	Components   []cdxComponent `json:"components"`
+}
+
+type cdxMetadata struct {
+	Timestamp string   `json:"timestamp"`
+	Tools     cdxTools `json:"tools"`
+}
+
+type cdxTools struct {
+	Components []cdxComponent `json:"components"`
+}
+
+type cdxComponent struct {
+	Type               string           `json:"type"`
+	BOMRef             string           `json:"bom-ref,omitempty"`
+	Name               string           `json:"name"`
+	Version            string           `json:"version,omitempty"`
+	PURL               string           `json:"purl,omitempty"`
+	Hashes             []cdxHash        `json:"hashes,omitempty"`
+	ExternalReferences []cdxExternalRef `json:"externalReferences,omitempty"`
+	Properties         []cdxProperty    `json:"properties,omitempty"`
+}
+
+type cdxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cdxExternalRef struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func toCycloneDX(components []Component) *cdxBOM {
+	bom := &cdxBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + newUUID(),
+		Version:      1,
+		Metadata: cdxMetadata{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Tools: cdxTools{Components: []cdxComponent{{
+				Type: "application",
+				Name: toolName,
+			}}},
+		},
+		Components: []cdxComponent{},
+	}
+
+	for _, comp := range components {
+		c := cdxComponent{
+			Type:    "container",
+			BOMRef:  comp.Namespace + "/" + comp.Name + "/" + comp.Container,
+			Name:    comp.Image,
+			Version: comp.Version,
+			PURL:    imagePURL(comp),
+			Properties: []cdxProperty{
+				{Name: "butler:namespace", Value: comp.Namespace},
+				{Name: "butler:workload", Value: comp.Kind + "/" + comp.Name},
+				{Name: "butler:container", Value: comp.Container},
+			},
+		}
+		if comp.Digest != "" {
+			c.Hashes = []cdxHash{{Alg: "SHA-256", Content: strings.TrimPrefix(comp.Digest, "sha256:")}}
+		}
+		if comp.Source != "" {
+			c.ExternalReferences = []cdxExternalRef{{Type: "vcs", URL: comp.Source}}
+		}
+		if comp.Chart != "" {
+			c.Properties = append(c.Properties,
+				cdxProperty{Name: "butler:helm-chart", Value: comp.Chart},
+				cdxProperty{Name: "butler:helm-chart-version", Value: comp.ChartVersion})
+		}
+		bom.Components = append(bom.Components, c)
+	}
+	return bom
+}
+
+// imagePURL returns the package URL of an OCI image
+// (https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#oci)
+func imagePURL(comp Component) string {
+	repo, _ := splitImage(comp.Image)
+	name := repo[strings.LastIndex(repo, "/")+1:]
+
+	purl := "pkg:oci/" + name
+	if comp.Digest != "" {
+		purl += "@" + strings.Replace(comp.Digest, ":", "%3A", 1)
+	}
+	query := url.Values{}
+	query.Set("repository_url", repo)
+	if comp.Version != "" {
+		query.Set("tag", comp.Version)
+	}
+	return purl + "?" + query.Encode()
+}
+
+// newUUID returns a random (version 4) UUID
+func newUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,408 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package maintenance implements butleradm maintenance commands.
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NewMaintenanceCmd creates the maintenance parent command
+func NewMaintenanceCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Schedule rolling maintenance windows for cluster fleets",
+		Long: `Schedule rolling maintenance for fleets of tenant clusters.
+
+A schedule pairs a label selector with a recurring weekly window and the
+work to perform (currently a Kubernetes version upgrade). Schedules are
+stored as labeled ConfigMaps in butler-system so they need no extra CRD.
+
+'maintenance run' performs pending work only while a window is open, so it
+can be driven from cron or a CronJob running butleradm.
+
+Commands:
+  schedule  Create or update a maintenance schedule
+  status    Show schedules, upcoming windows and pending clusters
+  run       Perform pending work for schedules whose window is open
+  delete    Delete a maintenance schedule
+
+Examples:
+  # Upgrade prod clusters on Saturday nights
+  butleradm maintenance schedule prod-upgrades --selector env=prod \
+    --window "Sat 02:00-06:00" --timezone Europe/Berlin --k8s-version v1.31.0
+
+  # Show upcoming work
+  butleradm maintenance status
+
+  # Run from cron every 15 minutes
+  butleradm maintenance run`,
+	}
+
+	cmd.AddCommand(newScheduleCmd(logger))
+	cmd.AddCommand(newStatusCmd(logger))
+	cmd.AddCommand(newRunCmd(logger))
+	cmd.AddCommand(newDeleteCmd(logger))
+
+	return cmd
+}
+
+func newScheduleCmd(logger *log.Logger) *cobra.Command {
+	s := &Schedule{}
+	var kubeconfig string
+
+	cmd := &cobra.Command{
+		Use:   "schedule NAME --selector SELECTOR --window WINDOW",
+		Short: "Create or update a maintenance schedule",
+		Long: `Create or update a maintenance schedule.
+
+Windows are DAYS HH:MM-HH:MM where DAYS is a day (Sat), a list (Sat,Sun),
+a range (Mon-Fri) or "daily". Windows that end before they start wrap past
+midnight.
+
+Examples:
+  butleradm maintenance schedule prod-upgrades --selector env=prod \
+    --window "Sat 02:00-06:00" --k8s-version v1.31.0
+
+  butleradm maintenance schedule dev-nightly --selector env=dev \
+    --window "Mon-Fri 22:00-02:00" --timezone America/New_York --k8s-version v1.31.0`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s.Name = args[0]
+			return runSchedule(cmd.Context(), logger, kubeconfig, s)
+		},
+	}
+
+	cmd.Flags().StringVarP(&s.Selector, "selector", "l", "", "label selector for the clusters (required)")
+	cmd.Flags().StringVar(&s.Window, "window", "", "recurring window, e.g. \"Sat 02:00-06:00\" (required)")
+	cmd.Flags().StringVar(&s.Timezone, "timezone", "UTC", "IANA time zone the window is expressed in")
+	cmd.Flags().StringVar(&s.KubernetesVersion, "k8s-version", "", "Kubernetes version to upgrade to during the window (required)")
+	cmd.Flags().IntVar(&s.Concurrency, "concurrency", 1, "clusters to upgrade at once")
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	_ = cmd.MarkFlagRequired("selector")
+	_ = cmd.MarkFlagRequired("window")
+	_ = cmd.MarkFlagRequired("k8s-version")
+
+	return cmd
+}
+
+func runSchedule(ctx context.Context, logger *log.Logger, kubeconfig string, s *Schedule) error {
+	if _, err := s.ParsedWindow(); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(s.KubernetesVersion, "v") {
+		return fmt.Errorf("kubernetes version must start with 'v', got %q", s.KubernetesVersion)
+	}
+	if s.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+
+	c, err := getClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	if err := saveSchedule(ctx, c, s); err != nil {
+		return err
+	}
+
+	w, _ := s.ParsedWindow()
+	start, _ := w.Next(time.Now())
+	logger.Success("maintenance schedule saved", "name", s.Name, "next", start.Format("Mon 2006-01-02 15:04 MST"))
+	return nil
+}
+
+func newStatusCmd(logger *log.Logger) *cobra.Command {
+	var kubeconfig string
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show schedules, upcoming windows and pending clusters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(cmd.Context(), logger, kubeconfig)
+		},
+	}
+
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to kubeconfig")
+
+	return cmd
+}
+
+func runStatus(ctx context.Context, logger *log.Logger, kubeconfig string) error {
+	c, err := getClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	schedules, err := listSchedules(ctx, c)
+	if err != nil {
+		return err
+	}
+	if len(schedules) == 0 {
+		logger.Info("no maintenance schedules defined")
+		return nil
+	}
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].Name < schedules[j].Name })
+
+	now := time.Now()
+	table := output.NewTable(os.Stdout, "NAME", "SELECTOR", "WINDOW", "NEXT", "TARGET", "PENDING", "LAST RUN")
+	for i := range schedules {
+		s := &schedules[i]
+
+		next := "-"
+		if w, err := s.ParsedWindow(); err != nil {
+			next = output.Danger("invalid window")
+		} else if end, active := w.Active(now); active {
+			next = output.Success("open until " + end.Format("15:04 MST"))
+		} else {
+			start, _ := w.Next(now)
+			next = fmt.Sprintf("%s (in %s)", start.Format("Mon 15:04 MST"), output.FormatDuration(start.Sub(now).Round(time.Minute)))
+		}
+
+		pending := "-"
+		if clusters, err := pendingClusters(ctx, c, s); err != nil {
+			logger.Debug("could not list clusters", "schedule", s.Name, "error", err)
+		} else {
+			pending = fmt.Sprintf("%d", len(clusters))
+		}
+
+		lastRun := "-"
+		if t, err := time.Parse(time.RFC3339, s.LastRun); err == nil {
+			lastRun = output.FormatAge(t) + " ago"
+		}
+
+		table.AddRow(s.Name, s.Selector, s.Window+" "+s.Timezone, next, s.KubernetesVersion, pending, lastRun)
+	}
+	return table.Flush()
+}
+
+func newRunCmd(logger *log.Logger) *cobra.Command {
+	var (
+		kubeconfig string
+		force      bool
+		dryRun     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "run [NAME]",
+		Short: "Perform pending work for schedules whose window is open",
+		Long: `Perform pending maintenance for schedules whose window is currently open.
+
+Without NAME every schedule is considered. Schedules outside their window
+are skipped unless --force is given. Clusters already at the target version
+are left alone, so running repeatedly during a window is safe.
+
+Examples:
+  # Run all open schedules (e.g. from cron)
+  butleradm maintenance run
+
+  # Run one schedule now, ignoring its window
+  butleradm maintenance run prod-upgrades --force`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			return runRun(cmd.Context(), logger, kubeconfig, name, force, dryRun)
+		},
+	}
+
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().BoolVar(&force, "force", false, "run even if the window is not open")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "show what would be upgraded without changing anything")
+
+	return cmd
+}
+
+func runRun(ctx context.Context, logger *log.Logger, kubeconfig, name string, force, dryRun bool) error {
+	c, err := getClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var schedules []Schedule
+	if name != "" {
+		s, err := getSchedule(ctx, c, name)
+		if err != nil {
+			return err
+		}
+		schedules = []Schedule{*s}
+	} else {
+		schedules, err = listSchedules(ctx, c)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	var failures []string
+	for i := range schedules {
+		s := &schedules[i]
+		w, err := s.ParsedWindow()
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", s.Name, err))
+			continue
+		}
+		if _, active := w.Active(now); !active && !force {
+			logger.Debug("window not open, skipping", "schedule", s.Name)
+			continue
+		}
+
+		clusters, err := pendingClusters(ctx, c, s)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", s.Name, err))
+			continue
+		}
+		if len(clusters) == 0 {
+			logger.Info("nothing pending", "schedule", s.Name)
+			continue
+		}
+
+		logger.Info("starting maintenance", "schedule", s.Name, "clusters", len(clusters), "target", s.KubernetesVersion)
+		for _, f := range upgradeClusters(ctx, c, logger, s, clusters, dryRun) {
+			failures = append(failures, fmt.Sprintf("%s: %s", s.Name, f))
+		}
+
+		if !dryRun {
+			s.LastRun = now.UTC().Format(time.RFC3339)
+			if err := saveSchedule(ctx, c, s); err != nil {
+				logger.Warn("could not record last run", "schedule", s.Name, "error", err)
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("maintenance finished with %d error(s):\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+// pendingClusters returns clusters matching the schedule not yet at the target version
+func pendingClusters(ctx context.Context, c *client.Client, s *Schedule) ([]unstructured.Unstructured, error) {
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{LabelSelector: s.Selector})
+	if err != nil {
+		return nil, fmt.Errorf("listing TenantClusters: %w", err)
+	}
+
+	var pending []unstructured.Unstructured
+	for _, tc := range list.Items {
+		version, _, _ := unstructured.NestedString(tc.Object, "spec", "kubernetesVersion")
+		if version != s.KubernetesVersion {
+			pending = append(pending, tc)
+		}
+	}
+	return pending, nil
+}
+
+// upgradeClusters patches clusters to the schedule's version with bounded
+// concurrency and returns a message per failure
+func upgradeClusters(ctx context.Context, c *client.Client, logger *log.Logger, s *Schedule, clusters []unstructured.Unstructured, dryRun bool) []string {
+	concurrency := s.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	patch, _ := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"kubernetesVersion": s.KubernetesVersion,
+		},
+	})
+	patchOpts := metav1.PatchOptions{}
+	if dryRun {
+		patchOpts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failures []string
+	)
+	sem := make(chan struct{}, concurrency)
+	for i := range clusters {
+		tc := &clusters[i]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			ref := tc.GetNamespace() + "/" + tc.GetName()
+			_, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(tc.GetNamespace()).Patch(
+				ctx, tc.GetName(), types.MergePatchType, patch, patchOpts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", ref, err))
+				logger.Warn("upgrade failed", "cluster", ref, "error", err)
+				return
+			}
+			logger.Success("upgrade started", "cluster", ref, "version", s.KubernetesVersion)
+		}()
+	}
+	wg.Wait()
+
+	return failures
+}
+
+func newDeleteCmd(logger *log.Logger) *cobra.Command {
+	var kubeconfig string
+
+	cmd := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a maintenance schedule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := getClient(kubeconfig)
+			if err != nil {
+				return err
+			}
+			if err := deleteSchedule(cmd.Context(), c, args[0]); err != nil {
+				return err
+			}
+			logger.Success("maintenance schedule deleted", "name", args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to kubeconfig")
+
+	return cmd
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
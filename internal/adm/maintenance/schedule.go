@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	butlerSystem = "butler-system"
+
+	// scheduleLabel marks ConfigMaps that hold maintenance schedules
+	scheduleLabel = "butler.butlerlabs.dev/maintenance-schedule"
+
+	// configMapPrefix is prepended to schedule names to form ConfigMap names
+	configMapPrefix = "maintenance-"
+)
+
+// Schedule is a maintenance window applied to a set of clusters
+type Schedule struct {
+	Name              string
+	Selector          string
+	Window            string
+	Timezone          string
+	KubernetesVersion string
+	Concurrency       int
+
+	// LastRun is when the schedule last performed work (RFC3339)
+	LastRun string
+}
+
+// ParsedWindow parses the schedule's window in its time zone
+func (s *Schedule) ParsedWindow() (*Window, error) {
+	return ParseWindow(s.Window, s.Timezone)
+}
+
+func (s *Schedule) toConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapPrefix + s.Name,
+			Namespace: butlerSystem,
+			Labels: map[string]string{
+				scheduleLabel: "true",
+			},
+		},
+		Data: map[string]string{
+			"name":              s.Name,
+			"selector":          s.Selector,
+			"window":            s.Window,
+			"timezone":          s.Timezone,
+			"kubernetesVersion": s.KubernetesVersion,
+			"concurrency":       strconv.Itoa(s.Concurrency),
+			"lastRun":           s.LastRun,
+		},
+	}
+}
+
+func scheduleFromConfigMap(cm *corev1.ConfigMap) Schedule {
+	concurrency, _ := strconv.Atoi(cm.Data["concurrency"])
+	return Schedule{
+		Name:              cm.Data["name"],
+		Selector:          cm.Data["selector"],
+		Window:            cm.Data["window"],
+		Timezone:          cm.Data["timezone"],
+		KubernetesVersion: cm.Data["kubernetesVersion"],
+		Concurrency:       concurrency,
+		LastRun:           cm.Data["lastRun"],
+	}
+}
+
+// saveSchedule creates or replaces a schedule
+func saveSchedule(ctx context.Context, c *client.Client, s *Schedule) error {
+	cm := s.toConfigMap()
+	cms := c.Clientset.CoreV1().ConfigMaps(butlerSystem)
+
+	existing, err := cms.Get(ctx, cm.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = cms.Create(ctx, cm, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("creating schedule %s: %w", s.Name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting schedule %s: %w", s.Name, err)
+	}
+
+	existing.Labels = cm.Labels
+	existing.Data = cm.Data
+	if _, err := cms.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating schedule %s: %w", s.Name, err)
+	}
+	return nil
+}
+
+// getSchedule loads a schedule by name
+func getSchedule(ctx context.Context, c *client.Client, name string) (*Schedule, error) {
+	cm, err := c.Clientset.CoreV1().ConfigMaps(butlerSystem).Get(ctx, configMapPrefix+name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, fmt.Errorf("maintenance schedule %q not found", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting schedule %s: %w", name, err)
+	}
+	s := scheduleFromConfigMap(cm)
+	return &s, nil
+}
+
+// listSchedules returns all schedules
+func listSchedules(ctx context.Context, c *client.Client) ([]Schedule, error) {
+	list, err := c.Clientset.CoreV1().ConfigMaps(butlerSystem).List(ctx, metav1.ListOptions{
+		LabelSelector: scheduleLabel + "=true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing maintenance schedules: %w", err)
+	}
+
+	schedules := make([]Schedule, 0, len(list.Items))
+	for i := range list.Items {
+		schedules = append(schedules, scheduleFromConfigMap(&list.Items[i]))
+	}
+	return schedules, nil
+}
+
+// deleteSchedule removes a schedule
+func deleteSchedule(ctx context.Context, c *client.Client, name string) error {
+	err := c.Clientset.CoreV1().ConfigMaps(butlerSystem).Delete(ctx, configMapPrefix+name, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("maintenance schedule %q not found", name)
+	}
+	return err
+}
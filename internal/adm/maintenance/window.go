@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Window is a recurring weekly maintenance window.
+// Windows whose end is before their start wrap past midnight.
+type Window struct {
+	Days     [7]bool
+	Start    time.Duration
+	End      time.Duration
+	Location *time.Location
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseWindow parses a window like "Sat 02:00-06:00", "Sat,Sun 01:00-03:00",
+// "Mon-Fri 22:00-02:00" or "daily 03:00-04:00" in the given time zone.
+func ParseWindow(spec, timezone string) (*Window, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("invalid window %q: expected DAYS HH:MM-HH:MM", spec)
+	}
+
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+		}
+	}
+
+	w := &Window{Location: loc}
+	if err := w.parseDays(fields[0]); err != nil {
+		return nil, fmt.Errorf("invalid window %q: %w", spec, err)
+	}
+
+	times := strings.SplitN(fields[1], "-", 2)
+	if len(times) != 2 {
+		return nil, fmt.Errorf("invalid window %q: expected HH:MM-HH:MM", spec)
+	}
+	var err error
+	if w.Start, err = parseClock(times[0]); err != nil {
+		return nil, fmt.Errorf("invalid window %q: %w", spec, err)
+	}
+	if w.End, err = parseClock(times[1]); err != nil {
+		return nil, fmt.Errorf("invalid window %q: %w", spec, err)
+	}
+	if w.Start == w.End {
+		return nil, fmt.Errorf("invalid window %q: start and end are equal", spec)
+	}
+
+	return w, nil
+}
+
+// parseDays fills Days from "daily", "Sat", "Sat,Sun" or "Mon-Fri"
+func (w *Window) parseDays(s string) error {
+	s = strings.ToLower(s)
+	if s == "daily" || s == "*" {
+		for i := range w.Days {
+			w.Days[i] = true
+		}
+		return nil
+	}
+
+	for _, part := range strings.Split(s, ",") {
+		if from, to, ok := strings.Cut(part, "-"); ok {
+			start, ok1 := weekdays[from]
+			end, ok2 := weekdays[to]
+			if !ok1 || !ok2 {
+				return fmt.Errorf("unknown day range %q", part)
+			}
+			for d := start; ; d = (d + 1) % 7 {
+				w.Days[d] = true
+				if d == end {
+					break
+				}
+			}
+			continue
+		}
+		day, ok := weekdays[part]
+		if !ok {
+			return fmt.Errorf("unknown day %q", part)
+		}
+		w.Days[day] = true
+	}
+	return nil
+}
+
+// parseClock parses HH:MM into a duration since midnight
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// length returns how long each occurrence of the window lasts
+func (w *Window) length() time.Duration {
+	if w.End > w.Start {
+		return w.End - w.Start
+	}
+	return 24*time.Hour - w.Start + w.End
+}
+
+// occurrence returns the window that starts on the given day, if any
+func (w *Window) occurrence(day time.Time) (time.Time, bool) {
+	if !w.Days[day.Weekday()] {
+		return time.Time{}, false
+	}
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, w.Location)
+	return midnight.Add(w.Start), true
+}
+
+// Active returns the end of the window if t falls inside an occurrence
+func (w *Window) Active(t time.Time) (time.Time, bool) {
+	t = t.In(w.Location)
+	// An occurrence that started yesterday may still be running
+	for _, offset := range []int{0, -1} {
+		start, ok := w.occurrence(t.AddDate(0, 0, offset))
+		if !ok {
+			continue
+		}
+		end := start.Add(w.length())
+		if !t.Before(start) && t.Before(end) {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Next returns the start and end of the next occurrence at or after t.
+// If t is inside a window, that window is returned.
+func (w *Window) Next(t time.Time) (time.Time, time.Time) {
+	if end, ok := w.Active(t); ok {
+		return end.Add(-w.length()), end
+	}
+	t = t.In(w.Location)
+	for offset := 0; offset <= 7; offset++ {
+		start, ok := w.occurrence(t.AddDate(0, 0, offset))
+		if ok && start.After(t) {
+			return start, start.Add(w.length())
+		}
+	}
+	return time.Time{}, time.Time{}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package network implements butleradm commands that change the network
+// settings of the management cluster nodes.
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/talosctl"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// NewNetworkCmd creates the network parent command
+func NewNetworkCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "network",
+		Short: "Change network settings of the management cluster nodes",
+		Long: `Change network settings of the management cluster nodes.
+
+Settings are written to each node's Talos machine config with talosctl, so
+they survive reboots and upgrades.
+
+Commands:
+  add-host-alias  Add an /etc/hosts entry to every node
+
+Examples:
+  # Resolve a corporate registry that internal DNS doesn't serve
+  butleradm network add-host-alias 10.0.0.20 registry.corp.example`,
+	}
+
+	cmd.AddCommand(newAddHostAliasCmd(logger))
+
+	return cmd
+}
+
+func newAddHostAliasCmd(logger *log.Logger) *cobra.Command {
+	var kubeconfig, talosconfig string
+
+	cmd := &cobra.Command{
+		Use:   "add-host-alias IP HOSTNAME [HOSTNAME...]",
+		Short: "Add an /etc/hosts entry to every node",
+		Long: `Add an /etc/hosts entry to every management cluster node.
+
+The entry is added to machine.network.extraHostEntries of each node's Talos
+machine config. Nodes that already resolve all the hostnames to IP are left
+alone, so the command can be re-run after adding nodes.
+
+For the temporary KIND cluster used during bootstrap, set
+providerConfig.<provider>.hostAliases in the bootstrap config instead.
+
+Examples:
+  butleradm network add-host-alias 10.0.0.20 registry.corp.example
+  butleradm network add-host-alias 10.0.0.21 prism.corp.example prism`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ip, hostnames := args[0], args[1:]
+			if net.ParseIP(ip) == nil {
+				return fmt.Errorf("invalid IP address %q", ip)
+			}
+			return runAddHostAlias(cmd.Context(), logger, kubeconfig, talosconfig, ip, hostnames)
+		},
+	}
+
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&talosconfig, "talosconfig", "", "path to talosconfig (default: $TALOSCONFIG or ~/.butler/<cluster>-talosconfig)")
+
+	return cmd
+}
+
+func runAddHostAlias(ctx context.Context, logger *log.Logger, kubeconfig, talosconfig, ip string, hostnames []string) error {
+	c, err := getClient(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	talos, err := talosctl.New(talosconfig)
+	if err != nil {
+		return err
+	}
+	defer talos.Close()
+
+	nodes, err := c.NodeInternalIPs(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	patch := hostAliasPatch(ip, hostnames)
+	for _, node := range nodes {
+		doc, err := talos.ReadMachineConfig(ctx, node)
+		if err != nil {
+			return err
+		}
+		present, err := hasHostAlias(doc, ip, hostnames)
+		if err != nil {
+			return fmt.Errorf("parsing machine config from %s: %w", node, err)
+		}
+		if present {
+			logger.Info("host alias already present", "node", node)
+			continue
+		}
+
+		if err := talos.PatchMachineConfig(ctx, node, patch); err != nil {
+			return fmt.Errorf("patching node %s: %w", node, err)
+		}
+		logger.Success("host alias added", "node", node, "ip", ip, "hostnames", strings.Join(hostnames, ","))
+	}
+	return nil
+}
+
+// hostAliasPatch builds a Talos config patch adding an extra host entry
+func hostAliasPatch(ip string, hostnames []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "machine:\n  network:\n    extraHostEntries:\n      - ip: %s\n        aliases:\n", ip)
+	for _, hostname := range hostnames {
+		fmt.Fprintf(&b, "          - %s\n", hostname)
+	}
+	return b.String()
+}
+
+// hasHostAlias reports whether a machine config already maps every hostname
+// to ip
+func hasHostAlias(doc []byte, ip string, hostnames []string) (bool, error) {
+	var config struct {
+		Machine struct {
+			Network struct {
+				ExtraHostEntries []struct {
+					IP      string   `json:"ip"`
+					Aliases []string `json:"aliases"`
+				} `json:"extraHostEntries"`
+			} `json:"network"`
+		} `json:"machine"`
+	}
+	if err := yaml.Unmarshal(doc, &config); err != nil {
+		return false, err
+	}
+
+	mapped := map[string]bool{}
+	for _, entry := range config.Machine.Network.ExtraHostEntries {
+		if entry.IP != ip {
+			continue
+		}
+		for _, alias := range entry.Aliases {
+			mapped[alias] = true
+		}
+	}
+	for _, hostname := range hostnames {
+		if !mapped[hostname] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
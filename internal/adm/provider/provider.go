@@ -19,17 +19,16 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/butlerdotdev/butler/internal/common/client"
 	"github.com/butlerdotdev/butler/internal/common/log"
 	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/providerapi"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -50,19 +49,28 @@ Provider configurations define how Butler connects to infrastructure
 providers like Nutanix, Harvester, Proxmox, or cloud platforms.
 
 Commands:
-  list      List all provider configurations
-  validate  Test connectivity to a provider
+  list       List all provider configurations
+  validate   Test connectivity to a provider
+  insecure   List provider configurations with TLS verification disabled
+  trust-ca   Add a provider's CA to the platform trust bundle
+  reconcile  Compare provider VMs with MachineRequests
 
 Examples:
   # List all providers
   butleradm provider list
 
   # Validate a provider configuration
-  butleradm provider validate nutanix`,
+  butleradm provider validate nutanix
+
+  # Find VMs Butler has lost track of
+  butleradm provider reconcile nutanix`,
 	}
 
 	cmd.AddCommand(newListCmd(logger))
 	cmd.AddCommand(newValidateCmd(logger))
+	cmd.AddCommand(newInsecureCmd(logger))
+	cmd.AddCommand(newTrustCACmd(logger))
+	cmd.AddCommand(newReconcileCmd(logger))
 
 	return cmd
 }
@@ -143,7 +151,21 @@ func runList(ctx context.Context, logger *log.Logger, opts *listOptions) error {
 		table.AddRow(name, provider, validatedStr, endpoint, age)
 	}
 
-	return table.Flush()
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	insecure := 0
+	for i := range list.Items {
+		if isInsecure(&list.Items[i]) {
+			insecure++
+		}
+	}
+	if insecure > 0 {
+		fmt.Println()
+		logger.Warn(fmt.Sprintf("%d provider configuration(s) skip TLS verification; see 'butleradm provider insecure'", insecure))
+	}
+	return nil
 }
 
 type validateOptions struct {
@@ -231,95 +253,19 @@ func runValidate(ctx context.Context, logger *log.Logger, name string, opts *val
 }
 
 func validateNutanix(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, opts *validateOptions, logger *log.Logger) error {
-	endpoint := getNestedString(pc.Object, "spec", "nutanix", "endpoint")
-	if endpoint == "" {
-		return fmt.Errorf("nutanix endpoint not configured")
-	}
-
-	// Get port from spec (default 9440 for Prism Central)
-	port := getNestedInt64(pc.Object, "spec", "nutanix", "port")
-	if port == 0 {
-		port = 9440
-	}
-
-	// Get insecure flag from provider config
-	insecure := getNestedBool(pc.Object, "spec", "nutanix", "insecure")
-	if opts.insecure {
-		insecure = true
-	}
-
-	// Get credentials from secret - credentialsRef is at spec level, not nested under nutanix
-	secretName := getNestedString(pc.Object, "spec", "credentialsRef", "name")
-	if secretName == "" {
-		return fmt.Errorf("credentials secret not configured (spec.credentialsRef.name)")
-	}
-
-	secret, err := c.Clientset.CoreV1().Secrets(butlerSystem).Get(ctx, secretName, metav1.GetOptions{})
+	nc, err := providerapi.NewNutanix(ctx, c, pc, opts.timeout, opts.insecure)
 	if err != nil {
-		return fmt.Errorf("getting credentials secret %s: %w", secretName, err)
-	}
-
-	// Keys are "username" and "password" per the CRD docs
-	username := string(secret.Data["username"])
-	password := string(secret.Data["password"])
-	if username == "" || password == "" {
-		// Try alternate key names used by CAPX
-		username = string(secret.Data["NUTANIX_USER"])
-		password = string(secret.Data["NUTANIX_PASSWORD"])
-	}
-	if username == "" || password == "" {
-		return fmt.Errorf("credentials secret %s missing username/password (or NUTANIX_USER/NUTANIX_PASSWORD)", secretName)
-	}
-
-	// Build the full API URL with port
-	// Strip trailing slash from endpoint
-	endpoint = strings.TrimSuffix(endpoint, "/")
-
-	// Check if endpoint already has a port
-	apiURL := endpoint
-	if !strings.Contains(strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://"), ":") {
-		// No port in endpoint, add it
-		apiURL = fmt.Sprintf("%s:%d", endpoint, port)
-	}
-
-	logger.Info("testing Prism Central connectivity", "endpoint", apiURL, "insecure", insecure)
-
-	// Test API connectivity
-	httpClient := &http.Client{
-		Timeout: opts.timeout,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: insecure,
-			},
-		},
+		return err
 	}
 
-	// Try to hit the clusters API endpoint
-	fullURL := fmt.Sprintf("%s/api/nutanix/v3/clusters/list", apiURL)
+	logger.Info("testing Prism Central connectivity", "endpoint", nc.APIURL, "insecure", nc.Insecure)
 
-	// Create request with empty JSON body (required by Nutanix API)
-	reqBody := strings.NewReader("{}")
-	req, err := http.NewRequestWithContext(ctx, "POST", fullURL, reqBody)
-	if err != nil {
-		return fmt.Errorf("creating request: %w", err)
-	}
-	req.SetBasicAuth(username, password)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("connecting to Prism Central at %s: %w", apiURL, err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == 401 {
-		return fmt.Errorf("authentication failed - check credentials")
-	}
-	if resp.StatusCode >= 400 {
-		return fmt.Errorf("API returned status %d", resp.StatusCode)
+	// Try to hit the clusters API endpoint with an empty JSON body (required by Nutanix API)
+	if err := nc.Do(ctx, http.MethodPost, "/api/nutanix/v3/clusters/list", map[string]interface{}{}, nil); err != nil {
+		return err
 	}
 
-	logger.Success("Prism Central API accessible", "status", resp.StatusCode)
+	logger.Success("Prism Central API accessible")
 	return nil
 }
 
@@ -339,81 +285,16 @@ func validateHarvester(ctx context.Context, c *client.Client, pc *unstructured.U
 }
 
 func validateProxmox(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, opts *validateOptions, logger *log.Logger) error {
-	endpoint := getNestedString(pc.Object, "spec", "proxmox", "endpoint")
-	if endpoint == "" {
-		return fmt.Errorf("proxmox endpoint not configured")
-	}
-
-	// Get insecure flag from provider config
-	insecure := getNestedBool(pc.Object, "spec", "proxmox", "insecure")
-	if opts.insecure {
-		insecure = true
-	}
-
-	// Get credentials from secret - credentialsRef is at spec level
-	secretName := getNestedString(pc.Object, "spec", "credentialsRef", "name")
-	if secretName == "" {
-		return fmt.Errorf("credentials secret not configured (spec.credentialsRef.name)")
-	}
-
-	secret, err := c.Clientset.CoreV1().Secrets(butlerSystem).Get(ctx, secretName, metav1.GetOptions{})
+	px, err := providerapi.NewProxmox(ctx, c, pc, opts.timeout, opts.insecure)
 	if err != nil {
-		return fmt.Errorf("getting credentials secret %s: %w", secretName, err)
-	}
-
-	// Try token-based auth first
-	tokenID := string(secret.Data["token"])
-	tokenSecret := string(secret.Data["tokenSecret"])
-
-	// Fallback to alternate key names
-	if tokenID == "" {
-		tokenID = string(secret.Data["PROXMOX_TOKEN_ID"])
-		tokenSecret = string(secret.Data["PROXMOX_TOKEN_SECRET"])
-	}
-
-	// Or username/password
-	username := string(secret.Data["username"])
-	password := string(secret.Data["password"])
-
-	if tokenID == "" && username == "" {
-		return fmt.Errorf("credentials secret %s missing token or username/password", secretName)
+		return err
 	}
 
-	logger.Info("testing Proxmox API connectivity", "endpoint", endpoint)
-
-	httpClient := &http.Client{
-		Timeout: opts.timeout,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: insecure,
-			},
-		},
-	}
+	logger.Info("testing Proxmox API connectivity", "endpoint", px.Endpoint)
 
 	// Test API connectivity - get version
-	apiURL := fmt.Sprintf("%s/api2/json/version", endpoint)
-	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
-	if err != nil {
-		return fmt.Errorf("creating request: %w", err)
-	}
-
-	if tokenID != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("PVEAPIToken=%s=%s", tokenID, tokenSecret))
-	} else {
-		req.SetBasicAuth(username, password)
-	}
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("connecting to Proxmox: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == 401 {
-		return fmt.Errorf("authentication failed - check credentials")
-	}
-	if resp.StatusCode >= 400 {
-		return fmt.Errorf("API returned status %d", resp.StatusCode)
+	if err := px.Get(ctx, "/version", nil); err != nil {
+		return err
 	}
 
 	logger.Success("Proxmox API accessible")
@@ -0,0 +1,415 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/access"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/providerapi"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// Reconcile findings
+	vmUnknown = "unknown"
+	vmLeaked  = "leaked"
+	vmMissing = "missing"
+
+	// providerController is the Nutanix provider's controller Deployment
+	providerController = "butler-provider-nutanix"
+
+	// adoptProviderVersion is the first provider release that leaves
+	// adopted MachineRequests' VMs alone and records their status
+	adoptProviderVersion = "v0.4.0"
+)
+
+type reconcileOptions struct {
+	kubeconfig      string
+	outputFormat    string
+	timeout         time.Duration
+	insecure        bool
+	category        string
+	clusterCategory string
+	adopt           bool
+	cleanup         bool
+}
+
+// VMFinding is a VM or MachineRequest that does not line up with the other
+type VMFinding struct {
+	Status         string `json:"status"`
+	VM             string `json:"vm"`
+	UUID           string `json:"uuid,omitempty"`
+	Cluster        string `json:"cluster,omitempty"`
+	MachineRequest string `json:"machineRequest,omitempty"`
+	Reason         string `json:"reason"`
+}
+
+func newReconcileCmd(logger *log.Logger) *cobra.Command {
+	opts := &reconcileOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reconcile NAME",
+		Short: "Compare provider VMs with MachineRequests",
+		Long: `Compare the VMs on a provider with the MachineRequests that should own them.
+
+Butler-managed VMs are those carrying the --category category (default
+ButlerManaged:true). Each is matched to a MachineRequest for this
+ProviderConfig by provider ID or machine name. Findings:
+
+  unknown  Managed VM of an existing cluster with no MachineRequest
+  leaked   Managed VM whose cluster no longer exists
+  missing  MachineRequest whose VM is not on the provider
+
+A VM's cluster comes from the --cluster-category category, or else the
+cluster whose name prefixes the VM name.
+
+--adopt creates MachineRequests for unknown VMs so Butler tracks them again.
+VMs without a matching cluster are skipped, and VMs whose names aren't
+valid Kubernetes names are reported. Adopting needs butler-provider-nutanix
+v0.4.0 or newer, which records the status of adopted machines instead of
+creating VMs for them.
+--cleanup deletes leaked VMs. Only Nutanix is supported.
+
+Examples:
+  # Report
+  butleradm provider reconcile nutanix
+
+  # Track unknown VMs and delete leaked ones
+  butleradm provider reconcile nutanix --adopt --cleanup`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReconcile(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 30*time.Second, "provider API timeout")
+	cmd.Flags().BoolVar(&opts.insecure, "insecure", false, "skip TLS certificate verification")
+	cmd.Flags().StringVar(&opts.category, "category", "ButlerManaged:true", "category (KEY:VALUE) marking Butler-managed VMs")
+	cmd.Flags().StringVar(&opts.clusterCategory, "cluster-category", "ButlerCluster", "category key holding a VM's cluster name")
+	cmd.Flags().BoolVar(&opts.adopt, "adopt", false, "create MachineRequests for unknown VMs")
+	cmd.Flags().BoolVar(&opts.cleanup, "cleanup", false, "delete leaked VMs")
+
+	return cmd
+}
+
+func runReconcile(ctx context.Context, logger *log.Logger, name string, opts *reconcileOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+	categoryKey, categoryValue, ok := strings.Cut(opts.category, ":")
+	if !ok || categoryKey == "" {
+		return fmt.Errorf("invalid --category %q: expected KEY:VALUE", opts.category)
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	pc, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace(butlerSystem).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting ProviderConfig %s: %w", name, err)
+	}
+	if provider := getNestedString(pc.Object, "spec", "provider"); provider != "nutanix" {
+		return fmt.Errorf("reconcile supports nutanix providers only, %s is %s", name, provider)
+	}
+
+	nc, err := providerapi.NewNutanix(ctx, c, pc, opts.timeout, opts.insecure)
+	if err != nil {
+		return err
+	}
+	vms, err := nc.ListVMs(ctx)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := loadClusterNamespaces(ctx, c)
+	if err != nil {
+		return err
+	}
+	mrs, err := machineRequestsFor(ctx, c, name)
+	if err != nil {
+		return err
+	}
+
+	byUUID := map[string]*providerapi.NutanixVM{}
+	byName := map[string]*providerapi.NutanixVM{}
+	for i := range vms {
+		byUUID[vms[i].Metadata.UUID] = &vms[i]
+		byName[vms[i].Spec.Name] = &vms[i]
+	}
+
+	findings := []VMFinding{}
+	tracked := map[string]bool{}
+	for _, mr := range mrs {
+		machineName := getNestedString(mr.Object, "spec", "machineName")
+		vm := byUUID[getNestedString(mr.Object, "status", "providerID")]
+		if vm == nil {
+			vm = byUUID[mr.GetAnnotations()[lifecycle.AdoptedProviderIDAnnotation]]
+		}
+		if vm == nil {
+			vm = byName[machineName]
+		}
+		if vm != nil {
+			tracked[vm.Metadata.UUID] = true
+			continue
+		}
+		switch getNestedString(mr.Object, "status", "phase") {
+		case "", "Pending", "Creating", "Deleting", "Deleted":
+			continue
+		}
+		findings = append(findings, VMFinding{
+			Status:         vmMissing,
+			VM:             machineName,
+			Cluster:        mr.GetLabels()[access.ClusterLabel],
+			MachineRequest: mr.GetNamespace() + "/" + mr.GetName(),
+			Reason:         "VM not found on provider",
+		})
+	}
+
+	var unknown []*providerapi.NutanixVM
+	for i := range vms {
+		vm := &vms[i]
+		if vm.Metadata.Categories[categoryKey] != categoryValue || tracked[vm.Metadata.UUID] {
+			continue
+		}
+		cluster := vm.Metadata.Categories[opts.clusterCategory]
+		if cluster == "" {
+			cluster = clusterForVM(clusters, vm.Spec.Name)
+		}
+		f := VMFinding{VM: vm.Spec.Name, UUID: vm.Metadata.UUID, Cluster: cluster}
+		if _, exists := clusters[cluster]; exists {
+			f.Status = vmUnknown
+			f.Reason = "no MachineRequest"
+			unknown = append(unknown, vm)
+		} else {
+			f.Status = vmLeaked
+			f.Reason = "cluster not found"
+			if cluster == "" {
+				f.Reason = "no cluster recorded"
+			}
+		}
+		findings = append(findings, f)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Status != findings[j].Status {
+			return findings[i].Status < findings[j].Status
+		}
+		return findings[i].VM < findings[j].VM
+	})
+
+	if opts.adopt || opts.cleanup {
+		return applyReconcile(ctx, c, nc, logger, pc.GetName(), opts, clusters, findings, unknown)
+	}
+
+	if format == output.FormatTable && len(findings) == 0 {
+		logger.Success("provider VMs match MachineRequests", "vms", len(vms), "machineRequests", len(mrs))
+		return nil
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(findings, func(w io.Writer) error {
+		table := output.NewTable(w, "STATUS", "VM", "UUID", "CLUSTER", "MACHINEREQUEST", "REASON")
+		table.SetPriority("UUID", -1)
+		for _, f := range findings {
+			table.AddRow(colorizeFinding(f.Status), f.VM, orDash(f.UUID), orDash(f.Cluster), orDash(f.MachineRequest), f.Reason)
+		}
+		return table.Flush()
+	})
+}
+
+func colorizeFinding(status string) string {
+	switch status {
+	case vmLeaked:
+		return output.Danger(status)
+	case vmMissing, vmUnknown:
+		return output.Warning(status)
+	}
+	return status
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// loadClusterNamespaces maps TenantCluster and ClusterBootstrap names to the
+// namespace their MachineRequests belong in
+func loadClusterNamespaces(ctx context.Context, c *client.Client) (map[string]string, error) {
+	clusters := map[string]string{}
+
+	bootstraps, err := c.Dynamic.Resource(client.ClusterBootstrapGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing ClusterBootstraps: %w", err)
+	}
+	for _, cb := range bootstraps.Items {
+		clusters[cb.GetName()] = cb.GetNamespace()
+	}
+
+	tenants, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing TenantClusters: %w", err)
+	}
+	for _, tc := range tenants.Items {
+		ns := getNestedString(tc.Object, "status", "tenantNamespace")
+		if ns == "" {
+			ns = tc.GetNamespace()
+		}
+		clusters[tc.GetName()] = ns
+	}
+
+	return clusters, nil
+}
+
+// machineRequestsFor lists the MachineRequests using a ProviderConfig
+func machineRequestsFor(ctx context.Context, c *client.Client, providerConfig string) ([]unstructured.Unstructured, error) {
+	list, err := c.Dynamic.Resource(client.MachineRequestGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing MachineRequests: %w", err)
+	}
+	var mrs []unstructured.Unstructured
+	for _, mr := range list.Items {
+		if getNestedString(mr.Object, "spec", "providerRef", "name") == providerConfig {
+			mrs = append(mrs, mr)
+		}
+	}
+	return mrs, nil
+}
+
+// clusterForVM returns the longest cluster name prefixing the VM name
+func clusterForVM(clusters map[string]string, vmName string) string {
+	best := ""
+	for name := range clusters {
+		if strings.HasPrefix(vmName, name+"-") && len(name) > len(best) {
+			best = name
+		}
+	}
+	return best
+}
+
+func applyReconcile(ctx context.Context, c *client.Client, nc *providerapi.Nutanix, logger *log.Logger, providerConfig string, opts *reconcileOptions, clusters map[string]string, findings []VMFinding, unknown []*providerapi.NutanixVM) error {
+	var failures []string
+
+	if opts.adopt {
+		if err := platform.RequireController(ctx, c, providerController, adoptProviderVersion, "--adopt"); err != nil {
+			return err
+		}
+		for _, vm := range unknown {
+			cluster := vm.Metadata.Categories[opts.clusterCategory]
+			if cluster == "" {
+				cluster = clusterForVM(clusters, vm.Spec.Name)
+			}
+			if cluster == "" || clusters[cluster] == "" {
+				logger.Warn("not adopting VM with no matching cluster", "vm", vm.Spec.Name)
+				continue
+			}
+			if problems := validation.IsDNS1123Subdomain(vm.Spec.Name); len(problems) > 0 {
+				failures = append(failures, fmt.Sprintf("adopting %s: not a valid MachineRequest name: %s", vm.Spec.Name, strings.Join(problems, "; ")))
+				continue
+			}
+			if err := adoptVM(ctx, c, providerConfig, cluster, clusters[cluster], vm); err != nil {
+				failures = append(failures, fmt.Sprintf("adopting %s: %v", vm.Spec.Name, err))
+				continue
+			}
+			logger.Success("VM adopted", "vm", vm.Spec.Name, "cluster", cluster)
+		}
+	}
+
+	if opts.cleanup {
+		for _, f := range findings {
+			if f.Status != vmLeaked {
+				continue
+			}
+			if err := nc.DeleteVM(ctx, f.UUID); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", f.VM, err))
+				continue
+			}
+			logger.Success("leaked VM deleted", "vm", f.VM, "uuid", f.UUID)
+		}
+	}
+
+	for _, f := range findings {
+		if f.Status == vmMissing {
+			logger.Warn("MachineRequest has no VM", "machineRequest", f.MachineRequest, "vm", f.VM)
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("reconcile finished with %d error(s):\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+// adoptVM creates a MachineRequest describing an existing VM. It is
+// annotated as adopted, with the VM's UUID, from the first write, so the
+// provider controller tracks rather than creates it.
+func adoptVM(ctx context.Context, c *client.Client, providerConfig, cluster, namespace string, vm *providerapi.NutanixVM) error {
+	role := "worker"
+	if strings.Contains(vm.Spec.Name, "-cp-") {
+		role = "control-plane"
+	}
+
+	mr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": client.MachineRequestGVR.GroupVersion().String(),
+		"kind":       "MachineRequest",
+		"metadata": map[string]interface{}{
+			"name":      vm.Spec.Name,
+			"namespace": namespace,
+			"labels":    map[string]interface{}{access.ClusterLabel: cluster},
+			"annotations": map[string]interface{}{
+				lifecycle.AdoptedAnnotation:           "true",
+				lifecycle.AdoptedProviderIDAnnotation: vm.Metadata.UUID,
+			},
+		},
+		"spec": map[string]interface{}{
+			"machineName": vm.Spec.Name,
+			"role":        role,
+			"cpu":         int64(vm.CPU()),
+			"memoryMB":    int64(vm.Spec.Resources.MemorySizeMib),
+			"diskGB":      int64(vm.DiskGB()),
+			"providerRef": map[string]interface{}{
+				"name":      providerConfig,
+				"namespace": butlerSystem,
+			},
+		},
+	}}
+
+	if _, err := c.Dynamic.Resource(client.MachineRequestGVR).Namespace(namespace).Create(ctx, mr, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("creating MachineRequest: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/providerapi"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// trustBundleName is the ConfigMap holding CAs trusted for provider endpoints.
+	// Each ProviderConfig's CA is stored under "<name>.crt" and all of them are
+	// concatenated under trustBundleKey.
+	trustBundleName = providerapi.TrustBundleName
+	trustBundleKey  = providerapi.TrustBundleKey
+)
+
+// InsecureProvider is a ProviderConfig with TLS verification disabled
+type InsecureProvider struct {
+	Name      string `json:"name"`
+	Provider  string `json:"provider"`
+	Endpoint  string `json:"endpoint,omitempty"`
+	TrustedCA bool   `json:"trustedCA"`
+	Age       string `json:"age"`
+}
+
+type insecureOptions struct {
+	kubeconfig   string
+	outputFormat string
+}
+
+func newInsecureCmd(logger *log.Logger) *cobra.Command {
+	opts := &insecureOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "insecure",
+		Short: "List provider configurations with TLS verification disabled",
+		Long: `List every ProviderConfig with TLS verification disabled (insecure: true).
+
+The TRUSTED CA column shows whether the provider's CA is already in the
+platform trust bundle, in which case insecure mode can be turned off.
+
+Examples:
+  # Posture report
+  butleradm provider insecure
+
+  # Machine-readable, e.g. for a compliance job
+  butleradm provider insecure -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInsecure(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+func runInsecure(ctx context.Context, logger *log.Logger, opts *insecureOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	list, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace(butlerSystem).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing ProviderConfigs: %w", err)
+	}
+
+	bundle, err := getTrustBundle(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	insecure := []InsecureProvider{}
+	for i := range list.Items {
+		pc := &list.Items[i]
+		if !isInsecure(pc) {
+			continue
+		}
+		info := extractProviderInfo(pc)
+		_, trusted := bundle.Data[pc.GetName()+".crt"]
+		insecure = append(insecure, InsecureProvider{
+			Name:      info.Name,
+			Provider:  info.Provider,
+			Endpoint:  info.Endpoint,
+			TrustedCA: trusted,
+			Age:       output.FormatAge(pc.GetCreationTimestamp().Time),
+		})
+	}
+
+	if format == output.FormatJSON || format == output.FormatYAML {
+		return output.NewPrinter(format, os.Stdout).Print(insecure, nil)
+	}
+
+	if len(insecure) == 0 {
+		logger.Success("all provider configurations verify TLS certificates")
+		return nil
+	}
+
+	table := output.NewTable(os.Stdout, "NAME", "PROVIDER", "ENDPOINT", "TRUSTED CA", "AGE")
+	for _, p := range insecure {
+		trusted := output.Warning("No")
+		if p.TrustedCA {
+			trusted = output.Success("Yes")
+		}
+		table.AddRow(p.Name, p.Provider, p.Endpoint, trusted, p.Age)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	logger.Warn(fmt.Sprintf("%d provider configuration(s) skip TLS verification", len(insecure)))
+	logger.Info("Trust the provider's CA with: butleradm provider trust-ca NAME --ca-file ca.pem --disable-insecure")
+	return nil
+}
+
+type trustCAOptions struct {
+	kubeconfig      string
+	caFile          string
+	disableInsecure bool
+	skipProbe       bool
+	timeout         time.Duration
+}
+
+func newTrustCACmd(logger *log.Logger) *cobra.Command {
+	opts := &trustCAOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "trust-ca NAME",
+		Short: "Add a provider's CA to the platform trust bundle",
+		Long: `Add a provider's CA certificate to the platform trust bundle.
+
+The certificate is stored in the butler-trust-bundle ConfigMap in
+butler-system, which 'provider validate' and Butler controllers use when
+connecting to provider endpoints. Before saving, the provider endpoint is
+contacted to confirm the CA actually verifies its certificate.
+
+With --disable-insecure the ProviderConfig is switched to verify TLS once
+the CA is stored.
+
+Examples:
+  # Trust the Prism Central CA and turn off insecure mode
+  butleradm provider trust-ca nutanix --ca-file prism-ca.pem --disable-insecure
+
+  # Store the CA without contacting the endpoint
+  butleradm provider trust-ca proxmox --ca-file pve-root-ca.pem --skip-probe`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTrustCA(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringVar(&opts.caFile, "ca-file", "", "PEM file with the provider's CA certificate(s) (required)")
+	cmd.Flags().BoolVar(&opts.disableInsecure, "disable-insecure", false, "set insecure: false on the ProviderConfig after storing the CA")
+	cmd.Flags().BoolVar(&opts.skipProbe, "skip-probe", false, "do not verify the CA against the provider endpoint")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 10*time.Second, "endpoint probe timeout")
+	_ = cmd.MarkFlagRequired("ca-file")
+
+	return cmd
+}
+
+func runTrustCA(ctx context.Context, logger *log.Logger, name string, opts *trustCAOptions) error {
+	pemData, err := os.ReadFile(opts.caFile)
+	if err != nil {
+		return fmt.Errorf("reading CA file: %w", err)
+	}
+	pool, certs, err := providerapi.ParseCAs(pemData)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", opts.caFile, err)
+	}
+	for _, cert := range certs {
+		logger.Debug("CA certificate", "subject", cert.Subject.String(), "notAfter", cert.NotAfter)
+		if time.Now().After(cert.NotAfter) {
+			return fmt.Errorf("CA certificate %q expired on %s", cert.Subject.CommonName, cert.NotAfter.Format("2006-01-02"))
+		}
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	pc, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace(butlerSystem).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting ProviderConfig %s: %w", name, err)
+	}
+
+	provider := getNestedString(pc.Object, "spec", "provider")
+	endpoint := getNestedString(pc.Object, "spec", provider, "endpoint")
+	if !opts.skipProbe {
+		if endpoint == "" {
+			return fmt.Errorf("ProviderConfig %s has no endpoint to verify against; use --skip-probe", name)
+		}
+		logger.Info("verifying CA against provider endpoint", "endpoint", endpoint)
+		if err := probeEndpoint(ctx, endpoint, defaultPort(provider, pc), pool, opts.timeout); err != nil {
+			return fmt.Errorf("CA does not verify %s: %w", endpoint, err)
+		}
+		logger.Success("endpoint certificate verified")
+	}
+
+	if err := saveTrustedCA(ctx, c, name, pemData); err != nil {
+		return err
+	}
+	logger.Success("CA added to trust bundle", "provider", name, "configmap", butlerSystem+"/"+trustBundleName)
+
+	if !isInsecure(pc) {
+		return nil
+	}
+	if !opts.disableInsecure {
+		logger.Info("ProviderConfig still skips TLS verification; re-run with --disable-insecure to turn it off")
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			provider: map[string]interface{}{"insecure": false},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("building patch: %w", err)
+	}
+	if _, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace(butlerSystem).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("disabling insecure mode: %w", err)
+	}
+	logger.Success("TLS verification enabled", "provider", name)
+	logger.Info("Re-validate with: butleradm provider validate " + name)
+	return nil
+}
+
+// isInsecure reports whether a ProviderConfig disables TLS verification
+func isInsecure(pc *unstructured.Unstructured) bool {
+	provider := getNestedString(pc.Object, "spec", "provider")
+	if provider == "" {
+		return false
+	}
+	return getNestedBool(pc.Object, "spec", provider, "insecure")
+}
+
+// defaultPort returns the port to use when the endpoint URL has none
+func defaultPort(provider string, pc *unstructured.Unstructured) string {
+	if provider == "nutanix" {
+		if port := getNestedInt64(pc.Object, "spec", "nutanix", "port"); port != 0 {
+			return fmt.Sprint(port)
+		}
+		return "9440"
+	}
+	return "443"
+}
+
+// probeEndpoint performs a TLS handshake verifying the server against pool
+func probeEndpoint(ctx context.Context, endpoint, port string, pool *x509.CertPool, timeout time.Duration) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("parsing endpoint: %w", err)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("endpoint is not https")
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: timeout},
+		Config:    &tls.Config{RootCAs: pool, ServerName: u.Hostname()},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// getTrustBundle returns the trust bundle ConfigMap, or an empty one if it does not exist
+func getTrustBundle(ctx context.Context, c *client.Client) (*corev1.ConfigMap, error) {
+	cm, err := c.Clientset.CoreV1().ConfigMaps(butlerSystem).Get(ctx, trustBundleName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: trustBundleName, Namespace: butlerSystem},
+			Data:       map[string]string{},
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading trust bundle: %w", err)
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	return cm, nil
+}
+
+// saveTrustedCA stores a provider's CA and rebuilds the combined bundle
+func saveTrustedCA(ctx context.Context, c *client.Client, name string, pemData []byte) error {
+	bundle, err := getTrustBundle(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	bundle.Data[name+".crt"] = string(pemData)
+
+	var keys []string
+	for key := range bundle.Data {
+		if key != trustBundleKey && strings.HasSuffix(key, ".crt") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var combined strings.Builder
+	for _, key := range keys {
+		combined.WriteString(strings.TrimSpace(bundle.Data[key]))
+		combined.WriteString("\n")
+	}
+	bundle.Data[trustBundleKey] = combined.String()
+
+	configMaps := c.Clientset.CoreV1().ConfigMaps(butlerSystem)
+	if bundle.ResourceVersion == "" {
+		_, err = configMaps.Create(ctx, bundle, metav1.CreateOptions{})
+	} else {
+		_, err = configMaps.Update(ctx, bundle, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("saving trust bundle: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reconcile implements butleradm reconcile, which asks the Butler
+// controllers to reconcile a resource now, or pauses and resumes its
+// reconciliation while an operator intervenes by hand.
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// RequestedAtAnnotation holds the time a reconcile was last requested.
+	// Controllers watch for changes to it and requeue the resource.
+	RequestedAtAnnotation = "butler.butlerlabs.dev/reconcile-requested-at"
+
+	// PausedAnnotation set to "true" makes controllers skip the resource
+	// until it is removed
+	PausedAnnotation = "butler.butlerlabs.dev/paused"
+)
+
+// kind is a Butler resource that can be reconciled
+type kind struct {
+	name      string
+	display   string
+	aliases   []string
+	gvr       schema.GroupVersionResource
+	namespace string // default namespace, "" if cluster-scoped
+}
+
+var kinds = []kind{
+	{name: "tenantcluster", display: "TenantCluster", aliases: []string{"tc"}, gvr: client.TenantClusterGVR, namespace: "butler-tenants"},
+	{name: "machinerequest", display: "MachineRequest", aliases: []string{"mr"}, gvr: client.MachineRequestGVR, namespace: "butler-system"},
+	{name: "providerconfig", display: "ProviderConfig", aliases: []string{"pc"}, gvr: client.ProviderConfigGVR, namespace: "butler-system"},
+	{name: "clusterbootstrap", display: "ClusterBootstrap", aliases: []string{"cb"}, gvr: client.ClusterBootstrapGVR, namespace: "butler-system"},
+	{name: "addondefinition", display: "AddonDefinition", gvr: client.AddonDefinitionGVR},
+	{name: "team", display: "Team", gvr: client.TeamGVR},
+	{name: "butlerconfig", display: "ButlerConfig", gvr: client.ButlerConfigGVR},
+}
+
+type reconcileOptions struct {
+	kubeconfig string
+	namespace  string
+	pause      bool
+	resume     bool
+}
+
+// NewReconcileCmd creates the reconcile parent command
+func NewReconcileCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Trigger, pause or resume reconciliation of Butler resources",
+		Long: `Trigger, pause or resume reconciliation of Butler resources.
+
+A reconcile is requested by stamping the ` + RequestedAtAnnotation + `
+annotation with the current time, which the controllers watch for.
+
+--pause sets ` + PausedAnnotation + `=true, and the controllers leave
+the resource alone until --resume removes it. Pause a resource before
+editing what it manages by hand, so a reconcile doesn't undo the change
+halfway; resuming also requests a reconcile.
+
+Commands:
+  tenantcluster     TenantClusters (default namespace butler-tenants)
+  machinerequest    MachineRequests (default namespace butler-system)
+  providerconfig    ProviderConfigs (default namespace butler-system)
+  clusterbootstrap  ClusterBootstraps (default namespace butler-system)
+  addondefinition   AddonDefinitions
+  team              Teams
+  butlerconfig      ButlerConfigs
+
+Examples:
+  # Reconcile a tenant cluster now
+  butleradm reconcile tenantcluster my-cluster
+
+  # Stage a manual fix of a machine without the controller interfering
+  butleradm reconcile machinerequest my-cluster-worker-0 --pause
+  butleradm reconcile machinerequest my-cluster-worker-0 --resume`,
+	}
+
+	for _, k := range kinds {
+		cmd.AddCommand(newKindCmd(logger, k))
+	}
+
+	return cmd
+}
+
+func newKindCmd(logger *log.Logger, k kind) *cobra.Command {
+	opts := &reconcileOptions{}
+
+	cmd := &cobra.Command{
+		Use:     k.name + " NAME",
+		Aliases: k.aliases,
+		Short:   fmt.Sprintf("Trigger, pause or resume reconciliation of %ss", k.display),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReconcile(cmd.Context(), logger, k, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	if k.namespace != "" {
+		cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", k.namespace, "namespace of the resource")
+	}
+	cmd.Flags().BoolVar(&opts.pause, "pause", false, "stop controllers from reconciling the resource")
+	cmd.Flags().BoolVar(&opts.resume, "resume", false, "let controllers reconcile the resource again")
+	cmd.MarkFlagsMutuallyExclusive("pause", "resume")
+
+	return cmd
+}
+
+func runReconcile(ctx context.Context, logger *log.Logger, k kind, name string, opts *reconcileOptions) error {
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	var resource dynamic.ResourceInterface = c.Dynamic.Resource(k.gvr)
+	if k.namespace != "" {
+		resource = c.Dynamic.Resource(k.gvr).Namespace(opts.namespace)
+	}
+
+	obj, err := resource.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting %s %s: %w", k.display, name, err)
+	}
+	paused := obj.GetAnnotations()[PausedAnnotation] == "true"
+
+	annotations := map[string]interface{}{}
+	switch {
+	case opts.pause:
+		if paused {
+			logger.Info("already paused", k.name, name)
+			return nil
+		}
+		annotations[PausedAnnotation] = "true"
+	case opts.resume:
+		if !paused {
+			logger.Info("not paused", k.name, name)
+		}
+		annotations[PausedAnnotation] = nil
+		annotations[RequestedAtAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
+	default:
+		if paused {
+			logger.Warn("reconciliation is paused; the request takes effect on --resume", k.name, name)
+		}
+		annotations[RequestedAtAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := resource.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("annotating %s %s: %w", k.display, name, err)
+	}
+
+	switch {
+	case opts.pause:
+		logger.Success("reconciliation paused", k.name, name)
+	case opts.resume:
+		logger.Success("reconciliation resumed", k.name, name)
+	default:
+		logger.Success("reconcile requested", k.name, name)
+	}
+	return nil
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
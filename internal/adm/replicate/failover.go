@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicate
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/apidns"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// kubeconfigKeys are the Secret keys Steward has stored admin kubeconfigs
+// under, newest first
+var kubeconfigKeys = []string{"admin.conf", "kubeconfig", "value"}
+
+type failoverOptions struct {
+	kubeconfig string
+	endpoints  map[string]string
+	yes        bool
+}
+
+// NewFailoverCmd creates the failover command
+func NewFailoverCmd(logger *log.Logger) *cobra.Command {
+	opts := &failoverOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "failover",
+		Short: "Promote a standby management cluster",
+		Long: `Promote a standby management cluster kept in sync by 'butleradm replicate'.
+
+Run against the standby after losing the primary site:
+
+  1. Tenant admin kubeconfigs are pointed at the standby. Clusters with an
+     API DNS name use it, and their control plane Service is annotated so
+     external-dns moves the record. Others have their endpoint host
+     replaced using --endpoint OLD=NEW.
+  2. butler-controller is scaled back up and takes over reconciliation.
+  3. The standby marker is removed; the cluster is now the primary.
+
+Make sure the old primary is down or its controller stopped first: two
+controllers reconciling the same clusters will fight. Stop any
+'butleradm replicate' still writing to this cluster.
+
+Examples:
+  # Promote the standby
+  butleradm failover --kubeconfig ~/.kube/butler-standby.yaml
+
+  # Tenant endpoints moved to a new address range
+  butleradm failover --kubeconfig ~/.kube/butler-standby.yaml \
+    --endpoint 10.10.0.50=10.20.0.50 --endpoint 10.10.0.51=10.20.0.51`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFailover(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to standby management cluster kubeconfig")
+	cmd.Flags().StringToStringVar(&opts.endpoints, "endpoint", nil, "replace a tenant API endpoint host, as OLD=NEW (repeatable)")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "skip the confirmation prompt")
+
+	return cmd
+}
+
+func runFailover(ctx context.Context, logger *log.Logger, opts *failoverOptions) error {
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to standby management cluster: %w", err)
+	}
+
+	standby, err := getStandby(ctx, c)
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("%s is not a standby: no %s/%s ConfigMap (seed it with 'butleradm replicate')",
+			c.Config.Host, platform.ConfigMapNamespace, standbyConfigMap)
+	}
+	if err != nil {
+		return fmt.Errorf("getting %s: %w", standbyConfigMap, err)
+	}
+
+	lag := "unknown"
+	if t, err := time.Parse(time.RFC3339, standby.Data[keyLastSync]); err == nil {
+		lag = time.Since(t).Round(time.Second).String()
+	}
+	replicas := int32(1)
+	if n, err := strconv.ParseInt(standby.Data[keyControllerReplicas], 10, 32); err == nil && n > 0 {
+		replicas = int32(n)
+	}
+
+	logger.Info("promoting standby", "cluster", c.Config.Host, "primary", standby.Data[keySource],
+		"lastSync", standby.Data[keyLastSync], "lag", lag)
+	if !opts.yes {
+		ok, err := prompt.Confirm("Promote " + c.Config.Host + "? Changes on the primary since the last sync are lost.")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted")
+		}
+	}
+
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing TenantClusters: %w", err)
+	}
+	var unchanged []string
+	for i := range list.Items {
+		tc := &list.Items[i]
+		rewritten, err := rewriteEndpoint(ctx, c, tc, opts.endpoints)
+		if err != nil {
+			return err
+		}
+		if !rewritten {
+			unchanged = append(unchanged, tc.GetNamespace()+"/"+tc.GetName())
+		}
+	}
+	if len(unchanged) > 0 {
+		logger.Warn("kubeconfig endpoints left unchanged; pass --endpoint OLD=NEW for them or fix by hand",
+			"clusters", strings.Join(unchanged, ", "))
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas))
+	_, err = c.Clientset.AppsV1().Deployments(platform.ConfigMapNamespace).Patch(ctx, controllerDeployment, types.MergePatchType, patch, metav1.PatchOptions{})
+	if errors.IsNotFound(err) {
+		logger.Warn("no butler-controller Deployment on this cluster; install Butler before using it as primary")
+	} else if err != nil {
+		return fmt.Errorf("resuming %s: %w", controllerDeployment, err)
+	}
+
+	err = c.Clientset.CoreV1().ConfigMaps(platform.ConfigMapNamespace).Delete(ctx, standbyConfigMap, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("deleting %s: %w", standbyConfigMap, err)
+	}
+
+	logger.Success("standby promoted", "cluster", c.Config.Host, "clusters", len(list.Items), "controllerReplicas", replicas)
+	return nil
+}
+
+// rewriteEndpoint points a tenant's admin kubeconfig at its endpoint on
+// this site. It reports false when there is nothing to point it at.
+func rewriteEndpoint(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, endpoints map[string]string) (bool, error) {
+	tenantNS, _, _ := unstructured.NestedString(tc.Object, "status", "tenantNamespace")
+	if tenantNS == "" {
+		return false, nil
+	}
+	secrets := c.Clientset.CoreV1().Secrets(tenantNS)
+	secretName := adminKubeconfigSecret(tc.GetName())
+	secret, err := secrets.Get(ctx, secretName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting kubeconfig secret %s/%s: %w", tenantNS, secretName, err)
+	}
+
+	key := ""
+	for _, k := range kubeconfigKeys {
+		if _, ok := secret.Data[k]; ok {
+			key = k
+			break
+		}
+	}
+	if key == "" {
+		return false, nil
+	}
+
+	var data []byte
+	if hostname := apidns.Hostname(tc); hostname != "" {
+		if _, err := apidns.Publish(ctx, c, tc); err != nil {
+			return false, err
+		}
+		data, err = apidns.RewriteServer(secret.Data[key], hostname)
+	} else {
+		data, err = replaceServerHosts(secret.Data[key], endpoints)
+	}
+	if err != nil {
+		return false, fmt.Errorf("rewriting kubeconfig of %s/%s: %w", tc.GetNamespace(), tc.GetName(), err)
+	}
+	if data == nil {
+		return false, nil
+	}
+
+	secret.Data[key] = data
+	if _, err := secrets.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return false, fmt.Errorf("updating kubeconfig secret %s/%s: %w", tenantNS, secretName, err)
+	}
+	return true, nil
+}
+
+// replaceServerHosts swaps kubeconfig server hosts found in endpoints,
+// keeping the port. It returns nil when no server matched.
+func replaceServerHosts(kubeconfig []byte, endpoints map[string]string) ([]byte, error) {
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+	changed := false
+	for name, cluster := range cfg.Clusters {
+		u, err := url.Parse(cluster.Server)
+		if err != nil {
+			return nil, fmt.Errorf("parsing server of cluster %s: %w", name, err)
+		}
+		host, ok := endpoints[u.Hostname()]
+		if !ok {
+			continue
+		}
+		if port := u.Port(); port != "" {
+			u.Host = net.JoinHostPort(host, port)
+		} else {
+			u.Host = host
+		}
+		cluster.Server = u.String()
+		changed = true
+	}
+	if !changed {
+		return nil, nil
+	}
+	return clientcmd.Write(*cfg)
+}
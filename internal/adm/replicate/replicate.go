@@ -0,0 +1,531 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replicate implements butleradm commands that keep a standby
+// management cluster in sync with the primary and promote it on failover.
+package replicate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// fieldManager owns the replicated fields on the standby
+	fieldManager = "butleradm-replicate"
+
+	// replicatedLabel marks objects written by replication, so ones
+	// deleted on the primary can be pruned from the standby
+	replicatedLabel = "butler.butlerlabs.dev/replicated"
+
+	// standbyConfigMap in butler-system marks a cluster as a standby and
+	// records the last sync
+	standbyConfigMap = "butler-standby"
+
+	// controllerDeployment is paused on the standby so it doesn't
+	// provision the replicated TenantClusters a second time
+	controllerDeployment = "butler-controller"
+)
+
+// Keys of the standby ConfigMap
+const (
+	keySource             = "source"
+	keyLastSync           = "lastSync"
+	keyControllerReplicas = "controllerReplicas"
+)
+
+var configMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+// replicatedResource is a kind of object copied to the standby
+type replicatedResource struct {
+	kind string
+	gvr  schema.GroupVersionResource
+
+	// namespace and name narrow replication to a single object
+	namespace string
+	name      string
+}
+
+// replicatedResources are copied in this order: configuration before the
+// clusters using it
+var replicatedResources = []replicatedResource{
+	{kind: "ConfigMap", gvr: configMapGVR, namespace: platform.ConfigMapNamespace, name: platform.ConfigMapName},
+	{kind: "ButlerConfig", gvr: client.ButlerConfigGVR},
+	{kind: "ProviderConfig", gvr: client.ProviderConfigGVR},
+	{kind: "ClusterBootstrap", gvr: client.ClusterBootstrapGVR},
+	{kind: "Team", gvr: client.TeamGVR},
+	{kind: "User", gvr: client.UserGVR},
+	{kind: "TenantCluster", gvr: client.TenantClusterGVR},
+}
+
+type replicateOptions struct {
+	kubeconfig string
+	target     string
+	interval   time.Duration
+	once       bool
+}
+
+// NewReplicateCmd creates the replicate command
+func NewReplicateCmd(logger *log.Logger) *cobra.Command {
+	opts := &replicateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "replicate",
+		Short: "Keep a standby management cluster in sync",
+		Long: `Replicate Butler resources from this management cluster to a standby.
+
+Copies the platform's custom resources (TenantClusters, ProviderConfigs,
+ClusterBootstraps, Teams, Users, ButlerConfigs), the butler-platform
+ConfigMap, the Secrets they reference and each tenant's admin kubeconfig
+to a standby management cluster in another failure domain. Objects deleted
+on the primary are removed from the standby on the next pass.
+
+The standby's butler-controller is scaled to zero so it doesn't provision
+the replicated clusters a second time; 'butleradm failover' brings it back.
+Hosted control plane datastores are not copied: tenant clusters only
+survive a site loss if their datastore is replicated outside Butler.
+
+Runs until interrupted, syncing every --interval, so it can be left running
+as a Deployment. Use --once from cron or to seed a new standby.
+
+Examples:
+  # Sync continuously to the standby site
+  butleradm replicate --to ~/.kube/butler-standby.yaml
+
+  # One pass from an explicit primary
+  butleradm replicate --kubeconfig ~/.kube/butler-primary.yaml --to ~/.kube/butler-standby.yaml --once`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReplicate(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to primary management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.target, "to", "", "path to standby management cluster kubeconfig (required)")
+	cmd.Flags().DurationVar(&opts.interval, "interval", time.Minute, "time between syncs")
+	cmd.Flags().BoolVar(&opts.once, "once", false, "sync once and exit")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func runReplicate(ctx context.Context, logger *log.Logger, opts *replicateOptions) error {
+	if opts.interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	source, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to primary management cluster: %w", err)
+	}
+	target, err := client.NewFromKubeconfigOnly(opts.target)
+	if err != nil {
+		return fmt.Errorf("connecting to standby management cluster: %w", err)
+	}
+	if source.Config.Host == target.Config.Host {
+		return fmt.Errorf("primary and standby are the same cluster (%s)", source.Config.Host)
+	}
+
+	if _, err := getStandby(ctx, source); err == nil {
+		return fmt.Errorf("%s is a standby; replicate from the primary", source.Config.Host)
+	}
+
+	r := &replicator{source: source, target: target, logger: logger}
+	if opts.once {
+		return r.sync(ctx)
+	}
+
+	logger.Info("replicating", "from", source.Config.Host, "to", target.Config.Host, "interval", opts.interval)
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.sync(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			logger.Warn("sync failed, retrying", "error", err, "in", opts.interval)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// replicator copies Butler state from the primary to the standby
+type replicator struct {
+	source *client.Client
+	target *client.Client
+	logger *log.Logger
+}
+
+// sync makes one full pass: pause the standby controller, copy resources
+// and Secrets, prune what the primary no longer has, then record the sync
+func (r *replicator) sync(ctx context.Context) error {
+	start := time.Now()
+
+	replicas, err := r.pauseController(ctx)
+	if err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	secrets := map[string]bool{}
+	counts := map[string]int{}
+
+	for _, res := range replicatedResources {
+		objects, err := readResource(ctx, r.source, res)
+		if errors.IsNotFound(err) {
+			r.logger.Debug("skipping resource", "kind", res.kind, "error", err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for i := range objects {
+			obj := &objects[i]
+			for _, ref := range secretRefs(obj) {
+				secrets[ref] = true
+			}
+			if err := r.applyObject(ctx, res.gvr, obj); err != nil {
+				return err
+			}
+			seen[objectKey(res.gvr, obj.GetNamespace(), obj.GetName())] = true
+			counts[res.kind]++
+		}
+	}
+
+	for ref := range secrets {
+		copied, err := r.copySecret(ctx, ref)
+		if err != nil {
+			return err
+		}
+		if copied {
+			namespace, name, _ := strings.Cut(ref, "/")
+			seen[objectKey(secretGVR, namespace, name)] = true
+			counts["Secret"]++
+		}
+	}
+
+	pruned, err := r.prune(ctx, seen)
+	if err != nil {
+		return err
+	}
+
+	if err := r.recordSync(ctx, replicas, start); err != nil {
+		return err
+	}
+
+	args := []interface{}{"pruned", pruned, "elapsed", time.Since(start).Round(time.Millisecond)}
+	for _, res := range replicatedResources {
+		if counts[res.kind] > 0 {
+			args = append(args, res.kind, counts[res.kind])
+		}
+	}
+	args = append(args, "Secret", counts["Secret"])
+	r.logger.Success("standby in sync", args...)
+	return nil
+}
+
+var secretGVR = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+// readResource lists the objects of a replicated kind
+func readResource(ctx context.Context, c *client.Client, res replicatedResource) ([]unstructured.Unstructured, error) {
+	if res.name != "" {
+		obj, err := c.Dynamic.Resource(res.gvr).Namespace(res.namespace).Get(ctx, res.name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return []unstructured.Unstructured{*obj}, nil
+	}
+
+	list, err := c.Dynamic.Resource(res.gvr).Namespace(res.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing %ss: %w", res.kind, err)
+	}
+	return list.Items, nil
+}
+
+// applyObject server-side applies an object to the standby, then its
+// status, which holds the tenant namespace and kubeconfig reference
+func (r *replicator) applyObject(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+	if obj.GetNamespace() != "" {
+		if err := ensureNamespace(ctx, r.target, obj.GetNamespace()); err != nil {
+			return err
+		}
+	}
+
+	status, hasStatus, _ := unstructured.NestedMap(obj.Object, "status")
+	cleanObject(obj)
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	resource := r.target.Dynamic.Resource(gvr).Namespace(obj.GetNamespace())
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return fmt.Errorf("encoding %s %s: %w", gvr.Resource, obj.GetName(), err)
+	}
+	force := true
+	if _, err := resource.Patch(ctx, obj.GetName(), types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: fieldManager, Force: &force}); err != nil {
+		return fmt.Errorf("applying %s %s: %w", gvr.Resource, obj.GetName(), err)
+	}
+
+	if !hasStatus || len(status) == 0 {
+		return nil
+	}
+	statusObj := map[string]interface{}{
+		"apiVersion": obj.GetAPIVersion(),
+		"kind":       obj.GetKind(),
+		"metadata":   map[string]interface{}{"name": obj.GetName(), "namespace": obj.GetNamespace()},
+		"status":     status,
+	}
+	data, err = json.Marshal(statusObj)
+	if err != nil {
+		return fmt.Errorf("encoding %s %s status: %w", gvr.Resource, obj.GetName(), err)
+	}
+	_, err = resource.Patch(ctx, obj.GetName(), types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: fieldManager, Force: &force}, "status")
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("applying %s %s status: %w", gvr.Resource, obj.GetName(), err)
+	}
+	return nil
+}
+
+// cleanObject drops server-populated metadata and finalizers, which the
+// paused standby controller could never clear, and labels the copy
+func cleanObject(obj *unstructured.Unstructured) {
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "selfLink", "ownerReferences", "finalizers"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations["kubectl.kubernetes.io/last-applied-configuration"]; ok {
+		delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+		obj.SetAnnotations(annotations)
+	}
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[replicatedLabel] = "true"
+	obj.SetLabels(labels)
+}
+
+// secretRefs returns the Secrets an object needs on the standby, as
+// namespace/name: a provider's spec.credentialsRef, a cluster's
+// status.kubeconfigSecretRef and its admin kubeconfig
+func secretRefs(obj *unstructured.Unstructured) []string {
+	var refs []string
+	for _, field := range [][]string{{"spec", "credentialsRef"}, {"status", "kubeconfigSecretRef"}} {
+		name, _, _ := unstructured.NestedString(obj.Object, append(field, "name")...)
+		if name == "" {
+			continue
+		}
+		namespace, _, _ := unstructured.NestedString(obj.Object, append(field, "namespace")...)
+		if namespace == "" {
+			namespace = obj.GetNamespace()
+		}
+		refs = append(refs, namespace+"/"+name)
+	}
+	if obj.GetKind() == "TenantCluster" {
+		if tenantNS, _, _ := unstructured.NestedString(obj.Object, "status", "tenantNamespace"); tenantNS != "" {
+			refs = append(refs, tenantNS+"/"+adminKubeconfigSecret(obj.GetName()))
+		}
+	}
+	return refs
+}
+
+// adminKubeconfigSecret is the Secret Steward keeps a tenant's admin
+// kubeconfig in
+func adminKubeconfigSecret(cluster string) string {
+	return cluster + "-admin-kubeconfig"
+}
+
+// copySecret copies a Secret to the standby. It reports false when the
+// Secret doesn't exist on the primary.
+func (r *replicator) copySecret(ctx context.Context, ref string) (bool, error) {
+	namespace, name, _ := strings.Cut(ref, "/")
+	secret, err := r.source.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		r.logger.Debug("referenced Secret not found, skipping", "secretRef", ref)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting Secret %s: %w", ref, err)
+	}
+	if err := ensureNamespace(ctx, r.target, namespace); err != nil {
+		return false, err
+	}
+
+	labels := map[string]string{replicatedLabel: "true"}
+	for k, v := range secret.Labels {
+		labels[k] = v
+	}
+	copied := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels, Annotations: secret.Annotations},
+		Type:       secret.Type,
+		Data:       secret.Data,
+	}
+	data, err := json.Marshal(copied)
+	if err != nil {
+		return false, fmt.Errorf("encoding Secret %s: %w", ref, err)
+	}
+	force := true
+	if _, err := r.target.Clientset.CoreV1().Secrets(namespace).Patch(ctx, name, types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: fieldManager, Force: &force}); err != nil {
+		return false, fmt.Errorf("applying Secret %s: %w", ref, err)
+	}
+	return true, nil
+}
+
+// prune deletes replicated objects on the standby that the primary no
+// longer has. It returns how many were deleted.
+func (r *replicator) prune(ctx context.Context, seen map[string]bool) (int, error) {
+	selector := metav1.ListOptions{LabelSelector: replicatedLabel + "=true"}
+	gvrs := []schema.GroupVersionResource{secretGVR}
+	for i := len(replicatedResources) - 1; i >= 0; i-- {
+		gvrs = append(gvrs, replicatedResources[i].gvr)
+	}
+
+	pruned := 0
+	for _, gvr := range gvrs {
+		list, err := r.target.Dynamic.Resource(gvr).List(ctx, selector)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return pruned, fmt.Errorf("listing replicated %s: %w", gvr.Resource, err)
+		}
+		for _, obj := range list.Items {
+			if seen[objectKey(gvr, obj.GetNamespace(), obj.GetName())] {
+				continue
+			}
+			err := r.target.Dynamic.Resource(gvr).Namespace(obj.GetNamespace()).Delete(ctx, obj.GetName(), metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return pruned, fmt.Errorf("deleting %s %s/%s: %w", gvr.Resource, obj.GetNamespace(), obj.GetName(), err)
+			}
+			r.logger.Info("removed from standby", "resource", gvr.Resource, "name", obj.GetNamespace()+"/"+obj.GetName())
+			pruned++
+		}
+	}
+	return pruned, nil
+}
+
+func objectKey(gvr schema.GroupVersionResource, namespace, name string) string {
+	return gvr.Resource + "/" + namespace + "/" + name
+}
+
+// pauseController scales the standby's butler-controller to zero. It
+// returns the replica count to restore on failover: the one recorded at
+// an earlier sync, or the Deployment's own.
+func (r *replicator) pauseController(ctx context.Context) (int32, error) {
+	var replicas int32 = 1
+	if cm, err := getStandby(ctx, r.target); err == nil {
+		if n, err := strconv.ParseInt(cm.Data[keyControllerReplicas], 10, 32); err == nil {
+			replicas = int32(n)
+		}
+	}
+
+	deployments := r.target.Clientset.AppsV1().Deployments(platform.ConfigMapNamespace)
+	deploy, err := deployments.Get(ctx, controllerDeployment, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return replicas, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("getting standby %s: %w", controllerDeployment, err)
+	}
+	if deploy.Spec.Replicas == nil || *deploy.Spec.Replicas == 0 {
+		return replicas, nil
+	}
+
+	replicas = *deploy.Spec.Replicas
+	patch := []byte(`{"spec":{"replicas":0}}`)
+	if _, err := deployments.Patch(ctx, controllerDeployment, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return 0, fmt.Errorf("pausing standby %s: %w", controllerDeployment, err)
+	}
+	r.logger.Info("paused standby controller", "deployment", controllerDeployment, "replicas", replicas)
+	return replicas, nil
+}
+
+// recordSync writes the standby ConfigMap
+func (r *replicator) recordSync(ctx context.Context, replicas int32, at time.Time) error {
+	cm := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: standbyConfigMap, Namespace: platform.ConfigMapNamespace},
+		Data: map[string]string{
+			keySource:             r.source.Config.Host,
+			keyLastSync:           at.UTC().Format(time.RFC3339),
+			keyControllerReplicas: strconv.Itoa(int(replicas)),
+		},
+	}
+	data, err := json.Marshal(cm)
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", standbyConfigMap, err)
+	}
+	force := true
+	if _, err := r.target.Clientset.CoreV1().ConfigMaps(platform.ConfigMapNamespace).Patch(ctx, standbyConfigMap, types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: fieldManager, Force: &force}); err != nil {
+		return fmt.Errorf("recording sync: %w", err)
+	}
+	return nil
+}
+
+// getStandby returns the standby ConfigMap, which only exists on a standby
+func getStandby(ctx context.Context, c *client.Client) (*corev1.ConfigMap, error) {
+	return c.Clientset.CoreV1().ConfigMaps(platform.ConfigMapNamespace).Get(ctx, standbyConfigMap, metav1.GetOptions{})
+}
+
+// ensureNamespace creates a namespace on the standby if it's missing
+func ensureNamespace(ctx context.Context, c *client.Client, name string) error {
+	_, err := c.Clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("getting namespace %s: %w", name, err)
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if _, err := c.Clientset.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating namespace %s: %w", name, err)
+	}
+	return nil
+}
+
+// getClient connects with an explicit kubeconfig on its own, as the global
+// --context names a context of the default kubeconfig, not of the primary
+// or standby one
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfigOnly(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
@@ -0,0 +1,466 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/talosctl"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// canarySecret is written to force a storage transformation before
+	// reading the API server's metrics
+	canarySecret    = "butler-encryption-canary"
+	canaryNamespace = "kube-system"
+
+	// transformationMetric counts how the API server transforms data on its way to etcd
+	transformationMetric = "apiserver_storage_transformation_operations_total"
+)
+
+// providerPrefixes maps etcd value prefixes to encryption providers
+var providerPrefixes = map[string]string{
+	"identity":              "none",
+	"k8s:enc:secretbox:v1:": "secretbox",
+	"k8s:enc:aescbc:v1:":    "aescbc",
+	"k8s:enc:aesgcm:v1:":    "aesgcm",
+	"k8s:enc:kms:v1:":       "kms (v1)",
+	"k8s:enc:kms:v2:":       "kms (v2)",
+}
+
+func newEncryptionCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encryption",
+		Short: "Verify and configure secrets encryption at rest",
+		Long: `Verify and configure encryption of Kubernetes Secrets at rest in etcd.
+
+Commands:
+  status  Show which encryption provider Secrets are written with
+  enable  Configure secretbox or KMS encryption on the management cluster`,
+	}
+
+	cmd.AddCommand(newEncryptionStatusCmd(logger))
+	cmd.AddCommand(newEncryptionEnableCmd(logger))
+
+	return cmd
+}
+
+func newEncryptionStatusCmd(logger *log.Logger) *cobra.Command {
+	var target targetFlags
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show which encryption provider Secrets are written with",
+		Long: `Verify secrets encryption at rest.
+
+A canary Secret is written to kube-system and the API server's storage
+transformation metrics are read back. They record the prefix of every value
+written to etcd (for example k8s:enc:secretbox:v1:), which shows the
+provider actually in use rather than what is configured.
+
+Examples:
+  # Management cluster
+  butleradm security encryption status
+
+  # Tenant cluster
+  butleradm security encryption status --cluster payments -n team-payments`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEncryptionStatus(cmd.Context(), logger, &target)
+		},
+	}
+
+	addTargetFlags(cmd, &target)
+
+	return cmd
+}
+
+func runEncryptionStatus(ctx context.Context, logger *log.Logger, target *targetFlags) error {
+	_, c, err := target.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	providers, err := verifyEncryption(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	table := output.NewTable(os.Stdout, "PROVIDER", "PREFIX", "WRITES")
+	for _, p := range providers {
+		name := output.Success(p.provider)
+		if p.provider == "none" {
+			name = output.Warning(p.provider)
+		}
+		table.AddRow(name, p.prefix, strconv.FormatFloat(p.count, 'f', 0, 64))
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	switch {
+	case len(providers) == 0:
+		logger.Warn("API server reported no storage transformations; encryption state unknown", "target", target.name())
+	case providers[0].provider != "none":
+		logger.Success("Secrets are encrypted at rest", "target", target.name(), "provider", providers[0].provider)
+	default:
+		logger.Warn("Secrets are written to etcd unencrypted", "target", target.name())
+		if target.cluster == "" {
+			logger.Info("Enable with: butleradm security encryption enable")
+		}
+	}
+	return nil
+}
+
+// providerUsage is how often the API server wrote data with a provider
+type providerUsage struct {
+	provider string
+	prefix   string
+	count    float64
+}
+
+// verifyEncryption writes a canary Secret and reads the storage transformation
+// metrics to find which providers are used for writes. Results are sorted by
+// count, so the first entry is the provider currently in use.
+func verifyEncryption(ctx context.Context, c *client.Client) ([]providerUsage, error) {
+	secrets := c.Clientset.CoreV1().Secrets(canaryNamespace)
+	canary := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: canarySecret},
+		StringData: map[string]string{"checked": time.Now().UTC().Format(time.RFC3339)},
+	}
+	if _, err := secrets.Create(ctx, canary, metav1.CreateOptions{}); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("writing canary secret: %w", err)
+		}
+		if _, err := secrets.Update(ctx, canary, metav1.UpdateOptions{}); err != nil {
+			return nil, fmt.Errorf("writing canary secret: %w", err)
+		}
+	}
+	defer func() {
+		_ = secrets.Delete(context.Background(), canarySecret, metav1.DeleteOptions{})
+	}()
+
+	return storageTransformations(ctx, c)
+}
+
+// storageTransformations reads the providers the API server has written Secrets with
+func storageTransformations(ctx context.Context, c *client.Client) ([]providerUsage, error) {
+	raw, err := c.Clientset.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading API server metrics: %w", err)
+	}
+
+	return parseTransformationMetrics(raw), nil
+}
+
+// parseTransformationMetrics extracts to_storage write counts per prefix.
+// When the metric carries a resource label only secrets are counted.
+func parseTransformationMetrics(raw []byte) []providerUsage {
+	counts := map[string]float64{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, transformationMetric+"{") {
+			continue
+		}
+		labelEnd := strings.LastIndex(line, "}")
+		if labelEnd < 0 {
+			continue
+		}
+		labels := parseLabels(line[len(transformationMetric)+1 : labelEnd])
+		if labels["transformation_type"] != "to_storage" || labels["status"] != "OK" {
+			continue
+		}
+		if resource, ok := labels["resource"]; ok && resource != "secrets" {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(line[labelEnd+1:]), 64)
+		if err != nil || value == 0 {
+			continue
+		}
+		counts[labels["transformer_prefix"]] += value
+	}
+
+	usage := make([]providerUsage, 0, len(counts))
+	for prefix, count := range counts {
+		provider, ok := providerPrefixes[prefix]
+		if !ok {
+			provider = "unknown"
+		}
+		usage = append(usage, providerUsage{provider: provider, prefix: prefix, count: count})
+	}
+	sort.Slice(usage, func(i, j int) bool { return usage[i].count > usage[j].count })
+	return usage
+}
+
+// parseLabels parses the label set of a Prometheus text-format sample
+func parseLabels(s string) map[string]string {
+	labels := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		labels[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	return labels
+}
+
+type enableOptions struct {
+	target         targetFlags
+	provider       string
+	kmsName        string
+	kmsEndpoint    string
+	talosconfig    string
+	rewriteSecrets bool
+}
+
+func newEncryptionEnableCmd(logger *log.Logger) *cobra.Command {
+	opts := &enableOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Configure secretbox or KMS encryption on the management cluster",
+		Long: `Configure secrets encryption at rest on the management cluster.
+
+The Talos machine configuration of every control plane node is patched with
+talosctl. With --provider secretbox a random key is generated (skipped if the
+nodes already have one). With --provider kms an EncryptionConfiguration that
+calls the KMS v2 plugin at --kms-endpoint is installed; an existing secretbox
+key is kept as a read fallback so existing data stays readable.
+
+Existing Secrets remain in their old format until rewritten; pass
+--rewrite-secrets to rewrite every Secret once the API servers restart.
+
+Tenant control planes are configured by Steward and are not changed here;
+use 'encryption status --cluster' to verify them.
+
+Examples:
+  # Enable secretbox encryption
+  butleradm security encryption enable
+
+  # Use an external KMS plugin listening on each control plane node
+  butleradm security encryption enable --provider kms \
+    --kms-name vault --kms-endpoint unix:///var/run/kms/vault.sock`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEncryptionEnable(cmd.Context(), logger, opts)
+		},
+	}
+
+	addTargetFlags(cmd, &opts.target)
+	cmd.Flags().StringVar(&opts.provider, "provider", "secretbox", "encryption provider (secretbox, kms)")
+	cmd.Flags().StringVar(&opts.kmsName, "kms-name", "butler-kms", "KMS provider name (with --provider kms)")
+	cmd.Flags().StringVar(&opts.kmsEndpoint, "kms-endpoint", "", "KMS plugin socket, e.g. unix:///var/run/kms/plugin.sock (with --provider kms)")
+	cmd.Flags().StringVar(&opts.talosconfig, "talosconfig", "", "path to talosconfig (default: $TALOSCONFIG or ~/.butler/<cluster>-talosconfig)")
+	cmd.Flags().BoolVar(&opts.rewriteSecrets, "rewrite-secrets", false, "rewrite all Secrets so they are stored with the new provider")
+
+	return cmd
+}
+
+func runEncryptionEnable(ctx context.Context, logger *log.Logger, opts *enableOptions) error {
+	if opts.target.cluster != "" {
+		return fmt.Errorf("tenant control plane encryption is managed by Steward and cannot be changed from butleradm; "+
+			"verify it with 'butleradm security encryption status --cluster %s'", opts.target.cluster)
+	}
+
+	switch opts.provider {
+	case "secretbox":
+	case "kms":
+		if opts.kmsEndpoint == "" {
+			return fmt.Errorf("--kms-endpoint is required with --provider kms")
+		}
+		if !strings.HasPrefix(opts.kmsEndpoint, "unix://") {
+			return fmt.Errorf("--kms-endpoint must be a unix:// socket path")
+		}
+	default:
+		return fmt.Errorf("unknown provider %q (valid: secretbox, kms)", opts.provider)
+	}
+
+	_, c, err := opts.target.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	talos, err := talosctl.New(opts.talosconfig)
+	if err != nil {
+		return err
+	}
+	defer talos.Close()
+
+	nodes, err := controlPlaneIPs(ctx, c)
+	if err != nil {
+		return err
+	}
+	logger.Info("configuring encryption", "provider", opts.provider, "controlPlanes", len(nodes))
+
+	// Every control plane must share the same key, so read it from the first node
+	secretboxKey, err := readSecretboxKey(ctx, talos, nodes[0])
+	if err != nil {
+		return err
+	}
+
+	var patch string
+	switch opts.provider {
+	case "secretbox":
+		if secretboxKey != "" {
+			logger.Success("secretbox encryption is already configured")
+			return nil
+		}
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return fmt.Errorf("generating key: %w", err)
+		}
+		patch = fmt.Sprintf("cluster:\n  secretboxEncryptionSecret: %s\n", base64.StdEncoding.EncodeToString(key))
+	case "kms":
+		patch = kmsPatch(opts.kmsName, opts.kmsEndpoint, secretboxKey)
+	}
+
+	for _, node := range nodes {
+		if err := talos.PatchMachineConfig(ctx, node, patch); err != nil {
+			return fmt.Errorf("patching control plane %s: %w", node, err)
+		}
+		logger.Success("control plane patched", "node", node)
+	}
+
+	if opts.rewriteSecrets {
+		logger.Waiting("waiting for API servers to restart")
+		if err := waiter.Sleep(ctx, 30*time.Second); err != nil {
+			return fmt.Errorf("interrupted while waiting for API servers: %w", err)
+		}
+		if err := rewriteSecrets(ctx, c, logger); err != nil {
+			return err
+		}
+	} else {
+		logger.Info("existing Secrets keep their old format until rewritten; re-run with --rewrite-secrets")
+	}
+
+	logger.Info("verify with: butleradm security encryption status")
+	return nil
+}
+
+// kmsPatch builds a Talos config patch installing a KMS v2 EncryptionConfiguration.
+// Talos names its secretbox key "key2"; keeping that name lets existing values decrypt.
+func kmsPatch(name, endpoint, secretboxKey string) string {
+	var providers strings.Builder
+	fmt.Fprintf(&providers, "              - kms:\n")
+	fmt.Fprintf(&providers, "                  apiVersion: v2\n")
+	fmt.Fprintf(&providers, "                  name: %s\n", name)
+	fmt.Fprintf(&providers, "                  endpoint: %s\n", endpoint)
+	if secretboxKey != "" {
+		fmt.Fprintf(&providers, "              - secretbox:\n")
+		fmt.Fprintf(&providers, "                  keys:\n")
+		fmt.Fprintf(&providers, "                    - name: key2\n")
+		fmt.Fprintf(&providers, "                      secret: %s\n", secretboxKey)
+	}
+	fmt.Fprintf(&providers, "              - identity: {}\n")
+
+	socketDir := endpoint[len("unix://"):]
+	if i := strings.LastIndex(socketDir, "/"); i > 0 {
+		socketDir = socketDir[:i]
+	}
+
+	return fmt.Sprintf(`machine:
+  files:
+    - path: /var/etc/kubernetes/butler-encryption.yaml
+      permissions: 0o600
+      op: create
+      content: |
+        apiVersion: apiserver.config.k8s.io/v1
+        kind: EncryptionConfiguration
+        resources:
+          - resources:
+              - secrets
+            providers:
+%scluster:
+  apiServer:
+    extraArgs:
+      encryption-provider-config: /etc/kubernetes/butler/butler-encryption.yaml
+    extraVolumes:
+      - hostPath: /var/etc/kubernetes
+        mountPath: /etc/kubernetes/butler
+        readonly: true
+      - hostPath: %s
+        mountPath: %s
+`, providers.String(), socketDir, socketDir)
+}
+
+// controlPlaneIPs returns the InternalIPs of control plane nodes
+func controlPlaneIPs(ctx context.Context, c *client.Client) ([]string, error) {
+	nodes, err := c.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: "node-role.kubernetes.io/control-plane",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing control plane nodes: %w", err)
+	}
+
+	var ips []string
+	for _, node := range nodes.Items {
+		for _, addr := range node.Status.Addresses {
+			if addr.Type == corev1.NodeInternalIP {
+				ips = append(ips, addr.Address)
+				break
+			}
+		}
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("no control plane nodes found")
+	}
+	return ips, nil
+}
+
+// rewriteSecrets updates every Secret in place so it is re-encrypted
+func rewriteSecrets(ctx context.Context, c *client.Client, logger *log.Logger) error {
+	list, err := c.Clientset.CoreV1().Secrets("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing secrets: %w", err)
+	}
+
+	failed := 0
+	for i := range list.Items {
+		s := &list.Items[i]
+		if _, err := c.Clientset.CoreV1().Secrets(s.Namespace).Update(ctx, s, metav1.UpdateOptions{}); err != nil {
+			logger.Debug("could not rewrite secret", "secretRef", s.Namespace+"/"+s.Name, "error", err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("rewrote %d of %d secrets; %d failed (re-run with -v for details)", len(list.Items)-failed, len(list.Items), failed)
+	}
+	logger.Success("secrets rewritten", "count", len(list.Items))
+	return nil
+}
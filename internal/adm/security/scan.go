@@ -0,0 +1,599 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Check statuses
+const (
+	StatusPass = "PASS"
+	StatusWarn = "WARN"
+	StatusFail = "FAIL"
+	StatusSkip = "SKIP"
+)
+
+// consoleAdminSecret holds the initial Butler Console admin password
+const consoleAdminSecret = "butler-console-admin"
+
+// weakPasswords are well-known defaults the console password must not be
+var weakPasswords = map[string]bool{
+	"admin":    true,
+	"butler":   true,
+	"password": true,
+	"changeme": true,
+}
+
+// severityWeight weighs checks when computing the score
+var severityWeight = map[string]int{
+	"high":   3,
+	"medium": 2,
+	"low":    1,
+}
+
+// Finding is the result of one check
+type Finding struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Severity string `json:"severity"`
+	Status   string `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+// Report is the result of a scan
+type Report struct {
+	Target   string    `json:"target"`
+	Score    int       `json:"score"`
+	Findings []Finding `json:"findings"`
+}
+
+// check is a single posture check
+type check struct {
+	id       string
+	title    string
+	severity string
+	run      func(ctx context.Context, c *client.Client) (status, detail string, err error)
+}
+
+// cisChecks follow the CIS Kubernetes Benchmark where it can be assessed through the API
+var cisChecks = []check{
+	{"1.2.27", "Secrets are encrypted at rest", "high", checkEncryption},
+	{"5.1.1", "cluster-admin is only bound where required", "medium", checkClusterAdminBindings},
+	{"5.1.3", "ClusterRoles avoid wildcards", "medium", checkWildcardClusterRoles},
+	{"5.1.5", "Default ServiceAccounts do not mount tokens", "low", checkDefaultServiceAccounts},
+	{"5.2.1", "Namespaces enforce a Pod Security Standard", "medium", checkPodSecurityLabels},
+	{"5.2.2", "No privileged containers", "high", checkPrivilegedContainers},
+	{"5.2.3", "No host namespace sharing", "high", checkHostNamespaces},
+	{"5.3.2", "Namespaces have NetworkPolicies", "medium", checkNetworkPolicies},
+	{"5.4.1", "Secrets are mounted as files, not env vars", "low", checkSecretEnvVars},
+}
+
+// butlerChecks cover Butler platform settings on the management cluster
+var butlerChecks = []check{
+	{"BTL-1", "ProviderConfigs verify TLS certificates", "high", checkInsecureProviders},
+	{"BTL-2", "Console admin password has been rotated", "high", checkConsolePassword},
+	{"BTL-3", "No wildcard RBAC for butler-system", "high", checkButlerWildcardRBAC},
+}
+
+type scanOptions struct {
+	target       targetFlags
+	outputFormat string
+	failUnder    int
+}
+
+func newScanCmd(logger *log.Logger) *cobra.Command {
+	opts := &scanOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "scan",
+		Short: "Run a security posture scan",
+		Long: `Run a security posture scan and print a scored report.
+
+Checks follow the CIS Kubernetes Benchmark where they can be assessed
+through the Kubernetes API (RBAC, pod security, network policies, secrets
+handling, encryption at rest). Node-level checks that need host access are
+not covered; run kube-bench on the nodes for those.
+
+On the management cluster Butler-specific checks are added:
+  BTL-1  ProviderConfigs with TLS verification disabled (insecure: true)
+  BTL-2  Initial console admin password still set
+  BTL-3  Wildcard RBAC granted to butler-system
+
+The score is the severity-weighted share of passing checks; warnings count
+half. Skipped checks are not scored.
+
+Examples:
+  # Scan the management cluster
+  butleradm security scan
+
+  # Scan a tenant cluster as JSON
+  butleradm security scan --cluster payments -n team-payments -o json
+
+  # Fail a CI job when the score drops below 80
+  butleradm security scan --fail-under 80`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runScan(cmd.Context(), logger, opts)
+		},
+	}
+
+	addTargetFlags(cmd, &opts.target)
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.Flags().IntVar(&opts.failUnder, "fail-under", 0, "exit with an error if the score is below this value (0-100)")
+
+	return cmd
+}
+
+func runScan(ctx context.Context, logger *log.Logger, opts *scanOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+	if opts.failUnder < 0 || opts.failUnder > 100 {
+		return fmt.Errorf("--fail-under must be between 0 and 100")
+	}
+
+	mgmt, target, err := opts.target.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("scanning", "target", opts.target.name())
+
+	report := &Report{Target: opts.target.name()}
+	report.Findings = runChecks(ctx, target, cisChecks)
+	if opts.target.cluster == "" {
+		report.Findings = append(report.Findings, runChecks(ctx, mgmt, butlerChecks)...)
+	}
+	report.Score = score(report.Findings)
+
+	if err := output.NewPrinter(format, os.Stdout).Print(report, func(w io.Writer) error {
+		return printReport(w, report)
+	}); err != nil {
+		return err
+	}
+
+	if report.Score < opts.failUnder {
+		return fmt.Errorf("score %d is below --fail-under %d", report.Score, opts.failUnder)
+	}
+	return nil
+}
+
+// runChecks runs checks in order; a check that cannot run is skipped
+func runChecks(ctx context.Context, c *client.Client, checks []check) []Finding {
+	findings := make([]Finding, 0, len(checks))
+	for _, chk := range checks {
+		status, detail, err := chk.run(ctx, c)
+		if err != nil {
+			status, detail = StatusSkip, err.Error()
+		}
+		findings = append(findings, Finding{
+			ID:       chk.id,
+			Title:    chk.title,
+			Severity: chk.severity,
+			Status:   status,
+			Detail:   detail,
+		})
+	}
+	return findings
+}
+
+// score returns the severity-weighted pass rate, 0-100
+func score(findings []Finding) int {
+	var earned, total int
+	for _, f := range findings {
+		weight := severityWeight[f.Severity] * 2
+		switch f.Status {
+		case StatusPass:
+			earned += weight
+		case StatusWarn:
+			earned += weight / 2
+		case StatusSkip:
+			continue
+		}
+		total += weight
+	}
+	if total == 0 {
+		return 100
+	}
+	return earned * 100 / total
+}
+
+func printReport(w io.Writer, report *Report) error {
+	table := output.NewTable(w, "ID", "SEVERITY", "STATUS", "CHECK", "DETAIL")
+	for _, f := range report.Findings {
+		status := f.Status
+		switch f.Status {
+		case StatusPass:
+			status = output.Success(status)
+		case StatusWarn:
+			status = output.Warning(status)
+		case StatusFail:
+			status = output.Danger(status)
+		case StatusSkip:
+			status = output.Dim(status)
+		}
+		table.AddRow(f.ID, f.Severity, status, f.Title, orDash(f.Detail))
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	scoreStr := fmt.Sprintf("%d/100", report.Score)
+	switch {
+	case report.Score >= 90:
+		scoreStr = output.Success(scoreStr)
+	case report.Score >= 70:
+		scoreStr = output.Warning(scoreStr)
+	default:
+		scoreStr = output.Danger(scoreStr)
+	}
+	fmt.Fprintf(w, "\nScore for %s: %s\n", report.Target, output.Bold(scoreStr))
+	return nil
+}
+
+func checkEncryption(ctx context.Context, c *client.Client) (string, string, error) {
+	providers, err := storageTransformations(ctx, c)
+	if err != nil {
+		return "", "", err
+	}
+	if len(providers) == 0 {
+		return StatusSkip, "no writes recorded yet; run 'butleradm security encryption status'", nil
+	}
+	if providers[0].provider == "none" {
+		return StatusFail, "Secrets are written to etcd unencrypted", nil
+	}
+	return StatusPass, "provider " + providers[0].provider, nil
+}
+
+func checkClusterAdminBindings(ctx context.Context, c *client.Client) (string, string, error) {
+	bindings, err := c.Clientset.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing ClusterRoleBindings: %w", err)
+	}
+
+	var found []string
+	for _, b := range bindings.Items {
+		if b.RoleRef.Kind != "ClusterRole" || b.RoleRef.Name != "cluster-admin" || strings.HasPrefix(b.Name, "system:") {
+			continue
+		}
+		for _, s := range b.Subjects {
+			if s.Kind == rbacv1.ServiceAccountKind && isSystemNamespace(s.Namespace) {
+				continue
+			}
+			if strings.HasPrefix(s.Name, "system:") {
+				continue
+			}
+			found = append(found, b.Name)
+			break
+		}
+	}
+	if len(found) > 0 {
+		return StatusWarn, "review bindings: " + summarize(found), nil
+	}
+	return StatusPass, "", nil
+}
+
+func checkWildcardClusterRoles(ctx context.Context, c *client.Client) (string, string, error) {
+	roles, err := c.Clientset.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing ClusterRoles: %w", err)
+	}
+
+	var found []string
+	for _, r := range roles.Items {
+		// The built-in aggregated roles are expected to be broad
+		if strings.HasPrefix(r.Name, "system:") || r.Name == "cluster-admin" || r.Name == "admin" || r.Name == "edit" {
+			continue
+		}
+		if hasWildcard(r.Rules) {
+			found = append(found, r.Name)
+		}
+	}
+	if len(found) > 0 {
+		return StatusWarn, summarize(found), nil
+	}
+	return StatusPass, "", nil
+}
+
+func checkDefaultServiceAccounts(ctx context.Context, c *client.Client) (string, string, error) {
+	accounts, err := c.Clientset.CoreV1().ServiceAccounts("").List(ctx, metav1.ListOptions{FieldSelector: "metadata.name=default"})
+	if err != nil {
+		return "", "", fmt.Errorf("listing ServiceAccounts: %w", err)
+	}
+
+	var found []string
+	for _, sa := range accounts.Items {
+		if isSystemNamespace(sa.Namespace) {
+			continue
+		}
+		if sa.AutomountServiceAccountToken == nil || *sa.AutomountServiceAccountToken {
+			found = append(found, sa.Namespace)
+		}
+	}
+	if len(found) > 0 {
+		return StatusWarn, "namespaces: " + summarize(found), nil
+	}
+	return StatusPass, "", nil
+}
+
+func checkPodSecurityLabels(ctx context.Context, c *client.Client) (string, string, error) {
+	namespaces, err := c.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing namespaces: %w", err)
+	}
+
+	var found []string
+	for _, ns := range namespaces.Items {
+		if isSystemNamespace(ns.Name) {
+			continue
+		}
+		if _, ok := ns.Labels["pod-security.kubernetes.io/enforce"]; !ok {
+			found = append(found, ns.Name)
+		}
+	}
+	if len(found) > 0 {
+		return StatusWarn, "unlabeled: " + summarize(found), nil
+	}
+	return StatusPass, "", nil
+}
+
+func checkPrivilegedContainers(ctx context.Context, c *client.Client) (string, string, error) {
+	return checkPods(ctx, c, StatusFail, func(pod *corev1.Pod) bool {
+		for _, ctr := range containers(pod) {
+			if ctr.SecurityContext != nil && ctr.SecurityContext.Privileged != nil && *ctr.SecurityContext.Privileged {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func checkHostNamespaces(ctx context.Context, c *client.Client) (string, string, error) {
+	return checkPods(ctx, c, StatusFail, func(pod *corev1.Pod) bool {
+		return pod.Spec.HostPID || pod.Spec.HostIPC || pod.Spec.HostNetwork
+	})
+}
+
+func checkSecretEnvVars(ctx context.Context, c *client.Client) (string, string, error) {
+	return checkPods(ctx, c, StatusWarn, func(pod *corev1.Pod) bool {
+		for _, ctr := range containers(pod) {
+			for _, env := range ctr.Env {
+				if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+					return true
+				}
+			}
+			for _, from := range ctr.EnvFrom {
+				if from.SecretRef != nil {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// containers returns a pod's init and regular containers
+func containers(pod *corev1.Pod) []corev1.Container {
+	all := make([]corev1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	all = append(all, pod.Spec.InitContainers...)
+	return append(all, pod.Spec.Containers...)
+}
+
+// checkPods reports pods outside system namespaces matching violates
+func checkPods(ctx context.Context, c *client.Client, failStatus string, violates func(*corev1.Pod) bool) (string, string, error) {
+	pods, err := c.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing pods: %w", err)
+	}
+
+	var found []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if isSystemNamespace(pod.Namespace) {
+			continue
+		}
+		if violates(pod) {
+			found = append(found, pod.Namespace+"/"+pod.Name)
+		}
+	}
+	if len(found) > 0 {
+		return failStatus, summarize(found), nil
+	}
+	return StatusPass, "", nil
+}
+
+func checkNetworkPolicies(ctx context.Context, c *client.Client) (string, string, error) {
+	namespaces, err := c.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing namespaces: %w", err)
+	}
+	policies, err := c.Clientset.NetworkingV1().NetworkPolicies("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing NetworkPolicies: %w", err)
+	}
+
+	covered := map[string]bool{}
+	for _, p := range policies.Items {
+		covered[p.Namespace] = true
+	}
+
+	var found []string
+	for _, ns := range namespaces.Items {
+		if !isSystemNamespace(ns.Name) && !covered[ns.Name] {
+			found = append(found, ns.Name)
+		}
+	}
+	if len(found) > 0 {
+		return StatusWarn, "no policies: " + summarize(found), nil
+	}
+	return StatusPass, "", nil
+}
+
+func checkInsecureProviders(ctx context.Context, c *client.Client) (string, string, error) {
+	list, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing ProviderConfigs: %w", err)
+	}
+
+	var found []string
+	for _, pc := range list.Items {
+		if providerInsecure(&pc) {
+			found = append(found, pc.GetName())
+		}
+	}
+	if len(found) > 0 {
+		return StatusFail, "insecure: " + summarize(found) + "; see 'butleradm provider insecure'", nil
+	}
+	return StatusPass, "", nil
+}
+
+// providerInsecure reports whether a ProviderConfig disables TLS verification
+func providerInsecure(pc *unstructured.Unstructured) bool {
+	provider, _, _ := unstructured.NestedString(pc.Object, "spec", "provider")
+	if provider == "" {
+		return false
+	}
+	insecure, _, _ := unstructured.NestedBool(pc.Object, "spec", provider, "insecure")
+	return insecure
+}
+
+func checkConsolePassword(ctx context.Context, c *client.Client) (string, string, error) {
+	secret, err := c.Clientset.CoreV1().Secrets(butlerSystem).Get(ctx, consoleAdminSecret, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return StatusPass, "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("reading %s: %w", consoleAdminSecret, err)
+	}
+
+	password, ok := secret.Data["admin-password"]
+	if !ok {
+		return StatusPass, "", nil
+	}
+	if weakPasswords[strings.ToLower(string(password))] {
+		return StatusFail, "admin password is a well-known default", nil
+	}
+	return StatusWarn, "initial password is still stored in Secret " + consoleAdminSecret + "; rotate it and delete the Secret", nil
+}
+
+func checkButlerWildcardRBAC(ctx context.Context, c *client.Client) (string, string, error) {
+	rbac := c.Clientset.RbacV1()
+
+	clusterRoles, err := rbac.ClusterRoles().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing ClusterRoles: %w", err)
+	}
+	wildcardClusterRoles := map[string]bool{}
+	for _, r := range clusterRoles.Items {
+		if r.Name == "cluster-admin" || hasWildcard(r.Rules) {
+			wildcardClusterRoles[r.Name] = true
+		}
+	}
+
+	var found []string
+
+	roles, err := rbac.Roles(butlerSystem).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing Roles: %w", err)
+	}
+	for _, r := range roles.Items {
+		if hasWildcard(r.Rules) {
+			found = append(found, "Role/"+r.Name)
+		}
+	}
+
+	roleBindings, err := rbac.RoleBindings(butlerSystem).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing RoleBindings: %w", err)
+	}
+	for _, b := range roleBindings.Items {
+		if b.RoleRef.Kind == "ClusterRole" && wildcardClusterRoles[b.RoleRef.Name] {
+			found = append(found, "RoleBinding/"+b.Name)
+		}
+	}
+
+	clusterRoleBindings, err := rbac.ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("listing ClusterRoleBindings: %w", err)
+	}
+	for _, b := range clusterRoleBindings.Items {
+		if !wildcardClusterRoles[b.RoleRef.Name] {
+			continue
+		}
+		for _, s := range b.Subjects {
+			if s.Kind == rbacv1.ServiceAccountKind && s.Namespace == butlerSystem {
+				found = append(found, "ClusterRoleBinding/"+b.Name)
+				break
+			}
+		}
+	}
+
+	if len(found) > 0 {
+		return StatusFail, summarize(found), nil
+	}
+	return StatusPass, "", nil
+}
+
+// hasWildcard reports whether any rule grants * verbs, resources or API groups
+func hasWildcard(rules []rbacv1.PolicyRule) bool {
+	for _, rule := range rules {
+		for _, values := range [][]string{rule.Verbs, rule.Resources, rule.APIGroups} {
+			for _, v := range values {
+				if v == "*" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isSystemNamespace reports whether a namespace belongs to Kubernetes itself
+func isSystemNamespace(ns string) bool {
+	return strings.HasPrefix(ns, "kube-")
+}
+
+// summarize lists the first few names and counts the rest
+func summarize(names []string) string {
+	const limit = 3
+	if len(names) <= limit {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(names[:limit], ", "), len(names)-limit)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package security implements butleradm security commands.
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	butlerSystem = "butler-system"
+
+	// tenantDefault is the default namespace for TenantClusters
+	tenantDefault = "butler-tenants"
+)
+
+// NewSecurityCmd creates the security parent command
+func NewSecurityCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security",
+		Short: "Inspect and harden platform security settings",
+		Long: `Inspect and harden security settings of the management cluster and
+tenant clusters.
+
+Commands:
+  scan        Run a security posture scan
+  encryption  Verify and configure secrets encryption at rest
+
+Examples:
+  # Scored posture report for the management cluster
+  butleradm security scan
+
+  # Check whether Secrets are encrypted in etcd on the management cluster
+  butleradm security encryption status
+
+  # Check a tenant cluster
+  butleradm security encryption status --cluster payments`,
+	}
+
+	cmd.AddCommand(newScanCmd(logger))
+	cmd.AddCommand(newEncryptionCmd(logger))
+
+	return cmd
+}
+
+// targetFlags selects the management cluster or a tenant cluster
+type targetFlags struct {
+	kubeconfig string
+	cluster    string
+	namespace  string
+}
+
+func addTargetFlags(cmd *cobra.Command, t *targetFlags) {
+	cmd.Flags().StringVar(&t.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&t.cluster, "cluster", "", "tenant cluster to inspect (default: the management cluster)")
+	cmd.Flags().StringVarP(&t.namespace, "namespace", "n", tenantDefault, "namespace of the TenantCluster")
+}
+
+// name returns a display name for the target
+func (t *targetFlags) name() string {
+	if t.cluster == "" {
+		return "management cluster"
+	}
+	return "tenant cluster " + t.cluster
+}
+
+// connect returns the management client and a client for the target cluster
+func (t *targetFlags) connect(ctx context.Context) (mgmt, target *client.Client, err error) {
+	if t.kubeconfig != "" {
+		mgmt, err = client.NewFromKubeconfig(t.kubeconfig)
+	} else {
+		mgmt, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	if t.cluster == "" {
+		return mgmt, mgmt, nil
+	}
+
+	target, err = mgmt.NewForTenant(ctx, t.namespace, t.cluster)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connecting to tenant cluster %s: %w", t.cluster, err)
+	}
+	return mgmt, target, nil
+}
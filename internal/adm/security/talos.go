@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/butlerdotdev/butler/internal/common/talosctl"
+	"sigs.k8s.io/yaml"
+)
+
+// readSecretboxKey returns the secretbox key from a node's machine config, or ""
+func readSecretboxKey(ctx context.Context, t *talosctl.Talosctl, node string) (string, error) {
+	doc, err := t.ReadMachineConfig(ctx, node)
+	if err != nil {
+		return "", err
+	}
+
+	var config struct {
+		Cluster struct {
+			SecretboxEncryptionSecret string `json:"secretboxEncryptionSecret"`
+		} `json:"cluster"`
+	}
+	if err := yaml.Unmarshal(doc, &config); err != nil {
+		return "", fmt.Errorf("parsing machine config from %s: %w", node, err)
+	}
+	return config.Cluster.SecretboxEncryptionSecret, nil
+}
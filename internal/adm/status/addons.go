@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// component is a workload that runs an addon
+type component struct {
+	daemonSet   bool
+	namespace   string
+	names       []string // alternatives, first found wins
+	displayName string
+}
+
+// addonCatalog lists the components to check for each addon type, by the
+// categories of the bootstrap config's addons section
+var addonCatalog = map[string]map[string][]component{
+	"cni": {
+		"cilium": {
+			{daemonSet: true, namespace: "kube-system", names: []string{"cilium"}, displayName: "Cilium"},
+			{namespace: "kube-system", names: []string{"cilium-operator"}, displayName: "Cilium Operator"},
+		},
+		"calico": {
+			{daemonSet: true, namespace: "calico-system", names: []string{"calico-node"}, displayName: "Calico Node"},
+			{namespace: "calico-system", names: []string{"calico-kube-controllers"}, displayName: "Calico Controllers"},
+		},
+		"flannel": {
+			{daemonSet: true, namespace: "kube-flannel", names: []string{"kube-flannel-ds"}, displayName: "Flannel"},
+		},
+	},
+	"storage": {
+		"longhorn": {
+			{namespace: "longhorn-system", names: []string{"longhorn-driver-deployer"}, displayName: "Longhorn"},
+			{daemonSet: true, namespace: "longhorn-system", names: []string{"longhorn-manager"}, displayName: "Longhorn Manager"},
+		},
+		"local-path": {
+			{namespace: "local-path-storage", names: []string{"local-path-provisioner"}, displayName: "Local Path Provisioner"},
+		},
+	},
+	"loadBalancer": {
+		"metallb": {
+			{namespace: "metallb-system", names: []string{"metallb-controller", "controller"}, displayName: "MetalLB Controller"},
+			{daemonSet: true, namespace: "metallb-system", names: []string{"metallb-speaker", "speaker"}, displayName: "MetalLB Speaker"},
+		},
+	},
+	"gitOps": {
+		"flux": {
+			{namespace: "flux-system", names: []string{"source-controller"}, displayName: "Flux Source"},
+			{namespace: "flux-system", names: []string{"kustomize-controller"}, displayName: "Flux Kustomize"},
+			{namespace: "flux-system", names: []string{"helm-controller"}, displayName: "Flux Helm"},
+			{namespace: "flux-system", names: []string{"notification-controller"}, displayName: "Flux Notification"},
+		},
+		"argocd": {
+			{namespace: "argocd", names: []string{"argocd-server"}, displayName: "Argo CD Server"},
+			{namespace: "argocd", names: []string{"argocd-repo-server"}, displayName: "Argo CD Repo Server"},
+		},
+	},
+}
+
+// addonSelection maps addon categories to the type installed, e.g.
+// "cni" to "cilium". Categories without an addon are absent.
+type addonSelection map[string]string
+
+// loadAddonSelection reads spec.addons of the platform's ClusterBootstrap.
+// Without one, e.g. on a platform whose ClusterBootstrap was never moved
+// off the bootstrap cluster, it detects which catalog addons are running.
+func loadAddonSelection(ctx context.Context, c *client.Client) addonSelection {
+	list, err := c.Dynamic.Resource(client.ClusterBootstrapGVR).List(ctx, metav1.ListOptions{})
+	if err == nil && len(list.Items) > 0 {
+		sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].GetName() < list.Items[j].GetName() })
+		selection := addonSelection{}
+		for category := range addonCatalog {
+			addonType, _, _ := unstructured.NestedString(list.Items[0].Object, "spec", "addons", category, "type")
+			if addonType != "" && addonType != "none" {
+				selection[category] = addonType
+			}
+		}
+		return selection
+	}
+
+	selection := addonSelection{}
+	for category, types := range addonCatalog {
+		for addonType, components := range types {
+			if installed(ctx, c, components) {
+				selection[category] = addonType
+				break
+			}
+		}
+	}
+	return selection
+}
+
+// installed reports whether any of an addon's components exists
+func installed(ctx context.Context, c *client.Client, components []component) bool {
+	for _, comp := range components {
+		for _, name := range comp.names {
+			if comp.daemonSet && hasDaemonSet(ctx, c, comp.namespace, name) {
+				return true
+			}
+			if !comp.daemonSet && hasDeployment(ctx, c, comp.namespace, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkAddons checks the components of the selected addons in categories
+func checkAddons(ctx context.Context, c *client.Client, selection addonSelection, categories ...string) []workload {
+	var workloads []workload
+	for _, category := range categories {
+		addonType, ok := selection[category]
+		if !ok {
+			continue
+		}
+		components, known := addonCatalog[category][addonType]
+		if !known {
+			workloads = append(workloads, workload{
+				Name:    addonType,
+				State:   stateUnknown,
+				Message: fmt.Sprintf("%s addon without a status check", category),
+			})
+			continue
+		}
+		for _, comp := range components {
+			if comp.daemonSet {
+				workloads = append(workloads, checkDaemonSetPatterns(ctx, c, comp.namespace, comp.names, comp.displayName))
+			} else {
+				workloads = append(workloads, checkDeploymentPatterns(ctx, c, comp.namespace, comp.names, comp.displayName))
+			}
+		}
+	}
+	return workloads
+}
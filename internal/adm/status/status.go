@@ -22,27 +22,25 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/credstore"
 	"github.com/butlerdotdev/butler/internal/common/log"
 	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 const (
-	butlerSystem    = "butler-system"
-	butlerTenants   = "butler-tenants"
-	capiSystem      = "capi-system"
-	certManager     = "cert-manager"
-	longhornSystem  = "longhorn-system"
-	metallbSystem   = "metallb-system"
-	ciliumNamespace = "kube-system"
-	fluxSystem      = "flux-system"
+	butlerSystem  = "butler-system"
+	butlerTenants = "butler-tenants"
+	capiSystem    = "capi-system"
+	certManager   = "cert-manager"
 )
 
 // Styles for status output
@@ -63,8 +61,10 @@ var (
 )
 
 type statusOptions struct {
-	kubeconfig string
-	wide       bool
+	kubeconfig   string
+	outputFormat string
+	wide         bool
+	tenants      bool
 }
 
 // NewStatusCmd creates the status command
@@ -79,8 +79,9 @@ func NewStatusCmd(logger *log.Logger) *cobra.Command {
 Shows the status of:
   • Butler controllers (butler-controller, butler-bootstrap)
   • CAPI providers (capk, capx, capmox)
-  • Infrastructure addons (Steward, Cilium, Longhorn, MetalLB, cert-manager)
-  • GitOps components (Flux)
+  • Infrastructure addons (cert-manager plus the CNI, storage and load
+    balancer selected in the platform's ClusterBootstrap)
+  • GitOps components (Flux or Argo CD, when installed)
   • Provider configurations
   • Tenant cluster summary
 
@@ -93,20 +94,108 @@ Examples:
   # Check status of a specific management cluster
   butleradm status --kubeconfig ~/.butler/butler-ntnx-kubeconfig
 
-  # Show detailed status
-  butleradm status --wide`,
+  # Show detailed status, including how the platform was bootstrapped
+  butleradm status --wide
+
+  # Add a table of every tenant cluster's workers, control plane health,
+  # certificate expiry and load balancer pool
+  butleradm status --tenants
+
+  # Feed the status to monitoring
+  butleradm status --tenants -o json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runStatus(cmd.Context(), logger, opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
-	cmd.Flags().BoolVar(&opts.wide, "wide", false, "show detailed status")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, wide, json, yaml)")
+	cmd.Flags().BoolVar(&opts.wide, "wide", false, "show detailed status, including the bootstrap config and CLI version")
+	cmd.Flags().BoolVar(&opts.tenants, "tenants", false, "show a detail table for each tenant cluster")
 
 	return cmd
 }
 
+// report is everything status checks; it is printed as text or, with
+// -o json/yaml, as a single document
+type report struct {
+	ManagementCluster string                  `json:"managementCluster"`
+	KubernetesVersion string                  `json:"kubernetesVersion"`
+	PlatformVersion   string                  `json:"platformVersion,omitempty"`
+	Kubeconfig        string                  `json:"kubeconfigPath"`
+	Bootstrap         *platform.BootstrapInfo `json:"bootstrap,omitempty"`
+	Components        []workload              `json:"components"`
+	Addons            []workload              `json:"addons"`
+	GitOps            []workload              `json:"gitOps,omitempty"`
+	ProviderConfigs   []providerConfigStatus  `json:"providerConfigs"`
+	TenantClusters    tenantSummary           `json:"tenantClusters"`
+	TenantDetails     []tenantDetail          `json:"tenantDetails,omitempty"`
+
+	// Errors holds the sections that could not be read, by section key
+	Errors map[string]string `json:"errors,omitempty"`
+
+	wide    bool
+	tenants bool
+}
+
+// Section keys of report.Errors
+const (
+	sectionPlatformVersion = "platformVersion"
+	sectionBootstrap       = "bootstrap"
+	sectionProviderConfigs = "providerConfigs"
+	sectionTenantClusters  = "tenantClusters"
+	sectionTenantDetails   = "tenantDetails"
+)
+
+// Workload states
+const (
+	stateOK      = "ok"
+	stateWarn    = "warn"
+	stateError   = "error"
+	stateMissing = "missing"
+	stateUnknown = "unknown"
+)
+
+// workload is the readiness of a Deployment or DaemonSet
+type workload struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Workload  string `json:"workload,omitempty"`
+	Ready     int32  `json:"ready"`
+	Desired   int32  `json:"desired"`
+	State     string `json:"state"`
+	Message   string `json:"message,omitempty"`
+}
+
+// providerConfigStatus is a ProviderConfig as status shows it
+type providerConfigStatus struct {
+	Name      string `json:"name"`
+	Provider  string `json:"provider"`
+	Validated bool   `json:"validated"`
+	Insecure  bool   `json:"insecure,omitempty"`
+	Endpoint  string `json:"endpoint,omitempty"`
+}
+
+// tenantSummary counts tenant clusters by phase
+type tenantSummary struct {
+	Total    int            `json:"total"`
+	Phases   map[string]int `json:"phases,omitempty"`
+	Clusters []tenantStatus `json:"clusters,omitempty"`
+}
+
+// tenantStatus is a tenant cluster in the summary
+type tenantStatus struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+}
+
 func runStatus(ctx context.Context, logger *log.Logger, opts *statusOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
 	// Resolve kubeconfig
 	kubeconfigPath := opts.kubeconfig
 	if kubeconfigPath == "" {
@@ -123,15 +212,114 @@ func runStatus(ctx context.Context, logger *log.Logger, opts *statusOptions) err
 		return fmt.Errorf("connecting to cluster: %w", err)
 	}
 
-	// Get cluster info
+	r := &report{
+		Kubeconfig: kubeconfigPath,
+		wide:       opts.wide || format == output.FormatWide,
+		tenants:    opts.tenants,
+	}
+	if err := r.collect(ctx, c); err != nil {
+		return err
+	}
+
+	switch format {
+	case output.FormatJSON:
+		return output.PrintJSON(os.Stdout, r)
+	case output.FormatYAML:
+		return output.PrintYAML(os.Stdout, r)
+	}
+	r.print()
+	return nil
+}
+
+// collect runs every check. Only an unreachable API server is an error;
+// sections that fail on their own are recorded in r.Errors.
+func (r *report) collect(ctx context.Context, c *client.Client) error {
 	serverVersion, err := c.Clientset.Discovery().ServerVersion()
 	if err != nil {
 		return fmt.Errorf("getting server version: %w", err)
 	}
+	r.KubernetesVersion = serverVersion.GitVersion
+	r.ManagementCluster = extractClusterName(r.Kubeconfig)
+
+	if installed, err := platform.LoadInstalledVersion(ctx, c); err != nil {
+		r.fail(sectionPlatformVersion, err)
+	} else if installed != nil {
+		r.PlatformVersion = installed.Version
+	}
+
+	if r.wide {
+		if r.Bootstrap, err = platform.LoadBootstrapInfo(ctx, c); err != nil {
+			r.fail(sectionBootstrap, err)
+		}
+	}
+
+	// Components
+	r.Components = append(r.Components,
+		checkDeployment(ctx, c, butlerSystem, "butler-controller", "Butler Controller"),
+		checkDeployment(ctx, c, capiSystem, "capi-controller-manager", "CAPI Core"),
+	)
+
+	// CAPI providers - check common naming patterns; providers that aren't
+	// installed are left out
+	for _, p := range []struct {
+		name   string
+		checks []providerCheck
+	}{
+		{"nutanix", []providerCheck{
+			{"capx-system", "capx-controller-manager"},
+			{"capx-system", "controller-manager"},
+			{capiSystem, "capx-controller-manager"},
+			{"nutanix-system", "controller-manager"},
+		}},
+		{"harvester", []providerCheck{
+			{"capi-harvester-system", "capi-harvester-controller-manager"},
+			{capiSystem, "capi-harvester-controller-manager"},
+		}},
+		{"kubevirt", []providerCheck{
+			{"capk-system", "capk-controller-manager"},
+			{capiSystem, "capk-controller-manager"},
+		}},
+	} {
+		if w, found := checkCAPIProvider(ctx, c, p.name, p.checks); found {
+			r.Components = append(r.Components, w)
+		}
+	}
+
+	r.Components = append(r.Components, checkDeployment(ctx, c, "steward-system", "steward", "Steward"))
 
-	// Extract cluster name from kubeconfig path
-	clusterName := extractClusterName(kubeconfigPath)
+	// Infrastructure
+	r.Addons = append(r.Addons,
+		checkDeployment(ctx, c, certManager, "cert-manager", "cert-manager"),
+		checkDeployment(ctx, c, certManager, "cert-manager-webhook", "cert-manager webhook"),
+	)
+	addons := loadAddonSelection(ctx, c)
+	r.Addons = append(r.Addons, checkAddons(ctx, c, addons, "cni", "storage", "loadBalancer")...)
+	r.GitOps = checkAddons(ctx, c, addons, "gitOps")
 
+	if r.ProviderConfigs, err = listProviderConfigs(ctx, c); err != nil {
+		r.fail(sectionProviderConfigs, err)
+	}
+	if r.TenantClusters, err = summarizeTenantClusters(ctx, c); err != nil {
+		r.fail(sectionTenantClusters, err)
+	}
+	if r.tenants {
+		if r.TenantDetails, err = listTenantDetails(ctx, c); err != nil {
+			r.fail(sectionTenantDetails, err)
+		}
+	}
+	return nil
+}
+
+// fail records that a section could not be read
+func (r *report) fail(section string, err error) {
+	if r.Errors == nil {
+		r.Errors = map[string]string{}
+	}
+	r.Errors[section] = err.Error()
+}
+
+// print writes the report as text
+func (r *report) print() {
 	// Print header
 	if output.IsTTY() {
 		fmt.Println(titleStyle.Render("Butler Platform Status"))
@@ -143,74 +331,64 @@ func runStatus(ctx context.Context, logger *log.Logger, opts *statusOptions) err
 	fmt.Println()
 
 	// Basic info
-	fmt.Printf("Management Cluster: %s\n", clusterName)
-	fmt.Printf("Kubernetes Version: %s\n", serverVersion.GitVersion)
-	fmt.Printf("Kubeconfig: %s\n", kubeconfigPath)
+	platformVersion := r.PlatformVersion
+	switch {
+	case r.Errors[sectionPlatformVersion] != "":
+		platformVersion = warnStyle.Render("unknown")
+	case platformVersion == "":
+		platformVersion = pendingStyle.Render("not pinned")
+	}
+	fmt.Printf("Management Cluster: %s\n", r.ManagementCluster)
+	fmt.Printf("Kubernetes Version: %s\n", r.KubernetesVersion)
+	fmt.Printf("Platform Version: %s\n", platformVersion)
+	fmt.Printf("Kubeconfig: %s\n", r.Kubeconfig)
 	fmt.Println()
 
-	// Check components
-	printSection("Butler Components")
-	checkDeployment(ctx, c, butlerSystem, "butler-controller", "Butler Controller")
-	checkDeployment(ctx, c, capiSystem, "capi-controller-manager", "CAPI Core")
-
-	// CAPI providers - check common naming patterns
-	checkCAPIProvider(ctx, c, "nutanix", []providerCheck{
-		{"capx-system", "capx-controller-manager"},
-		{"capx-system", "controller-manager"},
-		{capiSystem, "capx-controller-manager"},
-		{"nutanix-system", "controller-manager"},
-	})
-	checkCAPIProvider(ctx, c, "harvester", []providerCheck{
-		{"capi-harvester-system", "capi-harvester-controller-manager"},
-		{capiSystem, "capi-harvester-controller-manager"},
-	})
-	checkCAPIProvider(ctx, c, "kubevirt", []providerCheck{
-		{"capk-system", "capk-controller-manager"},
-		{capiSystem, "capk-controller-manager"},
-	})
+	if r.wide {
+		printSection("Bootstrap")
+		r.printBootstrapInfo()
+		fmt.Println()
+	}
 
-	checkDeployment(ctx, c, "steward-system", "steward", "Steward")
+	printSection("Butler Components")
+	printWorkloads(r.Components)
 	fmt.Println()
 
-	// Check infrastructure
 	printSection("Infrastructure Addons")
-	checkDeployment(ctx, c, certManager, "cert-manager", "cert-manager")
-	checkDeployment(ctx, c, certManager, "cert-manager-webhook", "cert-manager webhook")
-	checkDaemonSet(ctx, c, ciliumNamespace, "cilium", "Cilium")
-	checkDeployment(ctx, c, ciliumNamespace, "cilium-operator", "Cilium Operator")
-	checkDeployment(ctx, c, longhornSystem, "longhorn-driver-deployer", "Longhorn")
-
-	// MetalLB - check various naming patterns
-	if hasDeployment(ctx, c, metallbSystem, "controller") || hasDeployment(ctx, c, metallbSystem, "metallb-controller") {
-		checkDeploymentPatterns(ctx, c, metallbSystem, []string{"metallb-controller", "controller"}, "MetalLB Controller")
-		checkDaemonSetPatterns(ctx, c, metallbSystem, []string{"metallb-speaker", "speaker"}, "MetalLB Speaker")
-	}
+	printWorkloads(r.Addons)
 	fmt.Println()
 
-	// Check GitOps - only show if Flux is installed
-	if hasNamespace(ctx, c, fluxSystem) {
+	// GitOps - only shown if a GitOps addon is installed
+	if len(r.GitOps) > 0 {
 		printSection("GitOps")
-		checkDeployment(ctx, c, fluxSystem, "source-controller", "Flux Source")
-		checkDeployment(ctx, c, fluxSystem, "kustomize-controller", "Flux Kustomize")
-		checkDeployment(ctx, c, fluxSystem, "helm-controller", "Flux Helm")
-		checkDeployment(ctx, c, fluxSystem, "notification-controller", "Flux Notification")
+		printWorkloads(r.GitOps)
 		fmt.Println()
 	}
 
-	// Check ProviderConfigs
 	printSection("Provider Configs")
-	if err := listProviderConfigs(ctx, c); err != nil {
-		fmt.Printf("  %s Error listing ProviderConfigs: %v\n", statusIcon("error"), err)
+	if msg := r.Errors[sectionProviderConfigs]; msg != "" {
+		fmt.Printf("  %s Error listing ProviderConfigs: %s\n", statusIcon("error"), msg)
+	} else {
+		printProviderConfigs(r.ProviderConfigs)
 	}
 	fmt.Println()
 
-	// Check TenantClusters
 	printSection("Tenant Clusters")
-	if err := summarizeTenantClusters(ctx, c); err != nil {
-		fmt.Printf("  %s Error listing TenantClusters: %v\n", statusIcon("error"), err)
+	if msg := r.Errors[sectionTenantClusters]; msg != "" {
+		fmt.Printf("  %s Error listing TenantClusters: %s\n", statusIcon("error"), msg)
+	} else {
+		printTenantSummary(r.TenantClusters)
 	}
 
-	return nil
+	if r.tenants {
+		fmt.Println()
+		printSection("Tenant Details")
+		if msg := r.Errors[sectionTenantDetails]; msg != "" {
+			fmt.Printf("  %s Error listing TenantClusters: %s\n", statusIcon("error"), msg)
+		} else {
+			printTenantDetails(r.TenantDetails)
+		}
+	}
 }
 
 func findButlerKubeconfig() string {
@@ -231,9 +409,9 @@ func findButlerKubeconfig() string {
 		if entry.IsDir() {
 			continue
 		}
-		name := entry.Name()
+		name := strings.TrimSuffix(entry.Name(), credstore.SealedExt)
 		if strings.HasSuffix(name, "-kubeconfig") {
-			return filepath.Join(butlerDir, name)
+			return filepath.Join(butlerDir, entry.Name())
 		}
 	}
 
@@ -247,7 +425,7 @@ func findButlerKubeconfig() string {
 }
 
 func extractClusterName(kubeconfigPath string) string {
-	base := filepath.Base(kubeconfigPath)
+	base := strings.TrimSuffix(filepath.Base(kubeconfigPath), credstore.SealedExt)
 	// Remove -kubeconfig suffix
 	name := strings.TrimSuffix(base, "-kubeconfig")
 	// Remove .yaml/.yml suffix
@@ -270,124 +448,55 @@ func hasDeployment(ctx context.Context, c *client.Client, namespace, name string
 	return err == nil
 }
 
-// hasNamespace returns true if a namespace exists
-func hasNamespace(ctx context.Context, c *client.Client, name string) bool {
-	_, err := c.Clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+// hasDaemonSet returns true if a daemonset exists (doesn't check readiness)
+func hasDaemonSet(ctx context.Context, c *client.Client, namespace, name string) bool {
+	_, err := c.Clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
 	return err == nil
 }
 
+// readiness fills in the replica counts and state of a found workload
+func readiness(w workload, ready, desired int32) workload {
+	w.Ready = ready
+	w.Desired = desired
+	switch {
+	case ready >= desired && desired > 0:
+		w.State = stateOK
+	case ready > 0:
+		w.State = stateWarn
+	default:
+		w.State = stateError
+	}
+	return w
+}
+
 // checkDeploymentPatterns checks multiple possible deployment names
-func checkDeploymentPatterns(ctx context.Context, c *client.Client, namespace string, names []string, displayName string) {
+func checkDeploymentPatterns(ctx context.Context, c *client.Client, namespace string, names []string, displayName string) workload {
 	for _, name := range names {
 		deploy, err := c.Clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			continue
 		}
-
-		ready := deploy.Status.ReadyReplicas
-		desired := *deploy.Spec.Replicas
-
-		var status string
-		var icon string
-		if ready >= desired && desired > 0 {
-			status = okStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("ok")
-		} else if ready > 0 {
-			status = warnStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("warn")
-		} else {
-			status = errorStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("error")
-		}
-
-		fmt.Printf("  %s %-25s %s\n", icon, displayName, status)
-		return
+		w := workload{Name: displayName, Namespace: namespace, Workload: "deployment/" + name}
+		return readiness(w, deploy.Status.ReadyReplicas, *deploy.Spec.Replicas)
 	}
-	// Not found
-	fmt.Printf("  %s %-25s %s\n", statusIcon("missing"), displayName, pendingStyle.Render("not found"))
+	return workload{Name: displayName, Namespace: namespace, State: stateMissing}
 }
 
 // checkDaemonSetPatterns checks multiple possible daemonset names
-func checkDaemonSetPatterns(ctx context.Context, c *client.Client, namespace string, names []string, displayName string) {
+func checkDaemonSetPatterns(ctx context.Context, c *client.Client, namespace string, names []string, displayName string) workload {
 	for _, name := range names {
 		ds, err := c.Clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			continue
 		}
-
-		ready := ds.Status.NumberReady
-		desired := ds.Status.DesiredNumberScheduled
-
-		var status string
-		var icon string
-		if ready >= desired && desired > 0 {
-			status = okStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("ok")
-		} else if ready > 0 {
-			status = warnStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("warn")
-		} else {
-			status = errorStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("error")
-		}
-
-		fmt.Printf("  %s %-25s %s\n", icon, displayName, status)
-		return
+		w := workload{Name: displayName, Namespace: namespace, Workload: "daemonset/" + name}
+		return readiness(w, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled)
 	}
-	// Not found
-	fmt.Printf("  %s %-25s %s\n", statusIcon("missing"), displayName, pendingStyle.Render("not found"))
+	return workload{Name: displayName, Namespace: namespace, State: stateMissing}
 }
 
-func checkDeployment(ctx context.Context, c *client.Client, namespace, name, displayName string) {
-	deploy, err := c.Clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
-	if err != nil {
-		fmt.Printf("  %s %-25s %s\n", statusIcon("missing"), displayName, pendingStyle.Render("not found"))
-		return
-	}
-
-	ready := deploy.Status.ReadyReplicas
-	desired := *deploy.Spec.Replicas
-
-	var status string
-	var icon string
-	if ready >= desired && desired > 0 {
-		status = okStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-		icon = statusIcon("ok")
-	} else if ready > 0 {
-		status = warnStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-		icon = statusIcon("warn")
-	} else {
-		status = errorStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-		icon = statusIcon("error")
-	}
-
-	fmt.Printf("  %s %-25s %s\n", icon, displayName, status)
-}
-
-func checkDaemonSet(ctx context.Context, c *client.Client, namespace, name, displayName string) {
-	ds, err := c.Clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
-	if err != nil {
-		fmt.Printf("  %s %-25s %s\n", statusIcon("missing"), displayName, pendingStyle.Render("not found"))
-		return
-	}
-
-	ready := ds.Status.NumberReady
-	desired := ds.Status.DesiredNumberScheduled
-
-	var status string
-	var icon string
-	if ready >= desired && desired > 0 {
-		status = okStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-		icon = statusIcon("ok")
-	} else if ready > 0 {
-		status = warnStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-		icon = statusIcon("warn")
-	} else {
-		status = errorStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-		icon = statusIcon("error")
-	}
-
-	fmt.Printf("  %s %-25s %s\n", icon, displayName, status)
+func checkDeployment(ctx context.Context, c *client.Client, namespace, name, displayName string) workload {
+	return checkDeploymentPatterns(ctx, c, namespace, []string{name}, displayName)
 }
 
 // providerCheck defines a namespace/deployment pair to check
@@ -396,8 +505,9 @@ type providerCheck struct {
 	deployment string
 }
 
-// checkCAPIProvider checks multiple possible locations for a CAPI provider
-func checkCAPIProvider(ctx context.Context, c *client.Client, providerName string, checks []providerCheck) {
+// checkCAPIProvider checks multiple possible locations for a CAPI provider.
+// A provider found nowhere is not installed, which is fine.
+func checkCAPIProvider(ctx context.Context, c *client.Client, providerName string, checks []providerCheck) (workload, bool) {
 	// Map provider names to display names
 	displayNames := map[string]string{
 		"nutanix":   "CAPI Nutanix",
@@ -411,54 +521,81 @@ func checkCAPIProvider(ctx context.Context, c *client.Client, providerName strin
 	}
 
 	for _, check := range checks {
-		deploy, err := c.Clientset.AppsV1().Deployments(check.namespace).Get(ctx, check.deployment, metav1.GetOptions{})
-		if err != nil {
-			continue // Try next location
+		w := checkDeployment(ctx, c, check.namespace, check.deployment, displayName)
+		if w.State != stateMissing {
+			return w, true
 		}
+	}
+	return workload{}, false
+}
 
-		ready := deploy.Status.ReadyReplicas
-		desired := *deploy.Spec.Replicas
-
+// printWorkloads prints a line per workload
+func printWorkloads(workloads []workload) {
+	for _, w := range workloads {
 		var status string
-		var icon string
-		if ready >= desired && desired > 0 {
-			status = okStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("ok")
-		} else if ready > 0 {
-			status = warnStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("warn")
-		} else {
-			status = errorStyle.Render(fmt.Sprintf("%d/%d ready", ready, desired))
-			icon = statusIcon("error")
+		switch w.State {
+		case stateMissing:
+			status = pendingStyle.Render("not found")
+		case stateUnknown:
+			status = pendingStyle.Render(w.Message)
+		default:
+			counts := fmt.Sprintf("%d/%d ready", w.Ready, w.Desired)
+			switch w.State {
+			case stateOK:
+				status = okStyle.Render(counts)
+			case stateWarn:
+				status = warnStyle.Render(counts)
+			default:
+				status = errorStyle.Render(counts)
+			}
 		}
-
-		fmt.Printf("  %s %-25s %s\n", icon, displayName, status)
-		return
+		fmt.Printf("  %s %-25s %s\n", statusIcon(w.State), w.Name, status)
 	}
-
-	// Not found in any location - that's OK, provider might not be installed
-	// Only print if we expect it based on ProviderConfigs
 }
 
-func listProviderConfigs(ctx context.Context, c *client.Client) error {
+func listProviderConfigs(ctx context.Context, c *client.Client) ([]providerConfigStatus, error) {
 	list, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace(butlerSystem).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return err
-	}
-
-	if len(list.Items) == 0 {
-		fmt.Printf("  %s No ProviderConfigs found\n", statusIcon("warn"))
-		return nil
+		return nil, err
 	}
 
+	configs := []providerConfigStatus{}
 	for _, pc := range list.Items {
-		name := pc.GetName()
 		provider, _, _ := unstructured.NestedString(pc.Object, "spec", "provider")
 		validated, _, _ := unstructured.NestedBool(pc.Object, "status", "validated")
+		insecure, _, _ := unstructured.NestedBool(pc.Object, "spec", provider, "insecure")
 
+		// Get endpoint for display
+		var endpoint string
+		switch provider {
+		case "nutanix":
+			endpoint, _, _ = unstructured.NestedString(pc.Object, "spec", "nutanix", "endpoint")
+		case "harvester":
+			endpoint = "(in-cluster)"
+		}
+
+		configs = append(configs, providerConfigStatus{
+			Name:      pc.GetName(),
+			Provider:  provider,
+			Validated: validated,
+			Insecure:  insecure,
+			Endpoint:  endpoint,
+		})
+	}
+	sort.Slice(configs, func(i, j int) bool { return configs[i].Name < configs[j].Name })
+	return configs, nil
+}
+
+func printProviderConfigs(configs []providerConfigStatus) {
+	if len(configs) == 0 {
+		fmt.Printf("  %s No ProviderConfigs found\n", statusIcon("warn"))
+		return
+	}
+
+	for _, pc := range configs {
 		var status string
 		var icon string
-		if validated {
+		if pc.Validated {
 			status = okStyle.Render("validated")
 			icon = statusIcon("ok")
 		} else {
@@ -466,60 +603,55 @@ func listProviderConfigs(ctx context.Context, c *client.Client) error {
 			icon = statusIcon("warn")
 		}
 
-		// Get endpoint for display
-		var endpoint string
-		switch provider {
-		case "nutanix":
-			endpoint, _, _ = unstructured.NestedString(pc.Object, "spec", "nutanix", "endpoint")
-		case "harvester":
-			endpoint = "(in-cluster)"
+		// Insecure providers are flagged until their CA is trusted
+		if pc.Insecure {
+			icon = statusIcon("warn")
+			status += "  " + warnStyle.Render("TLS verification disabled")
 		}
 
-		if endpoint != "" {
-			fmt.Printf("  %s %-15s %-10s %s  endpoint: %s\n", icon, name, provider, status, endpoint)
+		if pc.Endpoint != "" {
+			fmt.Printf("  %s %-15s %-10s %s  endpoint: %s\n", icon, pc.Name, pc.Provider, status, pc.Endpoint)
 		} else {
-			fmt.Printf("  %s %-15s %-10s %s\n", icon, name, provider, status)
+			fmt.Printf("  %s %-15s %-10s %s\n", icon, pc.Name, pc.Provider, status)
 		}
 	}
-
-	return nil
 }
 
-func summarizeTenantClusters(ctx context.Context, c *client.Client) error {
+func summarizeTenantClusters(ctx context.Context, c *client.Client) (tenantSummary, error) {
 	// List across all namespaces
-	tcGVR := schema.GroupVersionResource{
-		Group:    "butler.butlerlabs.dev",
-		Version:  "v1alpha1",
-		Resource: "tenantclusters",
-	}
-
-	list, err := c.Dynamic.Resource(tcGVR).List(ctx, metav1.ListOptions{})
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return err
+		return tenantSummary{}, err
 	}
+	sortByNamespaceAndName(list.Items)
 
-	if len(list.Items) == 0 {
-		fmt.Printf("  No tenant clusters found\n")
-		return nil
-	}
-
-	// Count by phase
-	phases := make(map[string]int)
+	summary := tenantSummary{Total: len(list.Items)}
 	for _, tc := range list.Items {
 		phase, _, _ := unstructured.NestedString(tc.Object, "status", "phase")
+		summary.Clusters = append(summary.Clusters, tenantStatus{Namespace: tc.GetNamespace(), Name: tc.GetName(), Phase: phase})
+
 		if phase == "" {
 			phase = "Unknown"
 		}
-		phases[phase]++
+		if summary.Phases == nil {
+			summary.Phases = map[string]int{}
+		}
+		summary.Phases[phase]++
+	}
+	return summary, nil
+}
+
+func printTenantSummary(summary tenantSummary) {
+	if summary.Total == 0 {
+		fmt.Printf("  No tenant clusters found\n")
+		return
 	}
 
-	// Print summary
-	total := len(list.Items)
-	ready := phases["Ready"]
-	provisioning := phases["Provisioning"] + phases["Installing"]
-	failed := phases["Failed"]
+	ready := summary.Phases["Ready"]
+	provisioning := summary.Phases["Provisioning"] + summary.Phases["Installing"]
+	failed := summary.Phases["Failed"]
 
-	fmt.Printf("  Total: %d", total)
+	fmt.Printf("  Total: %d", summary.Total)
 	if ready > 0 {
 		fmt.Printf(" | %s", okStyle.Render(fmt.Sprintf("Ready: %d", ready)))
 	}
@@ -532,18 +664,20 @@ func summarizeTenantClusters(ctx context.Context, c *client.Client) error {
 	fmt.Println()
 
 	// List clusters
-	for _, tc := range list.Items {
-		name := tc.GetName()
-		namespace := tc.GetNamespace()
-		phase, _, _ := unstructured.NestedString(tc.Object, "status", "phase")
-
-		icon := statusIcon(strings.ToLower(phase))
-		phaseStr := formatPhase(phase)
-
-		fmt.Printf("    %s %s/%s: %s\n", icon, namespace, name, phaseStr)
+	for _, tc := range summary.Clusters {
+		icon := statusIcon(strings.ToLower(tc.Phase))
+		fmt.Printf("    %s %s/%s: %s\n", icon, tc.Namespace, tc.Name, formatPhase(tc.Phase))
 	}
+}
 
-	return nil
+// sortByNamespaceAndName orders objects by namespace, then name
+func sortByNamespaceAndName(items []unstructured.Unstructured) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].GetNamespace() != items[j].GetNamespace() {
+			return items[i].GetNamespace() < items[j].GetNamespace()
+		}
+		return items[i].GetName() < items[j].GetName()
+	})
 }
 
 func statusIcon(status string) string {
@@ -590,3 +724,26 @@ func formatPhase(phase string) string {
 		return pendingStyle.Render(phase)
 	}
 }
+
+// printBootstrapInfo shows the butleradm build and config that created the
+// platform
+func (r *report) printBootstrapInfo() {
+	info := r.Bootstrap
+	switch {
+	case r.Errors[sectionBootstrap] != "":
+		fmt.Printf("  %s Error reading bootstrap info: %s\n", statusIcon("error"), r.Errors[sectionBootstrap])
+		return
+	case info == nil:
+		fmt.Printf("  %s\n", pendingStyle.Render("not recorded (bootstrapped by an older butleradm)"))
+		return
+	}
+
+	fmt.Printf("  CLI Version: %s (%s)\n", info.CLIVersion, info.GitCommit)
+	fmt.Printf("  Bootstrapped: %s\n", info.BootstrappedAt)
+	if info.Config != "" {
+		fmt.Println("  Config:")
+		for _, line := range strings.Split(strings.TrimRight(info.Config, "\n"), "\n") {
+			fmt.Println("    " + line)
+		}
+	}
+}
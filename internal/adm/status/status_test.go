@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/adm/cmd"
+	"github.com/butlerdotdev/butler/internal/common/client/fake"
+	"github.com/butlerdotdev/butler/internal/common/cmdtest"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+)
+
+// created is when the fixture clusters were created; it is replaced with
+// a placeholder so golden files don't depend on the clock
+var created = time.Now().Add(-50 * time.Hour).UTC().Truncate(time.Second)
+
+func deployment(namespace, name string, ready, desired int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: &desired},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: ready},
+	}
+}
+
+func tenantCluster(namespace, name, phase string, ready, desired int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "butler.butlerlabs.dev/v1alpha1",
+		"kind":       "TenantCluster",
+		"metadata": map[string]interface{}{
+			"name":              name,
+			"namespace":         namespace,
+			"creationTimestamp": created.Format(time.RFC3339),
+		},
+		"spec": map[string]interface{}{
+			"providerConfigRef": map[string]interface{}{"name": "harvester"},
+			"networking": map[string]interface{}{
+				"loadBalancerPool": map[string]interface{}{"start": "10.40.0.10", "end": "10.40.0.19"},
+			},
+		},
+		"status": map[string]interface{}{
+			"phase":           phase,
+			"tenantNamespace": namespace + "-" + name,
+			"observedState": map[string]interface{}{
+				"workers": map[string]interface{}{"ready": ready, "desired": desired},
+			},
+		},
+	}}
+}
+
+func fixtures() []runtime.Object {
+	return []runtime.Object{
+		deployment("butler-system", "butler-controller", 1, 1),
+		deployment("capi-system", "capi-controller-manager", 1, 1),
+		deployment("capi-harvester-system", "capi-harvester-controller-manager", 0, 1),
+		deployment("steward-system", "steward", 1, 2),
+		deployment("cert-manager", "cert-manager", 1, 1),
+		deployment("cert-manager", "cert-manager-webhook", 1, 1),
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-flannel-ds", Namespace: "kube-flannel"},
+			Status:     appsv1.DaemonSetStatus{NumberReady: 3, DesiredNumberScheduled: 3},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "butler-platform-version", Namespace: "butler-system"},
+			Data:       map[string]string{"version": "v0.4.0"},
+		},
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "butler.butlerlabs.dev/v1alpha1",
+			"kind":       "ProviderConfig",
+			"metadata":   map[string]interface{}{"name": "harvester", "namespace": "butler-system"},
+			"spec":       map[string]interface{}{"provider": "harvester"},
+			"status":     map[string]interface{}{"validated": true},
+		}},
+		tenantCluster("butler-tenants", "alpha", "Ready", 3, 3),
+		tenantCluster("butler-tenants", "bravo", "Provisioning", 0, 2),
+		tenantCluster("team-a", "charlie", "Failed", 0, 1),
+	}
+}
+
+func TestStatus(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		args []string
+	}{
+		{"table", nil},
+		{"tenants", []string{"--tenants"}},
+		{"json", []string{"--tenants", "-o", "json"}},
+		{"yaml", []string{"--tenants", "-o", "yaml"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+
+			c := fake.NewClient(fixtures()...)
+			c.Clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.30.2"}
+
+			args := append([]string{"status", "--kubeconfig", "mgmt-kubeconfig"}, tt.args...)
+			res, err := cmdtest.Run(cmd.NewRootCmd(log.New("butleradm")), c, args...)
+			if err != nil {
+				t.Fatalf("butleradm %v: %v\n%s", args, err, res.Stderr)
+			}
+			got := bytes.ReplaceAll(res.Stdout, []byte(created.Format(time.RFC3339)), []byte("CREATED"))
+			cmdtest.Golden(t, filepath.Join("testdata", "status-"+tt.name+".golden"), got)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// certExpiryWarning is how close to expiry a control plane certificate is
+// highlighted
+const certExpiryWarning = 30 * 24 * time.Hour
+
+// tenantDetail is the health of a tenant cluster an operator checks first
+type tenantDetail struct {
+	Namespace string       `json:"namespace"`
+	Name      string       `json:"name"`
+	Phase     string       `json:"phase,omitempty"`
+	Workers   *workerCount `json:"workers,omitempty"`
+
+	// ControlPlane is Ready, NotReady or the reason of the CAPI Cluster's
+	// control plane condition; empty when the Cluster doesn't exist yet
+	ControlPlane string `json:"controlPlane,omitempty"`
+
+	CertExpiry *time.Time `json:"certExpiry,omitempty"`
+	LBPool     string     `json:"lbPool,omitempty"`
+	Provider   string     `json:"provider,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// workerCount is the ready and desired workers of a cluster
+type workerCount struct {
+	Ready   int64 `json:"ready"`
+	Desired int64 `json:"desired"`
+}
+
+// listTenantDetails checks every tenant cluster
+func listTenantDetails(ctx context.Context, c *client.Client) ([]tenantDetail, error) {
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sortByNamespaceAndName(list.Items)
+
+	details := []tenantDetail{}
+	for i := range list.Items {
+		tc := &list.Items[i]
+		tenantNS, _, _ := unstructured.NestedString(tc.Object, "status", "tenantNamespace")
+		phase, _, _ := unstructured.NestedString(tc.Object, "status", "phase")
+		provider, _, _ := unstructured.NestedString(tc.Object, "spec", "providerConfigRef", "name")
+
+		details = append(details, tenantDetail{
+			Namespace:    tc.GetNamespace(),
+			Name:         tc.GetName(),
+			Phase:        phase,
+			Workers:      tenantWorkers(ctx, c, tc, tenantNS),
+			ControlPlane: controlPlaneHealth(ctx, c, tc.GetName(), tenantNS),
+			CertExpiry:   certExpiry(ctx, c, tc.GetName(), tenantNS),
+			LBPool:       loadBalancerPool(tc),
+			Provider:     provider,
+			CreatedAt:    tc.GetCreationTimestamp().Time,
+		})
+	}
+	return details, nil
+}
+
+// printTenantDetails prints a row per tenant cluster
+func printTenantDetails(details []tenantDetail) {
+	if len(details) == 0 {
+		fmt.Printf("  No tenant clusters found\n")
+		return
+	}
+
+	table := output.NewTable(os.Stdout, "NAMESPACE", "NAME", "PHASE", "WORKERS", "CONTROL PLANE", "CERT EXPIRY", "LB POOL", "PROVIDER", "AGE")
+	table.SetPriority("NAME", 2)
+	table.SetPriority("PHASE", 1)
+	table.SetPriority("LB POOL", -1)
+	for _, d := range details {
+		workers := "-"
+		if d.Workers != nil {
+			workers = output.FormatWorkers(d.Workers.Ready, d.Workers.Desired)
+		}
+		controlPlane := orDash(d.ControlPlane)
+		switch d.ControlPlane {
+		case "":
+		case "Ready":
+			controlPlane = okStyle.Render(controlPlane)
+		default:
+			controlPlane = errorStyle.Render(controlPlane)
+		}
+
+		table.AddRow(
+			d.Namespace,
+			d.Name,
+			output.ColorizePhase(orDash(d.Phase)),
+			workers,
+			controlPlane,
+			formatCertExpiry(d.CertExpiry),
+			orDash(d.LBPool),
+			orDash(d.Provider),
+			output.FormatAge(d.CreatedAt),
+		)
+	}
+	table.Flush()
+}
+
+// tenantWorkers returns ready/desired workers from the TenantCluster
+// status, falling back to its MachineDeployment
+func tenantWorkers(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, tenantNS string) *workerCount {
+	ready, _, _ := unstructured.NestedInt64(tc.Object, "status", "observedState", "workers", "ready")
+	desired, _, _ := unstructured.NestedInt64(tc.Object, "status", "observedState", "workers", "desired")
+	if desired == 0 {
+		desired, _, _ = unstructured.NestedInt64(tc.Object, "spec", "workers", "replicas")
+	}
+
+	if tenantNS != "" {
+		for _, name := range []string{tc.GetName() + "-workers", tc.GetName() + "-md-0"} {
+			md, err := c.Dynamic.Resource(client.MachineDeploymentGVR).Namespace(tenantNS).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				continue
+			}
+			if replicas, _, _ := unstructured.NestedInt64(md.Object, "spec", "replicas"); replicas > 0 {
+				desired = replicas
+			}
+			ready, _, _ = unstructured.NestedInt64(md.Object, "status", "readyReplicas")
+			break
+		}
+	}
+
+	if desired == 0 {
+		return nil
+	}
+	return &workerCount{Ready: ready, Desired: desired}
+}
+
+// controlPlaneHealth reports the CAPI Cluster's view of the hosted
+// control plane
+func controlPlaneHealth(ctx context.Context, c *client.Client, name, tenantNS string) string {
+	if tenantNS == "" {
+		return ""
+	}
+	cluster, err := c.Dynamic.Resource(client.ClusterGVR).Namespace(tenantNS).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return ""
+	}
+
+	if ready, _, _ := unstructured.NestedBool(cluster.Object, "status", "controlPlaneReady"); ready {
+		return "Ready"
+	}
+	conditions, _, _ := unstructured.NestedSlice(cluster.Object, "status", "conditions")
+	for _, cond := range conditions {
+		cm, ok := cond.(map[string]interface{})
+		if !ok || (cm["type"] != "ControlPlaneReady" && cm["type"] != "ControlPlaneAvailable") {
+			continue
+		}
+		if cm["status"] == "True" {
+			return "Ready"
+		}
+		if reason, _ := cm["reason"].(string); reason != "" {
+			return reason
+		}
+		break
+	}
+	return "NotReady"
+}
+
+// certExpiry returns when the API server certificate of a cluster's
+// TenantControlPlane expires
+func certExpiry(ctx context.Context, c *client.Client, name, tenantNS string) *time.Time {
+	if tenantNS == "" {
+		return nil
+	}
+	tcp, err := c.Dynamic.Resource(client.TenantControlPlaneGVR).Namespace(tenantNS).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	secretName, _, _ := unstructured.NestedString(tcp.Object, "status", "certificates", "apiServer", "secretName")
+	if secretName == "" {
+		return nil
+	}
+	secret, err := c.Clientset.CoreV1().Secrets(tenantNS).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		if strings.HasSuffix(key, ".crt") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		block, _ := pem.Decode(secret.Data[key])
+		if block == nil {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		return &cert.NotAfter
+	}
+	return nil
+}
+
+// formatCertExpiry shows the expiry date, highlighted when it is close
+func formatCertExpiry(notAfter *time.Time) string {
+	if notAfter == nil {
+		return "-"
+	}
+	expiry := notAfter.Format("2006-01-02")
+	remaining := time.Until(*notAfter)
+	switch {
+	case remaining <= 0:
+		return errorStyle.Render(expiry + " (expired)")
+	case remaining < certExpiryWarning:
+		return warnStyle.Render(fmt.Sprintf("%s (in %s)", expiry, output.FormatDuration(remaining)))
+	default:
+		return expiry
+	}
+}
+
+// loadBalancerPool returns the MetalLB address range of a cluster
+func loadBalancerPool(tc *unstructured.Unstructured) string {
+	start, _, _ := unstructured.NestedString(tc.Object, "spec", "networking", "loadBalancerPool", "start")
+	end, _, _ := unstructured.NestedString(tc.Object, "spec", "networking", "loadBalancerPool", "end")
+	if start == "" {
+		return ""
+	}
+	return start + "-" + end
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
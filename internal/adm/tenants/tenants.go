@@ -0,0 +1,345 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tenants implements the butleradm tenants command.
+package tenants
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/cost"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Tenant is one tenant cluster's line in the report
+type Tenant struct {
+	Cluster     string  `json:"cluster"`
+	Namespace   string  `json:"namespace"`
+	Team        string  `json:"team,omitempty"`
+	Owner       string  `json:"owner,omitempty"`
+	Phase       string  `json:"phase"`
+	Provider    string  `json:"provider,omitempty"`
+	Workers     int64   `json:"workers"`
+	CPU         int64   `json:"cpuPerWorker"`
+	Memory      string  `json:"memoryPerWorker,omitempty"`
+	Disk        string  `json:"diskPerWorker,omitempty"`
+	Created     string  `json:"created"`
+	UptimeHours float64 `json:"uptimeHours"`
+	Cost        float64 `json:"estimatedCost,omitempty"`
+}
+
+// Report is the output of tenants report
+type Report struct {
+	Month    string   `json:"month"`
+	Currency string   `json:"currency,omitempty"`
+	Tenants  []Tenant `json:"tenants"`
+}
+
+// csvHeader are the columns of -o csv
+var csvHeader = []string{
+	"month", "namespace", "cluster", "team", "owner", "phase", "provider", "workers",
+	"cpu_per_worker", "memory_per_worker", "disk_per_worker", "created", "uptime_hours", "estimated_cost", "currency",
+}
+
+// NewTenantsCmd creates the tenants parent command
+func NewTenantsCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenants",
+		Short: "Report on tenant clusters across the platform",
+		Long: `Report on tenant clusters across every namespace of the platform.
+
+Commands:
+  report  Inventory of tenants with usage and estimated cost for chargeback
+
+Examples:
+  # This month's chargeback report for finance
+  butleradm tenants report -o csv > tenants.csv`,
+	}
+
+	cmd.AddCommand(newReportCmd(logger))
+
+	return cmd
+}
+
+type reportOptions struct {
+	kubeconfig   string
+	month        string
+	outputFormat string
+}
+
+func newReportCmd(logger *log.Logger) *cobra.Command {
+	opts := &reportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Inventory of tenants with usage and estimated cost",
+		Long: `List every tenant cluster with its owning team, worker count and machine
+size, the hours it existed in a month and its estimated cost, for finance
+and chargeback.
+
+The team is the cluster's owner when that is a Team, or the first Team the
+owning user belongs to. Costs are priced at the rates in the costs section
+of the butler-platform ConfigMap and left empty without them. They assume
+the cluster had its current size all month, and clusters deleted before
+the report was run are not included, so run it at the end of each month.
+
+Output formats:
+  table      Summary (default)
+  csv        One row per cluster, for spreadsheets
+  json/yaml  Structured report
+
+Examples:
+  # This month so far
+  butleradm tenants report
+
+  # Last month, for finance
+  butleradm tenants report --month 2026-09 -o csv > tenants-2026-09.csv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReport(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig")
+	cmd.Flags().StringVar(&opts.month, "month", "", "month to report on as YYYY-MM (default: the current month)")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, csv, json, yaml)")
+
+	return cmd
+}
+
+func runReport(ctx context.Context, logger *log.Logger, opts *reportOptions) error {
+	csvOutput := opts.outputFormat == "csv"
+	var format output.Format
+	if !csvOutput {
+		var err error
+		if format, err = output.ParseFormat(opts.outputFormat); err != nil {
+			return fmt.Errorf("%w (or csv)", err)
+		}
+	}
+
+	now := time.Now().UTC()
+	start, end := cost.MonthBounds(now)
+	if opts.month != "" {
+		t, err := time.Parse("2006-01", opts.month)
+		if err != nil {
+			return fmt.Errorf("invalid --month %q: expected YYYY-MM", opts.month)
+		}
+		if t.After(now) {
+			return fmt.Errorf("--month %s is in the future", opts.month)
+		}
+		start, end = cost.MonthBounds(t)
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	var rates *platform.Costs
+	if cfg, err := platform.Load(ctx, c); err != nil {
+		logger.Warn("could not load platform config; costs left empty", "error", err)
+	} else if cfg.Costs.Configured() {
+		rates = &cfg.Costs
+	}
+
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing TenantClusters: %w", err)
+	}
+	teams, err := loadTeams(ctx, c)
+	if err != nil {
+		logger.Warn("could not list Teams; owners not mapped to teams", "error", err)
+	}
+
+	report := &Report{Month: cost.Month(start)}
+	if rates != nil {
+		report.Currency = rates.CurrencyOrDefault()
+	}
+	for i := range list.Items {
+		tc := &list.Items[i]
+		created := tc.GetCreationTimestamp().Time
+		if !created.Before(end) {
+			continue
+		}
+		report.Tenants = append(report.Tenants, tenantOf(tc, teams, rates, start, end, now))
+	}
+	sort.Slice(report.Tenants, func(i, j int) bool {
+		a, b := report.Tenants[i], report.Tenants[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Cluster < b.Cluster
+	})
+
+	if csvOutput {
+		return writeCSV(os.Stdout, report)
+	}
+	return output.NewPrinter(format, os.Stdout).Print(report, func(w io.Writer) error {
+		return printTable(w, report, rates != nil)
+	})
+}
+
+// tenantOf builds a cluster's report line for the month from start to end
+func tenantOf(tc *unstructured.Unstructured, teams map[string]string, rates *platform.Costs, start, end, now time.Time) Tenant {
+	owner := tc.GetAnnotations()[lifecycle.OwnerAnnotation]
+	size := cost.ClusterSize(tc)
+	str := func(path ...string) string {
+		s, _, _ := unstructured.NestedString(tc.Object, path...)
+		return s
+	}
+	cpu, _, _ := unstructured.NestedInt64(tc.Object, "spec", "workers", "machineTemplate", "cpu")
+
+	t := Tenant{
+		Cluster:     tc.GetName(),
+		Namespace:   tc.GetNamespace(),
+		Team:        teams[strings.ToLower(owner)],
+		Owner:       owner,
+		Phase:       str("status", "phase"),
+		Provider:    str("spec", "providerConfigRef", "name"),
+		Workers:     size.Workers,
+		CPU:         cpu,
+		Memory:      str("spec", "workers", "machineTemplate", "memory"),
+		Disk:        str("spec", "workers", "machineTemplate", "diskSize"),
+		Created:     tc.GetCreationTimestamp().UTC().Format(time.RFC3339),
+		UptimeHours: cost.UptimeHours(tc.GetCreationTimestamp().Time, start, end, now),
+	}
+	if t.Phase == "" {
+		t.Phase = "Pending"
+	}
+	if rates != nil {
+		t.Cost = cost.Hourly(size, rates) * t.UptimeHours
+	}
+	return t
+}
+
+// loadTeams maps owners to their team: each Team to itself, and each
+// member to the first Team, by name, listing them
+func loadTeams(ctx context.Context, c *client.Client) (map[string]string, error) {
+	list, err := c.Dynamic.Resource(client.TeamGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].GetName() < list.Items[j].GetName()
+	})
+
+	teams := map[string]string{}
+	for _, team := range list.Items {
+		teams[strings.ToLower(team.GetName())] = team.GetName()
+	}
+	for _, team := range list.Items {
+		members, _, _ := unstructured.NestedSlice(team.Object, "spec", "access", "users")
+		for _, m := range members {
+			member, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := member["name"].(string)
+			if key := strings.ToLower(name); key != "" && teams[key] == "" {
+				teams[key] = team.GetName()
+			}
+		}
+	}
+	return teams, nil
+}
+
+func printTable(w io.Writer, report *Report, withCost bool) error {
+	if len(report.Tenants) == 0 {
+		fmt.Fprintf(w, "No tenant clusters in %s\n", report.Month)
+		return nil
+	}
+
+	headers := []string{"NAMESPACE", "CLUSTER", "TEAM", "PHASE", "WORKERS", "SIZE", "UPTIME"}
+	if withCost {
+		headers = append(headers, "COST ("+report.Currency+")")
+	}
+	table := output.NewTable(w, headers...)
+	var hours, total float64
+	for _, t := range report.Tenants {
+		size := fmt.Sprintf("%d CPU, %s", t.CPU, orDash(t.Memory))
+		row := []string{t.Namespace, t.Cluster, orDash(t.Team), output.ColorizePhase(t.Phase), strconv.FormatInt(t.Workers, 10), size,
+			fmt.Sprintf("%.0fh", t.UptimeHours)}
+		if withCost {
+			row = append(row, strconv.FormatFloat(t.Cost, 'f', 2, 64))
+		}
+		table.AddRow(row...)
+		hours += t.UptimeHours
+		total += t.Cost
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	summary := fmt.Sprintf("%d clusters, %.0f cluster hours in %s", len(report.Tenants), hours, report.Month)
+	if withCost {
+		summary += fmt.Sprintf(", estimated %s", cost.Format(total, report.Currency))
+	}
+	fmt.Fprintln(w, summary)
+	return nil
+}
+
+func writeCSV(w io.Writer, report *Report) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, t := range report.Tenants {
+		estimate := ""
+		if report.Currency != "" {
+			estimate = strconv.FormatFloat(t.Cost, 'f', 2, 64)
+		}
+		record := []string{
+			report.Month, t.Namespace, t.Cluster, t.Team, t.Owner, t.Phase, t.Provider,
+			strconv.FormatInt(t.Workers, 10), strconv.FormatInt(t.CPU, 10), t.Memory, t.Disk, t.Created,
+			strconv.FormatFloat(t.UptimeHours, 'f', 1, 64), estimate, report.Currency,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/manifests"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// ChannelManifest is the published description of a release channel
+//
+// Example:
+//
+//	channel: stable
+//	release: v0.5.0
+//	controllers:
+//	  butler-controller: v0.5.0
+//	  butler-bootstrap-controller: v0.5.0
+//	  butler-provider-nutanix: v0.5.1
+type ChannelManifest struct {
+	Channel string `json:"channel"`
+
+	// Release is the platform release the channel points at
+	Release string `json:"release"`
+
+	// Controllers maps controller Deployment names to image tags
+	Controllers map[string]string `json:"controllers"`
+}
+
+type channelOptions struct {
+	kubeconfig   string
+	channel      string
+	manifest     string
+	dryRun       bool
+	rollout      rolloutOptions
+	outputFormat string
+}
+
+func runChannelUpgrade(cmd *cobra.Command, logger *log.Logger, opts *channelOptions) error {
+	ctx := cmd.Context()
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+	if err := opts.rollout.validate(); err != nil {
+		return err
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+	cfg, err := platform.Load(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	channel := opts.channel
+	if channel == "" {
+		channel = cfg.Release.Channel
+	}
+	if channel == "" {
+		// Neither a flag nor a subscription: nothing to resolve
+		return cmd.Help()
+	}
+	if !platform.ValidChannel(channel) {
+		return fmt.Errorf("unknown release channel %q: must be one of %s, %s, %s", channel, platform.ChannelStable, platform.ChannelRC, platform.ChannelEdge)
+	}
+	if cfg.Release.Channel != "" && channel != cfg.Release.Channel {
+		logger.Warn("upgrading from a channel the platform doesn't subscribe to", "channel", channel, "subscribed", cfg.Release.Channel)
+	}
+
+	location := opts.manifest
+	if location == "" {
+		if location, err = cfg.Release.ManifestLocation(channel); err != nil {
+			return err
+		}
+	}
+	manifest, err := loadChannelManifest(ctx, location)
+	if err != nil {
+		return err
+	}
+	if manifest.Channel != "" && manifest.Channel != channel {
+		return fmt.Errorf("%s describes channel %q, not %q", location, manifest.Channel, channel)
+	}
+	if len(manifest.Controllers) == 0 {
+		return fmt.Errorf("%s lists no controller versions", location)
+	}
+	installed, err := platform.LoadInstalledVersion(ctx, c)
+	if err != nil {
+		logger.Warn("could not read the installed platform version", "error", err)
+	}
+	current := "unknown"
+	if installed != nil {
+		current = installed.Version
+	}
+	logger.Info("resolved release channel", "channel", channel, "release", manifest.Release, "installed", current, "manifest", location)
+
+	deployments, err := controllerDeployments(ctx, c, nil)
+	if err != nil {
+		return err
+	}
+
+	if opts.dryRun {
+		return printChannelPlan(format, manifest, deployments)
+	}
+
+	u := &controllerUpgrade{
+		c:       c,
+		logger:  logger,
+		opts:    &opts.rollout,
+		version: func(name string) string { return manifest.Controllers[name] },
+	}
+	if err := u.run(ctx, format, deployments); err != nil {
+		return err
+	}
+
+	if manifest.Release != "" {
+		if err := platform.RecordInstalledVersion(ctx, c.Clientset, manifest.Release, location); err != nil {
+			return err
+		}
+		logger.Success("platform version recorded", "version", manifest.Release)
+	}
+	return nil
+}
+
+// ControllerPlan is a controller's current and channel image
+type ControllerPlan struct {
+	Name    string `json:"name"`
+	Current string `json:"current"`
+	Target  string `json:"target,omitempty"`
+}
+
+// printChannelPlan shows the image each controller would move to
+func printChannelPlan(format output.Format, manifest *ChannelManifest, deployments []appsv1.Deployment) error {
+	var plan []ControllerPlan
+	for i := range deployments {
+		d := &deployments[i]
+		p := ControllerPlan{Name: d.Name}
+		if container := managerContainerOf(d); container != nil {
+			p.Current = container.Image
+			if version := manifest.Controllers[d.Name]; version != "" {
+				p.Target = manifests.WithTag(container.Image, version)
+			}
+		}
+		plan = append(plan, p)
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(plan, func(w io.Writer) error {
+		table := output.NewTable(w, "CONTROLLER", "CURRENT", "TARGET")
+		for _, p := range plan {
+			target := orDash(p.Target)
+			if p.Target == p.Current {
+				target = output.Dim("up to date")
+			}
+			table.AddRow(p.Name, p.Current, target)
+		}
+		return table.Flush()
+	})
+}
+
+// loadChannelManifest reads a channel manifest from a URL or a local file
+func loadChannelManifest(ctx context.Context, location string) (*ChannelManifest, error) {
+	data, err := platform.Fetch(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("loading channel manifest: %w", err)
+	}
+
+	var manifest ChannelManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parsing channel manifest %s: %w", location, err)
+	}
+	return &manifest, nil
+}
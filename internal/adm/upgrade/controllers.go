@@ -0,0 +1,591 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/manifests"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// controllerNamespace holds the Butler controllers
+	controllerNamespace = "butler-system"
+
+	// controllerSelector matches the controller Deployments, following the
+	// kubebuilder layout they are generated from
+	controllerSelector = "control-plane=controller-manager"
+
+	// managerContainer is the controller container in each Deployment
+	managerContainer = "manager"
+
+	// reconcileErrorsMetric counts failed reconciles in controller-runtime
+	reconcileErrorsMetric = "controller_runtime_reconcile_errors_total"
+)
+
+// Results of a controller upgrade
+const (
+	ResultUpgraded   = "Upgraded"
+	ResultUnchanged  = "Unchanged"
+	ResultRolledBack = "RolledBack"
+	ResultSkipped    = "Skipped"
+)
+
+// ControllerResult reports the upgrade of one controller
+type ControllerResult struct {
+	Name    string `json:"name"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Result  string `json:"result"`
+	Message string `json:"message,omitempty"`
+}
+
+type controllersOptions struct {
+	kubeconfig   string
+	version      string
+	only         []string
+	rollout      rolloutOptions
+	outputFormat string
+}
+
+// rolloutOptions control how controller upgrades are rolled out
+type rolloutOptions struct {
+	canary         bool
+	bake           time.Duration
+	rolloutTimeout time.Duration
+	maxErrorRate   float64
+}
+
+func addRolloutFlags(cmd *cobra.Command, opts *rolloutOptions) {
+	cmd.Flags().BoolVar(&opts.canary, "canary", false, "upgrade one controller at a time and roll back on regression")
+	cmd.Flags().DurationVar(&opts.bake, "bake", 5*time.Minute, "how long to watch each controller with --canary")
+	cmd.Flags().DurationVar(&opts.rolloutTimeout, "rollout-timeout", 5*time.Minute, "how long to wait for each rollout")
+	cmd.Flags().Float64Var(&opts.maxErrorRate, "max-error-rate", 1, "reconcile errors per minute that count as a regression with --canary")
+}
+
+func (o *rolloutOptions) validate() error {
+	if o.canary && o.bake <= 0 {
+		return fmt.Errorf("--bake must be positive")
+	}
+	return nil
+}
+
+func newControllersCmd(logger *log.Logger) *cobra.Command {
+	opts := &controllersOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "controllers --version VERSION",
+		Short: "Upgrade the Butler controllers",
+		Long: `Upgrade the Butler controllers in butler-system to a new image tag.
+
+Controllers are the Deployments labelled control-plane=controller-manager.
+Each keeps its image repository, so mirrored registries are preserved; only
+the tag changes.
+
+With --canary controllers are upgraded one at a time. After each rollout
+the controller bakes for --bake while its pods are watched for restarts and
+CrashLoopBackOff and its reconcile error rate is read from the
+controller-runtime metrics. On a regression the Deployment is rolled back
+to its previous image and the remaining controllers are left untouched.
+
+Examples:
+  # Upgrade everything at once
+  butleradm upgrade controllers --version v0.5.0
+
+  # One at a time, 10 minutes each, rolling back on trouble
+  butleradm upgrade controllers --version v0.5.0 --canary --bake 10m
+
+  # Only the Nutanix provider
+  butleradm upgrade controllers --version v0.5.1 --only butler-provider-nutanix --canary`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runControllers(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.version, "version", "", "image tag to upgrade to (required)")
+	cmd.Flags().StringSliceVar(&opts.only, "only", nil, "upgrade only these controller Deployments")
+	addRolloutFlags(cmd, &opts.rollout)
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	_ = cmd.MarkFlagRequired("version")
+
+	return cmd
+}
+
+func runControllers(ctx context.Context, logger *log.Logger, opts *controllersOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+	if err := opts.rollout.validate(); err != nil {
+		return err
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	deployments, err := controllerDeployments(ctx, c, opts.only)
+	if err != nil {
+		return err
+	}
+
+	u := &controllerUpgrade{
+		c:       c,
+		logger:  logger,
+		opts:    &opts.rollout,
+		version: func(string) string { return opts.version },
+	}
+	return u.run(ctx, format, deployments)
+}
+
+// run upgrades the controllers and prints the results
+func (u *controllerUpgrade) run(ctx context.Context, format output.Format, deployments []appsv1.Deployment) error {
+	if len(deployments) == 0 {
+		return fmt.Errorf("no controller Deployments found in %s", controllerNamespace)
+	}
+
+	var results []ControllerResult
+	var upgradeErr error
+	if u.opts.canary {
+		results, upgradeErr = u.canary(ctx, deployments)
+	} else {
+		results, upgradeErr = u.all(ctx, deployments)
+	}
+
+	err := output.NewPrinter(format, os.Stdout).Print(results, func(w io.Writer) error {
+		table := output.NewTable(w, "CONTROLLER", "FROM", "TO", "RESULT", "MESSAGE")
+		for _, r := range results {
+			table.AddRow(r.Name, r.From, orDash(r.To), controllerResultColor(r.Result), r.Message)
+		}
+		return table.Flush()
+	})
+	if err != nil {
+		return err
+	}
+	return upgradeErr
+}
+
+// controllerDeployments lists the controller Deployments, sorted by name
+func controllerDeployments(ctx context.Context, c *client.Client, only []string) ([]appsv1.Deployment, error) {
+	list, err := c.Clientset.AppsV1().Deployments(controllerNamespace).List(ctx, metav1.ListOptions{LabelSelector: controllerSelector})
+	if err != nil {
+		return nil, fmt.Errorf("listing controller Deployments: %w", err)
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range only {
+		wanted[name] = true
+	}
+	var out []appsv1.Deployment
+	for _, d := range list.Items {
+		if len(wanted) > 0 && !wanted[d.Name] {
+			continue
+		}
+		delete(wanted, d.Name)
+		out = append(out, d)
+	}
+	if len(wanted) > 0 {
+		var missing []string
+		for name := range wanted {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("controller Deployments not found in %s: %s", controllerNamespace, strings.Join(missing, ", "))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// controllerUpgrade moves controller Deployments to new image tags
+type controllerUpgrade struct {
+	c      *client.Client
+	logger *log.Logger
+	opts   *rolloutOptions
+
+	// version returns the image tag for a controller, or "" to leave it
+	version func(name string) string
+}
+
+// all upgrades every controller, then waits for the rollouts
+func (u *controllerUpgrade) all(ctx context.Context, deployments []appsv1.Deployment) ([]ControllerResult, error) {
+	results := make([]ControllerResult, len(deployments))
+	for i := range deployments {
+		results[i] = u.start(ctx, &deployments[i])
+	}
+
+	for i := range deployments {
+		if results[i].Result != ResultUpgraded {
+			continue
+		}
+		if err := u.waitForRollout(ctx, deployments[i].Name); err != nil {
+			results[i].Result = ResultFailed
+			results[i].Message = err.Error()
+		}
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Result == ResultFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return results, fmt.Errorf("%d of %d controllers failed to upgrade", failed, len(results))
+	}
+	return results, nil
+}
+
+// canary upgrades controllers one at a time, baking each and rolling it
+// back on regression. Controllers after a rollback are skipped.
+func (u *controllerUpgrade) canary(ctx context.Context, deployments []appsv1.Deployment) ([]ControllerResult, error) {
+	var results []ControllerResult
+	for i := range deployments {
+		d := &deployments[i]
+		result := u.start(ctx, d)
+		if result.Result != ResultUpgraded {
+			results = append(results, result)
+			if result.Result == ResultFailed {
+				return append(results, skipped(deployments[i+1:])...), fmt.Errorf("upgrading %s: %s", d.Name, result.Message)
+			}
+			continue
+		}
+
+		u.logger.Info("upgrading controller", "controller", d.Name, "to", result.To)
+		regression := ""
+		if err := u.waitForRollout(ctx, d.Name); err != nil {
+			regression = err.Error()
+		} else {
+			u.logger.Info("baking", "controller", d.Name, "for", u.opts.bake)
+			regression = u.bake(ctx, d)
+		}
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+
+		if regression == "" {
+			u.logger.Success("controller upgraded", "controller", d.Name, "image", result.To)
+			results = append(results, result)
+			continue
+		}
+
+		u.logger.Warn("regression detected, rolling back", "controller", d.Name, "reason", regression)
+		result.Result = ResultRolledBack
+		result.Message = regression
+		if err := u.setImage(ctx, d.Name, result.From); err != nil {
+			result.Result = ResultFailed
+			result.Message = fmt.Sprintf("%s; rollback failed: %v", regression, err)
+		} else if err := u.waitForRollout(ctx, d.Name); err != nil {
+			result.Message = fmt.Sprintf("%s; rollback not yet healthy: %v", regression, err)
+		}
+		results = append(results, result)
+		return append(results, skipped(deployments[i+1:])...), fmt.Errorf("%s regressed on %s and was rolled back to %s", d.Name, result.To, result.From)
+	}
+	return results, nil
+}
+
+// start points a controller at the new image tag
+func (u *controllerUpgrade) start(ctx context.Context, d *appsv1.Deployment) ControllerResult {
+	result := ControllerResult{Name: d.Name}
+	container := managerContainerOf(d)
+	if container == nil {
+		result.Result = ResultSkipped
+		result.Message = "no " + managerContainer + " container"
+		return result
+	}
+	result.From = container.Image
+	version := u.version(d.Name)
+	if version == "" {
+		result.Result = ResultSkipped
+		result.Message = "no target version"
+		return result
+	}
+	result.To = manifests.WithTag(container.Image, version)
+	if result.From == result.To {
+		result.Result = ResultUnchanged
+		return result
+	}
+	if err := u.setImage(ctx, d.Name, result.To); err != nil {
+		result.Result = ResultFailed
+		result.Message = err.Error()
+		return result
+	}
+	result.Result = ResultUpgraded
+	return result
+}
+
+// setImage changes the manager container's image
+func (u *controllerUpgrade) setImage(ctx context.Context, name, image string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []map[string]interface{}{{"name": managerContainer, "image": image}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := u.c.Clientset.AppsV1().Deployments(controllerNamespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("setting image of %s: %w", name, err)
+	}
+	return nil
+}
+
+// waitForRollout waits until every replica runs the current template. A
+// new pod crash-looping ends the wait early.
+func (u *controllerUpgrade) waitForRollout(ctx context.Context, name string) error {
+	return waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("rollout of %s", name),
+		Interval:    3 * time.Second,
+		Timeout:     u.opts.rolloutTimeout,
+	}, func(ctx context.Context) (bool, string, error) {
+		d, err := u.c.Clientset.AppsV1().Deployments(controllerNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("error: %v", err), nil
+		}
+		pods, err := u.pods(ctx, d)
+		if err == nil {
+			if reason := crashLooping(pods); reason != "" {
+				return false, "", fmt.Errorf("%s", reason)
+			}
+		}
+		replicas := int32(1)
+		if d.Spec.Replicas != nil {
+			replicas = *d.Spec.Replicas
+		}
+		status := fmt.Sprintf("%d/%d updated", d.Status.UpdatedReplicas, replicas)
+		done := d.Status.ObservedGeneration >= d.Generation &&
+			d.Status.UpdatedReplicas == replicas &&
+			d.Status.AvailableReplicas == replicas &&
+			d.Status.Replicas == replicas
+		return done, status, nil
+	})
+}
+
+// bake watches an upgraded controller for --bake and returns why it
+// regressed, or "" if it stayed healthy
+func (u *controllerUpgrade) bake(ctx context.Context, d *appsv1.Deployment) string {
+	pods, err := u.pods(ctx, d)
+	if err != nil {
+		return err.Error()
+	}
+	restarts := restartCount(pods)
+	errorsAtStart, metricsOK := u.reconcileErrors(ctx, pods)
+	start := time.Now()
+
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+	for time.Since(start) < u.opts.bake {
+		select {
+		case <-ctx.Done():
+			return ""
+		case <-ticker.C:
+		}
+		pods, err := u.pods(ctx, d)
+		if err != nil {
+			u.logger.Debug("could not list controller pods", "controller", d.Name, "error", err)
+			continue
+		}
+		if reason := crashLooping(pods); reason != "" {
+			return reason
+		}
+		if n := restartCount(pods); n > restarts {
+			return fmt.Sprintf("%d container restarts during the bake", n-restarts)
+		}
+	}
+
+	if !metricsOK {
+		u.logger.Debug("reconcile error metrics unavailable; judged on restarts only", "controller", d.Name)
+		return ""
+	}
+	errorsAtEnd, ok := u.reconcileErrors(ctx, pods)
+	if !ok {
+		return ""
+	}
+	rate := (errorsAtEnd - errorsAtStart) / time.Since(start).Minutes()
+	if rate > u.opts.maxErrorRate {
+		return fmt.Sprintf("%.1f reconcile errors per minute (limit %.1f)", rate, u.opts.maxErrorRate)
+	}
+	return ""
+}
+
+// pods returns the controller's pods running its current template
+func (u *controllerUpgrade) pods(ctx context.Context, d *appsv1.Deployment) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing selector of %s: %w", d.Name, err)
+	}
+	list, err := u.c.Clientset.CoreV1().Pods(controllerNamespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods of %s: %w", d.Name, err)
+	}
+	image := ""
+	if container := managerContainerOf(d); container != nil {
+		image = container.Image
+	}
+	var out []corev1.Pod
+	for _, p := range list.Items {
+		for _, c := range p.Spec.Containers {
+			if c.Name == managerContainer && c.Image == image {
+				out = append(out, p)
+			}
+		}
+	}
+	return out, nil
+}
+
+// reconcileErrors sums the reconcile error counters of the pods. It
+// reports false when no pod's metrics could be read.
+func (u *controllerUpgrade) reconcileErrors(ctx context.Context, pods []corev1.Pod) (float64, bool) {
+	total, read := 0.0, false
+	for _, p := range pods {
+		port := metricsPort(&p)
+		if port == "" {
+			continue
+		}
+		raw, err := u.c.Clientset.CoreV1().Pods(p.Namespace).ProxyGet("http", p.Name, port, "/metrics", nil).DoRaw(ctx)
+		if err != nil {
+			u.logger.Debug("reading controller metrics", "pod", p.Name, "error", err)
+			continue
+		}
+		total += sumMetric(raw, reconcileErrorsMetric)
+		read = true
+	}
+	return total, read
+}
+
+// sumMetric adds up every sample of a Prometheus text-format metric
+func sumMetric(raw []byte, name string) float64 {
+	total := 0.0
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, name+"{") && !strings.HasPrefix(line, name+" ") {
+			continue
+		}
+		fields := strings.Fields(line[strings.LastIndex(line, "}")+1:])
+		if len(fields) == 0 {
+			continue
+		}
+		if v, err := strconv.ParseFloat(fields[0], 64); err == nil {
+			total += v
+		}
+	}
+	return total
+}
+
+// crashLooping describes the first container stuck in CrashLoopBackOff or
+// failing to pull its image, or returns ""
+func crashLooping(pods []corev1.Pod) string {
+	for _, p := range pods {
+		for _, s := range p.Status.ContainerStatuses {
+			if s.State.Waiting == nil {
+				continue
+			}
+			switch s.State.Waiting.Reason {
+			case "CrashLoopBackOff", "ImagePullBackOff", "ErrImagePull", "CreateContainerConfigError":
+				return fmt.Sprintf("pod %s: %s", p.Name, s.State.Waiting.Reason)
+			}
+		}
+	}
+	return ""
+}
+
+func restartCount(pods []corev1.Pod) int32 {
+	var n int32
+	for _, p := range pods {
+		for _, s := range p.Status.ContainerStatuses {
+			n += s.RestartCount
+		}
+	}
+	return n
+}
+
+// metricsPort returns the manager container's port named metrics
+func metricsPort(p *corev1.Pod) string {
+	for _, c := range p.Spec.Containers {
+		if c.Name != managerContainer {
+			continue
+		}
+		for _, port := range c.Ports {
+			if port.Name == "metrics" {
+				return strconv.Itoa(int(port.ContainerPort))
+			}
+		}
+	}
+	return ""
+}
+
+func managerContainerOf(d *appsv1.Deployment) *corev1.Container {
+	for i := range d.Spec.Template.Spec.Containers {
+		if d.Spec.Template.Spec.Containers[i].Name == managerContainer {
+			return &d.Spec.Template.Spec.Containers[i]
+		}
+	}
+	return nil
+}
+
+func skipped(deployments []appsv1.Deployment) []ControllerResult {
+	var out []ControllerResult
+	for _, d := range deployments {
+		out = append(out, ControllerResult{Name: d.Name, Result: ResultSkipped, Message: "not attempted after rollback"})
+	}
+	return out
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func controllerResultColor(result string) string {
+	switch result {
+	case ResultFailed, ResultRolledBack:
+		return output.Danger(result)
+	case ResultUpgraded:
+		return output.Success(result)
+	default:
+		return result
+	}
+}
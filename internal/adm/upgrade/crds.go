@@ -0,0 +1,430 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/manifests"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// fieldManager owns the CRD fields applied by butleradm
+const fieldManager = "butleradm"
+
+var crdGVR = schema.GroupVersionResource{
+	Group:    "apiextensions.k8s.io",
+	Version:  "v1",
+	Resource: "customresourcedefinitions",
+}
+
+// Results of a CRD upgrade
+const (
+	ResultPlanned  = "Planned"
+	ResultApplied  = "Applied"
+	ResultMigrated = "Migrated"
+	ResultFailed   = "Failed"
+)
+
+// CRDResult reports the upgrade of one CRD
+type CRDResult struct {
+	Name           string `json:"name"`
+	StorageVersion string `json:"storageVersion"`
+
+	// StoredVersions are the versions objects may still be stored in
+	// etcd as, per the CRD status, after the upgrade
+	StoredVersions []string `json:"storedVersions"`
+
+	Objects  int `json:"objects"`
+	Migrated int `json:"migrated"`
+
+	// Deprecated lists served versions marked deprecated
+	Deprecated []string `json:"deprecated,omitempty"`
+
+	Result  string `json:"result"`
+	Message string `json:"message,omitempty"`
+}
+
+type crdsOptions struct {
+	kubeconfig   string
+	dryRun       bool
+	outputFormat string
+}
+
+func newCRDsCmd(logger *log.Logger) *cobra.Command {
+	opts := &crdsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "crds",
+		Short: "Apply new CRD versions and migrate stored objects",
+		Long: `Upgrade the Butler CRDs to the versions shipped with this butleradm.
+
+For each CRD:
+  1. Checks the new CRD still serves every version objects are stored as,
+     and that its conversion webhook Service exists if it uses one.
+  2. Applies the CRD and waits for it to be established.
+  3. Migrates stored objects to the new storage version by rewriting each
+     one unchanged, which re-encodes it in etcd.
+  4. Records the storage version as the only stored version, and verifies
+     nothing is left in an older one.
+
+A CRD only moves to a new storage version, e.g. v1alpha1 to v1beta1, once
+its objects are migrated; dropping the old version from a later release is
+then safe. Served versions marked deprecated are reported.
+
+Examples:
+  # See what would change
+  butleradm upgrade crds --dry-run
+
+  # Upgrade and migrate
+  butleradm upgrade crds
+
+  # Results as JSON
+  butleradm upgrade crds -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCRDs(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "check and report without changing anything")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+func runCRDs(ctx context.Context, logger *log.Logger, opts *crdsOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	crds, err := manifests.LoadCRDs()
+	if err != nil {
+		return err
+	}
+
+	var results []CRDResult
+	failed := 0
+	for _, crd := range crds {
+		result := upgradeCRD(ctx, c, logger, crd, opts.dryRun)
+		if result.Result == ResultFailed {
+			failed++
+		}
+		if len(result.Deprecated) > 0 {
+			logger.Warn("CRD serves deprecated versions; move clients off them", "crd", result.Name, "versions", strings.Join(result.Deprecated, ","))
+		}
+		results = append(results, result)
+	}
+
+	err = output.NewPrinter(format, os.Stdout).Print(results, func(w io.Writer) error {
+		table := output.NewTable(w, "CRD", "STORAGE", "STORED", "OBJECTS", "RESULT", "MESSAGE")
+		for _, r := range results {
+			objects := strconv.Itoa(r.Objects)
+			if r.Result == ResultMigrated {
+				objects = fmt.Sprintf("%d/%d", r.Migrated, r.Objects)
+			}
+			table.AddRow(r.Name, r.StorageVersion, strings.Join(r.StoredVersions, ","), objects, resultColor(r.Result), r.Message)
+		}
+		return table.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d CRDs failed to upgrade", failed, len(results))
+	}
+	return nil
+}
+
+// upgradeCRD checks, applies and migrates one CRD
+func upgradeCRD(ctx context.Context, c *client.Client, logger *log.Logger, desired *unstructured.Unstructured, dryRun bool) CRDResult {
+	name := desired.GetName()
+	result := CRDResult{
+		Name:           name,
+		StorageVersion: storageVersion(desired),
+		Deprecated:     deprecatedVersions(desired),
+	}
+	fail := func(format string, args ...interface{}) CRDResult {
+		result.Result = ResultFailed
+		result.Message = fmt.Sprintf(format, args...)
+		return result
+	}
+
+	var stored []string
+	live, err := c.Dynamic.Resource(crdGVR).Get(ctx, name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fail("getting CRD: %v", err)
+	}
+	if err == nil {
+		stored, _, _ = unstructured.NestedStringSlice(live.Object, "status", "storedVersions")
+	}
+	result.StoredVersions = stored
+
+	if missing := droppedVersions(desired, stored); len(missing) > 0 {
+		return fail("objects are stored as %s, which the new CRD no longer serves; upgrade through a release that still does", strings.Join(missing, ","))
+	}
+	if err := checkConversionWebhook(ctx, c, desired); err != nil {
+		return fail("%v", err)
+	}
+
+	gvr := schema.GroupVersionResource{
+		Group:    nestedString(desired, "spec", "group"),
+		Version:  result.StorageVersion,
+		Resource: nestedString(desired, "spec", "names", "plural"),
+	}
+	needsMigration := live != nil && !onlyVersion(stored, result.StorageVersion)
+
+	if dryRun {
+		result.Result = ResultPlanned
+		switch {
+		case live == nil:
+			result.Message = "would create"
+		case needsMigration:
+			if objects, err := c.Dynamic.Resource(gvr).List(ctx, metav1.ListOptions{}); err == nil {
+				result.Objects = len(objects.Items)
+			}
+			result.Message = fmt.Sprintf("would migrate objects from %s to %s", strings.Join(stored, ","), result.StorageVersion)
+		default:
+			result.Message = "would apply"
+		}
+		return result
+	}
+
+	data, err := json.Marshal(desired.Object)
+	if err != nil {
+		return fail("encoding CRD: %v", err)
+	}
+	force := true
+	if _, err := c.Dynamic.Resource(crdGVR).Patch(ctx, name, types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: fieldManager, Force: &force}); err != nil {
+		return fail("applying CRD: %v", err)
+	}
+	if err := manifests.NewDeployer(c.Clientset, c.Dynamic).WaitForCRDs(ctx, []string{name}); err != nil {
+		return fail("%v", err)
+	}
+	result.Result = ResultApplied
+	logger.Debug("applied CRD", "crd", name, "storage", result.StorageVersion)
+
+	if !needsMigration {
+		if live == nil {
+			result.StoredVersions = []string{result.StorageVersion}
+		}
+		return result
+	}
+
+	result.Objects, result.Migrated, err = migrateObjects(ctx, c, gvr)
+	if err != nil {
+		return fail("migrating to %s (%d/%d done): %v", result.StorageVersion, result.Migrated, result.Objects, err)
+	}
+	stored, err = pruneStoredVersions(ctx, c, name, result.StorageVersion)
+	if err != nil {
+		return fail("%v", err)
+	}
+	result.StoredVersions = stored
+	if !onlyVersion(stored, result.StorageVersion) {
+		return fail("objects may still be stored as %s", strings.Join(stored, ","))
+	}
+
+	result.Result = ResultMigrated
+	logger.Debug("migrated CRD", "crd", name, "objects", result.Migrated)
+	return result
+}
+
+// migrateObjects rewrites every object unchanged so the API server stores
+// it in the current storage version. It returns the object count and how
+// many were rewritten.
+func migrateObjects(ctx context.Context, c *client.Client, gvr schema.GroupVersionResource) (int, int, error) {
+	list, err := c.Dynamic.Resource(gvr).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, 0, fmt.Errorf("listing %s: %w", gvr.Resource, err)
+	}
+
+	migrated := 0
+	for i := range list.Items {
+		obj := &list.Items[i]
+		resource := c.Dynamic.Resource(gvr).Namespace(obj.GetNamespace())
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			_, err := resource.Update(ctx, obj, metav1.UpdateOptions{})
+			if errors.IsConflict(err) {
+				if latest, getErr := resource.Get(ctx, obj.GetName(), metav1.GetOptions{}); getErr == nil {
+					obj = latest
+				}
+			}
+			return err
+		})
+		if errors.IsNotFound(err) {
+			// Deleted since the list; nothing left to migrate
+			continue
+		}
+		if err != nil {
+			return len(list.Items), migrated, fmt.Errorf("rewriting %s %s: %w", gvr.Resource, objectName(obj), err)
+		}
+		migrated++
+	}
+	return len(list.Items), migrated, nil
+}
+
+// pruneStoredVersions records the storage version as the only one objects
+// are stored as, once they've all been migrated
+func pruneStoredVersions(ctx context.Context, c *client.Client, name, version string) ([]string, error) {
+	var stored []string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crd, err := c.Dynamic.Resource(crdGVR).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedStringSlice(crd.Object, []string{version}, "status", "storedVersions"); err != nil {
+			return err
+		}
+		updated, err := c.Dynamic.Resource(crdGVR).UpdateStatus(ctx, crd, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+		stored, _, _ = unstructured.NestedStringSlice(updated.Object, "status", "storedVersions")
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating stored versions: %w", err)
+	}
+	return stored, nil
+}
+
+// checkConversionWebhook verifies the Service behind a CRD's conversion
+// webhook exists, since migration converts every object through it
+func checkConversionWebhook(ctx context.Context, c *client.Client, crd *unstructured.Unstructured) error {
+	if nestedString(crd, "spec", "conversion", "strategy") != "Webhook" {
+		return nil
+	}
+	namespace := nestedString(crd, "spec", "conversion", "webhook", "clientConfig", "service", "namespace")
+	name := nestedString(crd, "spec", "conversion", "webhook", "clientConfig", "service", "name")
+	if name == "" {
+		return nil
+	}
+	_, err := c.Clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("conversion webhook Service %s/%s not found; upgrade the controllers first", namespace, name)
+	}
+	if err != nil {
+		return fmt.Errorf("getting conversion webhook Service %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
+
+// storageVersion returns the version a CRD stores objects as
+func storageVersion(crd *unstructured.Unstructured) string {
+	for _, v := range crdVersions(crd) {
+		if storage, _ := v["storage"].(bool); storage {
+			name, _ := v["name"].(string)
+			return name
+		}
+	}
+	return ""
+}
+
+// deprecatedVersions returns the served versions marked deprecated
+func deprecatedVersions(crd *unstructured.Unstructured) []string {
+	var out []string
+	for _, v := range crdVersions(crd) {
+		served, _ := v["served"].(bool)
+		deprecated, _ := v["deprecated"].(bool)
+		if served && deprecated {
+			name, _ := v["name"].(string)
+			out = append(out, name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// droppedVersions returns the stored versions the CRD no longer lists.
+// The API server refuses such a CRD, and the objects would be unreadable.
+func droppedVersions(crd *unstructured.Unstructured, stored []string) []string {
+	listed := map[string]bool{}
+	for _, v := range crdVersions(crd) {
+		name, _ := v["name"].(string)
+		listed[name] = true
+	}
+	var dropped []string
+	for _, v := range stored {
+		if !listed[v] {
+			dropped = append(dropped, v)
+		}
+	}
+	return dropped
+}
+
+func crdVersions(crd *unstructured.Unstructured) []map[string]interface{} {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	out := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		if m, ok := v.(map[string]interface{}); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func onlyVersion(stored []string, version string) bool {
+	return len(stored) == 1 && stored[0] == version
+}
+
+func nestedString(obj *unstructured.Unstructured, fields ...string) string {
+	s, _, _ := unstructured.NestedString(obj.Object, fields...)
+	return s
+}
+
+func objectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+func resultColor(result string) string {
+	switch result {
+	case ResultFailed:
+		return output.Danger(result)
+	case ResultPlanned:
+		return result
+	default:
+		return output.Success(result)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package upgrade implements butleradm upgrade commands.
+package upgrade
+
+import (
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+)
+
+// NewUpgradeCmd creates the upgrade command
+func NewUpgradeCmd(logger *log.Logger) *cobra.Command {
+	opts := &channelOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade Butler platform components",
+		Long: `Upgrade Butler components on the management cluster.
+
+With --channel, or when the platform config subscribes to a channel
+(release.channel in the butler-platform ConfigMap), the controller versions
+come from that channel's published manifest:
+
+  stable  Releases recommended for production
+  rc      Release candidates for the next stable release
+  edge    The latest builds
+
+Controllers the manifest doesn't list are left alone. Without a channel,
+use the commands below to choose versions yourself.
+
+Commands:
+  crds         Apply new CRD versions and migrate stored objects
+  controllers  Upgrade the controllers, optionally one at a time with rollback
+
+Examples:
+  # See what the stable channel would change
+  butleradm upgrade --channel stable --dry-run
+
+  # Follow the channel the platform subscribes to, canarying each controller
+  butleradm upgrade --canary
+
+  # Upgrade the CRDs, then canary the controllers to a version
+  butleradm upgrade crds
+  butleradm upgrade controllers --version v0.5.0 --canary`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runChannelUpgrade(cmd, logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.channel, "channel", "", "release channel to upgrade to: stable, rc or edge (default: the platform's subscription)")
+	cmd.Flags().StringVar(&opts.manifest, "manifest", "", "channel manifest URL or file, overriding the platform config")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "show the target versions without upgrading")
+	addRolloutFlags(cmd, &opts.rollout)
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	cmd.AddCommand(newCRDsCmd(logger))
+	cmd.AddCommand(newControllersCmd(logger))
+
+	return cmd
+}
+
+func getClient(kubeconfigPath string) (*client.Client, error) {
+	if kubeconfigPath != "" {
+		return client.NewFromKubeconfig(kubeconfigPath)
+	}
+	return client.NewFromDefault()
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package access records time-boxed credentials issued for tenant clusters.
+//
+// Every credential minted by `butlerctl cluster kubeconfig --expires` is
+// recorded as a labeled ConfigMap next to its TenantCluster on the management
+// cluster, so operators can audit outstanding access with `butleradm access list`.
+package access
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RecordLabel marks ConfigMaps holding issued credential records
+	RecordLabel = "butler.butlerlabs.dev/access-record"
+
+	// ClusterLabel holds the TenantCluster a record or MachineRequest belongs to
+	ClusterLabel = "butler.butlerlabs.dev/cluster"
+)
+
+// Record describes one issued credential
+type Record struct {
+	Cluster        string    `json:"cluster"`
+	Namespace      string    `json:"namespace"`
+	IssuedTo       string    `json:"issuedTo"`
+	Role           string    `json:"role"`
+	ServiceAccount string    `json:"serviceAccount"`
+	IssuedAt       time.Time `json:"issuedAt"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+// Expired reports whether the credential is no longer valid at t
+func (r *Record) Expired(t time.Time) bool {
+	return !t.Before(r.ExpiresAt)
+}
+
+// Save stores a record in the TenantCluster's namespace
+func Save(ctx context.Context, c *client.Client, r *Record) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: r.Cluster + "-access-",
+			Namespace:    r.Namespace,
+			Labels: map[string]string{
+				RecordLabel:  "true",
+				ClusterLabel: r.Cluster,
+			},
+		},
+		Data: map[string]string{
+			"cluster":        r.Cluster,
+			"issuedTo":       r.IssuedTo,
+			"role":           r.Role,
+			"serviceAccount": r.ServiceAccount,
+			"issuedAt":       r.IssuedAt.UTC().Format(time.RFC3339),
+			"expiresAt":      r.ExpiresAt.UTC().Format(time.RFC3339),
+		},
+	}
+
+	if _, err := c.Clientset.CoreV1().ConfigMaps(r.Namespace).Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("recording issued credential: %w", err)
+	}
+	return nil
+}
+
+// List returns records in a namespace, or all namespaces when namespace is empty.
+// An empty cluster matches every cluster.
+func List(ctx context.Context, c *client.Client, namespace, cluster string) ([]Record, error) {
+	selector := RecordLabel + "=true"
+	if cluster != "" {
+		selector += "," + ClusterLabel + "=" + cluster
+	}
+
+	list, err := c.Clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("listing access records: %w", err)
+	}
+
+	records := make([]Record, 0, len(list.Items))
+	for _, cm := range list.Items {
+		issuedAt, _ := time.Parse(time.RFC3339, cm.Data["issuedAt"])
+		expiresAt, _ := time.Parse(time.RFC3339, cm.Data["expiresAt"])
+		records = append(records, Record{
+			Cluster:        cm.Data["cluster"],
+			Namespace:      cm.Namespace,
+			IssuedTo:       cm.Data["issuedTo"],
+			Role:           cm.Data["role"],
+			ServiceAccount: cm.Data["serviceAccount"],
+			IssuedAt:       issuedAt,
+			ExpiresAt:      expiresAt,
+		})
+	}
+	return records, nil
+}
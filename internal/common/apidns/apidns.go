@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apidns gives tenant API endpoints stable DNS names.
+//
+// When the butler-platform ConfigMap sets dns.zone, `butlerctl cluster
+// create` records the cluster's name in the zone as an annotation on the
+// TenantCluster and adds it to the API server certificate. Publishing
+// annotates the hosted control plane Service for external-dns, which keeps
+// the record pointed at whatever address the Service currently has.
+// Kubeconfigs then use the name instead of the address.
+package apidns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// HostnameAnnotation records a cluster's API endpoint DNS name
+	HostnameAnnotation = "butler.butlerlabs.dev/api-hostname"
+
+	// ExternalDNSAnnotation asks external-dns to publish a Service
+	ExternalDNSAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+)
+
+// Hostname returns the DNS name recorded on a TenantCluster, or ""
+func Hostname(tc *unstructured.Unstructured) string {
+	return tc.GetAnnotations()[HostnameAnnotation]
+}
+
+// Publish annotates the cluster's hosted control plane Service, named
+// after the cluster in its tenant namespace, for external-dns. It returns
+// false when the cluster has no DNS name or its Service doesn't exist yet.
+func Publish(ctx context.Context, c *client.Client, tc *unstructured.Unstructured) (bool, error) {
+	hostname := Hostname(tc)
+	tenantNamespace, _, _ := unstructured.NestedString(tc.Object, "status", "tenantNamespace")
+	if hostname == "" || tenantNamespace == "" {
+		return false, nil
+	}
+
+	services := c.Clientset.CoreV1().Services(tenantNamespace)
+	svc, err := services.Get(ctx, tc.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting Service %s/%s: %w", tenantNamespace, tc.GetName(), err)
+	}
+	if svc.Annotations[ExternalDNSAnnotation] == hostname {
+		return true, nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{ExternalDNSAnnotation: hostname},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	if _, err := services.Patch(ctx, svc.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return false, fmt.Errorf("annotating Service %s/%s: %w", tenantNamespace, svc.Name, err)
+	}
+	return true, nil
+}
+
+// Resolves reports whether hostname has an address yet
+func Resolves(ctx context.Context, hostname string) bool {
+	addrs, err := net.DefaultResolver.LookupHost(ctx, hostname)
+	return err == nil && len(addrs) > 0
+}
+
+// RewriteServer replaces the host of every cluster server in a kubeconfig
+// with hostname, keeping the port
+func RewriteServer(kubeconfig []byte, hostname string) ([]byte, error) {
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+	for name, cluster := range cfg.Clusters {
+		u, err := url.Parse(cluster.Server)
+		if err != nil {
+			return nil, fmt.Errorf("parsing server of cluster %s: %w", name, err)
+		}
+		if port := u.Port(); port != "" {
+			u.Host = net.JoinHostPort(hostname, port)
+		} else {
+			u.Host = hostname
+		}
+		cluster.Server = u.String()
+	}
+	return clientcmd.Write(*cfg)
+}
@@ -24,6 +24,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/butlerdotdev/butler/internal/common/credstore"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -66,11 +68,21 @@ var (
 		Version:  ButlerAPIVersion,
 		Resource: "teams",
 	}
+	UserGVR = schema.GroupVersionResource{
+		Group:    ButlerAPIGroup,
+		Version:  ButlerAPIVersion,
+		Resource: "users",
+	}
 	ButlerConfigGVR = schema.GroupVersionResource{
 		Group:    ButlerAPIGroup,
 		Version:  ButlerAPIVersion,
 		Resource: "butlerconfigs",
 	}
+	AddonDefinitionGVR = schema.GroupVersionResource{
+		Group:    ButlerAPIGroup,
+		Version:  ButlerAPIVersion,
+		Resource: "addondefinitions",
+	}
 	// CAPI resources
 	MachineDeploymentGVR = schema.GroupVersionResource{
 		Group:    "cluster.x-k8s.io",
@@ -82,12 +94,40 @@ var (
 		Version:  "v1beta1",
 		Resource: "clusters",
 	}
+	MachineGVR = schema.GroupVersionResource{
+		Group:    "cluster.x-k8s.io",
+		Version:  "v1beta1",
+		Resource: "machines",
+	}
+	// Flux resources
+	HelmReleaseGVR = schema.GroupVersionResource{
+		Group:    "helm.toolkit.fluxcd.io",
+		Version:  "v2",
+		Resource: "helmreleases",
+	}
+	// Steward hosted control plane resources (Kamaji API)
+	TenantControlPlaneGVR = schema.GroupVersionResource{
+		Group:    "kamaji.clastix.io",
+		Version:  "v1alpha1",
+		Resource: "tenantcontrolplanes",
+	}
+	DataStoreGVR = schema.GroupVersionResource{
+		Group:    "kamaji.clastix.io",
+		Version:  "v1alpha1",
+		Resource: "datastores",
+	}
+	// Sealed Secrets resources
+	SealedSecretGVR = schema.GroupVersionResource{
+		Group:    "bitnami.com",
+		Version:  "v1alpha1",
+		Resource: "sealedsecrets",
+	}
 )
 
 // Client wraps Kubernetes clients for Butler operations
 type Client struct {
 	// Clientset for core Kubernetes resources
-	Clientset *kubernetes.Clientset
+	Clientset kubernetes.Interface
 
 	// Dynamic client for Butler CRDs
 	Dynamic dynamic.Interface
@@ -96,13 +136,21 @@ type Client struct {
 	Config *rest.Config
 }
 
-// NewFromKubeconfig creates a client from a kubeconfig path
+// NewFromKubeconfig creates a client from a kubeconfig path. Kubeconfigs
+// sealed by credstore are unsealed with the OS keychain key. The global
+// --context and impersonation flags apply.
 func NewFromKubeconfig(kubeconfigPath string) (*Client, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
-	if err != nil {
-		return nil, fmt.Errorf("building config from %s: %w", kubeconfigPath, err)
-	}
-	return newClient(config)
+	f := *Flags
+	f.Kubeconfig = kubeconfigPath
+	return NewFromFlags(&f)
+}
+
+// NewFromKubeconfigOnly creates a client for a cluster other than the one
+// the global flags select, such as a standby management cluster or a
+// provider's own cluster. Only the kubeconfig path is used: the global
+// --context, impersonation flags and an installed Factory don't apply.
+func NewFromKubeconfigOnly(kubeconfigPath string) (*Client, error) {
+	return newFromFlags(&ConnectionFlags{Kubeconfig: kubeconfigPath})
 }
 
 // NewFromBytes creates a client from kubeconfig bytes
@@ -114,12 +162,24 @@ func NewFromBytes(kubeconfig []byte) (*Client, error) {
 	return newClient(config)
 }
 
-// NewFromDefault creates a client using standard kubeconfig discovery.
+// NewFromDefault creates a client from the global connection flags, using
+// standard kubeconfig discovery when --kubeconfig isn't set.
 // Priority order:
-//  1. KUBECONFIG environment variable
-//  2. Butler kubeconfigs in ~/.butler/ (files ending in -kubeconfig)
-//  3. Standard ~/.kube/config
+//  1. --kubeconfig flag
+//  2. KUBECONFIG environment variable
+//  3. Butler kubeconfigs in ~/.butler/ (files ending in -kubeconfig)
+//  4. Standard ~/.kube/config
+//  5. In-cluster service account, for butleradm running as a Job
 func NewFromDefault() (*Client, error) {
+	return NewFromFlags(Flags)
+}
+
+// errNoKubeconfig is returned when discovery finds nothing to connect with
+var errNoKubeconfig = fmt.Errorf("no kubeconfig found; set KUBECONFIG env var, use --kubeconfig flag, or ensure ~/.kube/config exists")
+
+// discoverKubeconfig returns the kubeconfig to use without --kubeconfig, or
+// "" to fall back to the in-cluster config
+func discoverKubeconfig() (string, error) {
 	// 1. Check KUBECONFIG environment variable first (standard kubectl behavior)
 	if kubeconfigEnv := os.Getenv("KUBECONFIG"); kubeconfigEnv != "" {
 		// KUBECONFIG can contain multiple paths separated by ":"
@@ -131,31 +191,32 @@ func NewFromDefault() (*Client, error) {
 				continue
 			}
 			if _, err := os.Stat(p); err == nil {
-				return NewFromKubeconfig(p)
+				return p, nil
 			}
 		}
 		// If KUBECONFIG is set but files don't exist, return error
-		return nil, fmt.Errorf("KUBECONFIG is set but no valid kubeconfig found at: %s", kubeconfigEnv)
+		return "", fmt.Errorf("KUBECONFIG is set but no valid kubeconfig found at: %s", kubeconfigEnv)
 	}
 
 	home, err := os.UserHomeDir()
 	if err != nil {
-		return nil, fmt.Errorf("getting home directory: %w", err)
+		return "", fmt.Errorf("getting home directory: %w", err)
 	}
 
 	// 2. Try Butler-specific kubeconfigs in ~/.butler/
 	butlerDir := filepath.Join(home, ".butler")
 	if kubeconfigPath := findButlerKubeconfig(butlerDir); kubeconfigPath != "" {
-		return NewFromKubeconfig(kubeconfigPath)
+		return kubeconfigPath, nil
 	}
 
 	// 3. Fall back to standard kubeconfig
 	defaultConfig := filepath.Join(home, ".kube", "config")
 	if _, err := os.Stat(defaultConfig); err == nil {
-		return NewFromKubeconfig(defaultConfig)
+		return defaultConfig, nil
 	}
 
-	return nil, fmt.Errorf("no kubeconfig found; set KUBECONFIG env var, use --kubeconfig flag, or ensure ~/.kube/config exists")
+	// 4. Running in a pod
+	return "", nil
 }
 
 // findButlerKubeconfig looks for kubeconfig files in the Butler directory
@@ -165,14 +226,14 @@ func findButlerKubeconfig(butlerDir string) string {
 		return ""
 	}
 
-	// Look for files ending in -kubeconfig
+	// Look for files ending in -kubeconfig, sealed or not
 	for _, entry := range entries {
 		if entry.IsDir() {
 			continue
 		}
-		name := entry.Name()
+		name := strings.TrimSuffix(entry.Name(), credstore.SealedExt)
 		if strings.HasSuffix(name, "-kubeconfig") {
-			return filepath.Join(butlerDir, name)
+			return filepath.Join(butlerDir, entry.Name())
 		}
 	}
 
@@ -187,6 +248,8 @@ func findButlerKubeconfig(butlerDir string) string {
 
 // newClient creates a client from a rest config
 func newClient(config *rest.Config) (*Client, error) {
+	instrument(config)
+
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("creating clientset: %w", err)
@@ -237,3 +300,66 @@ func (c *Client) ListProviderConfigs(ctx context.Context, namespace string) (*un
 func (c *Client) GetProviderConfig(ctx context.Context, namespace, name string) (*unstructured.Unstructured, error) {
 	return c.Dynamic.Resource(ProviderConfigGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 }
+
+// GetTenantKubeconfig returns the admin kubeconfig for a TenantCluster.
+// Steward stores it in the <name>-admin-kubeconfig Secret in the cluster's
+// tenant namespace.
+func (c *Client) GetTenantKubeconfig(ctx context.Context, namespace, name string) ([]byte, error) {
+	tc, err := c.GetTenantCluster(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("getting TenantCluster %s/%s: %w", namespace, name, err)
+	}
+
+	tenantNS, _, _ := unstructured.NestedString(tc.Object, "status", "tenantNamespace")
+	if tenantNS == "" {
+		phase, _, _ := unstructured.NestedString(tc.Object, "status", "phase")
+		return nil, fmt.Errorf("TenantCluster %s does not have a tenant namespace yet (phase: %s)", name, phase)
+	}
+
+	secretName := name + "-admin-kubeconfig"
+	secret, err := c.Clientset.CoreV1().Secrets(tenantNS).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting kubeconfig secret %s/%s: %w", tenantNS, secretName, err)
+	}
+
+	// Steward uses admin.conf; older versions used kubeconfig or value
+	for _, key := range []string{"admin.conf", "kubeconfig", "value"} {
+		if data, ok := secret.Data[key]; ok {
+			return data, nil
+		}
+	}
+	return nil, fmt.Errorf("kubeconfig secret %s/%s does not contain kubeconfig data (keys: admin.conf, kubeconfig, or value)",
+		tenantNS, secretName)
+}
+
+// NewForTenant returns a client for a TenantCluster using its admin kubeconfig
+func (c *Client) NewForTenant(ctx context.Context, namespace, name string) (*Client, error) {
+	kubeconfig, err := c.GetTenantKubeconfig(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromBytes(kubeconfig)
+}
+
+// NodeInternalIPs returns the InternalIP of every node matching selector,
+// e.g. for talosctl
+func (c *Client) NodeInternalIPs(ctx context.Context, selector string) ([]string, error) {
+	nodes, err := c.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("listing nodes: %w", err)
+	}
+
+	var ips []string
+	for _, node := range nodes.Items {
+		for _, addr := range node.Status.Addresses {
+			if addr.Type == corev1.NodeInternalIP {
+				ips = append(ips, addr.Address)
+				break
+			}
+		}
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("no nodes found")
+	}
+	return ips, nil
+}
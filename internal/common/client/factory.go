@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import "context"
+
+// Factory creates management cluster clients. Commands connect through
+// NewFromDefault and NewFromKubeconfig, which defer to the installed
+// Factory, so tests can run whole commands against a fake cluster.
+type Factory interface {
+	New(flags *ConnectionFlags) (*Client, error)
+}
+
+// factory replaces kubeconfig loading when set by UseFactory
+var factory Factory
+
+// UseFactory makes every new management cluster client come from f; nil
+// restores kubeconfig loading
+func UseFactory(f Factory) {
+	factory = f
+}
+
+type factoryKey struct{}
+
+// WithFactory returns a context carrying f. Both root commands install the
+// Factory of the context they are executed with.
+func WithFactory(ctx context.Context, f Factory) context.Context {
+	return context.WithValue(ctx, factoryKey{}, f)
+}
+
+// FactoryFrom returns the Factory carried by ctx, or nil
+func FactoryFrom(ctx context.Context) Factory {
+	f, _ := ctx.Value(factoryKey{}).(Factory)
+	return f
+}
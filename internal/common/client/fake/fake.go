@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory management cluster for running
+// commands without a real API server. Install it on the context the root
+// command is executed with:
+//
+//	c := fake.NewClient(tenantCluster, providerConfig)
+//	ctx := client.WithFactory(context.Background(), fake.Factory(c))
+//	err := root.ExecuteContext(ctx)
+//
+// Only management cluster clients are faked; tenant clients built from a
+// cluster's kubeconfig still try to connect.
+package fake
+
+import (
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// listKinds names the list kind of every custom resource the CLIs read, as
+// the fake dynamic client needs it to serve lists
+var listKinds = map[schema.GroupVersionResource]string{
+	client.TenantClusterGVR:      "TenantClusterList",
+	client.ClusterBootstrapGVR:   "ClusterBootstrapList",
+	client.ProviderConfigGVR:     "ProviderConfigList",
+	client.MachineRequestGVR:     "MachineRequestList",
+	client.TeamGVR:               "TeamList",
+	client.UserGVR:               "UserList",
+	client.ButlerConfigGVR:       "ButlerConfigList",
+	client.AddonDefinitionGVR:    "AddonDefinitionList",
+	client.MachineDeploymentGVR:  "MachineDeploymentList",
+	client.ClusterGVR:            "ClusterList",
+	client.MachineGVR:            "MachineList",
+	client.HelmReleaseGVR:        "HelmReleaseList",
+	client.TenantControlPlaneGVR: "TenantControlPlaneList",
+	client.DataStoreGVR:          "DataStoreList",
+	client.SealedSecretGVR:       "SealedSecretList",
+}
+
+// NewClient returns a Client whose clientsets are seeded with objects.
+// Unstructured objects are served by the dynamic client, typed ones such
+// as Secrets and ConfigMaps by the Clientset.
+func NewClient(objects ...runtime.Object) *client.Client {
+	var typed, dynamic []runtime.Object
+	for _, obj := range objects {
+		if _, ok := obj.(*unstructured.Unstructured); ok {
+			dynamic = append(dynamic, obj)
+		} else {
+			typed = append(typed, obj)
+		}
+	}
+
+	return &client.Client{
+		Clientset: kubefake.NewSimpleClientset(typed...),
+		Dynamic:   dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme.Scheme, listKinds, dynamic...),
+		Config:    &rest.Config{Host: "https://fake.invalid"},
+	}
+}
+
+// factory hands out the same Client for every connection
+type factory struct {
+	client *client.Client
+}
+
+// Factory returns a client.Factory that always returns c, whatever the
+// connection flags
+func Factory(c *client.Client) client.Factory {
+	return &factory{client: c}
+}
+
+func (f *factory) New(*client.ConnectionFlags) (*client.Client, error) {
+	return f.client, nil
+}
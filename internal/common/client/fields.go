@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// unknownFieldPattern matches the fields named in a strict decoding error
+var unknownFieldPattern = regexp.MustCompile(`unknown field "([^"]+)"`)
+
+// RejectedFields returns the fields a write was rejected for: those strict
+// field validation didn't know and those whose value is outside the
+// schema's enum. Requests need FieldValidation Strict for the former.
+func RejectedFields(err error) []string {
+	var fields []string
+	if errors.IsBadRequest(err) {
+		for _, m := range unknownFieldPattern.FindAllStringSubmatch(err.Error(), -1) {
+			fields = append(fields, m[1])
+		}
+	}
+	if status, ok := err.(errors.APIStatus); ok && errors.IsInvalid(err) && status.Status().Details != nil {
+		for _, cause := range status.Status().Details.Causes {
+			if cause.Type == metav1.CauseTypeFieldValueNotSupported {
+				fields = append(fields, cause.Field)
+			}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/butlerdotdev/butler/internal/common/credstore"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// ConnectionFlags selects the management cluster the way kubectl does. Both
+// CLIs register Flags on their root command, so every subcommand that
+// connects through NewFromDefault or NewFromKubeconfig honors them.
+//
+// A subcommand's own --kubeconfig flag takes the place of the global one.
+// Namespaces are not global: commands that use one define --namespace.
+type ConnectionFlags struct {
+	// Kubeconfig is the kubeconfig file; empty means discovery
+	Kubeconfig string
+
+	// Context is the kubeconfig context to use instead of the current one
+	Context string
+
+	// As, AsGroups and AsUID impersonate a user, e.g. to check what a
+	// Team member is allowed to do. They need RBAC for the impersonate verb.
+	As       string
+	AsGroups []string
+	AsUID    string
+}
+
+// Flags holds the global connection flags of the running CLI
+var Flags = &ConnectionFlags{}
+
+// AddFlags registers the connection flags on fs
+func (f *ConnectionFlags) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&f.Kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig (env: KUBECONFIG)")
+	fs.StringVar(&f.Context, "context", "", "kubeconfig context to use")
+	fs.StringVar(&f.As, "as", "", "username to impersonate")
+	fs.StringArrayVar(&f.AsGroups, "as-group", nil, "group to impersonate, repeatable")
+	fs.StringVar(&f.AsUID, "as-uid", "", "UID to impersonate")
+}
+
+// NewFromFlags creates a client for the cluster f selects, or asks the
+// Factory installed with UseFactory. Without a kubeconfig the usual
+// discovery applies; see NewFromDefault.
+func NewFromFlags(f *ConnectionFlags) (*Client, error) {
+	if f.As == "" && (len(f.AsGroups) > 0 || f.AsUID != "") {
+		return nil, fmt.Errorf("--as-group and --as-uid need --as")
+	}
+	if factory != nil {
+		return factory.New(f)
+	}
+	return newFromFlags(f)
+}
+
+// newFromFlags builds the client for f without consulting the Factory
+func newFromFlags(f *ConnectionFlags) (*Client, error) {
+	path := f.Kubeconfig
+	if path == "" {
+		var err error
+		if path, err = discoverKubeconfig(); err != nil {
+			return nil, err
+		}
+	}
+
+	if path == "" {
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, errNoKubeconfig
+		}
+		config.Impersonate.UserName = f.As
+		config.Impersonate.Groups = f.AsGroups
+		config.Impersonate.UID = f.AsUID
+		return newClient(config)
+	}
+
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: f.Context}
+	overrides.AuthInfo.Impersonate = f.As
+	overrides.AuthInfo.ImpersonateGroups = f.AsGroups
+	overrides.AuthInfo.ImpersonateUID = f.AsUID
+
+	var loader clientcmd.ClientConfig
+	if credstore.IsSealed(path) {
+		data, err := credstore.Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		raw, err := clientcmd.Load(data)
+		if err != nil {
+			return nil, fmt.Errorf("parsing kubeconfig: %w", err)
+		}
+		loader = clientcmd.NewNonInteractiveClientConfig(*raw, f.Context, overrides, nil)
+	} else {
+		rules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: path}
+		loader = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+	}
+	config, err := loader.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("building config from %s: %w", path, err)
+	}
+	return newClient(config)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Client-side rate limits shared by every client of the process. They match
+// kubectl's; client-go's own defaults of 5/10 make list-heavy commands crawl.
+const (
+	DefaultQPS   = 50
+	DefaultBurst = 100
+)
+
+// Stats summarizes the API requests made by the running CLI
+type Stats struct {
+	// Calls is the number of requests sent, retries included
+	Calls int
+
+	// Retries counts responses client-go retries: 429s and 5xx errors
+	// with a Retry-After header
+	Retries int
+
+	// Latency is the time spent waiting on responses, summed
+	Latency time.Duration
+
+	// Throttled is the time requests waited on the client-side rate limit
+	Throttled time.Duration
+}
+
+// String renders s as e.g. "12 API calls, 1 retry, 2.3s total"
+func (s Stats) String() string {
+	out := fmt.Sprintf("%d API %s, %d %s, %s total", s.Calls, plural(s.Calls, "call", "calls"),
+		s.Retries, plural(s.Retries, "retry", "retries"), roundDuration(s.Latency))
+	if s.Throttled >= time.Millisecond {
+		out += fmt.Sprintf(", %s throttled", roundDuration(s.Throttled))
+	}
+	return out
+}
+
+var (
+	statsMu sync.Mutex
+	stats   Stats
+
+	// tracer logs every request when set by TraceRequests
+	tracer *log.Logger
+
+	// limiter is shared so the rate limit holds across clients
+	limiter = &countingLimiter{RateLimiter: flowcontrol.NewTokenBucketRateLimiter(DefaultQPS, DefaultBurst)}
+)
+
+// RequestStats returns the requests made so far
+func RequestStats() Stats {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	return stats
+}
+
+// TraceRequests logs method, resource, namespace, status and latency of
+// every API request at debug level, e.g. for -vv
+func TraceRequests(logger *log.Logger) {
+	tracer = logger
+}
+
+// instrument rate-limits and records the requests made through config
+func instrument(config *rest.Config) {
+	if config.RateLimiter == nil && config.QPS == 0 {
+		config.RateLimiter = limiter
+	}
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &recordingTransport{next: rt}
+	})
+}
+
+// recordingTransport counts requests and traces them when enabled
+type recordingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	status := "error"
+	retry := false
+	if err == nil {
+		status = fmt.Sprintf("%d", resp.StatusCode)
+		retry = resp.StatusCode == http.StatusTooManyRequests ||
+			(resp.StatusCode >= 500 && resp.Header.Get("Retry-After") != "")
+	}
+
+	statsMu.Lock()
+	stats.Calls++
+	stats.Latency += latency
+	if retry {
+		stats.Retries++
+	}
+	statsMu.Unlock()
+
+	if tracer != nil {
+		resource, namespace := describeRequest(req.URL.Path)
+		tracer.Debug("api request", "method", req.Method, "resource", resource, "namespace", namespace,
+			"status", status, "latency", latency.Round(time.Millisecond))
+	}
+	return resp, err
+}
+
+// describeRequest returns the group/version/resource and namespace of an
+// API path such as /apis/butler.butlerlabs.dev/v1alpha1/namespaces/ns/tenantclusters.
+// Paths outside the resource APIs are returned as they are.
+func describeRequest(path string) (resource, namespace string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	var gv string
+	switch {
+	case len(parts) >= 3 && parts[0] == "api":
+		gv, parts = parts[1], parts[2:]
+	case len(parts) >= 4 && parts[0] == "apis":
+		gv, parts = parts[1]+"/"+parts[2], parts[3:]
+	default:
+		return path, ""
+	}
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		namespace, parts = parts[1], parts[2:]
+	}
+	resource = parts[0]
+	if len(parts) >= 3 {
+		// A subresource, e.g. pods/NAME/log
+		resource += "/" + parts[2]
+	}
+	return gv + "/" + resource, namespace
+}
+
+// countingLimiter records how long requests wait on the rate limit
+type countingLimiter struct {
+	flowcontrol.RateLimiter
+}
+
+func (l *countingLimiter) Accept() {
+	start := time.Now()
+	l.RateLimiter.Accept()
+	l.record(time.Since(start))
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	start := time.Now()
+	err := l.RateLimiter.Wait(ctx)
+	l.record(time.Since(start))
+	return err
+}
+
+func (l *countingLimiter) record(waited time.Duration) {
+	statsMu.Lock()
+	stats.Throttled += waited
+	statsMu.Unlock()
+}
+
+// roundDuration rounds to milliseconds below a second and to tenths above
+func roundDuration(d time.Duration) time.Duration {
+	if d < time.Second {
+		return d.Round(time.Millisecond)
+	}
+	return d.Round(100 * time.Millisecond)
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cmdtest runs CLI commands in-process against a fake management
+// cluster and compares what they print with golden files.
+//
+//	root := cmd.NewRootCmd(log.New("butlerctl"))
+//	res, err := cmdtest.Run(root, fake.NewClient(tc), "cluster", "list", "-o", "yaml")
+//	cmdtest.Golden(t, "testdata/list.yaml.golden", res.Stdout)
+//
+// Run BUTLER_UPDATE_GOLDEN=1 go test ./... to rewrite golden files after an
+// intended output change. Commands print to os.Stdout directly, so Run
+// swaps it and must not be used from parallel tests.
+package cmdtest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/client/fake"
+	"github.com/spf13/cobra"
+)
+
+// EnvUpdateGolden rewrites golden files instead of comparing when set to a
+// true value
+const EnvUpdateGolden = "BUTLER_UPDATE_GOLDEN"
+
+// Result is what a command printed
+type Result struct {
+	Stdout []byte
+	Stderr []byte
+}
+
+// Run executes root with args, connecting every management cluster client
+// to c, and captures its output. Build a new root for every Run; cobra
+// keeps flag values on the command.
+func Run(root *cobra.Command, c *client.Client, args ...string) (*Result, error) {
+	// The connection flags and the installed Factory are shared by every
+	// root command; each run starts from and leaves defaults behind
+	reset()
+	defer reset()
+	ctx := client.WithFactory(context.Background(), fake.Factory(c))
+
+	stdout, restoreStdout, err := capture(&os.Stdout)
+	if err != nil {
+		return nil, err
+	}
+	stderr, restoreStderr, err := capture(&os.Stderr)
+	if err != nil {
+		restoreStdout()
+		return nil, err
+	}
+
+	root.SetArgs(args)
+	root.SetOut(os.Stdout)
+	root.SetErr(os.Stderr)
+	runErr := root.ExecuteContext(ctx)
+
+	restoreStdout()
+	restoreStderr()
+	return &Result{Stdout: <-stdout, Stderr: <-stderr}, runErr
+}
+
+// reset restores the package globals a run may have set
+func reset() {
+	*client.Flags = client.ConnectionFlags{}
+	client.UseFactory(nil)
+}
+
+// capture points *f at a pipe until restore is called, and delivers what
+// was written on the returned channel
+func capture(f **os.File) (<-chan []byte, func(), error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	orig := *f
+	*f = w
+
+	out := make(chan []byte, 1)
+	go func() {
+		data, _ := io.ReadAll(r)
+		r.Close()
+		out <- data
+	}()
+
+	restore := func() {
+		w.Close()
+		*f = orig
+	}
+	return out, restore, nil
+}
+
+// Golden compares got with the golden file at path, or rewrites the file
+// when EnvUpdateGolden is set
+func Golden(t testing.TB, path string, got []byte) {
+	t.Helper()
+
+	if update, _ := strconv.ParseBool(os.Getenv(EnvUpdateGolden)); update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("creating %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("writing %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file (run with %s=1 to create it): %v", EnvUpdateGolden, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s (run with %s=1 to update)\n--- got\n%s\n--- want\n%s", path, EnvUpdateGolden, got, want)
+	}
+}
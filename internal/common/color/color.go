@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package color decides whether the CLIs may write ANSI colors. Tables and
+// help on stdout and logs on stderr share the same rules: never when
+// NO_COLOR (https://no-color.org/) or BUTLER_NO_COLOR is set, and only to
+// a terminal.
+package color
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+// Disabled reports whether colors are turned off by the environment
+func Disabled() bool {
+	// NO_COLOR takes precedence
+	if _, exists := os.LookupEnv("NO_COLOR"); exists {
+		return true
+	}
+	// Also check BUTLER_NO_COLOR for convenience
+	if _, exists := os.LookupEnv("BUTLER_NO_COLOR"); exists {
+		return true
+	}
+	return false
+}
+
+// IsTerminal returns true if f is a terminal
+func IsTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// Enabled returns true if colors may be written to f
+func Enabled(f *os.File) bool {
+	return !Disabled() && IsTerminal(f)
+}
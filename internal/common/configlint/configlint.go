@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package configlint checks config values against a JSON Schema.
+//
+// viper and the YAML decoders ignore keys they don't know, so a misspelled
+// setting silently falls back to its default. Lint reports unknown keys
+// with the closest valid names, values of the wrong shape and values
+// outside an enum. Only the schema keywords the generated config schemas
+// use are understood: type, properties, additionalProperties, items, enum.
+package configlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/suggest"
+)
+
+// Problem is a config value that doesn't match the schema
+type Problem struct {
+	// Path locates the value, e.g. cluster.controlPlane.cpu
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (p Problem) String() string {
+	return p.Path + ": " + p.Message
+}
+
+// Lint checks values against a JSON Schema and returns the problems found,
+// ordered by path
+func Lint(schema []byte, values map[string]interface{}) ([]Problem, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("parsing schema: %w", err)
+	}
+
+	var problems []Problem
+	check("", values, root, &problems)
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Path < problems[j].Path
+	})
+	return problems, nil
+}
+
+func check(path string, value interface{}, schema map[string]interface{}, problems *[]Problem) {
+	report := func(format string, args ...interface{}) {
+		p := path
+		if p == "" {
+			p = "(root)"
+		}
+		*problems = append(*problems, Problem{Path: p, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		if s, isString := value.(string); isString && !containsString(enum, s) {
+			report("%q is not one of %s", s, joinValues(enum))
+		}
+	}
+
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			if value != nil {
+				report("expected a map, got %s", describe(value))
+			}
+			return
+		}
+		props, _ := schema["properties"].(map[string]interface{})
+		for _, key := range sortedKeys(obj) {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			if prop, ok := props[key].(map[string]interface{}); ok {
+				check(child, obj[key], prop, problems)
+				continue
+			}
+			switch extra := schema["additionalProperties"].(type) {
+			case map[string]interface{}:
+				check(child, obj[key], extra, problems)
+			case bool:
+				if !extra {
+					msg := "unknown key"
+					if matches := suggest.Closest(key, sortedKeys(props)); len(matches) > 0 {
+						msg += fmt.Sprintf(", did you mean %s?", strings.Join(matches, " or "))
+					}
+					*problems = append(*problems, Problem{Path: child, Message: msg})
+				}
+			}
+		}
+
+	case "array":
+		list, ok := value.([]interface{})
+		if !ok {
+			if value != nil {
+				report("expected a list, got %s", describe(value))
+			}
+			return
+		}
+		items, _ := schema["items"].(map[string]interface{})
+		for i, item := range list {
+			check(fmt.Sprintf("%s[%d]", path, i), item, items, problems)
+		}
+
+	case "string", "integer", "number", "boolean":
+		// Scalars are converted by viper's weak typing, so only the shape is
+		// checked
+		switch value.(type) {
+		case map[string]interface{}:
+			report("expected a %s, got a map", schema["type"])
+		case []interface{}:
+			report("expected a %s, got a list", schema["type"])
+		}
+	}
+}
+
+func describe(v interface{}) string {
+	switch v.(type) {
+	case []interface{}:
+		return "a list"
+	case map[string]interface{}:
+		return "a map"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func containsString(values []interface{}, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func joinValues(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%v", v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cost estimates what tenant clusters cost from the rates in the
+// platform config, and checks the spend against budgets.
+//
+// Budgets are set with `butlerctl cost budget` as annotations on a
+// TenantCluster or Team; `butlerctl cost report` shows spend against them
+// and `butlerctl cost check` notifies owners when the projected spend for
+// the month exceeds a budget.
+package cost
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// BudgetAnnotation holds the budget of a TenantCluster or Team, e.g.
+	// 500/month
+	BudgetAnnotation = "butler.butlerlabs.dev/budget"
+
+	// BudgetAlertAnnotation holds the month (2006-01) the owner was last
+	// alerted of an overrun
+	BudgetAlertAnnotation = "butler.butlerlabs.dev/budget-alert-sent"
+
+	// hoursPerMonth converts monthly rates to hourly ones
+	hoursPerMonth = 730
+)
+
+// Budget periods
+const (
+	PeriodDay   = "day"
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+)
+
+// Budget is a spending limit per period
+type Budget struct {
+	Amount float64
+	Period string
+}
+
+// ParseBudget parses AMOUNT/PERIOD, e.g. 500/month, 120/week or 20/day. A
+// bare amount is per month.
+func ParseBudget(s string) (Budget, error) {
+	amount, period, found := strings.Cut(strings.TrimSpace(s), "/")
+	if !found {
+		period = PeriodMonth
+	}
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) || value <= 0 {
+		return Budget{}, fmt.Errorf("invalid budget %q: amount must be a positive number", s)
+	}
+	switch period = strings.ToLower(period); period {
+	case PeriodDay, PeriodWeek, PeriodMonth:
+	default:
+		return Budget{}, fmt.Errorf("invalid budget %q: period must be day, week or month", s)
+	}
+	return Budget{Amount: value, Period: period}, nil
+}
+
+func (b Budget) String() string {
+	return strconv.FormatFloat(b.Amount, 'f', -1, 64) + "/" + b.Period
+}
+
+// Monthly returns the budget for the month containing t
+func (b Budget) Monthly(t time.Time) float64 {
+	start, end := MonthBounds(t)
+	days := end.Sub(start).Hours() / 24
+	switch b.Period {
+	case PeriodDay:
+		return b.Amount * days
+	case PeriodWeek:
+		return b.Amount * days / 7
+	}
+	return b.Amount
+}
+
+// BudgetOf returns the budget recorded in annotations, if any
+func BudgetOf(annotations map[string]string) (Budget, bool, error) {
+	v, ok := annotations[BudgetAnnotation]
+	if !ok || v == "" {
+		return Budget{}, false, nil
+	}
+	b, err := ParseBudget(v)
+	if err != nil {
+		return Budget{}, false, fmt.Errorf("invalid %s annotation: %w", BudgetAnnotation, err)
+	}
+	return b, true, nil
+}
+
+// Size is the compute a cluster's workers request
+type Size struct {
+	Workers   int64   `json:"workers"`
+	CPU       int64   `json:"cpu"`
+	MemoryGiB float64 `json:"memoryGiB"`
+	DiskGiB   float64 `json:"diskGiB"`
+}
+
+// ClusterSize returns what a TenantCluster's workers request
+func ClusterSize(tc *unstructured.Unstructured) Size {
+	replicas, _, _ := unstructured.NestedInt64(tc.Object, "spec", "workers", "replicas")
+	if replicas == 0 {
+		replicas = 1
+	}
+	cpu, _, _ := unstructured.NestedInt64(tc.Object, "spec", "workers", "machineTemplate", "cpu")
+	memory, _, _ := unstructured.NestedString(tc.Object, "spec", "workers", "machineTemplate", "memory")
+	disk, _, _ := unstructured.NestedString(tc.Object, "spec", "workers", "machineTemplate", "diskSize")
+	return Size{
+		Workers:   replicas,
+		CPU:       replicas * cpu,
+		MemoryGiB: float64(replicas) * gib(memory),
+		DiskGiB:   float64(replicas) * gib(disk),
+	}
+}
+
+// gib converts a quantity such as 8Gi to GiB, or 0 if it doesn't parse
+func gib(s string) float64 {
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0
+	}
+	return q.AsApproximateFloat64() / (1 << 30)
+}
+
+// Hourly returns the hourly cost of a cluster of the given size
+func Hourly(size Size, rates *platform.Costs) float64 {
+	return rates.ControlPlaneHour +
+		float64(size.CPU)*rates.CPUHour +
+		size.MemoryGiB*rates.MemoryGiBHour +
+		size.DiskGiB*rates.DiskGiBMonth/hoursPerMonth
+}
+
+// Estimate is the estimated spend of a cluster in the current month
+type Estimate struct {
+	// Hourly is the burn rate at the cluster's current size
+	Hourly float64 `json:"hourly"`
+
+	// MonthToDate is the spend since the start of the month, or since the
+	// cluster was created if that was later
+	MonthToDate float64 `json:"monthToDate"`
+
+	// Projected is the spend by the end of the month if the cluster keeps
+	// its current size
+	Projected float64 `json:"projected"`
+}
+
+// EstimateCluster estimates a cluster's spend for the month containing
+// now. Clusters are assumed to have had their current size all month, as
+// no sizing history is kept.
+func EstimateCluster(tc *unstructured.Unstructured, rates *platform.Costs, now time.Time) Estimate {
+	hourly := Hourly(ClusterSize(tc), rates)
+	start, end := MonthBounds(now)
+	elapsed := UptimeHours(tc.GetCreationTimestamp().Time, start, end, now)
+	remaining := max(end.Sub(now).Hours(), 0)
+	return Estimate{
+		Hourly:      hourly,
+		MonthToDate: hourly * elapsed,
+		Projected:   hourly * (elapsed + remaining),
+	}
+}
+
+// UptimeHours returns how many hours of the period from start to end a
+// cluster created at created has existed by now
+func UptimeHours(created, start, end, now time.Time) float64 {
+	if created.After(start) {
+		start = created
+	}
+	if now.Before(end) {
+		end = now
+	}
+	return max(end.Sub(start).Hours(), 0)
+}
+
+// MonthBounds returns the start of the month containing t and of the next
+// month, in t's location
+func MonthBounds(t time.Time) (time.Time, time.Time) {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return start, start.AddDate(0, 1, 0)
+}
+
+// Month formats t's month for BudgetAlertAnnotation
+func Month(t time.Time) string {
+	return t.Format("2006-01")
+}
+
+// Format renders an amount with its currency
+func Format(amount float64, currency string) string {
+	return fmt.Sprintf("%.2f %s", amount, currency)
+}
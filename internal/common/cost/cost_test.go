@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBudget(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    Budget
+		wantErr bool
+	}{
+		{"500/month", Budget{500, PeriodMonth}, false},
+		{"120/week", Budget{120, PeriodWeek}, false},
+		{"20/day", Budget{20, PeriodDay}, false},
+		{"500", Budget{500, PeriodMonth}, false},
+		{"12.50/Day", Budget{12.5, PeriodDay}, false},
+		{" 500/month ", Budget{500, PeriodMonth}, false},
+		{"", Budget{}, true},
+		{"/month", Budget{}, true},
+		{"500/", Budget{}, true},
+		{"500/year", Budget{}, true},
+		{"0/month", Budget{}, true},
+		{"-5/month", Budget{}, true},
+		{"NaN/month", Budget{}, true},
+		{"Inf/month", Budget{}, true},
+		{"five/month", Budget{}, true},
+		{"500/month/extra", Budget{}, true},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseBudget(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBudget(%q) error = %v, want error %t", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseBudget(%q) = %+v, want %+v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBudgetString(t *testing.T) {
+	for _, s := range []string{"500/month", "12.5/day", "120/week"} {
+		b, err := ParseBudget(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != s {
+			t.Errorf("ParseBudget(%q).String() = %q", s, got)
+		}
+	}
+}
+
+func TestBudgetMonthly(t *testing.T) {
+	february := time.Date(2026, time.February, 10, 12, 0, 0, 0, time.UTC)
+	october := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		budget Budget
+		at     time.Time
+		want   float64
+	}{
+		{Budget{500, PeriodMonth}, february, 500},
+		{Budget{10, PeriodDay}, february, 280},
+		{Budget{10, PeriodDay}, october, 310},
+		{Budget{70, PeriodWeek}, february, 280},
+	} {
+		if got := tt.budget.Monthly(tt.at); got != tt.want {
+			t.Errorf("%s.Monthly(%s) = %v, want %v", tt.budget, tt.at.Format("2006-01"), got, tt.want)
+		}
+	}
+}
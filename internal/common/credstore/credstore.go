@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package credstore saves cluster credentials under ~/.butler, optionally
+// sealed with a key held in the OS keychain.
+//
+// By default credentials are plain files with 0600 permissions, which
+// kubectl and talosctl read directly. With BUTLER_CREDENTIAL_STORE=keyring
+// they are encrypted with AES-GCM and written with a .sealed suffix; the key
+// lives in the macOS Keychain, the Secret Service (libsecret) on Linux, or
+// the Windows Credential Manager. Only the key goes into the keychain since
+// a kubeconfig can exceed the size some keychains accept.
+package credstore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	// StoreEnv selects where credentials are kept: "file" (default) or
+	// "keyring"
+	StoreEnv = "BUTLER_CREDENTIAL_STORE"
+
+	// SealedExt marks a credential file encrypted with the keychain key
+	SealedExt = ".sealed"
+
+	// keyringService and keyringUser name the keychain entry holding the key
+	keyringService = "butler"
+	keyringUser    = "credentials-key"
+)
+
+// UseKeyring reports whether BUTLER_CREDENTIAL_STORE asks for the keychain
+func UseKeyring() (bool, error) {
+	switch v := strings.ToLower(os.Getenv(StoreEnv)); v {
+	case "", "file":
+		return false, nil
+	case "keyring":
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid %s %q: must be file or keyring", StoreEnv, v)
+	}
+}
+
+// IsSealed reports whether path names a sealed credential file
+func IsSealed(path string) bool {
+	return strings.HasSuffix(path, SealedExt)
+}
+
+// Save writes data to dir/name, sealed when the keychain store is selected.
+// It returns the path written.
+func Save(dir, name string, data []byte) (string, error) {
+	sealed, err := UseKeyring()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("creating %s: %w", dir, err)
+	}
+
+	path := filepath.Join(dir, name)
+	if sealed {
+		aead, err := Cipher(true)
+		if err != nil {
+			return "", err
+		}
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return "", fmt.Errorf("generating nonce: %w", err)
+		}
+		data = aead.Seal(nonce, nonce, data, []byte(name))
+		path += SealedExt
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	// Don't leave a stale copy in the other format behind
+	other := strings.TrimSuffix(path, SealedExt)
+	if !sealed {
+		other = path + SealedExt
+	}
+	if err := os.Remove(other); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("removing %s: %w", other, err)
+	}
+	return path, nil
+}
+
+// Load reads a credential file, unsealing it with the keychain key when it
+// has the .sealed suffix
+func Load(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !IsSealed(path) {
+		return data, nil
+	}
+
+	aead, err := Cipher(false)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("%s is truncated", path)
+	}
+	name := strings.TrimSuffix(filepath.Base(path), SealedExt)
+	nonce, sealed := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, sealed, []byte(name))
+	if err != nil {
+		return nil, fmt.Errorf("unsealing %s (renamed, or the keychain key was replaced?): %w", path, err)
+	}
+	return plain, nil
+}
+
+// Cipher returns an AEAD using the key in the OS keychain, generating and
+// storing a key when create is set and none exists yet
+func Cipher(create bool) (cipher.AEAD, error) {
+	encoded, err := keyring.Get(keyringService, keyringUser)
+	switch {
+	case errors.Is(err, keyring.ErrNotFound) && create:
+		key := make([]byte, 32)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, fmt.Errorf("generating credentials key: %w", err)
+		}
+		encoded = base64.StdEncoding.EncodeToString(key)
+		if err := keyring.Set(keyringService, keyringUser, encoded); err != nil {
+			return nil, fmt.Errorf("storing credentials key in the OS keychain: %w", err)
+		}
+	case errors.Is(err, keyring.ErrNotFound):
+		return nil, fmt.Errorf("no credentials key in the OS keychain (service %q)", keyringService)
+	case err != nil:
+		return nil, fmt.Errorf("reading credentials key from the OS keychain: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding credentials key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("loading credentials key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
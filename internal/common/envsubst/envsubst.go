@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envsubst expands environment variable references in config files
+// so one file can serve several environments, with credentials and
+// endpoints injected by CI.
+//
+// Only the braced forms are expanded: ${VAR}, and ${VAR:-fallback}, which
+// uses fallback when VAR is unset or empty. $${VAR} is left as the literal
+// ${VAR}. A bare $VAR is never touched, so values such as passwords may
+// contain dollar signs. Comment lines are copied as they are.
+package envsubst
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// reference matches an escaped or plain ${VAR} or ${VAR:-fallback}
+var reference = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// Expand replaces variable references in data. It fails listing every
+// variable that is unset and has no fallback, rather than silently
+// substituting empty strings.
+func Expand(data []byte) ([]byte, error) {
+	return expand(data, os.LookupEnv)
+}
+
+func expand(data []byte, lookup func(string) (string, bool)) ([]byte, error) {
+	var missing []string
+	seen := map[string]bool{}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") || !strings.Contains(line, "${") {
+			continue
+		}
+		lines[i] = reference.ReplaceAllStringFunc(line, func(ref string) string {
+			if strings.HasPrefix(ref, "$$") {
+				return ref[1:]
+			}
+			m := reference.FindStringSubmatch(ref)
+			name, hasFallback, fallback := m[1], m[2] != "", m[3]
+			if value, ok := lookup(name); ok && (value != "" || !hasFallback) {
+				return value
+			}
+			if hasFallback {
+				return fallback
+			}
+			if !seen[name] {
+				seen[name] = true
+				missing = append(missing, name)
+			}
+			return ref
+		})
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("environment variables not set: %s (use ${VAR:-fallback} for a default)", strings.Join(missing, ", "))
+	}
+	return []byte(strings.Join(lines, "")), nil
+}
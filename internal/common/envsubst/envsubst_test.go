@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envsubst
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	env := map[string]string{
+		"ENDPOINT": "https://pc.example.com:9440",
+		"PASSWORD": "pa$$word",
+		"EMPTY":    "",
+	}
+	lookup := func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+
+	for _, tt := range []struct {
+		name    string
+		in      string
+		want    string
+		missing []string
+	}{
+		{"no references", "workers: 3\n", "workers: 3\n", nil},
+		{"braced", "endpoint: ${ENDPOINT}\n", "endpoint: https://pc.example.com:9440\n", nil},
+		{"several on a line", "url: ${ENDPOINT}/${ENDPOINT}", "url: https://pc.example.com:9440/https://pc.example.com:9440", nil},
+		{"value with dollar signs is not expanded again", "password: ${PASSWORD}", "password: pa$$word", nil},
+		{"bare dollar untouched", "password: $PASSWORD", "password: $PASSWORD", nil},
+		{"escaped", "literal: $${ENDPOINT}", "literal: ${ENDPOINT}", nil},
+		{"fallback when unset", "zone: ${ZONE:-eu-west}", "zone: eu-west", nil},
+		{"fallback when empty", "zone: ${EMPTY:-eu-west}", "zone: eu-west", nil},
+		{"empty fallback", "zone: ${ZONE:-}", "zone: ", nil},
+		{"fallback unused when set", "endpoint: ${ENDPOINT:-none}", "endpoint: https://pc.example.com:9440", nil},
+		{"set but empty without fallback", "value: ${EMPTY}", "value: ", nil},
+		{"comment lines are copied", "# uses ${UNSET}\nworkers: 3\n", "# uses ${UNSET}\nworkers: 3\n", nil},
+		{"indented comment", "  # ${UNSET}\n", "  # ${UNSET}\n", nil},
+		{"invalid name is left alone", "value: ${1ABC}", "value: ${1ABC}", nil},
+		{"unclosed reference is left alone", "value: ${ENDPOINT", "value: ${ENDPOINT", nil},
+		{"missing listed once in order", "a: ${B_VAR}\nb: ${A_VAR}\nc: ${B_VAR}\n", "", []string{"B_VAR", "A_VAR"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expand([]byte(tt.in), lookup)
+			if tt.missing != nil {
+				if err == nil {
+					t.Fatalf("expand succeeded, want an error naming %v", tt.missing)
+				}
+				if !strings.Contains(err.Error(), strings.Join(tt.missing, ", ")) {
+					t.Errorf("error %q doesn't list %v", err, tt.missing)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expand(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
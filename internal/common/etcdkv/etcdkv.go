@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package etcdkv reads and writes keys of an etcd cluster through the JSON
+// gateway etcd serves on its client port (/v3/kv/*), so the CLIs can dump
+// and restore a key prefix without an etcd client library.
+//
+// Keys and values are raw bytes; the gateway carries them base64-encoded,
+// which encoding/json does for []byte.
+package etcdkv
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// pageSize is the number of keys fetched per range request
+const pageSize = 500
+
+// KV is a key and its value
+type KV struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// Client talks to one etcd member
+type Client struct {
+	endpoint string
+	http     *http.Client
+}
+
+// New returns a client for an etcd member at endpoint, e.g.
+// https://127.0.0.1:2379
+func New(endpoint string, tlsConfig *tls.Config) *Client {
+	return &Client{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		http: &http.Client{
+			Timeout:   60 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+}
+
+type rangeRequest struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+	Limit    int64  `json:"limit,string,omitempty"`
+	Revision int64  `json:"revision,string,omitempty"`
+}
+
+type responseHeader struct {
+	Revision int64 `json:"revision,string"`
+}
+
+type rangeResponse struct {
+	Header responseHeader `json:"header"`
+	KVs    []KV           `json:"kvs"`
+	More   bool           `json:"more"`
+}
+
+type deleteRangeResponse struct {
+	Deleted int64 `json:"deleted,string"`
+}
+
+// Range returns every key under prefix as of one revision, so the result
+// is a consistent point-in-time view, along with that revision
+func (c *Client) Range(ctx context.Context, prefix []byte) ([]KV, int64, error) {
+	end := PrefixEnd(prefix)
+	key := prefix
+	var revision int64
+	var kvs []KV
+	for {
+		var resp rangeResponse
+		req := rangeRequest{Key: key, RangeEnd: end, Limit: pageSize, Revision: revision}
+		if err := c.call(ctx, "/v3/kv/range", req, &resp); err != nil {
+			return nil, 0, err
+		}
+		if revision == 0 {
+			revision = resp.Header.Revision
+		}
+		kvs = append(kvs, resp.KVs...)
+		if !resp.More || len(resp.KVs) == 0 {
+			return kvs, revision, nil
+		}
+		// Continue just after the last key returned
+		last := resp.KVs[len(resp.KVs)-1].Key
+		key = append(append([]byte{}, last...), 0)
+	}
+}
+
+// Put writes a key
+func (c *Client) Put(ctx context.Context, key, value []byte) error {
+	return c.call(ctx, "/v3/kv/put", KV{Key: key, Value: value}, nil)
+}
+
+// DeletePrefix removes every key under prefix and returns how many there
+// were
+func (c *Client) DeletePrefix(ctx context.Context, prefix []byte) (int64, error) {
+	var resp deleteRangeResponse
+	req := rangeRequest{Key: prefix, RangeEnd: PrefixEnd(prefix)}
+	if err := c.call(ctx, "/v3/kv/deleterange", req, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Deleted, nil
+}
+
+// PrefixEnd returns the range end covering every key that starts with
+// prefix
+func PrefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// All 0xff: no upper bound
+	return []byte{0}
+}
+
+func (c *Client) call(ctx context.Context, path string, body, out interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encoding request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+path, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("etcd %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("etcd %s: reading response: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("etcd %s: status %d: %s", path, resp.StatusCode, strings.TrimSpace(string(payload)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(payload, out); err != nil {
+		return fmt.Errorf("etcd %s: decoding response: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubecache caches data fetched from Kubernetes clusters on the
+// local machine.
+//
+// Tenant kubeconfigs live under ~/.butler/cache/kubeconfigs/, encrypted with
+// AES-GCM using a per-machine key stored next to them with 0600 permissions,
+// or kept in the OS keychain when BUTLER_CREDENTIAL_STORE=keyring.
+// The cache keeps repeated butlerctl calls from round-tripping to the
+// management cluster and lets a recently fetched kubeconfig be used while the
+// management cluster is unreachable. The result of management cluster
+// detection is cached as well (see Verified).
+package kubecache
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/credstore"
+)
+
+const (
+	// DefaultTTL is how long a cached kubeconfig is used without refetching
+	DefaultTTL = 15 * time.Minute
+
+	// TTLEnv overrides DefaultTTL; "0" disables the cache
+	TTLEnv = "BUTLER_KUBECONFIG_CACHE_TTL"
+
+	keyFile        = "key"
+	entryExt       = ".enc"
+	managementFile = "management-clusters.json"
+)
+
+// disabled is set by Disable for the lifetime of the process
+var disabled bool
+
+// Disable turns off all cache reads and writes, e.g. for --no-cache
+func Disable() {
+	disabled = true
+}
+
+// Disabled reports whether Disable was called
+func Disabled() bool {
+	return disabled
+}
+
+// Entry is a cached kubeconfig
+type Entry struct {
+	Kubeconfig []byte    `json:"kubeconfig"`
+	FetchedAt  time.Time `json:"fetchedAt"`
+}
+
+// Fresh reports whether the entry is younger than ttl
+func (e *Entry) Fresh(ttl time.Duration) bool {
+	return time.Since(e.FetchedAt) < ttl
+}
+
+// Cache is an encrypted on-disk kubeconfig cache
+type Cache struct {
+	dir string
+	TTL time.Duration
+}
+
+// New returns the cache in the default location with the TTL taken from
+// BUTLER_KUBECONFIG_CACHE_TTL
+func New() (*Cache, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := TTL()
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{dir: dir, TTL: ttl}, nil
+}
+
+// Dir returns the cache root, ~/.butler/cache
+func Dir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting home directory: %w", err)
+	}
+	return filepath.Join(home, ".butler", "cache"), nil
+}
+
+// TTL returns the configured cache TTL
+func TTL() (time.Duration, error) {
+	v := os.Getenv(TTLEnv)
+	if v == "" {
+		return DefaultTTL, nil
+	}
+	if v == "0" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(v)
+	if err != nil || ttl < 0 {
+		return 0, fmt.Errorf("invalid %s %q: expected a duration such as 15m or 1h", TTLEnv, v)
+	}
+	return ttl, nil
+}
+
+// Enabled reports whether entries should be read and written
+func (c *Cache) Enabled() bool {
+	return !disabled && c.TTL > 0
+}
+
+// Get returns the cached kubeconfig for a tenant cluster on the given
+// management API server, or nil if none is cached. Stale entries are
+// returned too; callers decide with Fresh whether to use them.
+func (c *Cache) Get(server, namespace, name string) (*Entry, error) {
+	sealed, err := os.ReadFile(c.entryPath(server, namespace, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading cached kubeconfig: %w", err)
+	}
+
+	aead, err := c.cipher(false)
+	if err != nil || aead == nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("cached kubeconfig for %s/%s is corrupt", namespace, name)
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, ciphertext, []byte(entryID(server, namespace, name)))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting cached kubeconfig for %s/%s: %w", namespace, name, err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(plain, &entry); err != nil {
+		return nil, fmt.Errorf("parsing cached kubeconfig: %w", err)
+	}
+	return &entry, nil
+}
+
+// Put stores a kubeconfig fetched now
+func (c *Cache) Put(server, namespace, name string, kubeconfig []byte) error {
+	plain, err := json.Marshal(&Entry{Kubeconfig: kubeconfig, FetchedAt: time.Now()})
+	if err != nil {
+		return fmt.Errorf("encoding cache entry: %w", err)
+	}
+
+	aead, err := c.cipher(true)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("generating nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, plain, []byte(entryID(server, namespace, name)))
+
+	path := c.entryPath(server, namespace, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+	if err := os.WriteFile(path, sealed, 0600); err != nil {
+		return fmt.Errorf("writing cached kubeconfig: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a cached kubeconfig if present
+func (c *Cache) Delete(server, namespace, name string) error {
+	if err := os.Remove(c.entryPath(server, namespace, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing cached kubeconfig: %w", err)
+	}
+	return nil
+}
+
+// Clear removes every cached kubeconfig and the encryption key, returning
+// the number of entries removed
+func Clear() (int, error) {
+	dir, err := Dir()
+	if err != nil {
+		return 0, err
+	}
+	c := &Cache{dir: dir}
+
+	entries, err := os.ReadDir(c.kubeconfigDir())
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, fmt.Errorf("reading cache directory: %w", err)
+	}
+
+	removed := 0
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), entryExt) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.kubeconfigDir(), e.Name())); err != nil {
+			return removed, fmt.Errorf("removing cached kubeconfig: %w", err)
+		}
+		removed++
+	}
+
+	for _, name := range []string{keyFile, managementFile} {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return removed, fmt.Errorf("removing %s: %w", name, err)
+		}
+	}
+	return removed, nil
+}
+
+// Verified reports whether the cluster identified by key passed management
+// cluster detection within ttl
+func Verified(key string, ttl time.Duration) bool {
+	if disabled {
+		return false
+	}
+	verified, err := loadManagementClusters()
+	if err != nil {
+		return false
+	}
+	at, ok := verified[key]
+	return ok && time.Since(at) < ttl
+}
+
+// MarkVerified records that the cluster identified by key passed management
+// cluster detection now
+func MarkVerified(key string) error {
+	if disabled {
+		return nil
+	}
+	verified, err := loadManagementClusters()
+	if err != nil {
+		verified = map[string]time.Time{}
+	}
+	verified[key] = time.Now()
+
+	data, err := json.Marshal(verified)
+	if err != nil {
+		return fmt.Errorf("encoding management cluster cache: %w", err)
+	}
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, managementFile), data, 0600); err != nil {
+		return fmt.Errorf("writing management cluster cache: %w", err)
+	}
+	return nil
+}
+
+func loadManagementClusters() (map[string]time.Time, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, managementFile))
+	if err != nil {
+		return nil, err
+	}
+	verified := map[string]time.Time{}
+	if err := json.Unmarshal(data, &verified); err != nil {
+		return nil, err
+	}
+	return verified, nil
+}
+
+func (c *Cache) kubeconfigDir() string {
+	return filepath.Join(c.dir, "kubeconfigs")
+}
+
+func (c *Cache) entryPath(server, namespace, name string) string {
+	sum := sha256.Sum256([]byte(entryID(server, namespace, name)))
+	return filepath.Join(c.kubeconfigDir(), hex.EncodeToString(sum[:])+entryExt)
+}
+
+// cipher loads the cache key, creating it when create is set. Without
+// create, a missing key yields a nil AEAD.
+func (c *Cache) cipher(create bool) (cipher.AEAD, error) {
+	if keychain, err := credstore.UseKeyring(); err != nil {
+		return nil, err
+	} else if keychain {
+		aead, err := credstore.Cipher(create)
+		if err != nil && !create {
+			return nil, nil
+		}
+		return aead, err
+	}
+
+	path := filepath.Join(c.dir, keyFile)
+	key, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && create:
+		key = make([]byte, 32)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, fmt.Errorf("generating cache key: %w", err)
+		}
+		if err := os.MkdirAll(c.dir, 0700); err != nil {
+			return nil, fmt.Errorf("creating cache directory: %w", err)
+		}
+		if err := os.WriteFile(path, key, 0600); err != nil {
+			return nil, fmt.Errorf("writing cache key: %w", err)
+		}
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("reading cache key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("loading cache key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func entryID(server, namespace, name string) string {
+	return server + "|" + namespace + "/" + name
+}
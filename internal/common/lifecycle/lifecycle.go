@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lifecycle defines the TenantCluster annotations that track who
+// owns a cluster, when it expires and whether Butler provisioned it.
+//
+// Ownership is recorded by `butlerctl cluster create --owner/--contact` and
+// expiry by `--ttl`; `butleradm gc run` notifies owners of expiring clusters
+// and destroys expired ones. `butlerctl cluster adopt` marks clusters created
+// outside Butler.
+package lifecycle
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// OwnerAnnotation records the Team or user (email) owning a cluster
+	OwnerAnnotation = "butler.butlerlabs.dev/owner"
+
+	// ContactAnnotation records how to reach the cluster's owner
+	ContactAnnotation = "butler.butlerlabs.dev/contact"
+
+	// ExpiresAtAnnotation holds the RFC 3339 time after which the cluster
+	// is destroyed by garbage collection
+	ExpiresAtAnnotation = "butler.butlerlabs.dev/expires-at"
+
+	// ExpiryNoticeAnnotation holds the time the owner was last warned of
+	// the upcoming expiry
+	ExpiryNoticeAnnotation = "butler.butlerlabs.dev/expiry-notice-sent"
+
+	// AdoptedAnnotation marks a cluster created outside Butler. Its machines
+	// are not managed; only access, addons and health are. On a
+	// MachineRequest it marks an existing VM the provider must not create.
+	AdoptedAnnotation = "butler.butlerlabs.dev/adopted"
+
+	// AdoptedProviderIDAnnotation holds the provider ID of the existing VM
+	// an adopted MachineRequest describes
+	AdoptedProviderIDAnnotation = "butler.butlerlabs.dev/adopted-provider-id"
+)
+
+// Adopted reports whether annotations mark a cluster created outside Butler
+func Adopted(annotations map[string]string) bool {
+	return annotations[AdoptedAnnotation] == "true"
+}
+
+// ExpiresAt returns the expiry recorded in annotations, if any
+func ExpiresAt(annotations map[string]string) (time.Time, bool, error) {
+	v, ok := annotations[ExpiresAtAnnotation]
+	if !ok || v == "" {
+		return time.Time{}, false, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s annotation %q: %w", ExpiresAtAnnotation, v, err)
+	}
+	return t, true, nil
+}
+
+// FormatExpiry formats an expiry time for the annotation
+func FormatExpiry(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -23,7 +23,11 @@ import (
 	"io"
 	"log/slog"
 	"os"
+	"strconv"
+	"strings"
 
+	"github.com/butlerdotdev/butler/internal/common/color"
+	"github.com/butlerdotdev/butler/internal/common/redact"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -41,11 +45,50 @@ var (
 	keyStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("4"))
 )
 
+// EnvPlain enables plain-text logs when set to a true value
+const EnvPlain = "BUTLER_PLAIN_LOGS"
+
+// plain is set by SetPlain for the lifetime of the process
+var plain bool
+
+// SetPlain switches to plain-text logs, e.g. for --plain-logs
+func SetPlain() {
+	plain = true
+}
+
+// Plain reports whether plain-text logs are enabled by flag or environment.
+// Plain logs never carry colors, and values with spaces or quotes are
+// quoted so each line stays one parseable key=value record.
+func Plain() bool {
+	if plain {
+		return true
+	}
+	enabled, _ := strconv.ParseBool(os.Getenv(EnvPlain))
+	return enabled
+}
+
+// colorEnabled reports whether log lines written to w may be colored: not
+// in plain mode, and otherwise under the same NO_COLOR and terminal rules
+// as the output package
+func colorEnabled(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && !Plain() && color.Enabled(f)
+}
+
+// render applies style when logs on stderr are colored
+func render(style lipgloss.Style, s string) string {
+	if !colorEnabled(os.Stderr) {
+		return s
+	}
+	return style.Render(s)
+}
+
 // Logger wraps slog.Logger with Butler-specific functionality
 type Logger struct {
 	*slog.Logger
-	name  string
-	level slog.Level
+	name      string
+	level     *slog.LevelVar
+	verbosity int
 }
 
 // New creates a new Logger with the given name
@@ -55,6 +98,14 @@ func New(name string) *Logger {
 
 // NewWithLevel creates a new Logger with the given name and level
 func NewWithLevel(name string, level slog.Level) *Logger {
+	levelVar := &slog.LevelVar{}
+	levelVar.Set(level)
+	return newLogger(name, levelVar)
+}
+
+// newLogger creates a Logger sharing level with its parent, so verbosity
+// set later applies to component loggers too
+func newLogger(name string, level *slog.LevelVar) *Logger {
 	handler := &prettyHandler{
 		name:   name,
 		level:  level,
@@ -71,14 +122,29 @@ func NewWithLevel(name string, level slog.Level) *Logger {
 // SetVerbose enables debug logging
 func (l *Logger) SetVerbose(verbose bool) {
 	if verbose {
-		l.level = slog.LevelDebug
+		l.SetVerbosity(1)
 	}
 }
 
+// SetVerbosity sets how much is logged: 1 (-v) enables debug logging, 2
+// (-vv) also traces every API request
+func (l *Logger) SetVerbosity(verbosity int) {
+	l.verbosity = verbosity
+	if verbosity > 0 {
+		l.level.Set(slog.LevelDebug)
+	}
+}
+
+// Verbosity returns the level set by SetVerbosity
+func (l *Logger) Verbosity() int {
+	return l.verbosity
+}
+
 // WithComponent returns a new logger with a component name suffix
 func (l *Logger) WithComponent(component string) *Logger {
-	newName := l.name + "/" + component
-	return NewWithLevel(newName, l.level)
+	child := newLogger(l.name+"/"+component, l.level)
+	child.verbosity = l.verbosity
+	return child
 }
 
 // Phase logs a phase transition (used for bootstrap phases)
@@ -86,55 +152,63 @@ func (l *Logger) Phase(phase string) {
 	style := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("2")).
 		Bold(true)
-	l.Info(style.Render("▶ " + phase))
+	l.Info(render(style, "▶ "+phase))
 }
 
 // Success logs a success message
 func (l *Logger) Success(msg string, args ...any) {
 	style := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("2"))
-	l.Info(style.Render("✓ "+msg), args...)
+	l.Info(render(style, "✓ "+msg), args...)
 }
 
 // Waiting logs a waiting/polling message
 func (l *Logger) Waiting(msg string, args ...any) {
 	style := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("3"))
-	l.Info(style.Render("⏳ "+msg), args...)
+	l.Info(render(style, "⏳ "+msg), args...)
 }
 
 // prettyHandler is a custom slog handler for pretty terminal output
 type prettyHandler struct {
 	name   string
-	level  slog.Level
+	level  *slog.LevelVar
 	output io.Writer
 	attrs  []slog.Attr
 	groups []string
 }
 
 func (h *prettyHandler) Enabled(_ context.Context, level slog.Level) bool {
-	return level >= h.level
+	return level >= h.level.Level()
 }
 
 func (h *prettyHandler) Handle(_ context.Context, r slog.Record) error {
+	colored := colorEnabled(h.output)
+	paint := func(style lipgloss.Style, s string) string {
+		if !colored {
+			return s
+		}
+		return style.Render(s)
+	}
+
 	// Format timestamp
-	ts := timestampStyle.Render(r.Time.Format("15:04:05"))
+	ts := paint(timestampStyle, r.Time.Format("15:04:05"))
 
 	// Format level
 	var levelStr string
 	switch r.Level {
 	case slog.LevelDebug:
-		levelStr = debugStyle.Render("DBG")
+		levelStr = paint(debugStyle, "DBG")
 	case slog.LevelInfo:
-		levelStr = infoStyle.Render("INF")
+		levelStr = paint(infoStyle, "INF")
 	case slog.LevelWarn:
-		levelStr = warnStyle.Render("WRN")
+		levelStr = paint(warnStyle, "WRN")
 	case slog.LevelError:
-		levelStr = errorStyle.Render("ERR")
+		levelStr = paint(errorStyle, "ERR")
 	}
 
 	// Format name
-	name := nameStyle.Render("[" + h.name + "]")
+	name := paint(nameStyle, "["+h.name+"]")
 
 	// Format message
 	msg := r.Message
@@ -142,8 +216,12 @@ func (h *prettyHandler) Handle(_ context.Context, r slog.Record) error {
 	// Format attributes
 	var attrs string
 	r.Attrs(func(a slog.Attr) bool {
-		key := keyStyle.Render(a.Key + "=")
-		attrs += " " + key + fmt.Sprintf("%v", a.Value.Any())
+		key := paint(keyStyle, a.Key+"=")
+		value := fmt.Sprintf("%v", redact.Attr(a.Key, a.Value.Any()))
+		if Plain() && strings.ContainsAny(value, " \"=\t\n") {
+			value = strconv.Quote(value)
+		}
+		attrs += " " + key + value
 		return true
 	})
 
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package netcheck validates the addresses and networks given to the CLIs.
+//
+// Parsing uses net/netip, which rejects leading zeros, trailing text and
+// CIDRs with host bits set rather than guessing what was meant.
+package netcheck
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"strings"
+)
+
+const (
+	// MaxPodPrefixBitsV4 is the smallest IPv4 pod network that still holds
+	// one node's default /24 pod range
+	MaxPodPrefixBitsV4 = 24
+
+	// MinServicePrefixBitsV4 and MinServicePrefixBitsV6 are the largest
+	// service networks kube-apiserver accepts
+	MinServicePrefixBitsV4 = 12
+	MinServicePrefixBitsV6 = 108
+)
+
+// ParseIP parses a single IPv4 or IPv6 address
+func ParseIP(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil || addr.Zone() != "" {
+		return netip.Addr{}, fmt.Errorf("invalid IP address %q", s)
+	}
+	return addr, nil
+}
+
+// ParseCIDR parses a network in CIDR notation. Host bits must be zero.
+func ParseCIDR(s string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(s))
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %q", s)
+	}
+	if prefix != prefix.Masked() {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %q: host bits are set (did you mean %s?)", s, prefix.Masked())
+	}
+	return prefix, nil
+}
+
+// Pool is an inclusive range of addresses, e.g. a MetalLB address pool
+type Pool struct {
+	Start netip.Addr
+	End   netip.Addr
+}
+
+// ParsePool parses a single address, a START-END range or a CIDR
+func ParsePool(s string) (Pool, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		prefix, err := ParseCIDR(s)
+		if err != nil {
+			return Pool{}, err
+		}
+		return Pool{Start: prefix.Addr(), End: lastAddr(prefix)}, nil
+	}
+
+	startStr, endStr, isRange := strings.Cut(s, "-")
+	if !isRange {
+		addr, err := ParseIP(s)
+		if err != nil {
+			return Pool{}, err
+		}
+		return Pool{Start: addr, End: addr}, nil
+	}
+	start, err := ParseIP(startStr)
+	if err != nil {
+		return Pool{}, fmt.Errorf("invalid start: %w", err)
+	}
+	end, err := ParseIP(endStr)
+	if err != nil {
+		return Pool{}, fmt.Errorf("invalid end: %w", err)
+	}
+	return NewPool(start, end)
+}
+
+// NewPool builds a pool from its bounds, which must be of the same family
+// with start <= end
+func NewPool(start, end netip.Addr) (Pool, error) {
+	if start.Is4() != end.Is4() {
+		return Pool{}, fmt.Errorf("%s and %s are different IP families", start, end)
+	}
+	if end.Less(start) {
+		return Pool{}, fmt.Errorf("start %s is after end %s", start, end)
+	}
+	return Pool{Start: start, End: end}, nil
+}
+
+// Size returns the number of addresses in the pool, capped at MaxUint64
+// for very large IPv6 ranges
+func (p Pool) Size() uint64 {
+	start := new(big.Int).SetBytes(p.Start.AsSlice())
+	size := new(big.Int).SetBytes(p.End.AsSlice())
+	size.Sub(size, start).Add(size, big.NewInt(1))
+	if !size.IsUint64() {
+		return math.MaxUint64
+	}
+	return size.Uint64()
+}
+
+// Contains reports whether addr is in the pool
+func (p Pool) Contains(addr netip.Addr) bool {
+	return !addr.Less(p.Start) && !p.End.Less(addr)
+}
+
+// Overlaps reports whether the pool shares an address with prefix
+func (p Pool) Overlaps(prefix netip.Prefix) bool {
+	first, last := prefix.Masked().Addr(), lastAddr(prefix)
+	return p.Start.Is4() == first.Is4() && !p.End.Less(first) && !last.Less(p.Start)
+}
+
+// String renders the pool as START-END, or a single address
+func (p Pool) String() string {
+	if p.Start == p.End {
+		return p.Start.String()
+	}
+	return p.Start.String() + "-" + p.End.String()
+}
+
+// ValidateClusterNetworks checks that the pod and service CIDRs parse,
+// are sized for Kubernetes and don't overlap
+func ValidateClusterNetworks(podCIDR, serviceCIDR string) (pods, services netip.Prefix, err error) {
+	if pods, err = ParseCIDR(podCIDR); err != nil {
+		return pods, services, fmt.Errorf("pod network: %w", err)
+	}
+	if services, err = ParseCIDR(serviceCIDR); err != nil {
+		return pods, services, fmt.Errorf("service network: %w", err)
+	}
+
+	if pods.Addr().Is4() && pods.Bits() > MaxPodPrefixBitsV4 {
+		return pods, services, fmt.Errorf("pod network %s is too small; use a /%d or larger", pods, MaxPodPrefixBitsV4)
+	}
+	minBits := MinServicePrefixBitsV4
+	if services.Addr().Is6() {
+		minBits = MinServicePrefixBitsV6
+	}
+	if services.Bits() < minBits {
+		return pods, services, fmt.Errorf("service network %s is too large; kube-apiserver accepts /%d or smaller", services, minBits)
+	}
+
+	if pods.Overlaps(services) {
+		return pods, services, fmt.Errorf("pod network %s overlaps service network %s", pods, services)
+	}
+	return pods, services, nil
+}
+
+// lastAddr returns the last address of a prefix
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Masked().Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 1 << (7 - bit%8)
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
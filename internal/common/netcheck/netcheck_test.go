@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netcheck
+
+import (
+	"math"
+	"net/netip"
+	"testing"
+)
+
+func TestParseIP(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		wantErr bool
+	}{
+		{"10.127.14.40", false},
+		{" 10.127.14.40 ", false},
+		{"fd00::1", false},
+		{"::ffff:10.0.0.1", false},
+		{"", true},
+		{"10.127.14", true},
+		{"10.127.14.256", true},
+		{"010.127.14.40", true},
+		{"10.127.14.40/32", true},
+		{"10.127.14.40 extra", true},
+		{"fe80::1%eth0", true},
+		{"host.example.com", true},
+	} {
+		_, err := ParseIP(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseIP(%q) error = %v, want error %t", tt.in, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseCIDR(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"10.244.0.0/16", "10.244.0.0/16", false},
+		{"fd00:10:96::/112", "fd00:10:96::/112", false},
+		{"10.0.0.0/0", "", true},
+		{"10.244.1.0/16", "", true},
+		{"10.244.0.0", "", true},
+		{"10.244.0.0/33", "", true},
+		{"10.244.0.0/-1", "", true},
+	} {
+		got, err := ParseCIDR(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseCIDR(%q) error = %v, want error %t", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("ParseCIDR(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParsePool(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    string
+		size    uint64
+		wantErr bool
+	}{
+		{"10.127.14.40", "10.127.14.40", 1, false},
+		{"10.127.14.40-10.127.14.50", "10.127.14.40-10.127.14.50", 11, false},
+		{" 10.127.14.40 - 10.127.14.50 ", "10.127.14.40-10.127.14.50", 11, false},
+		{"10.127.14.40-10.127.14.40", "10.127.14.40", 1, false},
+		{"10.127.14.32/28", "10.127.14.32-10.127.14.47", 16, false},
+		{"10.127.14.40/32", "10.127.14.40", 1, false},
+		{"fd00::10-fd00::1f", "fd00::10-fd00::1f", 16, false},
+		{"fd00::/64", "fd00::-fd00::ffff:ffff:ffff:ffff", math.MaxUint64, false},
+		{"fd00::/48", "fd00::-fd00::ffff:ffff:ffff:ffff:ffff", math.MaxUint64, false},
+		{"10.127.14.50-10.127.14.40", "", 0, true},
+		{"10.127.14.40-fd00::1", "", 0, true},
+		{"10.127.14.40-", "", 0, true},
+		{"-10.127.14.40", "", 0, true},
+		{"10.127.14.33/28", "", 0, true},
+		{"", "", 0, true},
+	} {
+		pool, err := ParsePool(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePool(%q) error = %v, want error %t", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if pool.String() != tt.want {
+			t.Errorf("ParsePool(%q) = %s, want %s", tt.in, pool, tt.want)
+		}
+		if pool.Size() != tt.size {
+			t.Errorf("ParsePool(%q).Size() = %d, want %d", tt.in, pool.Size(), tt.size)
+		}
+	}
+}
+
+func TestPoolContainsAndOverlaps(t *testing.T) {
+	pool, err := ParsePool("10.127.14.40-10.127.14.50")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		addr string
+		want bool
+	}{
+		{"10.127.14.39", false},
+		{"10.127.14.40", true},
+		{"10.127.14.45", true},
+		{"10.127.14.50", true},
+		{"10.127.14.51", false},
+		{"::ffff:10.127.14.45", false},
+	} {
+		if got := pool.Contains(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("Contains(%s) = %t, want %t", tt.addr, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		prefix string
+		want   bool
+	}{
+		{"10.127.14.0/24", true},
+		{"10.127.14.32/29", false},
+		{"10.127.14.32/28", true},
+		{"10.127.14.48/30", true},
+		{"10.127.14.52/30", false},
+		{"10.127.14.45/32", true},
+		{"fd00::/8", false},
+	} {
+		if got := pool.Overlaps(netip.MustParsePrefix(tt.prefix)); got != tt.want {
+			t.Errorf("Overlaps(%s) = %t, want %t", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateClusterNetworks(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		pods     string
+		services string
+		wantErr  bool
+	}{
+		{"defaults", "10.244.0.0/16", "10.96.0.0/12", false},
+		{"smallest pod network", "10.244.0.0/24", "10.96.0.0/24", false},
+		{"pod network too small", "10.244.0.0/25", "10.96.0.0/12", true},
+		{"service network too large", "10.244.0.0/16", "10.96.0.0/11", true},
+		{"ipv6", "fd00:10:244::/56", "fd00:10:96::/112", false},
+		{"ipv6 service network too large", "fd00:10:244::/56", "fd00:10:96::/107", true},
+		{"overlapping", "10.0.0.0/8", "10.96.0.0/12", true},
+		{"invalid pods", "10.244.0.0", "10.96.0.0/12", true},
+		{"invalid services", "10.244.0.0/16", "10.96.0.1/12", true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ValidateClusterNetworks(tt.pods, tt.services)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateClusterNetworks(%q, %q) error = %v, want error %t", tt.pods, tt.services, err, tt.wantErr)
+			}
+		})
+	}
+}
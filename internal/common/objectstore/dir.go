@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// checksumSuffix names the sidecar file holding an object's checksum in
+// sha256sum format
+const checksumSuffix = ".sha256"
+
+// dirStore keeps objects in a local directory, which may be a mounted volume
+type dirStore struct {
+	dir string
+}
+
+func newDirStore(dir string) *dirStore {
+	return &dirStore{dir: dir}
+}
+
+func (s *dirStore) Put(ctx context.Context, key string, data []byte) error {
+	key = filepath.Base(key)
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("creating %s: %w", s.dir, err)
+	}
+	if err := writeFileAtomic(s.dir, key, data); err != nil {
+		return err
+	}
+	sum := fmt.Sprintf("%s  %s\n", Checksum(data), key)
+	return writeFileAtomic(s.dir, key+checksumSuffix, []byte(sum))
+}
+
+func (s *dirStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", key, err)
+	}
+
+	var expected string
+	if sum, err := os.ReadFile(s.path(key + checksumSuffix)); err == nil {
+		expected, _, _ = strings.Cut(strings.TrimSpace(string(sum)), " ")
+	}
+	if err := verify(key, data, expected); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *dirStore) List(ctx context.Context) ([]Object, error) {
+	entries, err := os.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", s.dir, err)
+	}
+
+	var objects []Object
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || strings.HasSuffix(name, checksumSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		objects = append(objects, Object{Key: name, Size: info.Size(), Modified: info.ModTime()})
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (s *dirStore) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil {
+		return fmt.Errorf("deleting %s: %w", key, err)
+	}
+	os.Remove(s.path(key + checksumSuffix))
+	return nil
+}
+
+func (s *dirStore) String() string {
+	return s.dir
+}
+
+// path confines keys to the store directory
+func (s *dirStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.Base(key))
+}
+
+// writeFileAtomic writes through a temporary file so a failed write never
+// leaves a truncated object behind
+func writeFileAtomic(dir, name string, data []byte) error {
+	tmp, err := os.CreateTemp(dir, "."+name+".*")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return fmt.Errorf("setting permissions on %s: %w", name, err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, name)); err != nil {
+		return fmt.Errorf("saving %s: %w", name, err)
+	}
+	return nil
+}
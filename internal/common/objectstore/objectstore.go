@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectstore reads and writes backups and exports in a local
+// directory or an S3-compatible bucket (AWS S3, MinIO, or GCS through its
+// XML API with HMAC keys).
+//
+// Locations are a directory path or file:// URL, s3://bucket/prefix or
+// gs://bucket/prefix. Every object carries a SHA-256 checksum that is
+// verified when it is read back.
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Server-side encryption modes for S3
+const (
+	SSENone = ""
+	SSES3   = "AES256"
+	SSEKMS  = "aws:kms"
+)
+
+// Keys read from a credentials Secret
+const (
+	SecretAccessKeyIDKey     = "accessKeyID"
+	SecretSecretAccessKeyKey = "secretAccessKey"
+	SecretSessionTokenKey    = "sessionToken"
+)
+
+// Object is an object held in a Store
+type Object struct {
+	// Key is the object's name relative to the store location
+	Key      string
+	Size     int64
+	Modified time.Time
+}
+
+// Store is a location objects are written to
+type Store interface {
+	// Put writes an object, replacing any with the same key
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get reads an object and verifies its checksum
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// List returns the objects directly under the location
+	List(ctx context.Context) ([]Object, error)
+
+	// Delete removes an object
+	Delete(ctx context.Context, key string) error
+
+	// String describes the location for messages
+	String() string
+}
+
+// ChecksumError is returned by Get when an object's content doesn't match
+// the checksum recorded when it was written
+type ChecksumError struct {
+	Key      string
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("checksum mismatch for %s: expected sha256 %s, got %s; the object is corrupt or was modified", e.Key, e.Expected, e.Actual)
+}
+
+// Credentials authenticate to an S3-compatible service. For GCS these are
+// an HMAC key.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// Options configure object storage access. The zero value uses the
+// standard AWS environment variables.
+type Options struct {
+	// Endpoint overrides the service URL, e.g. a MinIO server. Setting it
+	// implies path-style addressing.
+	Endpoint string
+
+	// Region of the bucket (default: AWS_REGION, then us-east-1)
+	Region string
+
+	// PathStyle addresses buckets as endpoint/bucket instead of
+	// bucket.endpoint
+	PathStyle bool
+
+	// CredentialsSecret is a namespace/name Secret on the management
+	// cluster holding accessKeyID, secretAccessKey and optionally
+	// sessionToken
+	CredentialsSecret string
+
+	// SSE is the server-side encryption mode: AES256 or aws:kms
+	SSE string
+
+	// SSEKMSKeyID is the KMS key for aws:kms encryption (default: the
+	// bucket's key)
+	SSEKMSKeyID string
+
+	// Credentials are used as-is when set; see LoadCredentials
+	Credentials *Credentials
+}
+
+// AddFlags registers the object storage flags on a command
+func (o *Options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Endpoint, "storage-endpoint", os.Getenv("AWS_ENDPOINT_URL_S3"), "S3-compatible endpoint, e.g. a MinIO URL (env: AWS_ENDPOINT_URL_S3)")
+	cmd.Flags().StringVar(&o.Region, "storage-region", "", "bucket region (default: AWS_REGION or us-east-1)")
+	cmd.Flags().BoolVar(&o.PathStyle, "storage-path-style", false, "use path-style bucket addressing")
+	cmd.Flags().StringVar(&o.CredentialsSecret, "storage-credentials-secret", "", "namespace/name of a Secret with accessKeyID and secretAccessKey (default: AWS_* env vars)")
+	cmd.Flags().StringVar(&o.SSE, "storage-sse", "", "server-side encryption: AES256 or aws:kms")
+	cmd.Flags().StringVar(&o.SSEKMSKeyID, "storage-sse-kms-key-id", "", "KMS key for --storage-sse aws:kms")
+}
+
+// Validate checks the encryption settings
+func (o *Options) Validate() error {
+	switch o.SSE {
+	case SSENone, SSES3, SSEKMS:
+	default:
+		return fmt.Errorf("invalid --storage-sse %q: must be %s or %s", o.SSE, SSES3, SSEKMS)
+	}
+	if o.SSEKMSKeyID != "" && o.SSE != SSEKMS {
+		return fmt.Errorf("--storage-sse-kms-key-id requires --storage-sse %s", SSEKMS)
+	}
+	if o.CredentialsSecret != "" {
+		if _, _, err := splitSecretRef(o.CredentialsSecret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remote reports whether location is object storage rather than a directory
+func Remote(location string) bool {
+	return strings.HasPrefix(location, "s3://") || strings.HasPrefix(location, "gs://")
+}
+
+// LoadCredentials reads CredentialsSecret from the management cluster. It
+// does nothing when no Secret is configured.
+func (o *Options) LoadCredentials(ctx context.Context, c *client.Client) error {
+	if o.CredentialsSecret == "" {
+		return nil
+	}
+	namespace, name, err := splitSecretRef(o.CredentialsSecret)
+	if err != nil {
+		return err
+	}
+	secret, err := c.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting storage credentials secret %s: %w", o.CredentialsSecret, err)
+	}
+
+	creds := &Credentials{
+		AccessKeyID:     string(secret.Data[SecretAccessKeyIDKey]),
+		SecretAccessKey: string(secret.Data[SecretSecretAccessKeyKey]),
+		SessionToken:    string(secret.Data[SecretSessionTokenKey]),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return fmt.Errorf("storage credentials secret %s must have %s and %s keys", o.CredentialsSecret, SecretAccessKeyIDKey, SecretSecretAccessKeyKey)
+	}
+	o.Credentials = creds
+	return nil
+}
+
+// Open returns the Store for a location
+func Open(location string, opts *Options) (Store, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	i := strings.Index(location, "://")
+	if i < 0 {
+		return newDirStore(location), nil
+	}
+
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage location %q: %w", location, err)
+	}
+	switch u.Scheme {
+	case "file":
+		return newDirStore(location[i+3:]), nil
+	case "s3", "gs":
+		return newS3Store(u, opts)
+	}
+	return nil, fmt.Errorf("unsupported storage location %q: use a directory, s3://bucket/path or gs://bucket/path", location)
+}
+
+// Checksum returns the hex SHA-256 of data
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// verify checks data against a recorded checksum; an empty expected
+// checksum means none was recorded
+func verify(key string, data []byte, expected string) error {
+	if expected == "" {
+		return nil
+	}
+	if actual := Checksum(data); !strings.EqualFold(actual, expected) {
+		return &ChecksumError{Key: key, Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+func splitSecretRef(ref string) (string, string, error) {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" {
+		return "", "", fmt.Errorf("invalid credentials secret %q: must be namespace/name", ref)
+	}
+	return namespace, name, nil
+}
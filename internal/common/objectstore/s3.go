@@ -0,0 +1,395 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// gcsEndpoint is the GCS XML API, which accepts S3 requests signed
+	// with an HMAC key
+	gcsEndpoint = "https://storage.googleapis.com"
+
+	// defaultRegion is used when neither --storage-region nor AWS_REGION is set
+	defaultRegion = "us-east-1"
+
+	// checksumMetadata is the user metadata key recording an object's SHA-256
+	checksumMetadata = "sha256"
+
+	// s3RequestTimeout bounds a single request
+	s3RequestTimeout = 5 * time.Minute
+)
+
+// s3Store keeps objects in an S3-compatible bucket, signing requests with
+// AWS Signature Version 4
+type s3Store struct {
+	scheme    string
+	bucket    string
+	prefix    string
+	endpoint  *url.URL
+	region    string
+	pathStyle bool
+	sse       string
+	kmsKeyID  string
+	creds     Credentials
+
+	httpClient *http.Client
+}
+
+func newS3Store(u *url.URL, opts *Options) (*s3Store, error) {
+	s := &s3Store{
+		scheme:     u.Scheme,
+		bucket:     u.Host,
+		prefix:     strings.Trim(u.Path, "/"),
+		region:     opts.Region,
+		pathStyle:  opts.PathStyle,
+		sse:        opts.SSE,
+		kmsKeyID:   opts.SSEKMSKeyID,
+		httpClient: &http.Client{Timeout: s3RequestTimeout},
+	}
+	if s.bucket == "" {
+		return nil, fmt.Errorf("invalid storage location %q: missing bucket", u.String())
+	}
+	if s.prefix != "" {
+		s.prefix += "/"
+	}
+
+	endpoint := opts.Endpoint
+	switch {
+	case u.Scheme == "gs":
+		if s.sse != SSENone {
+			return nil, fmt.Errorf("--storage-sse is not supported for gs://; GCS encrypts objects at rest by default")
+		}
+		if endpoint == "" {
+			endpoint = gcsEndpoint
+		}
+		if s.region == "" {
+			s.region = "auto"
+		}
+		s.pathStyle = true
+	case endpoint != "":
+		// Custom endpoints (MinIO and friends) rarely support virtual hosts
+		s.pathStyle = true
+	}
+	if s.region == "" {
+		s.region = firstEnv("AWS_REGION", "AWS_DEFAULT_REGION")
+	}
+	if s.region == "" {
+		s.region = defaultRegion
+	}
+	if endpoint == "" {
+		endpoint = "https://s3." + s.region + ".amazonaws.com"
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	ep, err := url.Parse(endpoint)
+	if err != nil || ep.Host == "" {
+		return nil, fmt.Errorf("invalid storage endpoint %q", endpoint)
+	}
+	s.endpoint = ep
+
+	if opts.Credentials != nil {
+		s.creds = *opts.Credentials
+	} else {
+		s.creds = Credentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+	}
+	if s.creds.AccessKeyID == "" || s.creds.SecretAccessKey == "" {
+		return nil, fmt.Errorf("no object storage credentials: set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY or use --storage-credentials-secret")
+	}
+	return s, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, data []byte) error {
+	headers := map[string]string{
+		"Content-Type":                   "application/octet-stream",
+		"X-Amz-Meta-" + checksumMetadata: Checksum(data),
+	}
+	if s.sse != SSENone {
+		headers["X-Amz-Server-Side-Encryption"] = s.sse
+		if s.kmsKeyID != "" {
+			headers["X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"] = s.kmsKeyID
+		}
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, s.prefix+key, nil, data, headers)
+	if err != nil {
+		return fmt.Errorf("uploading %s: %w", key, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.prefix+key, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", key, err)
+	}
+
+	expected := resp.Header.Get("X-Amz-Meta-" + checksumMetadata)
+	if expected == "" {
+		expected = resp.Header.Get("X-Goog-Meta-" + checksumMetadata)
+	}
+	if err := verify(key, data, expected); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// listBucketResult is the ListObjectsV2 response
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *s3Store) List(ctx context.Context) ([]Object, error) {
+	var objects []Object
+	token := ""
+	for {
+		query := url.Values{
+			"list-type": {"2"},
+			"prefix":    {s.prefix},
+			"delimiter": {"/"},
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		resp, err := s.do(ctx, http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", s, err)
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: decoding response: %w", s, err)
+		}
+
+		for _, c := range result.Contents {
+			key := strings.TrimPrefix(c.Key, s.prefix)
+			if key == "" {
+				continue
+			}
+			objects = append(objects, Object{Key: key, Size: c.Size, Modified: c.LastModified})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	return objects, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, s.prefix+key, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %w", key, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *s3Store) String() string {
+	return s.scheme + "://" + s.bucket + "/" + s.prefix
+}
+
+// s3Error is the body of a failed S3 request
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// do sends a signed request and returns the response if it succeeded. An
+// empty key addresses the bucket itself.
+func (s *s3Store) do(ctx context.Context, method, key string, query url.Values, body []byte, headers map[string]string) (*http.Response, error) {
+	u := *s.endpoint
+	path := "/" + key
+	if s.pathStyle {
+		path = "/" + s.bucket + path
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	u.Path = path
+	u.RawPath = awsEscape(path, false)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	s.sign(req, body, time.Now().UTC())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	var e s3Error
+	if data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024)); xml.Unmarshal(data, &e) == nil && e.Code != "" {
+		return nil, fmt.Errorf("%s: %s (HTTP %d)", e.Code, e.Message, resp.StatusCode)
+	}
+	return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+}
+
+// sign adds an AWS Signature Version 4 Authorization header
+func (s *s3Store) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := Checksum(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.creds.SessionToken)
+	}
+
+	// Sign the host and every x-amz- header
+	canonical := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		lk := strings.ToLower(k)
+		if strings.HasPrefix(lk, "x-amz-") || lk == "content-type" {
+			canonical[lk] = strings.TrimSpace(strings.Join(v, ","))
+		}
+	}
+	names := make([]string, 0, len(canonical))
+	for k := range canonical {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var headerLines strings.Builder
+	for _, k := range names {
+		headerLines.WriteString(k + ":" + canonical[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		headerLines.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		Checksum([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.creds.SecretAccessKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery encodes query parameters sorted by key, as SigV4 requires
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, awsEscape(k, true)+"="+awsEscape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// awsEscape percent-encodes everything but unreserved characters, and '/'
+// unless escapeSlash is set
+func awsEscape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !escapeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func firstEnv(names ...string) string {
+	for _, n := range names {
+		if v := os.Getenv(n); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -22,9 +22,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/butlerdotdev/butler/internal/common/color"
+	"github.com/butlerdotdev/butler/internal/common/redact"
 	"github.com/charmbracelet/lipgloss"
 	"golang.org/x/term"
 	"sigs.k8s.io/yaml"
@@ -90,21 +94,13 @@ var (
 // ColorEnabled returns true if colors should be used
 // Respects NO_COLOR env var (https://no-color.org/)
 func ColorEnabled() bool {
-	// NO_COLOR takes precedence
-	if _, exists := os.LookupEnv("NO_COLOR"); exists {
-		return false
-	}
-	// Also check BUTLER_NO_COLOR for convenience
-	if _, exists := os.LookupEnv("BUTLER_NO_COLOR"); exists {
-		return false
-	}
 	// Only colorize if stdout is a TTY
-	return IsTTY()
+	return color.Enabled(os.Stdout)
 }
 
 // IsTTY returns true if stdout is a terminal
 func IsTTY() bool {
-	return term.IsTerminal(int(os.Stdout.Fd()))
+	return color.IsTerminal(os.Stdout)
 }
 
 // ColorizePhase returns a colorized phase string if TTY, plain otherwise
@@ -234,20 +230,45 @@ func FormatAge(t time.Time) string {
 	if t.IsZero() {
 		return "<unknown>"
 	}
+	return FormatDuration(time.Since(t))
+}
 
-	d := time.Since(t)
-	switch {
-	case d < time.Minute:
-		return fmt.Sprintf("%ds", int(d.Seconds()))
-	case d < time.Hour:
-		return fmt.Sprintf("%dm", int(d.Minutes()))
-	case d < 24*time.Hour:
-		return fmt.Sprintf("%dh", int(d.Hours()))
-	case d < 7*24*time.Hour:
-		return fmt.Sprintf("%dd", int(d.Hours()/24))
-	default:
-		return fmt.Sprintf("%dw", int(d.Hours()/(24*7)))
+// FormatDuration formats a duration kubectl-style in its two largest units,
+// e.g. "45s", "5m30s", "1d23h" or "1y20d". The smaller unit is left out
+// when it is zero; negative durations, e.g. from clock skew, are "0s".
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+
+	const (
+		day  = 24 * time.Hour
+		year = 365 * day
+	)
+	units := []struct {
+		size   time.Duration
+		suffix string
+	}{
+		{year, "y"},
+		{day, "d"},
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
 	}
+
+	for i, u := range units[:len(units)-1] {
+		if d < u.size {
+			continue
+		}
+		next := units[i+1]
+		major := d / u.size
+		minor := (d % u.size) / next.size
+		if minor == 0 {
+			return fmt.Sprintf("%d%s", major, u.suffix)
+		}
+		return fmt.Sprintf("%d%s%d%s", major, u.suffix, minor, next.suffix)
+	}
+	return fmt.Sprintf("%ds", d/time.Second)
 }
 
 // FormatWorkers formats worker counts as ready/desired
@@ -261,22 +282,47 @@ func FormatWorkers(ready, desired int64) string {
 // Table provides a simple table writer with header support
 // Note: When using colors, we use fixed-width columns instead of tabwriter
 // because tabwriter counts ANSI escape codes as visible characters
+//
+// On a terminal, tables wider than the window are fitted to it: the least
+// important columns are truncated with an ellipsis first, then hidden.
+// Piped output and --no-truncate keep every value whole.
 type Table struct {
-	writer    io.Writer
-	headers   []string
-	rows      [][]string
-	colWidths []int
-	useColors bool
+	writer        io.Writer
+	headers       []string
+	rows          [][]string
+	colWidths     []int
+	useColors     bool
+	headerWritten bool
+
+	// maxWidth is the terminal width to fit, or 0 to never truncate
+	maxWidth   int
+	priorities map[int]int
+	widths     []int
+	hidden     []bool
+}
+
+// minColumnWidth is the narrowest a column is truncated to, unless its
+// header is wider
+const minColumnWidth = 6
+
+// noTruncate is set by DisableTruncation for the lifetime of the process
+var noTruncate bool
+
+// DisableTruncation keeps tables at full width, e.g. for --no-truncate
+func DisableTruncation() {
+	noTruncate = true
 }
 
 // NewTable creates a new table writer
 func NewTable(output io.Writer, headers ...string) *Table {
 	t := &Table{
-		writer:    output,
-		headers:   headers,
-		rows:      make([][]string, 0),
-		colWidths: make([]int, len(headers)),
-		useColors: IsTTY(),
+		writer:     output,
+		headers:    headers,
+		rows:       make([][]string, 0),
+		colWidths:  make([]int, len(headers)),
+		useColors:  ColorEnabled(),
+		maxWidth:   terminalWidth(output),
+		priorities: map[int]int{},
 	}
 
 	// Initialize column widths from headers
@@ -287,6 +333,17 @@ func NewTable(output io.Writer, headers ...string) *Table {
 	return t
 }
 
+// SetPriority ranks a column for narrow terminals. Columns with a lower
+// priority are truncated and hidden first; the default is 0, and among
+// equals the later column gives way.
+func (t *Table) SetPriority(header string, priority int) {
+	for i, h := range t.headers {
+		if h == header {
+			t.priorities[i] = priority
+		}
+	}
+}
+
 // AddRow adds a row to the table
 func (t *Table) AddRow(columns ...string) {
 	// Store the raw (uncolored) row for width calculation
@@ -303,32 +360,50 @@ func (t *Table) AddRow(columns ...string) {
 	t.rows = append(t.rows, columns)
 }
 
-// Flush writes the table to output
+// Flush writes the table to output. It may be called repeatedly to stream
+// rows as they arrive; the header is written once and buffered rows are
+// cleared, while column widths carry over so later rows stay aligned.
 func (t *Table) Flush() error {
 	// Print headers
-	if len(t.headers) > 0 {
+	if len(t.headers) > 0 && !t.headerWritten {
+		t.fit()
+		last := t.lastVisible(len(t.headers))
 		for i, h := range t.headers {
+			if t.hidden[i] {
+				continue
+			}
+			width := t.width(i)
+			plain := truncate(h, width)
+			h = plain
 			if t.useColors {
 				h = HeaderStyle.Render(h)
 			}
 			// Pad based on visible width
 			fmt.Fprint(t.writer, h)
-			if i < len(t.headers)-1 {
-				padding := t.colWidths[i] - len(t.headers[i]) + 2
+			if i < last {
+				padding := width - len(plain) + 2
 				fmt.Fprint(t.writer, strings.Repeat(" ", padding))
 			}
 		}
 		fmt.Fprintln(t.writer)
+		t.headerWritten = true
 	}
 
 	// Print rows
 	for _, row := range t.rows {
+		last := t.lastVisible(len(row))
 		for i, col := range row {
+			if i < len(t.hidden) && t.hidden[i] {
+				continue
+			}
+			if i < len(t.colWidths) && visibleLength(col) > t.width(i) {
+				col = truncate(col, t.width(i))
+			}
 			fmt.Fprint(t.writer, col)
-			if i < len(row)-1 && i < len(t.colWidths) {
+			if i < last && i < len(t.colWidths) {
 				// Calculate padding based on visible length vs column width
 				visLen := visibleLength(col)
-				padding := t.colWidths[i] - visLen + 2
+				padding := t.width(i) - visLen + 2
 				if padding < 2 {
 					padding = 2
 				}
@@ -337,10 +412,151 @@ func (t *Table) Flush() error {
 		}
 		fmt.Fprintln(t.writer)
 	}
+	t.rows = t.rows[:0]
 
 	return nil
 }
 
+// width returns the width column i is printed at
+func (t *Table) width(i int) int {
+	if t.widths != nil {
+		return t.widths[i]
+	}
+	return t.colWidths[i]
+}
+
+// lastVisible returns the index of the last shown column of a row
+func (t *Table) lastVisible(n int) int {
+	last := n - 1
+	for last > 0 && last < len(t.hidden) && t.hidden[last] {
+		last--
+	}
+	return last
+}
+
+// fit fixes the column layout when the header is written. Without a width
+// limit, or when the table fits, columns keep growing with their content.
+func (t *Table) fit() {
+	t.hidden = make([]bool, len(t.headers))
+	if t.maxWidth <= 0 || lineWidth(t.colWidths, t.hidden) <= t.maxWidth {
+		return
+	}
+
+	// Least important first
+	order := make([]int, len(t.headers))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := t.priorities[order[a]], t.priorities[order[b]]
+		if pa != pb {
+			return pa < pb
+		}
+		return order[a] > order[b]
+	})
+
+	widths := append([]int(nil), t.colWidths...)
+	for _, i := range order {
+		excess := lineWidth(widths, t.hidden) - t.maxWidth
+		if excess <= 0 {
+			break
+		}
+		widths[i] = max(widths[i]-excess, min(t.colWidths[i], max(len(t.headers[i]), minColumnWidth)))
+	}
+	hiddenCount := 0
+	for _, i := range order[:len(order)-1] {
+		if lineWidth(widths, t.hidden) <= t.maxWidth {
+			break
+		}
+		t.hidden[i] = true
+		hiddenCount++
+	}
+
+	// Give room freed by hidden columns back, most important first
+	for j := len(order) - 1; j >= 0 && hiddenCount > 0; j-- {
+		i := order[j]
+		if t.hidden[i] {
+			continue
+		}
+		slack := t.maxWidth - lineWidth(widths, t.hidden)
+		if slack <= 0 {
+			break
+		}
+		widths[i] = min(t.colWidths[i], widths[i]+slack)
+	}
+	t.widths = widths
+
+	if hiddenCount > 0 {
+		fmt.Fprintln(os.Stderr, Dim(fmt.Sprintf("(%d columns hidden; widen the terminal or use --no-truncate)", hiddenCount)))
+	}
+}
+
+// lineWidth returns the width of a line with the given column widths
+func lineWidth(widths []int, hidden []bool) int {
+	total, shown := 0, 0
+	for i, w := range widths {
+		if !hidden[i] {
+			total += w
+			shown++
+		}
+	}
+	if shown > 1 {
+		total += 2 * (shown - 1)
+	}
+	return total
+}
+
+// truncate shortens s to width visible characters, ending in an ellipsis.
+// Colors are dropped from truncated values.
+func truncate(s string, width int) string {
+	if visibleLength(s) <= width {
+		return s
+	}
+	runes := []rune(stripANSI(s))
+	if width <= 1 {
+		return "…"
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// stripANSI removes ANSI escape codes from s
+func stripANSI(s string) string {
+	var b strings.Builder
+	inEscape := false
+	for _, r := range s {
+		if r == '\x1b' {
+			inEscape = true
+			continue
+		}
+		if inEscape {
+			if r == 'm' {
+				inEscape = false
+			}
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// terminalWidth returns the width to fit tables written to w into: the
+// terminal's, or COLUMNS when set. It is 0 when w isn't a terminal or
+// truncation is disabled.
+func terminalWidth(w io.Writer) int {
+	f, ok := w.(*os.File)
+	if noTruncate || !ok || !term.IsTerminal(int(f.Fd())) {
+		return 0
+	}
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
 // visibleLength returns the visible length of a string, excluding ANSI escape codes
 func visibleLength(s string) int {
 	// Simple ANSI stripper - handles common escape sequences
@@ -362,16 +578,16 @@ func visibleLength(s string) int {
 	return visibleLen
 }
 
-// PrintJSON prints data as JSON
+// PrintJSON prints data as JSON, with credentials redacted
 func PrintJSON(output io.Writer, data interface{}) error {
 	encoder := json.NewEncoder(output)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(data)
+	return encoder.Encode(redact.Value(data))
 }
 
-// PrintYAML prints data as YAML
+// PrintYAML prints data as YAML, with credentials redacted
 func PrintYAML(output io.Writer, data interface{}) error {
-	yamlData, err := yaml.Marshal(data)
+	yamlData, err := yaml.Marshal(redact.Value(data))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	const day = 24 * time.Hour
+	for _, tt := range []struct {
+		in   time.Duration
+		want string
+	}{
+		{-5 * time.Second, "0s"},
+		{0, "0s"},
+		{999 * time.Millisecond, "0s"},
+		{45 * time.Second, "45s"},
+		{time.Minute, "1m"},
+		{5*time.Minute + 30*time.Second, "5m30s"},
+		{59*time.Minute + 59*time.Second, "59m59s"},
+		{time.Hour, "1h"},
+		{time.Hour + 59*time.Second, "1h"},
+		{2*time.Hour + 5*time.Minute, "2h5m"},
+		{day, "1d"},
+		{day + 23*time.Hour + 59*time.Minute, "1d23h"},
+		{364 * day, "364d"},
+		{365 * day, "1y"},
+		{385 * day, "1y20d"},
+		{3*365*day + 12*time.Hour, "3y"},
+	} {
+		if got := FormatDuration(tt.in); got != tt.want {
+			t.Errorf("FormatDuration(%s) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFormatWorkers(t *testing.T) {
+	for _, tt := range []struct {
+		ready, desired int64
+		want           string
+	}{
+		{0, 0, "-"},
+		{0, 3, "0/3"},
+		{3, 3, "3/3"},
+	} {
+		if got := FormatWorkers(tt.ready, tt.desired); got != tt.want {
+			t.Errorf("FormatWorkers(%d, %d) = %q, want %q", tt.ready, tt.desired, got, tt.want)
+		}
+	}
+}
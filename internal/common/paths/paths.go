@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package paths holds the small bits of file path handling that differ
+// between unix and Windows hosts.
+package paths
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Expand replaces a leading ~ with the user's home directory. Both ~/ and
+// ~\ are accepted; ~user forms and paths that only start with a tilde are
+// left alone.
+func Expand(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") && !strings.HasPrefix(path, `~\`) {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, filepath.FromSlash(path[1:]))
+}
+
+// ButlerDir returns ~/.butler, where bootstrap saves cluster credentials
+func ButlerDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting home directory: %w", err)
+	}
+	return filepath.Join(home, ".butler"), nil
+}
+
+// ExportHint returns the shell command that sets an environment variable,
+// in PowerShell syntax on Windows
+func ExportHint(name, value string) string {
+	if runtime.GOOS == "windows" {
+		return fmt.Sprintf(`$env:%s = "%s"`, name, value)
+	}
+	return fmt.Sprintf("export %s=%s", name, value)
+}
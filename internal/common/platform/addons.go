@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ButlerConfigName is the platform's singleton ButlerConfig
+const ButlerConfigName = "butler"
+
+// Addons is the catalog of addons tenant clusters may choose from: the
+// cluster-scoped AddonDefinitions, plus the built-in addons whose versions
+// the ButlerConfig pins under spec.defaultAddonVersions.
+type Addons struct {
+	// categories maps AddonDefinition names to their category
+	categories map[string]string
+
+	// versions maps addon names to the chart version to install
+	versions map[string]string
+}
+
+// LoadAddons reads the AddonDefinitions and the ButlerConfig's default
+// addon versions. AddonDefinitions the caller may not list are treated as
+// absent, leaving only the ButlerConfig's built-in addons.
+func LoadAddons(ctx context.Context, c *client.Client) (*Addons, error) {
+	a := &Addons{categories: map[string]string{}, versions: map[string]string{}}
+
+	list, err := c.Dynamic.Resource(client.AddonDefinitionGVR).List(ctx, metav1.ListOptions{})
+	switch {
+	case errors.IsNotFound(err) || errors.IsForbidden(err):
+	case err != nil:
+		return nil, fmt.Errorf("listing AddonDefinitions: %w", err)
+	default:
+		for _, def := range list.Items {
+			category, _, _ := unstructured.NestedString(def.Object, "spec", "category")
+			version, _, _ := unstructured.NestedString(def.Object, "spec", "chart", "defaultVersion")
+			a.categories[def.GetName()] = category
+			if version != "" {
+				a.versions[def.GetName()] = version
+			}
+		}
+	}
+
+	bc, err := getButlerConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if bc != nil {
+		pinned, _, _ := unstructured.NestedStringMap(bc.Object, "spec", "defaultAddonVersions")
+		for name, version := range pinned {
+			if version != "" {
+				a.versions[name] = version
+			}
+		}
+	}
+	return a, nil
+}
+
+// Version returns the chart version for an addon of the given category
+// (an AddonDefinition category such as cni or storage). It fails when the
+// platform doesn't offer the addon or no version is known for it.
+func (a *Addons) Version(category, name string) (string, error) {
+	defined, isDefined := a.categories[name]
+	if isDefined && defined != category {
+		return "", fmt.Errorf("addon %s is a %s addon, not %s", name, defined, category)
+	}
+	version := a.versions[name]
+	if !isDefined && version == "" {
+		available := a.Available(category)
+		if len(available) == 0 {
+			return "", fmt.Errorf("%s addon %s is not available on this platform (no AddonDefinition %s)", category, name, name)
+		}
+		return "", fmt.Errorf("%s addon %s is not available on this platform (available: %s)", category, name, strings.Join(available, ", "))
+	}
+	if version == "" {
+		return "", fmt.Errorf("no version known for addon %s; set spec.chart.defaultVersion on its AddonDefinition", name)
+	}
+	return version, nil
+}
+
+// Available lists the AddonDefinitions of a category
+func (a *Addons) Available(category string) []string {
+	var names []string
+	for name, c := range a.categories {
+		if c == category {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getButlerConfig returns the platform ButlerConfig, or nil if it doesn't
+// exist or the caller may not read it
+func getButlerConfig(ctx context.Context, c *client.Client) (*unstructured.Unstructured, error) {
+	bc, err := c.Dynamic.Resource(client.ButlerConfigGVR).Get(ctx, ButlerConfigName, metav1.GetOptions{})
+	if errors.IsNotFound(err) || errors.IsForbidden(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting ButlerConfig %s: %w", ButlerConfigName, err)
+	}
+	return bc, nil
+}
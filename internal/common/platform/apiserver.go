@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// APIServer lists the API server settings users may pass through to their
+// own clusters with --feature-gates and --apiserver-extra-arg. Entries are
+// names or glob patterns; nothing is allowed unless listed.
+//
+// Example:
+//
+//	apiServer:
+//	  allowedFeatureGates: ["InPlacePodVerticalScaling", "*Alpha*"]
+//	  allowedExtraArgs: ["audit-log-maxage", "max-requests-*"]
+type APIServer struct {
+	// AllowedFeatureGates are the feature gates users may set
+	AllowedFeatureGates []string `json:"allowedFeatureGates,omitempty"`
+
+	// AllowedExtraArgs are the API server flags, without dashes, users may set
+	AllowedExtraArgs []string `json:"allowedExtraArgs,omitempty"`
+}
+
+// CheckFeatureGate returns an error unless the feature gate is allowed
+func (a *APIServer) CheckFeatureGate(name string) error {
+	if matchAny(a.AllowedFeatureGates, name) {
+		return nil
+	}
+	return fmt.Errorf("feature gate %s is not allowed by the platform (allowed: %s)", name, describeAllowed(a.AllowedFeatureGates))
+}
+
+// CheckExtraArg returns an error unless the API server flag is allowed
+func (a *APIServer) CheckExtraArg(name string) error {
+	if matchAny(a.AllowedExtraArgs, name) {
+		return nil
+	}
+	return fmt.Errorf("API server flag --%s is not allowed by the platform (allowed: %s)", name, describeAllowed(a.AllowedExtraArgs))
+}
+
+// matchAny reports whether name matches one of the patterns
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// describeAllowed lists an allowlist for error messages
+func describeAllowed(patterns []string) string {
+	if len(patterns) == 0 {
+		return "none; ask a platform admin to add it to apiServer in the " + ConfigMapName + " ConfigMap"
+	}
+	return strings.Join(patterns, ", ")
+}
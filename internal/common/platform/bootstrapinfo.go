@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BootstrapInfoConfigMapName is the ConfigMap recording how the management
+// cluster was bootstrapped
+const BootstrapInfoConfigMapName = "butler-bootstrap-info"
+
+// Keys of the bootstrap info ConfigMap
+const (
+	cliVersionKey      = "cliVersion"
+	gitCommitKey       = "gitCommit"
+	bootstrappedAtKey  = "bootstrappedAt"
+	bootstrapConfigKey = "config.yaml"
+)
+
+// BootstrapInfo records the butleradm build and config that created a
+// management cluster
+type BootstrapInfo struct {
+	CLIVersion     string `json:"cliVersion"`
+	GitCommit      string `json:"gitCommit,omitempty"`
+	BootstrappedAt string `json:"bootstrappedAt,omitempty"`
+
+	// Config is the bootstrap config, with credentials redacted
+	Config string `json:"config,omitempty"`
+}
+
+// LoadBootstrapInfo returns the bootstrap record, or nil for a cluster
+// bootstrapped before it was kept
+func LoadBootstrapInfo(ctx context.Context, c *client.Client) (*BootstrapInfo, error) {
+	cm, err := c.Clientset.CoreV1().ConfigMaps(ConfigMapNamespace).Get(ctx, BootstrapInfoConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting %s/%s: %w", ConfigMapNamespace, BootstrapInfoConfigMapName, err)
+	}
+	return &BootstrapInfo{
+		CLIVersion:     cm.Data[cliVersionKey],
+		GitCommit:      cm.Data[gitCommitKey],
+		BootstrappedAt: cm.Data[bootstrappedAtKey],
+		Config:         cm.Data[bootstrapConfigKey],
+	}, nil
+}
+
+// RecordBootstrapInfo writes the bootstrap record. The config must already
+// be redacted.
+func RecordBootstrapInfo(ctx context.Context, cs kubernetes.Interface, info *BootstrapInfo) error {
+	err := applyConfigMap(ctx, cs, BootstrapInfoConfigMapName, map[string]string{
+		cliVersionKey:      info.CLIVersion,
+		gitCommitKey:       info.GitCommit,
+		bootstrappedAtKey:  info.BootstrappedAt,
+		bootstrapConfigKey: info.Config,
+	})
+	if err != nil {
+		return fmt.Errorf("recording bootstrap info: %w", err)
+	}
+	return nil
+}
+
+// applyConfigMap creates a ConfigMap in the platform namespace or replaces
+// its data
+func applyConfigMap(ctx context.Context, cs kubernetes.Interface, name string, data map[string]string) error {
+	configMaps := cs.CoreV1().ConfigMaps(ConfigMapNamespace)
+
+	cm, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ConfigMapNamespace},
+			Data:       data,
+		}
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	} else if err == nil {
+		cm.Data = data
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("writing ConfigMap %s/%s: %w", ConfigMapNamespace, name, err)
+	}
+	return nil
+}
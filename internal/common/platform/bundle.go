@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultReleaseBundle is where Butler publishes release bundles
+	DefaultReleaseBundle = "https://butlerlabs.dev/releases/v1/{{ .Version }}/bundle.yaml"
+
+	// VersionConfigMapName is the ConfigMap recording the installed
+	// platform release
+	VersionConfigMapName = "butler-platform-version"
+)
+
+// Keys of the platform version ConfigMap
+const (
+	versionKey     = "version"
+	bundleKey      = "bundle"
+	installedAtKey = "installedAt"
+)
+
+var releaseVersionPattern = regexp.MustCompile(`^v\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?$`)
+
+// ReleaseBundle pins the components of one platform release
+//
+// Example:
+//
+//	release: v0.3.1
+//	crds: crds.yaml
+//	controllers:
+//	  butler-controller: v0.3.1
+//	  butler-bootstrap-controller: v0.3.1
+//	  butler-provider-nutanix: v0.3.2
+type ReleaseBundle struct {
+	Release string `json:"release"`
+
+	// CRDs is a URL or path of the release's CRD manifests, relative to
+	// the bundle; unset means the CRDs embedded in butleradm
+	CRDs string `json:"crds,omitempty"`
+
+	// Controllers maps controller Deployment names to image tags
+	Controllers map[string]string `json:"controllers"`
+}
+
+// InstalledVersion is the platform release recorded on a management cluster
+type InstalledVersion struct {
+	Version     string `json:"version"`
+	Bundle      string `json:"bundle,omitempty"`
+	InstalledAt string `json:"installedAt,omitempty"`
+}
+
+// ValidReleaseVersion reports whether version looks like a release tag,
+// e.g. v0.3.1 or v0.4.0-rc.1
+func ValidReleaseVersion(version string) bool {
+	return releaseVersionPattern.MatchString(version)
+}
+
+// BundleLocation returns the bundle URL or path of a release from a
+// template rendered with the version as .Version; an empty template means
+// DefaultReleaseBundle
+func BundleLocation(location, version string) (string, error) {
+	if location == "" {
+		location = DefaultReleaseBundle
+	}
+	return renderLocation("bundle", location, struct{ Version string }{version})
+}
+
+// LoadReleaseBundle reads the bundle of a release and checks it describes
+// that release
+func LoadReleaseBundle(ctx context.Context, location, version string) (*ReleaseBundle, error) {
+	data, err := Fetch(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("loading release bundle: %w", err)
+	}
+	var bundle ReleaseBundle
+	if err := yaml.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("parsing release bundle %s: %w", location, err)
+	}
+	if bundle.Release != version {
+		return nil, fmt.Errorf("%s describes release %q, not %q", location, bundle.Release, version)
+	}
+	if len(bundle.Controllers) == 0 {
+		return nil, fmt.Errorf("%s lists no controller versions", location)
+	}
+	return &bundle, nil
+}
+
+// CRDLocation returns where the bundle's CRD manifests are, resolving a
+// relative reference against the bundle's own location. It returns "" when
+// the bundle uses the embedded CRDs.
+func (b *ReleaseBundle) CRDLocation(bundleLocation string) (string, error) {
+	if b.CRDs == "" || isURL(b.CRDs) || filepath.IsAbs(b.CRDs) {
+		return b.CRDs, nil
+	}
+	if isURL(bundleLocation) {
+		base, err := url.Parse(bundleLocation)
+		if err != nil {
+			return "", fmt.Errorf("parsing bundle location: %w", err)
+		}
+		ref, err := url.Parse(b.CRDs)
+		if err != nil {
+			return "", fmt.Errorf("parsing bundle crds: %w", err)
+		}
+		return base.ResolveReference(ref).String(), nil
+	}
+	return filepath.Join(filepath.Dir(bundleLocation), b.CRDs), nil
+}
+
+// Fetch reads a URL or local file
+func Fetch(ctx context.Context, location string) ([]byte, error) {
+	if !isURL(location) {
+		return os.ReadFile(location)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	resp, err := (&http.Client{Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", location, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: status %d", location, resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", location, err)
+	}
+	return data, nil
+}
+
+// LoadInstalledVersion returns the recorded platform release, or nil for a
+// cluster bootstrapped without --platform-version
+func LoadInstalledVersion(ctx context.Context, c *client.Client) (*InstalledVersion, error) {
+	cm, err := c.Clientset.CoreV1().ConfigMaps(ConfigMapNamespace).Get(ctx, VersionConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting %s/%s: %w", ConfigMapNamespace, VersionConfigMapName, err)
+	}
+	if cm.Data[versionKey] == "" {
+		return nil, nil
+	}
+	return &InstalledVersion{
+		Version:     cm.Data[versionKey],
+		Bundle:      cm.Data[bundleKey],
+		InstalledAt: cm.Data[installedAtKey],
+	}, nil
+}
+
+// RecordInstalledVersion writes the platform release to the version
+// ConfigMap, stamping the install time
+func RecordInstalledVersion(ctx context.Context, cs kubernetes.Interface, version, bundle string) error {
+	err := applyConfigMap(ctx, cs, VersionConfigMapName, map[string]string{
+		versionKey:     version,
+		bundleKey:      bundle,
+		installedAtKey: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("recording platform version: %w", err)
+	}
+	return nil
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://")
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConsoleServiceName is the Ingress and Service name of the Butler Console addon
+	ConsoleServiceName = "butler-console"
+
+	// DefaultConsoleClusterPath is the console page for a tenant cluster
+	DefaultConsoleClusterPath = "/clusters/{{ .Namespace }}/{{ .Name }}"
+)
+
+// Console locates the Butler Console so the CLIs can link to it. When URL
+// is unset it is discovered from the addon's Ingress or LoadBalancer Service.
+//
+// Example:
+//
+//	console:
+//	  url: https://butler.example.com
+//	  clusterPath: "/ui/tenants/{{ .Namespace }}/{{ .Name }}"
+type Console struct {
+	// URL is the console's base URL
+	URL string `json:"url,omitempty"`
+
+	// ClusterPath is a Go template rendered with TemplateData for a
+	// cluster's page; defaults to DefaultConsoleClusterPath
+	ClusterPath string `json:"clusterPath,omitempty"`
+}
+
+// ResolveConsole fills in Console.URL from the installed addon when the
+// platform config doesn't set it. It returns false if no console is found.
+func (c *Config) ResolveConsole(ctx context.Context, cl *client.Client) bool {
+	if c.Console.URL == "" {
+		c.Console.URL = discoverConsoleURL(ctx, cl)
+	}
+	return c.Console.URL != ""
+}
+
+// ClusterURL returns the console page of a tenant cluster, or "" when no
+// console URL is known
+func (c *Console) ClusterURL(data TemplateData) (string, error) {
+	if c.URL == "" {
+		return "", nil
+	}
+	path := c.ClusterPath
+	if path == "" {
+		path = DefaultConsoleClusterPath
+	}
+	tmpl, err := template.New("clusterPath").Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("parsing console clusterPath: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("rendering console clusterPath: %w", err)
+	}
+	return strings.TrimSuffix(c.URL, "/") + "/" + strings.TrimPrefix(buf.String(), "/"), nil
+}
+
+// discoverConsoleURL looks for the console addon's Ingress, then its
+// LoadBalancer Service. Lookup failures, including RBAC denials, mean no
+// console.
+func discoverConsoleURL(ctx context.Context, c *client.Client) string {
+	ing, err := c.Clientset.NetworkingV1().Ingresses(ConfigMapNamespace).Get(ctx, ConsoleServiceName, metav1.GetOptions{})
+	if err == nil && len(ing.Spec.Rules) > 0 && ing.Spec.Rules[0].Host != "" {
+		host := ing.Spec.Rules[0].Host
+		for _, tls := range ing.Spec.TLS {
+			for _, h := range tls.Hosts {
+				if h == host {
+					return "https://" + host
+				}
+			}
+		}
+		return "http://" + host
+	}
+
+	svc, err := c.Clientset.CoreV1().Services(ConfigMapNamespace).Get(ctx, ConsoleServiceName, metav1.GetOptions{})
+	if err == nil {
+		for _, lb := range svc.Status.LoadBalancer.Ingress {
+			host := lb.IP
+			if host == "" {
+				host = lb.Hostname
+			}
+			if host != "" {
+				return "http://" + host
+			}
+		}
+	}
+	return ""
+}
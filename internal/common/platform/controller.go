@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ControllerDeployment is the butler-controller Deployment in
+// ConfigMapNamespace
+const ControllerDeployment = "butler-controller"
+
+// ControllerVersion returns the image tag a controller Deployment in
+// ConfigMapNamespace runs, e.g. v0.4.1, or "" when the image is untagged
+func ControllerVersion(ctx context.Context, c *client.Client, deployment string) (string, error) {
+	deploy, err := c.Clientset.AppsV1().Deployments(ConfigMapNamespace).Get(ctx, deployment, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting %s/%s: %w", ConfigMapNamespace, deployment, err)
+	}
+	for _, container := range deploy.Spec.Template.Spec.Containers {
+		ref, _, _ := strings.Cut(container.Image, "@")
+		if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+			return ref[i+1:], nil
+		}
+	}
+	return "", nil
+}
+
+// RequireController fails unless a controller Deployment runs release min
+// or newer. Untagged and non-release images such as latest fail too, as
+// what they support can't be told.
+func RequireController(ctx context.Context, c *client.Client, deployment, min, feature string) error {
+	version, err := ControllerVersion(ctx, c, deployment)
+	if err != nil {
+		return err
+	}
+	if !ValidReleaseVersion(version) || compareReleases(version, min) < 0 {
+		return fmt.Errorf("%s needs %s %s or newer, but it runs %s", feature, deployment, min, orUntagged(version))
+	}
+	return nil
+}
+
+// compareReleases orders release tags by their numeric part; pre-releases
+// order before their release
+func compareReleases(a, b string) int {
+	coreA, preA, _ := strings.Cut(strings.TrimPrefix(a, "v"), "-")
+	coreB, preB, _ := strings.Cut(strings.TrimPrefix(b, "v"), "-")
+	va, _ := parseKubernetesVersion(coreA)
+	if cmp := compareVersionPrefix(va, coreB); cmp != 0 {
+		return cmp
+	}
+	switch {
+	case preA == preB:
+		return 0
+	case preA == "":
+		return 1
+	case preB == "":
+		return -1
+	}
+	return strings.Compare(preA, preB)
+}
+
+func orUntagged(version string) string {
+	if version == "" {
+		return "untagged"
+	}
+	return version
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import "testing"
+
+func TestCompareReleases(t *testing.T) {
+	for _, tt := range []struct {
+		a, b string
+		want int
+	}{
+		{"v0.4.0", "v0.4.0", 0},
+		{"v0.4.1", "v0.4.0", 1},
+		{"v0.3.9", "v0.4.0", -1},
+		{"v0.10.0", "v0.9.0", 1},
+		{"v1.0.0", "v0.99.99", 1},
+		{"0.4.0", "v0.4.0", 0},
+		{"v0.4.0-rc.1", "v0.4.0", -1},
+		{"v0.4.0", "v0.4.0-rc.1", 1},
+		{"v0.4.0-rc.1", "v0.4.0-rc.2", -1},
+		{"v0.4.0-rc.1", "v0.3.9", 1},
+	} {
+		if got := compareReleases(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareReleases(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// Conventions defines naming and metadata rules enforced at create time.
+//
+// Example:
+//
+//	conventions:
+//	  namePattern: "^(dev|stg|prd)-[a-z0-9-]+$"
+//	  requiredLabels:
+//	    - key: cost-center
+//	      description: Finance cost center
+//	      pattern: "^[0-9]{4}$"
+//	    - key: environment
+//	      values: [dev, staging, prod]
+//	      default: "{{ if hasPrefix .Name \"prd-\" }}prod{{ else }}dev{{ end }}"
+//	  requiredAnnotations:
+//	    - key: butler.butlerlabs.dev/owner
+//	      description: Owning team or person
+type Conventions struct {
+	// NamePattern is a regular expression cluster names must match
+	NamePattern string `json:"namePattern,omitempty"`
+
+	// RequiredLabels must be present on every TenantCluster
+	RequiredLabels []Requirement `json:"requiredLabels,omitempty"`
+
+	// RequiredAnnotations must be present on every TenantCluster
+	RequiredAnnotations []Requirement `json:"requiredAnnotations,omitempty"`
+}
+
+// Requirement describes a required label or annotation
+type Requirement struct {
+	// Key is the label or annotation key
+	Key string `json:"key"`
+
+	// Description is shown when prompting for the value
+	Description string `json:"description,omitempty"`
+
+	// Pattern is an optional regular expression the value must match
+	Pattern string `json:"pattern,omitempty"`
+
+	// Values optionally restricts the value to a fixed set
+	Values []string `json:"values,omitempty"`
+
+	// Default is a Go template rendered with TemplateData to suggest a value
+	Default string `json:"default,omitempty"`
+}
+
+// TemplateData is the data available to Requirement.Default templates
+type TemplateData struct {
+	Name      string
+	Namespace string
+}
+
+// ValidateName checks a cluster name against the configured pattern
+func (c *Conventions) ValidateName(name string) error {
+	if c.NamePattern == "" {
+		return nil
+	}
+	re, err := regexp.Compile(c.NamePattern)
+	if err != nil {
+		return fmt.Errorf("platform namePattern %q is invalid: %w", c.NamePattern, err)
+	}
+	if !re.MatchString(name) {
+		return fmt.Errorf("cluster name %q does not match the platform naming convention %s", name, c.NamePattern)
+	}
+	return nil
+}
+
+// Validate checks a value against the requirement's pattern and allowed values
+func (r *Requirement) Validate(value string) error {
+	if value == "" {
+		return fmt.Errorf("%s is required", r.Key)
+	}
+	if len(r.Values) > 0 {
+		allowed := false
+		for _, v := range r.Values {
+			if v == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%s=%q is not allowed (valid: %s)", r.Key, value, strings.Join(r.Values, ", "))
+		}
+	}
+	if r.Pattern != "" {
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return fmt.Errorf("platform pattern for %s is invalid: %w", r.Key, err)
+		}
+		if !re.MatchString(value) {
+			return fmt.Errorf("%s=%q does not match %s", r.Key, value, r.Pattern)
+		}
+	}
+	return nil
+}
+
+// DefaultValue renders the requirement's default template
+func (r *Requirement) DefaultValue(data TemplateData) (string, error) {
+	if r.Default == "" {
+		return "", nil
+	}
+	tmpl, err := template.New(r.Key).Funcs(template.FuncMap{
+		"hasPrefix": strings.HasPrefix,
+		"hasSuffix": strings.HasSuffix,
+		"lower":     strings.ToLower,
+		"upper":     strings.ToUpper,
+	}).Parse(r.Default)
+	if err != nil {
+		return "", fmt.Errorf("parsing default template for %s: %w", r.Key, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("rendering default template for %s: %w", r.Key, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// LabelKeys returns the keys of all required labels
+func (c *Conventions) LabelKeys() []string {
+	keys := make([]string, 0, len(c.RequiredLabels))
+	for _, r := range c.RequiredLabels {
+		keys = append(keys, r.Key)
+	}
+	return keys
+}
+
+// Check validates a cluster's name, labels and annotations without prompting.
+// All problems are reported together.
+func (c *Conventions) Check(name string, labels, annotations map[string]string) error {
+	var problems []string
+	if err := c.ValidateName(name); err != nil {
+		problems = append(problems, err.Error())
+	}
+	for i := range c.RequiredLabels {
+		if err := c.RequiredLabels[i].Validate(labels[c.RequiredLabels[i].Key]); err != nil {
+			problems = append(problems, "label "+err.Error())
+		}
+	}
+	for i := range c.RequiredAnnotations {
+		if err := c.RequiredAnnotations[i].Validate(annotations[c.RequiredAnnotations[i].Key]); err != nil {
+			problems = append(problems, "annotation "+err.Error())
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("platform conventions not met: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
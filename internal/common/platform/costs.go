@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+// Costs are the rates cluster cost estimates are based on. Estimates price
+// what clusters request (worker CPU, memory and disk, plus a flat rate per
+// hosted control plane), not what they use. Leave them unset to disable
+// cost reporting.
+//
+// Example:
+//
+//	costs:
+//	  currency: EUR
+//	  cpuHour: 0.02
+//	  memoryGiBHour: 0.003
+//	  diskGiBMonth: 0.08
+//	  controlPlaneHour: 0.05
+type Costs struct {
+	// Currency labels amounts in reports (default: USD)
+	Currency string `json:"currency,omitempty"`
+
+	// CPUHour is the price of one worker vCPU for an hour
+	CPUHour float64 `json:"cpuHour,omitempty"`
+
+	// MemoryGiBHour is the price of one GiB of worker memory for an hour
+	MemoryGiBHour float64 `json:"memoryGiBHour,omitempty"`
+
+	// DiskGiBMonth is the price of one GiB of worker disk for a month
+	DiskGiBMonth float64 `json:"diskGiBMonth,omitempty"`
+
+	// ControlPlaneHour is the price of one hosted control plane for an hour
+	ControlPlaneHour float64 `json:"controlPlaneHour,omitempty"`
+}
+
+// Configured reports whether any rate is set
+func (c *Costs) Configured() bool {
+	return c.CPUHour > 0 || c.MemoryGiBHour > 0 || c.DiskGiBMonth > 0 || c.ControlPlaneHour > 0
+}
+
+// CurrencyOrDefault returns the currency amounts are in
+func (c *Costs) CurrencyOrDefault() string {
+	if c.Currency == "" {
+		return "USD"
+	}
+	return c.Currency
+}
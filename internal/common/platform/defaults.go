@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Defaults are platform-wide values for cluster create flags the user
+// doesn't set. Operators publish them under defaults in the platform
+// config, along with the supported Kubernetes versions:
+//
+//	defaults:
+//	  kubernetesVersion: v1.31.4
+//	  workers: 3
+//	  cpu: 8
+//	  memory: 16Gi
+//	  disk: 100Gi
+//	  podCIDR: 10.200.0.0/16
+//	  serviceCIDR: 10.96.0.0/12
+//	  controlPlaneReplicas: 3
+//	kubernetesVersions:
+//	  min: v1.30
+//	  max: v1.32
+type Defaults struct {
+	// KubernetesVersion is the version of new clusters
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// Workers is the worker count of new clusters
+	Workers int64 `json:"workers,omitempty"`
+
+	// CPU is the CPU cores per worker
+	CPU int64 `json:"cpu,omitempty"`
+
+	// Memory is the memory per worker, as a quantity such as 16Gi
+	Memory string `json:"memory,omitempty"`
+
+	// Disk is the disk size per worker, as a quantity such as 100Gi
+	Disk string `json:"disk,omitempty"`
+
+	// PodCIDR is the pod network of new clusters
+	PodCIDR string `json:"podCIDR,omitempty"`
+
+	// ServiceCIDR is the service network of new clusters
+	ServiceCIDR string `json:"serviceCIDR,omitempty"`
+
+	// ControlPlaneReplicas is the control plane replica count
+	ControlPlaneReplicas int64 `json:"controlPlaneReplicas,omitempty"`
+
+	// Versions bounds the Kubernetes versions clusters may request
+	Versions VersionRange `json:"-"`
+}
+
+// VersionRange is an inclusive range of Kubernetes versions. A bound of
+// v1.32 admits every v1.32 patch release; empty bounds are open.
+type VersionRange struct {
+	// Min is the oldest version clusters may use
+	Min string `json:"min,omitempty"`
+
+	// Max is the newest version clusters may use
+	Max string `json:"max,omitempty"`
+}
+
+// LoadDefaults reads defaults and kubernetesVersions from the platform
+// config. Without them, or when the caller may not read the platform
+// config, all fields are empty and the CLI's built-in defaults apply.
+func LoadDefaults(ctx context.Context, c *client.Client) (*Defaults, error) {
+	cfg, err := Load(ctx, c)
+	if errors.IsForbidden(err) {
+		return &Defaults{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d := cfg.Defaults
+	d.Versions = cfg.KubernetesVersions
+	for _, bound := range []string{d.Versions.Min, d.Versions.Max} {
+		if bound == "" {
+			continue
+		}
+		if _, err := parseKubernetesVersion(bound); err != nil {
+			return nil, fmt.Errorf("platform config %s/%s kubernetesVersions: %w", ConfigMapNamespace, ConfigMapName, err)
+		}
+	}
+	return &d, nil
+}
+
+// CheckKubernetesVersion returns an error if version is outside the
+// supported range
+func (r VersionRange) CheckKubernetesVersion(version string) error {
+	if r.Min == "" && r.Max == "" {
+		return nil
+	}
+	v, err := parseKubernetesVersion(version)
+	if err != nil {
+		return err
+	}
+
+	tooOld := r.Min != "" && compareVersionPrefix(v, r.Min) < 0
+	tooNew := r.Max != "" && compareVersionPrefix(v, r.Max) > 0
+	if tooOld || tooNew {
+		return fmt.Errorf("kubernetes version %s is not supported by this platform (supported: %s; kubernetesVersions in the %s ConfigMap)",
+			version, r, ConfigMapName)
+	}
+	return nil
+}
+
+// String describes the range for messages
+func (r VersionRange) String() string {
+	switch {
+	case r.Min != "" && r.Max != "":
+		return r.Min + " to " + r.Max
+	case r.Min != "":
+		return r.Min + " or newer"
+	case r.Max != "":
+		return r.Max + " or older"
+	}
+	return "any"
+}
+
+// parseKubernetesVersion splits "v1.31.4" into its numeric components
+func parseKubernetesVersion(s string) ([]int, error) {
+	core, _, _ := strings.Cut(strings.TrimPrefix(s, "v"), "-")
+	fields := strings.Split(core, ".")
+	if len(fields) > 3 {
+		return nil, fmt.Errorf("invalid kubernetes version %q", s)
+	}
+	parts := make([]int, len(fields))
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubernetes version %q", s)
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
+
+// compareVersionPrefix compares v with bound over the components bound
+// specifies, so v1.32.3 equals the bound v1.32
+func compareVersionPrefix(v []int, bound string) int {
+	b, _ := parseKubernetesVersion(bound)
+	for i := range b {
+		var n int
+		if i < len(v) {
+			n = v[i]
+		}
+		if n != b[i] {
+			if n < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
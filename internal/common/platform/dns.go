@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// DefaultAPIHostname is the name of a tenant API endpoint within the zone
+const DefaultAPIHostname = "api.{{ .Name }}"
+
+// DNS gives tenant API endpoints stable names in a zone managed by
+// external-dns, so kubeconfigs survive the endpoint address changing.
+//
+// Example:
+//
+//	dns:
+//	  zone: k8s.example.com
+//	  hostname: "api.{{ .Name }}.{{ .Namespace }}"
+type DNS struct {
+	// Zone is the DNS zone external-dns manages; unset disables DNS names
+	Zone string `json:"zone,omitempty"`
+
+	// Hostname is a Go template rendered with TemplateData for the name
+	// within Zone; defaults to DefaultAPIHostname
+	Hostname string `json:"hostname,omitempty"`
+}
+
+// APIHostname returns the fully qualified API endpoint name of a cluster,
+// or "" when no zone is configured
+func (d *DNS) APIHostname(data TemplateData) (string, error) {
+	if d.Zone == "" {
+		return "", nil
+	}
+	tmpl := d.Hostname
+	if tmpl == "" {
+		tmpl = DefaultAPIHostname
+	}
+	t, err := template.New("hostname").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("parsing dns hostname template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("rendering dns hostname template: %w", err)
+	}
+	host := strings.Trim(strings.TrimSpace(buf.String()), ".")
+	if host == "" {
+		return "", fmt.Errorf("dns hostname template %q renders empty", tmpl)
+	}
+	return host + "." + strings.Trim(d.Zone, "."), nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// DefaultContextName names merged kubeconfig contexts after the cluster
+const DefaultContextName = "{{ .Name }}"
+
+// Kubeconfig controls how 'butlerctl cluster kubeconfig --merge' names the
+// entries it adds to the user's kubeconfig.
+//
+// Example:
+//
+//	kubeconfig:
+//	  contextName: "butler-{{ .Namespace }}-{{ .Name }}"
+type Kubeconfig struct {
+	// ContextName is a Go template rendered with TemplateData for the
+	// context, cluster and user entries; defaults to DefaultContextName
+	ContextName string `json:"contextName,omitempty"`
+}
+
+// RenderContextName renders tmpl, or the configured template when tmpl is
+// empty, for a cluster. A value without template actions is used as is.
+func (k *Kubeconfig) RenderContextName(tmpl string, data TemplateData) (string, error) {
+	if tmpl == "" {
+		tmpl = k.ContextName
+	}
+	if tmpl == "" {
+		tmpl = DefaultContextName
+	}
+	t, err := template.New("contextName").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("parsing context name template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("rendering context name template: %w", err)
+	}
+	name := strings.TrimSpace(buf.String())
+	if name == "" {
+		return "", fmt.Errorf("context name template %q renders empty", tmpl)
+	}
+	return name, nil
+}
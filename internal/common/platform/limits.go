@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Built-in limits used when the platform config doesn't set them
+const (
+	DefaultMinWorkers  = 1
+	DefaultMaxWorkers  = 100
+	DefaultMinCPU      = 1
+	DefaultMaxCPU      = 256
+	DefaultMinMemoryMB = 2048
+	DefaultMinDiskGB   = 20
+)
+
+// Limits bounds the size of TenantClusters the CLIs will request. Operators
+// set them under limits in the platform config; unset or zero fields fall
+// back to the built-in defaults.
+//
+// Example:
+//
+//	limits:
+//	  maxWorkers: 500
+//	  maxCPU: 192
+//	  minMemoryMB: 1024
+type Limits struct {
+	// MinWorkers is the smallest worker count a cluster may have
+	MinWorkers int64 `json:"minWorkers,omitempty"`
+
+	// MaxWorkers is the largest worker count a cluster may have
+	MaxWorkers int64 `json:"maxWorkers,omitempty"`
+
+	// MinCPU is the fewest CPU cores per worker
+	MinCPU int64 `json:"minCPU,omitempty"`
+
+	// MaxCPU is the most CPU cores per worker
+	MaxCPU int64 `json:"maxCPU,omitempty"`
+
+	// MinMemoryMB is the least memory per worker, in MB
+	MinMemoryMB int64 `json:"minMemoryMB,omitempty"`
+
+	// MinDiskGB is the smallest disk per worker, in GB
+	MinDiskGB int64 `json:"minDiskGB,omitempty"`
+
+	// configured records the fields set in the platform config
+	configured map[string]bool
+}
+
+// DefaultLimits returns the built-in limits
+func DefaultLimits() *Limits {
+	return &Limits{
+		MinWorkers:  DefaultMinWorkers,
+		MaxWorkers:  DefaultMaxWorkers,
+		MinCPU:      DefaultMinCPU,
+		MaxCPU:      DefaultMaxCPU,
+		MinMemoryMB: DefaultMinMemoryMB,
+		MinDiskGB:   DefaultMinDiskGB,
+		configured:  map[string]bool{},
+	}
+}
+
+// LoadLimits reads limits from the platform config over the built-in
+// defaults. A platform config the caller may not read yields the defaults.
+func LoadLimits(ctx context.Context, c *client.Client) (*Limits, error) {
+	limits := DefaultLimits()
+
+	cfg, err := Load(ctx, c)
+	if errors.IsForbidden(err) {
+		return limits, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range []struct {
+		name  string
+		value int64
+		field *int64
+	}{
+		{"minWorkers", cfg.Limits.MinWorkers, &limits.MinWorkers},
+		{"maxWorkers", cfg.Limits.MaxWorkers, &limits.MaxWorkers},
+		{"minCPU", cfg.Limits.MinCPU, &limits.MinCPU},
+		{"maxCPU", cfg.Limits.MaxCPU, &limits.MaxCPU},
+		{"minMemoryMB", cfg.Limits.MinMemoryMB, &limits.MinMemoryMB},
+		{"minDiskGB", cfg.Limits.MinDiskGB, &limits.MinDiskGB},
+	} {
+		if f.value != 0 {
+			*f.field = f.value
+			limits.configured[f.name] = true
+		}
+	}
+
+	if limits.MinWorkers > limits.MaxWorkers {
+		return nil, fmt.Errorf("platform config %s/%s: limits.minWorkers (%d) exceeds maxWorkers (%d)", ConfigMapNamespace, ConfigMapName, limits.MinWorkers, limits.MaxWorkers)
+	}
+	if limits.MinCPU > limits.MaxCPU {
+		return nil, fmt.Errorf("platform config %s/%s: limits.minCPU (%d) exceeds maxCPU (%d)", ConfigMapNamespace, ConfigMapName, limits.MinCPU, limits.MaxCPU)
+	}
+	return limits, nil
+}
+
+// CheckWorkers validates a worker count against the limits
+func (l *Limits) CheckWorkers(n int64) error {
+	if n < l.MinWorkers {
+		return l.violation("workers", "at least", l.MinWorkers, n, "minWorkers")
+	}
+	if n > l.MaxWorkers {
+		return l.violation("workers", "at most", l.MaxWorkers, n, "maxWorkers")
+	}
+	return nil
+}
+
+// CheckCPU validates the CPU cores per worker against the limits
+func (l *Limits) CheckCPU(n int64) error {
+	if n < l.MinCPU {
+		return l.violation("cpu", "at least", l.MinCPU, n, "minCPU")
+	}
+	if n > l.MaxCPU {
+		return l.violation("cpu", "at most", l.MaxCPU, n, "maxCPU")
+	}
+	return nil
+}
+
+// CheckMemoryMB validates the memory per worker against the limits
+func (l *Limits) CheckMemoryMB(n int64) error {
+	if n < l.MinMemoryMB {
+		return l.violation("memory (MB)", "at least", l.MinMemoryMB, n, "minMemoryMB")
+	}
+	return nil
+}
+
+// CheckDiskGB validates the disk per worker against the limits
+func (l *Limits) CheckDiskGB(n int64) error {
+	if n < l.MinDiskGB {
+		return l.violation("disk (GB)", "at least", l.MinDiskGB, n, "minDiskGB")
+	}
+	return nil
+}
+
+// violation builds an error naming the limit and where it is set, so users
+// know whom to ask and operators know what to change
+func (l *Limits) violation(what, bound string, limit, got int64, field string) error {
+	source := fmt.Sprintf("built-in default; operators can change it with limits.%s in the %s ConfigMap", field, ConfigMapName)
+	if l.configured[field] {
+		source = fmt.Sprintf("limits.%s in the %s ConfigMap", field, ConfigMapName)
+	}
+	return fmt.Errorf("%s must be %s %d, got %d (%s)", what, bound, limit, got, source)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package platform reads platform-wide settings that operators publish for the CLIs.
+//
+// Settings live in the butler-platform ConfigMap in butler-system under the
+// config.yaml key. A missing ConfigMap is not an error; callers get an empty
+// Config and fall back to built-in behavior.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigMapName is the ConfigMap holding platform settings
+	ConfigMapName = "butler-platform"
+
+	// ConfigMapNamespace is where the platform ConfigMap lives
+	ConfigMapNamespace = "butler-system"
+
+	// ConfigKey is the ConfigMap data key holding the YAML settings
+	ConfigKey = "config.yaml"
+)
+
+// Config is the platform-wide CLI configuration
+type Config struct {
+	// APIServer limits the API server settings users may pass through
+	APIServer APIServer `json:"apiServer,omitempty"`
+
+	// Conventions defines naming and metadata rules for TenantClusters
+	Conventions Conventions `json:"conventions,omitempty"`
+
+	// Console locates the Butler Console for deep links
+	Console Console `json:"console,omitempty"`
+
+	// Costs are the rates cluster cost estimates are based on
+	Costs Costs `json:"costs,omitempty"`
+
+	// Defaults are the values of cluster create flags users don't set
+	Defaults Defaults `json:"defaults,omitempty"`
+
+	// DNS names tenant API endpoints through external-dns
+	DNS DNS `json:"dns,omitempty"`
+
+	// KubernetesVersions bounds the Kubernetes versions clusters may use
+	KubernetesVersions VersionRange `json:"kubernetesVersions,omitempty"`
+
+	// Kubeconfig names the entries merged into user kubeconfigs
+	Kubeconfig Kubeconfig `json:"kubeconfig,omitempty"`
+
+	// Limits bounds the worker counts and sizes clusters may request
+	Limits Limits `json:"limits,omitempty"`
+
+	// Profiles are named workload sets applied to new clusters
+	Profiles map[string]Profile `json:"profiles,omitempty"`
+
+	// Release is the release channel upgrades follow
+	Release Release `json:"release,omitempty"`
+
+	// SecretEncryption sets the keys for Secrets in exports and backups
+	SecretEncryption SecretEncryption `json:"secretEncryption,omitempty"`
+
+	// Snapshots is where tenant control plane snapshots are stored
+	Snapshots Snapshots `json:"snapshots,omitempty"`
+}
+
+// Load reads the platform configuration from the management cluster
+func Load(ctx context.Context, c *client.Client) (*Config, error) {
+	cfg := &Config{}
+
+	cm, err := c.Clientset.CoreV1().ConfigMaps(ConfigMapNamespace).Get(ctx, ConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting platform config %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+
+	data, ok := cm.Data[ConfigKey]
+	if !ok {
+		return cfg, nil
+	}
+
+	if err := yaml.Unmarshal([]byte(data), cfg); err != nil {
+		return nil, fmt.Errorf("parsing platform config %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/workload"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// FluxNamespace is where profile Kustomizations are created in the
+	// tenant cluster
+	FluxNamespace = "flux-system"
+
+	// DefaultFluxInterval is how often Flux reconciles a profile
+	DefaultFluxInterval = "10m"
+)
+
+// Profile is a set of workloads applied to a new cluster once it is
+// Ready, selected with 'butlerctl cluster create --profile'.
+//
+// Example:
+//
+//	profiles:
+//	  baseline:
+//	    description: Namespaces, RBAC and the monitoring agent
+//	    configMaps: [baseline-rbac, baseline-agents]
+//	    kustomization:
+//	      url: https://github.com/acme/platform-config
+//	      branch: main
+//	      path: ./tenants/baseline
+type Profile struct {
+	// Description is shown when the profile is unknown
+	Description string `json:"description,omitempty"`
+
+	// ConfigMaps in butler-system whose data values hold manifests
+	ConfigMaps []string `json:"configMaps,omitempty"`
+
+	// Kustomization has the tenant cluster's Flux sync a Git path; Flux
+	// must be installed there
+	Kustomization *Kustomization `json:"kustomization,omitempty"`
+}
+
+// Kustomization points Flux at a path in a Git repository
+type Kustomization struct {
+	// URL is the Git repository
+	URL string `json:"url"`
+
+	// Branch to sync; defaults to main
+	Branch string `json:"branch,omitempty"`
+
+	// Path within the repository; defaults to the root
+	Path string `json:"path,omitempty"`
+
+	// Interval is how often Flux reconciles; defaults to DefaultFluxInterval
+	Interval string `json:"interval,omitempty"`
+}
+
+// Profile returns the named profile
+func (c *Config) Profile(name string) (*Profile, error) {
+	if p, ok := c.Profiles[name]; ok {
+		return &p, nil
+	}
+	if len(c.Profiles) == 0 {
+		return nil, fmt.Errorf("unknown profile %q: the platform config defines no profiles", name)
+	}
+	var lines []string
+	for n, p := range c.Profiles {
+		line := "  " + n
+		if p.Description != "" {
+			line += " - " + p.Description
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	return nil, fmt.Errorf("unknown profile %q; available profiles:\n%s", name, strings.Join(lines, "\n"))
+}
+
+// Workloads returns the objects the profile applies, reading its
+// ConfigMaps from the management cluster
+func (p *Profile) Workloads(ctx context.Context, c *client.Client, name string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+
+	for _, cmName := range p.ConfigMaps {
+		cm, err := c.Clientset.CoreV1().ConfigMaps(ConfigMapNamespace).Get(ctx, cmName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("getting profile %s ConfigMap %s/%s: %w", name, ConfigMapNamespace, cmName, err)
+		}
+		keys := make([]string, 0, len(cm.Data))
+		for key := range cm.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			parsed, err := workload.Parse([]byte(cm.Data[key]))
+			if err != nil {
+				return nil, fmt.Errorf("profile %s ConfigMap %s key %s: %w", name, cmName, key, err)
+			}
+			objs = append(objs, parsed...)
+		}
+	}
+
+	if k := p.Kustomization; k != nil {
+		if k.URL == "" {
+			return nil, fmt.Errorf("profile %s: kustomization.url is required", name)
+		}
+		objs = append(objs, k.objects("butler-profile-"+name)...)
+	}
+
+	return objs, nil
+}
+
+// objects returns the Flux GitRepository and Kustomization syncing k
+func (k *Kustomization) objects(name string) []*unstructured.Unstructured {
+	branch := k.Branch
+	if branch == "" {
+		branch = "main"
+	}
+	path := k.Path
+	if path == "" {
+		path = "./"
+	}
+	interval := k.Interval
+	if interval == "" {
+		interval = DefaultFluxInterval
+	}
+
+	repo := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "source.toolkit.fluxcd.io/v1",
+		"kind":       "GitRepository",
+		"metadata":   map[string]interface{}{"name": name, "namespace": FluxNamespace},
+		"spec": map[string]interface{}{
+			"url":      k.URL,
+			"ref":      map[string]interface{}{"branch": branch},
+			"interval": interval,
+		},
+	}}
+	kustomization := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kustomize.toolkit.fluxcd.io/v1",
+		"kind":       "Kustomization",
+		"metadata":   map[string]interface{}{"name": name, "namespace": FluxNamespace},
+		"spec": map[string]interface{}{
+			"interval":  interval,
+			"path":      path,
+			"prune":     true,
+			"sourceRef": map[string]interface{}{"kind": "GitRepository", "name": name},
+		},
+	}}
+	return []*unstructured.Unstructured{repo, kustomization}
+}
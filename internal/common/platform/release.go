@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// Release channels, from most to least conservative
+const (
+	ChannelStable = "stable"
+	ChannelRC     = "rc"
+	ChannelEdge   = "edge"
+)
+
+// DefaultChannelManifest is where Butler publishes channel manifests
+const DefaultChannelManifest = "https://butlerlabs.dev/releases/v1/channels/{{ .Channel }}.yaml"
+
+// Release subscribes the platform to a release channel, so upgrades take
+// their component versions from the channel's published manifest.
+//
+// Example:
+//
+//	release:
+//	  channel: stable
+//	  manifest: https://mirror.example.com/butler/{{ .Channel }}.yaml
+type Release struct {
+	// Channel is the subscribed release channel; unset means upgrades
+	// need an explicit --channel
+	Channel string `json:"channel,omitempty" jsonschema:"enum=stable|rc|edge"`
+
+	// Manifest is a URL or file path template, rendered with the channel
+	// as .Channel, of the channel manifest; defaults to
+	// DefaultChannelManifest. Point it at a mirror for air-gapped sites.
+	Manifest string `json:"manifest,omitempty"`
+}
+
+// ValidChannel reports whether channel is a known release channel
+func ValidChannel(channel string) bool {
+	switch channel {
+	case ChannelStable, ChannelRC, ChannelEdge:
+		return true
+	}
+	return false
+}
+
+// ManifestLocation returns the manifest URL or path of a channel
+func (r *Release) ManifestLocation(channel string) (string, error) {
+	location := r.Manifest
+	if location == "" {
+		location = DefaultChannelManifest
+	}
+	return renderLocation("manifest", location, struct{ Channel string }{channel})
+}
+
+// renderLocation renders a URL or path template
+func renderLocation(name, location string, data interface{}) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("parsing release %s template: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("rendering release %s template: %w", name, err)
+	}
+	return buf.String(), nil
+}
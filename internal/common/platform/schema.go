@@ -0,0 +1,28 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import _ "embed"
+
+//go:generate go run ../../../hack/schemagen -type Config -tag json -title "Butler platform config" -out config.schema.json
+
+// Schema is the JSON Schema of the config.yaml key of the platform
+// ConfigMap, generated from Config. Run 'make generate' after changing the
+// config types.
+//
+//go:embed config.schema.json
+var Schema []byte
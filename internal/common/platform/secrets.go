@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+// SecretEncryption sets the keys used when 'butlerctl cluster export' and
+// 'butleradm backup create' include Secrets. Only public keys and key
+// identifiers belong here. Unset SOPS keys fall back to the user's
+// .sops.yaml and SOPS_* environment; the Sealed Secrets controller defaults
+// to kubeseal's.
+//
+// Example:
+//
+//	secretEncryption:
+//	  sops:
+//	    age:
+//	      - age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
+//	    kms:
+//	      - arn:aws:kms:eu-west-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab
+//	  sealedSecrets:
+//	    namespace: sealed-secrets
+//	    controllerName: sealed-secrets
+type SecretEncryption struct {
+	// SOPS lists the recipients sops encrypts to
+	SOPS SOPSKeys `json:"sops,omitempty"`
+
+	// SealedSecrets locates the controller whose certificate seals Secrets
+	SealedSecrets SealedSecrets `json:"sealedSecrets,omitempty"`
+}
+
+// SOPSKeys are sops recipients by key type
+type SOPSKeys struct {
+	Age     []string `json:"age,omitempty"`
+	KMS     []string `json:"kms,omitempty"`
+	GCPKMS  []string `json:"gcpKms,omitempty"`
+	AzureKV []string `json:"azureKv,omitempty"`
+	PGP     []string `json:"pgp,omitempty"`
+}
+
+// Empty reports whether no recipients are configured
+func (k *SOPSKeys) Empty() bool {
+	return len(k.Age)+len(k.KMS)+len(k.GCPKMS)+len(k.AzureKV)+len(k.PGP) == 0
+}
+
+// SealedSecrets locates the Sealed Secrets controller
+type SealedSecrets struct {
+	Namespace      string `json:"namespace,omitempty"`
+	ControllerName string `json:"controllerName,omitempty"`
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import "github.com/butlerdotdev/butler/internal/common/objectstore"
+
+// Snapshots is the object storage tenant control plane snapshots are
+// written to by 'butlerctl cluster snapshot'. The credentials Secret is
+// read with the user's own access, so only operators who may read it can
+// take or restore snapshots.
+//
+// Example:
+//
+//	snapshots:
+//	  location: s3://butler-snapshots/tenants
+//	  endpoint: https://minio.example.com
+//	  credentialsSecret: butler-system/snapshot-storage
+type Snapshots struct {
+	// Location is a directory, s3://bucket/prefix or gs://bucket/prefix
+	Location string `json:"location,omitempty"`
+
+	// Endpoint overrides the S3 service URL, e.g. a MinIO server
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Region of the bucket
+	Region string `json:"region,omitempty"`
+
+	// PathStyle addresses buckets as endpoint/bucket
+	PathStyle bool `json:"pathStyle,omitempty"`
+
+	// CredentialsSecret is a namespace/name Secret holding accessKeyID and
+	// secretAccessKey
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// ApplyTo fills object storage options the user left unset
+func (s *Snapshots) ApplyTo(opts *objectstore.Options) {
+	if opts.Endpoint == "" {
+		opts.Endpoint = s.Endpoint
+	}
+	if opts.Region == "" {
+		opts.Region = s.Region
+	}
+	if !opts.PathStyle {
+		opts.PathStyle = s.PathStyle
+	}
+	if opts.CredentialsSecret == "" {
+		opts.CredentialsSecret = s.CredentialsSecret
+	}
+}
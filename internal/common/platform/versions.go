@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ComponentVersions is the set of Kubernetes versions one platform
+// component supports. butler-controller reports these for the installed
+// Steward and CAPI providers in the ButlerConfig status:
+//
+//	status:
+//	  kubernetesVersions:
+//	    - component: steward
+//	      versions: [v1.30.8, v1.31.4, v1.32.0]
+//	    - component: cluster-api-provider-harvester
+//	      versions: [v1.30.8, v1.31.4]
+type ComponentVersions struct {
+	Component string   `json:"component"`
+	Versions  []string `json:"versions"`
+}
+
+// KubernetesVersions describes the Kubernetes versions clusters may use
+type KubernetesVersions struct {
+	// Supported lists the versions every component supports that fall in
+	// the configured range, newest first
+	Supported []string `json:"supported"`
+
+	// Default is the platform default version, if one is set
+	Default string `json:"default,omitempty"`
+
+	// Range is the kubernetesVersions range of the platform config
+	Range VersionRange `json:"range,omitempty"`
+
+	// Components are the versions reported per component
+	Components []ComponentVersions `json:"components,omitempty"`
+}
+
+// LoadKubernetesVersions reads the versions reported in the ButlerConfig
+// status, narrowed to the operator's configured range. Components is
+// empty when the platform doesn't report versions.
+func LoadKubernetesVersions(ctx context.Context, c *client.Client) (*KubernetesVersions, error) {
+	defaults, err := LoadDefaults(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	kv := &KubernetesVersions{
+		Supported: []string{},
+		Default:   defaults.KubernetesVersion,
+		Range:     defaults.Versions,
+	}
+
+	bc, err := getButlerConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if bc == nil {
+		return kv, nil
+	}
+
+	reported, _, _ := unstructured.NestedSlice(bc.Object, "status", "kubernetesVersions")
+	for _, item := range reported {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		comp := ComponentVersions{}
+		comp.Component, _, _ = unstructured.NestedString(entry, "component")
+		comp.Versions, _, _ = unstructured.NestedStringSlice(entry, "versions")
+		kv.Components = append(kv.Components, comp)
+	}
+
+	for _, v := range commonVersions(kv.Components) {
+		if kv.Range.CheckKubernetesVersion(v) == nil {
+			kv.Supported = append(kv.Supported, v)
+		}
+	}
+	return kv, nil
+}
+
+// Reported returns true if the platform reports supported versions
+func (kv *KubernetesVersions) Reported() bool {
+	return len(kv.Components) > 0
+}
+
+// All returns every version any component reports, newest first
+func (kv *KubernetesVersions) All() []string {
+	var all []string
+	for _, comp := range kv.Components {
+		for _, v := range comp.Versions {
+			if !containsVersion(all, strings.TrimPrefix(v, "v")) {
+				all = append(all, "v"+strings.TrimPrefix(v, "v"))
+			}
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return compareVersions(all[i], all[j]) > 0
+	})
+	return all
+}
+
+// IsSupported reports whether version is in Supported
+func (kv *KubernetesVersions) IsSupported(version string) bool {
+	return containsVersion(kv.Supported, strings.TrimPrefix(version, "v"))
+}
+
+// Supports reports whether the component supports version
+func (cv ComponentVersions) Supports(version string) bool {
+	return containsVersion(cv.Versions, strings.TrimPrefix(version, "v"))
+}
+
+// Check returns an error if version is outside the configured range or not
+// supported by every component. Versions aren't checked against components
+// when the platform doesn't report any.
+func (kv *KubernetesVersions) Check(version string) error {
+	if err := kv.Range.CheckKubernetesVersion(version); err != nil {
+		return err
+	}
+	if !kv.Reported() {
+		return nil
+	}
+
+	if kv.IsSupported(version) {
+		return nil
+	}
+
+	var missing []string
+	for _, comp := range kv.Components {
+		if !comp.Supports(version) {
+			missing = append(missing, comp.Component)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("kubernetes version %s is not supported by %s; see 'butlerctl versions list'", version, strings.Join(missing, ", "))
+	}
+	return fmt.Errorf("kubernetes version %s is not supported by this platform; see 'butlerctl versions list'", version)
+}
+
+// commonVersions returns the versions every component supports, newest first
+func commonVersions(components []ComponentVersions) []string {
+	if len(components) == 0 {
+		return nil
+	}
+
+	var common []string
+	for _, v := range components[0].Versions {
+		supported := true
+		for _, comp := range components[1:] {
+			if !containsVersion(comp.Versions, strings.TrimPrefix(v, "v")) {
+				supported = false
+				break
+			}
+		}
+		if supported && !containsVersion(common, strings.TrimPrefix(v, "v")) {
+			common = append(common, "v"+strings.TrimPrefix(v, "v"))
+		}
+	}
+
+	sort.Slice(common, func(i, j int) bool {
+		return compareVersions(common[i], common[j]) > 0
+	})
+	return common
+}
+
+// containsVersion reports whether versions holds want, ignoring any "v" prefix
+func containsVersion(versions []string, want string) bool {
+	for _, v := range versions {
+		if strings.TrimPrefix(v, "v") == want {
+			return true
+		}
+	}
+	return false
+}
+
+// compareVersions orders two Kubernetes versions; unparsable ones sort last
+func compareVersions(a, b string) int {
+	va, errA := parseKubernetesVersion(a)
+	_, errB := parseKubernetesVersion(b)
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	case errB != nil:
+		return 1
+	}
+	return compareVersionPrefix(va, b)
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy evaluates platform Rego policies before mutating operations.
+//
+// Platform operators install policies as a ConfigMap in butler-system (every
+// key ending in .rego). A local directory adds policies to those, e.g. to try
+// one out; it can't replace them. Policies are written in the conftest style: rules under package "butler" add messages to "deny".
+//
+//	package butler
+//
+//	deny contains msg if {
+//	    input.operation == "create"
+//	    input.object.spec.workers.replicas > 5
+//	    msg := "clusters may not start with more than 5 workers"
+//	}
+//
+// Evaluation shells out to the opa binary, the same way bootstrap drives
+// docker and kubectl, so the CLI does not carry the OPA runtime.
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ConfigMapName is the ConfigMap holding platform policies
+	ConfigMapName = "butler-policies"
+
+	// ConfigMapNamespace is where the policy ConfigMap lives
+	ConfigMapNamespace = "butler-system"
+
+	// EnvPolicyDir names a local directory of policies evaluated along with
+	// the ConfigMap
+	EnvPolicyDir = "BUTLER_POLICY_DIR"
+
+	// Query is the Rego rule evaluated for violations
+	Query = "data.butler.deny"
+
+	// OverrideVerb is the RBAC verb on tenantclusters required to use --override-policy
+	OverrideVerb = "override-policy"
+)
+
+// Operation names passed to policies as input.operation
+const (
+	OperationCreate = "create"
+	OperationScale  = "scale"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// Input is the document policies are evaluated against
+type Input struct {
+	// Operation is the mutating operation (create, scale, update, delete)
+	Operation string `json:"operation"`
+
+	// Object is the resource as it will look after the operation
+	Object map[string]interface{} `json:"object"`
+
+	// OldObject is the current resource for updates
+	OldObject map[string]interface{} `json:"oldObject,omitempty"`
+}
+
+// Options controls policy enforcement for a command
+type Options struct {
+	// Dir is a local policy directory evaluated along with the
+	// butler-policies ConfigMap; when empty BUTLER_POLICY_DIR is used
+	Dir string
+
+	// Override skips enforcement for users granted the override-policy verb
+	Override bool
+}
+
+// AddFlags registers the policy flags on a mutating command
+func AddFlags(cmd *cobra.Command, opts *Options) {
+	cmd.Flags().StringVar(&opts.Dir, "policy-dir", "", "local directory of Rego policies evaluated along with the butler-policies ConfigMap (default: $BUTLER_POLICY_DIR)")
+	cmd.Flags().BoolVar(&opts.Override, "override-policy", false, "proceed despite policy violations (requires the override-policy RBAC verb)")
+}
+
+// ViolationError is returned when one or more policies deny an operation
+type ViolationError struct {
+	Operation  string
+	Violations []string
+}
+
+func (e *ViolationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s denied by platform policy:\n", e.Operation)
+	for _, v := range e.Violations {
+		fmt.Fprintf(&b, "  • %s\n", v)
+	}
+	b.WriteString("\nAsk a platform operator to adjust the request, or re-run with --override-policy if you are permitted to.")
+	return b.String()
+}
+
+// Enforce evaluates platform policies for the input and returns a
+// *ViolationError when any policy denies it. With Override set, violations
+// are logged instead, provided the caller holds the override-policy verb.
+func Enforce(ctx context.Context, c *client.Client, logger *log.Logger, opts Options, input Input) error {
+	dirs, cleanup, err := resolvePolicyDirs(ctx, c, opts.Dir)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(dirs) == 0 {
+		logger.Debug("no platform policies installed")
+		return nil
+	}
+
+	violations, err := evaluate(ctx, dirs, input)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		logger.Debug("policy check passed", "operation", input.Operation)
+		return nil
+	}
+
+	if !opts.Override {
+		return &ViolationError{Operation: input.Operation, Violations: violations}
+	}
+
+	namespace, _, _ := unstructured.NestedString(input.Object, "metadata", "namespace")
+	allowed, err := canOverride(ctx, c, namespace)
+	if err != nil {
+		return fmt.Errorf("checking override-policy permission: %w", err)
+	}
+	if !allowed {
+		return fmt.Errorf("--override-policy requires the %q verb on tenantclusters.%s in namespace %q",
+			OverrideVerb, client.ButlerAPIGroup, namespace)
+	}
+
+	for _, v := range violations {
+		logger.Warn("policy violation overridden", "violation", v)
+	}
+	return nil
+}
+
+// resolvePolicyDirs returns the directories of .rego files to evaluate:
+// the butler-policies ConfigMap's, written to a temporary directory, and the
+// local one. None means no policies are installed.
+func resolvePolicyDirs(ctx context.Context, c *client.Client, localDir string) ([]string, func(), error) {
+	noop := func() {}
+
+	if localDir == "" {
+		localDir = os.Getenv(EnvPolicyDir)
+	}
+	if localDir != "" {
+		if _, err := os.Stat(localDir); err != nil {
+			return nil, noop, fmt.Errorf("reading policy directory: %w", err)
+		}
+	}
+
+	configMapDir, cleanup, err := writeConfigMapPolicies(ctx, c)
+	if err != nil {
+		return nil, noop, err
+	}
+
+	var dirs []string
+	if configMapDir != "" {
+		dirs = append(dirs, configMapDir)
+	}
+	if localDir != "" {
+		dirs = append(dirs, localDir)
+	}
+	return dirs, cleanup, nil
+}
+
+// writeConfigMapPolicies writes the policies of the butler-policies
+// ConfigMap to a temporary directory. An empty path means there are none.
+func writeConfigMapPolicies(ctx context.Context, c *client.Client) (string, func(), error) {
+	noop := func() {}
+
+	cm, err := c.Clientset.CoreV1().ConfigMaps(ConfigMapNamespace).Get(ctx, ConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return "", noop, nil
+	}
+	if err != nil {
+		return "", noop, fmt.Errorf("getting policy ConfigMap %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "butler-policies-*")
+	if err != nil {
+		return "", noop, fmt.Errorf("creating policy directory: %w", err)
+	}
+	cleanup := func() { os.RemoveAll(tmpDir) }
+
+	written := 0
+	for key, rego := range cm.Data {
+		if !strings.HasSuffix(key, ".rego") {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(tmpDir, filepath.Base(key)), []byte(rego), 0600); err != nil {
+			cleanup()
+			return "", noop, fmt.Errorf("writing policy %s: %w", key, err)
+		}
+		written++
+	}
+	if written == 0 {
+		cleanup()
+		return "", noop, nil
+	}
+
+	return tmpDir, cleanup, nil
+}
+
+// opaResult mirrors the subset of `opa eval --format json` output we use
+type opaResult struct {
+	Result []struct {
+		Expressions []struct {
+			Value interface{} `json:"value"`
+		} `json:"expressions"`
+	} `json:"result"`
+}
+
+// evaluate runs the deny query against the policies in dirs
+func evaluate(ctx context.Context, dirs []string, input Input) ([]string, error) {
+	if _, err := exec.LookPath("opa"); err != nil {
+		return nil, fmt.Errorf("platform policies are installed but the opa binary was not found in PATH (https://www.openpolicyagent.org/docs/latest/#running-opa)")
+	}
+
+	inputJSON, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling policy input: %w", err)
+	}
+
+	args := []string{"eval", "--format", "json"}
+	for _, dir := range dirs {
+		args = append(args, "--data", dir)
+	}
+	args = append(args, "--stdin-input", Query)
+	cmd := exec.CommandContext(ctx, "opa", args...)
+	cmd.Stdin = bytes.NewReader(inputJSON)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("evaluating policies: %w, output: %s", err, stderr.String())
+	}
+
+	var result opaResult
+	if err := json.Unmarshal(out, &result); err != nil {
+		return nil, fmt.Errorf("parsing opa output: %w", err)
+	}
+
+	var violations []string
+	for _, r := range result.Result {
+		for _, expr := range r.Expressions {
+			msgs, ok := expr.Value.([]interface{})
+			if !ok {
+				continue
+			}
+			for _, m := range msgs {
+				violations = append(violations, fmt.Sprintf("%v", m))
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// canOverride checks whether the current user holds the override-policy verb
+func canOverride(ctx context.Context, c *client.Client, namespace string) (bool, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      OverrideVerb,
+				Group:     client.ButlerAPIGroup,
+				Resource:  client.TenantClusterGVR.Resource,
+			},
+		},
+	}
+
+	resp, err := c.Clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return resp.Status.Allowed, nil
+}
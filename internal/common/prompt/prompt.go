@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package prompt reads answers to interactive questions from stdin.
+//
+// All confirmations go through this package so --non-interactive (or
+// BUTLER_NON_INTERACTIVE) turns every prompt into an immediate error
+// instead of a read that hangs CI. Prompts are written to stderr to keep
+// stdout clean for -o json/yaml.
+package prompt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// EnvNonInteractive enables non-interactive mode when set to a true value
+const EnvNonInteractive = "BUTLER_NON_INTERACTIVE"
+
+// ErrNonInteractive is returned instead of prompting in non-interactive mode
+var ErrNonInteractive = errors.New("input required but running non-interactively (--non-interactive); pass --yes to skip confirmations")
+
+var (
+	// nonInteractive is set by SetNonInteractive for the lifetime of the process
+	nonInteractive bool
+
+	// reader is shared so answers to consecutive prompts aren't lost to
+	// buffering
+	reader *bufio.Reader
+
+	// Output receives prompt text
+	Output io.Writer = os.Stderr
+)
+
+// SetNonInteractive turns all prompts into errors, e.g. for --non-interactive
+func SetNonInteractive() {
+	nonInteractive = true
+}
+
+// NonInteractive reports whether prompts are disabled by flag or environment
+func NonInteractive() bool {
+	if nonInteractive {
+		return true
+	}
+	enabled, _ := strconv.ParseBool(os.Getenv(EnvNonInteractive))
+	return enabled
+}
+
+// Interactive reports whether the user can be asked questions: prompts are
+// enabled and stdin is a terminal
+func Interactive() bool {
+	return !NonInteractive() && term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// ReadLine prints question and returns the trimmed answer
+func ReadLine(question string) (string, error) {
+	if NonInteractive() {
+		return "", ErrNonInteractive
+	}
+	fmt.Fprint(Output, question)
+
+	if reader == nil {
+		reader = bufio.NewReader(os.Stdin)
+	}
+	input, err := reader.ReadString('\n')
+	if err != nil && (input == "" || !errors.Is(err, io.EOF)) {
+		return "", fmt.Errorf("reading answer: %w", err)
+	}
+	return strings.TrimSpace(input), nil
+}
+
+// Confirm asks a yes/no question, defaulting to no
+func Confirm(question string) (bool, error) {
+	input, err := ReadLine(question + " [y/N]: ")
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(input) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
+
+// ConfirmExact requires the user to type expected, for destructive
+// operations where a stray "y" must not be enough
+func ConfirmExact(question, expected string) error {
+	input, err := ReadLine(question)
+	if err != nil {
+		return err
+	}
+	if input != expected {
+		return fmt.Errorf("you typed %q, expected %q", input, expected)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerapi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// harvesterImageGVR is Harvester's VirtualMachineImage, served in-cluster
+var harvesterImageGVR = schema.GroupVersionResource{
+	Group:    "harvesterhci.io",
+	Version:  "v1beta1",
+	Resource: "virtualmachineimages",
+}
+
+// Image is an OS image workers can boot from
+type Image struct {
+	// Name is the provider's display name
+	Name string `json:"name"`
+
+	// Ref is the value for 'cluster create --image': a UUID for Nutanix,
+	// namespace/name for Harvester and a template VMID for Proxmox
+	Ref string `json:"ref"`
+
+	Description string `json:"description,omitempty"`
+	SizeBytes   int64  `json:"sizeBytes,omitempty"`
+
+	// TalosVersion is the Talos release recognized in the image name
+	TalosVersion string `json:"talosVersion,omitempty"`
+
+	// Kubernetes is the range of Kubernetes versions that Talos release
+	// supports, e.g. "v1.27-v1.32"
+	Kubernetes string `json:"kubernetes,omitempty"`
+}
+
+// talosKubernetes maps Talos minor releases to the Kubernetes minor
+// versions they support, from the Talos support matrix
+var talosKubernetes = map[string][2]string{
+	"1.5":  {"v1.23", "v1.28"},
+	"1.6":  {"v1.24", "v1.29"},
+	"1.7":  {"v1.25", "v1.30"},
+	"1.8":  {"v1.26", "v1.31"},
+	"1.9":  {"v1.27", "v1.32"},
+	"1.10": {"v1.28", "v1.33"},
+	"1.11": {"v1.29", "v1.34"},
+}
+
+// talosPattern finds a Talos version in an image name or description
+var talosPattern = regexp.MustCompile(`(?i)talos[-_ ]?v?(\d+)\.(\d+)(\.\d+)?`)
+
+// ListImages returns the OS images available to a ProviderConfig, sorted by
+// name, with Talos compatibility hints filled in
+func ListImages(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, timeout time.Duration) ([]Image, error) {
+	provider, _, _ := unstructured.NestedString(pc.Object, "spec", "provider")
+
+	var images []Image
+	var err error
+	switch provider {
+	case "nutanix":
+		var n *Nutanix
+		if n, err = NewNutanix(ctx, c, pc, timeout, false); err == nil {
+			images, err = n.ListImages(ctx)
+		}
+	case "proxmox":
+		var p *Proxmox
+		if p, err = NewProxmox(ctx, c, pc, timeout, false); err == nil {
+			images, err = p.ListTemplates(ctx)
+		}
+	case "harvester":
+		images, err = listHarvesterImages(ctx, c)
+	default:
+		return nil, fmt.Errorf("listing images is not supported for %s providers", provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range images {
+		images[i].TalosVersion, images[i].Kubernetes = talosHint(images[i].Name + " " + images[i].Description)
+	}
+	sort.Slice(images, func(i, j int) bool { return images[i].Name < images[j].Name })
+	return images, nil
+}
+
+// listHarvesterImages lists VirtualMachineImages in all namespaces
+func listHarvesterImages(ctx context.Context, c *client.Client) ([]Image, error) {
+	list, err := c.Dynamic.Resource(harvesterImageGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Harvester VirtualMachineImages: %w", err)
+	}
+
+	images := make([]Image, 0, len(list.Items))
+	for _, item := range list.Items {
+		name, _, _ := unstructured.NestedString(item.Object, "spec", "displayName")
+		if name == "" {
+			name = item.GetName()
+		}
+		description, _, _ := unstructured.NestedString(item.Object, "spec", "description")
+		size, _, _ := unstructured.NestedInt64(item.Object, "status", "size")
+		images = append(images, Image{
+			Name:        name,
+			Ref:         item.GetNamespace() + "/" + item.GetName(),
+			Description: description,
+			SizeBytes:   size,
+		})
+	}
+	return images, nil
+}
+
+// talosHint returns the Talos version named in s and the Kubernetes range
+// it supports, if known
+func talosHint(s string) (talos, kubernetes string) {
+	m := talosPattern.FindStringSubmatch(s)
+	if m == nil {
+		return "", ""
+	}
+	talos = "v" + m[1] + "." + m[2] + m[3]
+	if k8s, ok := talosKubernetes[m[1]+"."+m[2]]; ok {
+		kubernetes = k8s[0] + "-" + k8s[1]
+	}
+	return talos, kubernetes
+}
+
+// SupportsKubernetes reports whether the image's Talos release supports
+// version. Images without a recognized Talos release are assumed to.
+func (img Image) SupportsKubernetes(version string) bool {
+	if img.Kubernetes == "" {
+		return true
+	}
+	lo, hi, _ := strings.Cut(img.Kubernetes, "-")
+	minor := kubernetesMinor(version)
+	return minor != "" && compareMinor(minor, lo) >= 0 && compareMinor(minor, hi) <= 0
+}
+
+// kubernetesMinor returns "v1.31" for "v1.31.4"
+func kubernetesMinor(version string) string {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+	return "v" + parts[0] + "." + parts[1]
+}
+
+// compareMinor compares two "vX.Y" versions
+func compareMinor(a, b string) int {
+	var amaj, amin, bmaj, bmin int
+	fmt.Sscanf(a, "v%d.%d", &amaj, &amin)
+	fmt.Sscanf(b, "v%d.%d", &bmaj, &bmin)
+	switch {
+	case amaj != bmaj:
+		return amaj - bmaj
+	default:
+		return amin - bmin
+	}
+}
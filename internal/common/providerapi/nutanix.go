@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// nutanixPageSize is the number of entities requested per Prism Central list call
+const nutanixPageSize = 250
+
+// Nutanix talks to the Prism Central v3 API
+type Nutanix struct {
+	// APIURL is the Prism Central base URL including the port
+	APIURL string
+
+	// Insecure is set when TLS verification is disabled
+	Insecure bool
+
+	username string
+	password string
+	http     *http.Client
+}
+
+// NewNutanix builds a Prism Central client from a ProviderConfig and its
+// credentials secret
+func NewNutanix(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, timeout time.Duration, insecure bool) (*Nutanix, error) {
+	endpoint, _, _ := unstructured.NestedString(pc.Object, "spec", "nutanix", "endpoint")
+	if endpoint == "" {
+		return nil, fmt.Errorf("nutanix endpoint not configured")
+	}
+
+	// Get port from spec (default 9440 for Prism Central)
+	port, _, _ := unstructured.NestedInt64(pc.Object, "spec", "nutanix", "port")
+	if port == 0 {
+		port = 9440
+	}
+
+	httpClient, insecure, err := newHTTPClient(ctx, c, pc, "nutanix", timeout, insecure)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := credentials(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keys are "username" and "password" per the CRD docs
+	username := string(secret.Data["username"])
+	password := string(secret.Data["password"])
+	if username == "" || password == "" {
+		// Try alternate key names used by CAPX
+		username = string(secret.Data["NUTANIX_USER"])
+		password = string(secret.Data["NUTANIX_PASSWORD"])
+	}
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("credentials secret %s missing username/password (or NUTANIX_USER/NUTANIX_PASSWORD)", secret.Name)
+	}
+
+	// Build the full API URL with port
+	// Strip trailing slash from endpoint
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	// Check if endpoint already has a port
+	apiURL := endpoint
+	if !strings.Contains(strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://"), ":") {
+		// No port in endpoint, add it
+		apiURL = fmt.Sprintf("%s:%d", endpoint, port)
+	}
+
+	return &Nutanix{
+		APIURL:   apiURL,
+		Insecure: insecure,
+		username: username,
+		password: password,
+		http:     httpClient,
+	}, nil
+}
+
+// Do sends a request to path and decodes the JSON response into out, if set
+func (n *Nutanix) Do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, n.APIURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.SetBasicAuth(n.username, n.password)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("connecting to Prism Central at %s: %w", n.APIURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 401 {
+		return fmt.Errorf("authentication failed - check credentials")
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding %s response: %w", path, err)
+	}
+	return nil
+}
+
+// NutanixVM is the subset of a Prism Central v3 VM used by the CLIs
+type NutanixVM struct {
+	Metadata struct {
+		UUID         string            `json:"uuid"`
+		CreationTime string            `json:"creation_time"`
+		Categories   map[string]string `json:"categories"`
+	} `json:"metadata"`
+	Spec struct {
+		Name      string `json:"name"`
+		Resources struct {
+			NumSockets        int32 `json:"num_sockets"`
+			NumVcpusPerSocket int32 `json:"num_vcpus_per_socket"`
+			MemorySizeMib     int32 `json:"memory_size_mib"`
+			DiskList          []struct {
+				DiskSizeMib int64 `json:"disk_size_mib"`
+			} `json:"disk_list"`
+		} `json:"resources"`
+	} `json:"spec"`
+	Status struct {
+		Resources struct {
+			PowerState string `json:"power_state"`
+		} `json:"resources"`
+	} `json:"status"`
+}
+
+// CPU returns the VM's total vCPUs
+func (vm *NutanixVM) CPU() int32 {
+	r := vm.Spec.Resources
+	if r.NumVcpusPerSocket == 0 {
+		return r.NumSockets
+	}
+	return r.NumSockets * r.NumVcpusPerSocket
+}
+
+// DiskGB returns the size of the VM's first disk in GB
+func (vm *NutanixVM) DiskGB() int32 {
+	for _, d := range vm.Spec.Resources.DiskList {
+		if d.DiskSizeMib > 0 {
+			return int32(d.DiskSizeMib / 1024)
+		}
+	}
+	return 0
+}
+
+// ListVMs returns all VMs visible to the configured user
+func (n *Nutanix) ListVMs(ctx context.Context) ([]NutanixVM, error) {
+	var vms []NutanixVM
+	for offset := 0; ; offset += nutanixPageSize {
+		var page struct {
+			Metadata struct {
+				TotalMatches int `json:"total_matches"`
+			} `json:"metadata"`
+			Entities []NutanixVM `json:"entities"`
+		}
+		body := map[string]interface{}{"kind": "vm", "length": nutanixPageSize, "offset": offset}
+		if err := n.Do(ctx, http.MethodPost, "/api/nutanix/v3/vms/list", body, &page); err != nil {
+			return nil, fmt.Errorf("listing VMs: %w", err)
+		}
+		vms = append(vms, page.Entities...)
+		if len(page.Entities) == 0 || len(vms) >= page.Metadata.TotalMatches {
+			return vms, nil
+		}
+	}
+}
+
+// DeleteVM deletes a VM by UUID. Prism Central deletes asynchronously.
+func (n *Nutanix) DeleteVM(ctx context.Context, uuid string) error {
+	if err := n.Do(ctx, http.MethodDelete, "/api/nutanix/v3/vms/"+uuid, nil, nil); err != nil {
+		return fmt.Errorf("deleting VM %s: %w", uuid, err)
+	}
+	return nil
+}
+
+// nutanixImage is the subset of a Prism Central v3 image used here
+type nutanixImage struct {
+	Metadata struct {
+		UUID string `json:"uuid"`
+	} `json:"metadata"`
+	Spec struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"spec"`
+	Status struct {
+		Resources struct {
+			ImageType string `json:"image_type"`
+			SizeBytes int64  `json:"size_bytes"`
+		} `json:"resources"`
+	} `json:"status"`
+}
+
+// ListImages returns the disk images in the image service. ISOs are left
+// out since workers boot from disk images.
+func (n *Nutanix) ListImages(ctx context.Context) ([]Image, error) {
+	var images []Image
+	for offset, seen := 0, 0; ; offset += nutanixPageSize {
+		var page struct {
+			Metadata struct {
+				TotalMatches int `json:"total_matches"`
+			} `json:"metadata"`
+			Entities []nutanixImage `json:"entities"`
+		}
+		body := map[string]interface{}{"kind": "image", "length": nutanixPageSize, "offset": offset}
+		if err := n.Do(ctx, http.MethodPost, "/api/nutanix/v3/images/list", body, &page); err != nil {
+			return nil, fmt.Errorf("listing images: %w", err)
+		}
+		for _, img := range page.Entities {
+			if img.Status.Resources.ImageType == "ISO_IMAGE" {
+				continue
+			}
+			images = append(images, Image{
+				Name:        img.Spec.Name,
+				Ref:         img.Metadata.UUID,
+				Description: img.Spec.Description,
+				SizeBytes:   img.Status.Resources.SizeBytes,
+			})
+		}
+		seen += len(page.Entities)
+		if len(page.Entities) == 0 || seen >= page.Metadata.TotalMatches {
+			return images, nil
+		}
+	}
+}
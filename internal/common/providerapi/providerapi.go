@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providerapi talks to the infrastructure behind a ProviderConfig.
+//
+// Clients are built from the ProviderConfig's endpoint and its credentials
+// Secret in butler-system, and trust the CAs added with
+// 'butleradm provider trust-ca'.
+package providerapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// Namespace holds ProviderConfigs and their credentials Secrets
+	Namespace = "butler-system"
+
+	// TrustBundleName is the ConfigMap holding CAs trusted for provider endpoints.
+	// Each ProviderConfig's CA is stored under "<name>.crt" and all of them are
+	// concatenated under TrustBundleKey.
+	TrustBundleName = "butler-trust-bundle"
+	TrustBundleKey  = "ca-bundle.crt"
+)
+
+// TrustedCA returns the pool of CAs trusted for a provider, or nil
+func TrustedCA(ctx context.Context, c *client.Client, name string) (*x509.CertPool, error) {
+	cm, err := c.Clientset.CoreV1().ConfigMaps(Namespace).Get(ctx, TrustBundleName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading trust bundle: %w", err)
+	}
+	data, ok := cm.Data[name+".crt"]
+	if !ok {
+		return nil, nil
+	}
+	pool, _, err := ParseCAs([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("parsing trusted CA for %s: %w", name, err)
+	}
+	return pool, nil
+}
+
+// ParseCAs parses PEM certificates into a pool
+func ParseCAs(data []byte) (*x509.CertPool, []*x509.Certificate, error) {
+	pool := x509.NewCertPool()
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		pool.AddCert(cert)
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, nil, fmt.Errorf("no PEM certificates found")
+	}
+	return pool, certs, nil
+}
+
+// newHTTPClient returns an HTTP client for a provider endpoint. TLS is
+// verified against the system roots plus the provider's trusted CA unless
+// insecure is set here or on the ProviderConfig.
+func newHTTPClient(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, provider string, timeout time.Duration, insecure bool) (*http.Client, bool, error) {
+	if v, _, _ := unstructured.NestedBool(pc.Object, "spec", provider, "insecure"); v {
+		insecure = true
+	}
+
+	// CAs added with trust-ca let the endpoint verify without insecure mode
+	rootCAs, err := TrustedCA(ctx, c, pc.GetName())
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: insecure,
+				RootCAs:            rootCAs,
+			},
+		},
+	}, insecure, nil
+}
+
+// credentials returns the ProviderConfig's credentials Secret
+func credentials(ctx context.Context, c *client.Client, pc *unstructured.Unstructured) (*corev1.Secret, error) {
+	// credentialsRef is at spec level, not nested under the provider
+	secretName, _, _ := unstructured.NestedString(pc.Object, "spec", "credentialsRef", "name")
+	if secretName == "" {
+		return nil, fmt.Errorf("credentials secret not configured (spec.credentialsRef.name)")
+	}
+
+	secret, err := c.Clientset.CoreV1().Secrets(Namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting credentials secret %s: %w", secretName, err)
+	}
+	return secret, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Proxmox talks to the Proxmox VE API
+type Proxmox struct {
+	// Endpoint is the Proxmox VE base URL
+	Endpoint string
+
+	tokenID     string
+	tokenSecret string
+	username    string
+	password    string
+	http        *http.Client
+}
+
+// NewProxmox builds a Proxmox VE client from a ProviderConfig and its
+// credentials secret
+func NewProxmox(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, timeout time.Duration, insecure bool) (*Proxmox, error) {
+	endpoint, _, _ := unstructured.NestedString(pc.Object, "spec", "proxmox", "endpoint")
+	if endpoint == "" {
+		return nil, fmt.Errorf("proxmox endpoint not configured")
+	}
+
+	httpClient, _, err := newHTTPClient(ctx, c, pc, "proxmox", timeout, insecure)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := credentials(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Proxmox{
+		Endpoint: strings.TrimSuffix(endpoint, "/"),
+		http:     httpClient,
+	}
+
+	// Try token-based auth first
+	p.tokenID = string(secret.Data["token"])
+	p.tokenSecret = string(secret.Data["tokenSecret"])
+
+	// Fallback to alternate key names
+	if p.tokenID == "" {
+		p.tokenID = string(secret.Data["PROXMOX_TOKEN_ID"])
+		p.tokenSecret = string(secret.Data["PROXMOX_TOKEN_SECRET"])
+	}
+
+	// Or username/password
+	p.username = string(secret.Data["username"])
+	p.password = string(secret.Data["password"])
+
+	if p.tokenID == "" && p.username == "" {
+		return nil, fmt.Errorf("credentials secret %s missing token or username/password", secret.Name)
+	}
+	return p, nil
+}
+
+// Get fetches an /api2/json path and decodes its data field into out, if set
+func (p *Proxmox) Get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.Endpoint+"/api2/json"+path, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	if p.tokenID != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("PVEAPIToken=%s=%s", p.tokenID, p.tokenSecret))
+	} else {
+		req.SetBasicAuth(p.username, p.password)
+	}
+
+	resp, err := p.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("connecting to Proxmox: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 401 {
+		return fmt.Errorf("authentication failed - check credentials")
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	envelope := struct {
+		Data interface{} `json:"data"`
+	}{Data: out}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("decoding %s response: %w", path, err)
+	}
+	return nil
+}
+
+// ListTemplates returns the VM templates workers can be cloned from. The
+// reference is the template's VMID.
+func (p *Proxmox) ListTemplates(ctx context.Context) ([]Image, error) {
+	var resources []struct {
+		VMID     int    `json:"vmid"`
+		Name     string `json:"name"`
+		Node     string `json:"node"`
+		Template int    `json:"template"`
+		MaxDisk  int64  `json:"maxdisk"`
+	}
+	if err := p.Get(ctx, "/cluster/resources?type=vm", &resources); err != nil {
+		return nil, fmt.Errorf("listing templates: %w", err)
+	}
+
+	var images []Image
+	for _, r := range resources {
+		if r.Template != 1 {
+			continue
+		}
+		images = append(images, Image{
+			Name:        r.Name,
+			Ref:         strconv.Itoa(r.VMID),
+			Description: "node " + r.Node,
+			SizeBytes:   r.MaxDisk,
+		})
+	}
+	return images, nil
+}
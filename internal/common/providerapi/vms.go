@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// harvesterVMGVR is the KubeVirt VirtualMachine Harvester runs workers as
+var harvesterVMGVR = schema.GroupVersionResource{
+	Group:    "kubevirt.io",
+	Version:  "v1",
+	Resource: "virtualmachines",
+}
+
+// ListVMNames returns the names of the VMs a ProviderConfig's credentials
+// can see
+func ListVMNames(ctx context.Context, c *client.Client, pc *unstructured.Unstructured, timeout time.Duration) (map[string]bool, error) {
+	provider, _, _ := unstructured.NestedString(pc.Object, "spec", "provider")
+
+	names := map[string]bool{}
+	switch provider {
+	case "nutanix":
+		n, err := NewNutanix(ctx, c, pc, timeout, false)
+		if err != nil {
+			return nil, err
+		}
+		vms, err := n.ListVMs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, vm := range vms {
+			names[vm.Spec.Name] = true
+		}
+	case "proxmox":
+		p, err := NewProxmox(ctx, c, pc, timeout, false)
+		if err != nil {
+			return nil, err
+		}
+		var resources []struct {
+			Name     string `json:"name"`
+			Template int    `json:"template"`
+		}
+		if err := p.Get(ctx, "/cluster/resources?type=vm", &resources); err != nil {
+			return nil, fmt.Errorf("listing VMs: %w", err)
+		}
+		for _, r := range resources {
+			if r.Template != 1 {
+				names[r.Name] = true
+			}
+		}
+	case "harvester":
+		namespace, _, _ := unstructured.NestedString(pc.Object, "spec", "harvester", "namespace")
+		if namespace == "" {
+			namespace = "default"
+		}
+		list, err := c.Dynamic.Resource(harvesterVMGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("listing VirtualMachines in %s: %w", namespace, err)
+		}
+		for _, vm := range list.Items {
+			names[vm.GetName()] = true
+		}
+	default:
+		return nil, fmt.Errorf("listing VMs is not supported for %s providers", provider)
+	}
+	return names, nil
+}
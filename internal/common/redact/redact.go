@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redact masks credentials in anything the CLIs print: YAML and
+// JSON output, dry-run documents and log attributes. Exports are not masked.
+//
+// Values are masked by key. A key is sensitive when it names a password,
+// token, secret, private key or inline kubeconfig, unless it only refers to
+// one (secretRef, tokenName, kubeconfigPath and the like). The data and
+// stringData of Secret objects are masked whole. The global --show-secrets
+// flag turns redaction off.
+package redact
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Placeholder replaces masked values
+const Placeholder = "<redacted>"
+
+// sensitiveWords mark a key as holding a credential. Keys are compared
+// lowercased with '-' and '_' removed.
+var sensitiveWords = []string{
+	"password", "passwd", "passphrase",
+	"token",
+	"secret",
+	"privatekey", "clientkeydata", "apikey", "accesskey",
+	"kubeconfig", "talosconfig",
+	"credentials",
+}
+
+// referenceSuffixes mark a key as naming where a credential lives rather
+// than holding it
+var referenceSuffixes = []string{
+	"ref", "name", "names", "namespace", "path", "file", "url", "id", "type",
+	"ttl", "expiry", "expires", "expiresat", "expiration",
+}
+
+// show is set by ShowSecrets for the lifetime of the process
+var show bool
+
+// ShowSecrets disables redaction, e.g. for --show-secrets
+func ShowSecrets() {
+	show = true
+}
+
+// Enabled reports whether values are being masked
+func Enabled() bool {
+	return !show
+}
+
+// SensitiveKey reports whether a field of this name holds a credential
+func SensitiveKey(key string) bool {
+	k := strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(key))
+	for _, suffix := range referenceSuffixes {
+		if strings.HasSuffix(k, suffix) {
+			return false
+		}
+	}
+	for _, word := range sensitiveWords {
+		if strings.Contains(k, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// Value returns v with credentials masked. Maps and slices are copied, not
+// modified. Other types, such as structs, are masked through their JSON
+// form and returned unchanged when there is nothing to mask, so their
+// field order survives.
+func Value(v interface{}) interface{} {
+	if show {
+		return v
+	}
+	return Mask(v)
+}
+
+// Mask is Value regardless of --show-secrets, for data that is stored on a
+// cluster rather than shown to the user
+func Mask(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		out, _ := walk(v, false)
+		return out
+	case string, bool, int, int32, int64, float64:
+		return v
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return v
+	}
+	out, changed := walk(generic, false)
+	if !changed {
+		return v
+	}
+	return out
+}
+
+// Attr masks a single key/value pair, for log attributes. Only text is
+// masked under a sensitive key, so counts such as "secrets", 3 survive.
+func Attr(key string, v interface{}) interface{} {
+	if show {
+		return v
+	}
+	switch v.(type) {
+	case string, []byte:
+		if SensitiveKey(key) && !empty(v) {
+			return Placeholder
+		}
+		return v
+	}
+	return Value(v)
+}
+
+// walk copies v, masking every non-empty leaf under a sensitive key or
+// when mask is set. It reports whether anything was masked.
+func walk(v interface{}, mask bool) (interface{}, bool) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		secret := val["kind"] == "Secret"
+		out := make(map[string]interface{}, len(val))
+		changed := false
+		for k, item := range val {
+			m := mask || SensitiveKey(k) || (secret && (k == "data" || k == "stringData"))
+			masked, c := walk(item, m)
+			out[k] = masked
+			changed = changed || c
+		}
+		return out, changed
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		changed := false
+		for i, item := range val {
+			masked, c := walk(item, mask)
+			out[i] = masked
+			changed = changed || c
+		}
+		return out, changed
+	default:
+		if mask && !empty(v) {
+			return Placeholder, true
+		}
+		return v, false
+	}
+}
+
+// empty reports whether v is unset, so masking doesn't hide that a field
+// was left blank
+func empty(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case []byte:
+		return len(val) == 0
+	case bool:
+		return !val
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redact
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSensitiveKey(t *testing.T) {
+	for _, tt := range []struct {
+		key  string
+		want bool
+	}{
+		{"password", true},
+		{"Password", true},
+		{"admin_password", true},
+		{"db-passwd", true},
+		{"passphrase", true},
+		{"token", true},
+		{"bearerToken", true},
+		{"clientSecret", true},
+		{"secret", true},
+		{"privateKey", true},
+		{"client-key-data", true},
+		{"apiKey", true},
+		{"accessKey", true},
+		{"secretAccessKey", true},
+		{"kubeconfig", true},
+		{"adminKubeconfig", true},
+		{"talosconfig", true},
+		{"credentials", true},
+
+		// References name where a credential lives
+		{"secretRef", false},
+		{"credentialsRef", false},
+		{"kubeconfigSecretRef", false},
+		{"tokenName", false},
+		{"secretName", false},
+		{"secretNames", false},
+		{"secretNamespace", false},
+		{"kubeconfigPath", false},
+		{"passwordFile", false},
+		{"token_url", false},
+		{"accessKeyID", false},
+		{"tokenType", false},
+		{"tokenTTL", false},
+		{"tokenExpiresAt", false},
+
+		// Unrelated keys
+		{"name", false},
+		{"username", false},
+		{"endpoint", false},
+		{"replicas", false},
+		{"", false},
+	} {
+		if got := SensitiveKey(tt.key); got != tt.want {
+			t.Errorf("SensitiveKey(%q) = %t, want %t", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestMask(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		in   interface{}
+		want interface{}
+	}{
+		{
+			"sensitive keys",
+			map[string]interface{}{"username": "admin", "password": "hunter2"},
+			map[string]interface{}{"username": "admin", "password": Placeholder},
+		},
+		{
+			"references are kept",
+			map[string]interface{}{"credentialsRef": map[string]interface{}{"name": "nutanix-creds", "namespace": "butler-system"}},
+			map[string]interface{}{"credentialsRef": map[string]interface{}{"name": "nutanix-creds", "namespace": "butler-system"}},
+		},
+		{
+			"empty values stay visible",
+			map[string]interface{}{"password": "", "token": nil, "secret": false},
+			map[string]interface{}{"password": "", "token": nil, "secret": false},
+		},
+		{
+			"every leaf under a sensitive key",
+			map[string]interface{}{"credentials": map[string]interface{}{"user": "admin", "keys": []interface{}{"k1"}}},
+			map[string]interface{}{"credentials": map[string]interface{}{"user": Placeholder, "keys": []interface{}{Placeholder}}},
+		},
+		{
+			"nested in lists",
+			[]interface{}{map[string]interface{}{"name": "a", "token": "t"}},
+			[]interface{}{map[string]interface{}{"name": "a", "token": Placeholder}},
+		},
+		{
+			"secret data",
+			map[string]interface{}{"kind": "Secret", "data": map[string]interface{}{"username": "YWRtaW4=", "ca.crt": "LS0t"}, "type": "Opaque"},
+			map[string]interface{}{"kind": "Secret", "data": map[string]interface{}{"username": Placeholder, "ca.crt": Placeholder}, "type": "Opaque"},
+		},
+		{
+			"data of other kinds",
+			map[string]interface{}{"kind": "ConfigMap", "data": map[string]interface{}{"username": "admin"}},
+			map[string]interface{}{"kind": "ConfigMap", "data": map[string]interface{}{"username": "admin"}},
+		},
+		{
+			"structs through JSON",
+			struct {
+				Name     string `json:"name"`
+				Password string `json:"password"`
+			}{"db", "hunter2"},
+			map[string]interface{}{"name": "db", "password": Placeholder},
+		},
+		{"scalars", "hunter2", "hunter2"},
+		{"nil", nil, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mask(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Mask = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaskCopies(t *testing.T) {
+	in := map[string]interface{}{"password": "hunter2"}
+	Mask(in)
+	if in["password"] != "hunter2" {
+		t.Errorf("Mask modified its input: %v", in)
+	}
+
+	type config struct {
+		Name string `json:"name"`
+	}
+	unchanged := config{Name: "db"}
+	if got := Mask(unchanged); got != unchanged {
+		t.Errorf("Mask(%#v) = %#v, want it returned as is", unchanged, got)
+	}
+}
+
+func TestAttr(t *testing.T) {
+	for _, tt := range []struct {
+		key   string
+		value interface{}
+		want  interface{}
+	}{
+		{"token", "abc", Placeholder},
+		{"token", "", ""},
+		{"token", []byte("abc"), Placeholder},
+		{"secrets", 3, 3},
+		{"cluster", "alpha", "alpha"},
+		{"config", map[string]interface{}{"password": "x"}, map[string]interface{}{"password": Placeholder}},
+	} {
+		if got := Attr(tt.key, tt.value); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Attr(%q, %#v) = %#v, want %#v", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretcrypt encrypts Secrets that leave the cluster in exports and
+// backups, so Git repositories and backup buckets never hold them in plain
+// text.
+//
+// Two formats are supported. sealed converts a Secret to a SealedSecret with
+// kubeseal, using the certificate of the management cluster's Sealed Secrets
+// controller; only that controller can decrypt it. sops encrypts the Secret's
+// data in place with sops, to the recipients in the platform config or the
+// user's own sops configuration. Both tools must be installed locally.
+package secretcrypt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Encryption formats
+const (
+	Sealed = "sealed"
+	SOPS   = "sops"
+)
+
+// Sealed Secrets controller defaults, as kubeseal uses them
+const (
+	DefaultControllerNamespace = "kube-system"
+	DefaultControllerName      = "sealed-secrets-controller"
+)
+
+// encryptedRegex limits sops to the Secret's payload so metadata stays
+// readable and diffable
+const encryptedRegex = "^(data|stringData)$"
+
+// Validate checks an encryption format given on the command line
+func Validate(format string) error {
+	switch format {
+	case Sealed, SOPS:
+		return nil
+	}
+	return fmt.Errorf("invalid secret encryption %q: must be %s or %s", format, Sealed, SOPS)
+}
+
+// Encrypter encrypts Secrets in one format
+type Encrypter struct {
+	format string
+	keys   platform.SOPSKeys
+
+	// certFile is the sealing certificate, fetched once
+	certFile string
+}
+
+// New checks the tool for a format is installed and loads its keys from the
+// platform config. For sealed it fetches the controller's certificate, so
+// Secrets can be sealed offline; call Close when done.
+func New(ctx context.Context, c *client.Client, format string) (*Encrypter, error) {
+	if err := Validate(format); err != nil {
+		return nil, err
+	}
+	tool, hint := "sops", "https://github.com/getsops/sops"
+	if format == Sealed {
+		tool, hint = "kubeseal", "https://github.com/bitnami-labs/sealed-secrets#kubeseal"
+	}
+	if _, err := exec.LookPath(tool); err != nil {
+		return nil, fmt.Errorf("%s not found in PATH; install it to encrypt Secrets (%s)", tool, hint)
+	}
+
+	cfg, err := platform.Load(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	e := &Encrypter{format: format, keys: cfg.SecretEncryption.SOPS}
+	if format == SOPS {
+		return e, nil
+	}
+
+	cert, err := fetchCert(ctx, c, cfg.SecretEncryption.SealedSecrets)
+	if err != nil {
+		return nil, err
+	}
+	e.certFile, err = writeTemp("butler-sealed-secrets-*.pem", cert)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Format returns the encryption format
+func (e *Encrypter) Format() string {
+	return e.format
+}
+
+// Close removes the fetched sealing certificate
+func (e *Encrypter) Close() {
+	if e.certFile != "" {
+		os.Remove(e.certFile)
+	}
+}
+
+// Encrypt returns a Secret as an encrypted manifest: a SealedSecret, or a
+// Secret with sops-encrypted data. Server-managed metadata is dropped.
+func (e *Encrypter) Encrypt(ctx context.Context, secret *corev1.Secret) ([]byte, error) {
+	meta := map[string]interface{}{
+		"name":      secret.Name,
+		"namespace": secret.Namespace,
+	}
+	if len(secret.Labels) > 0 {
+		meta["labels"] = secret.Labels
+	}
+	plain, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   meta,
+		"type":       string(secret.Type),
+		"data":       secret.Data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encoding Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	var out []byte
+	if e.format == Sealed {
+		out, err = run(ctx, plain, "kubeseal", "--format", "yaml", "--cert", e.certFile)
+	} else {
+		out, err = e.sops(ctx, plain)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encrypting Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+	return out, nil
+}
+
+// sops encrypts a manifest via a private temporary file, as sops doesn't
+// infer the format of stdin
+func (e *Encrypter) sops(ctx context.Context, plain []byte) ([]byte, error) {
+	file, err := writeTemp("butler-secret-*.yaml", plain)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file)
+
+	args := []string{"--encrypt", "--encrypted-regex", encryptedRegex}
+	for _, recipients := range []struct {
+		flag string
+		keys []string
+	}{
+		{"--age", e.keys.Age},
+		{"--kms", e.keys.KMS},
+		{"--gcp-kms", e.keys.GCPKMS},
+		{"--azure-kv", e.keys.AzureKV},
+		{"--pgp", e.keys.PGP},
+	} {
+		if len(recipients.keys) > 0 {
+			args = append(args, recipients.flag, strings.Join(recipients.keys, ","))
+		}
+	}
+	out, err := run(ctx, nil, "sops", append(args, file)...)
+	if err != nil && e.keys.Empty() {
+		return nil, fmt.Errorf("%w; set secretEncryption.sops in the %s ConfigMap, a .sops.yaml or e.g. SOPS_AGE_RECIPIENTS", err, platform.ConfigMapName)
+	}
+	return out, err
+}
+
+// Decrypt returns the plain Secret manifest of a sops-encrypted one. The
+// user's sops configuration must hold a matching private key.
+func Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	if _, err := exec.LookPath("sops"); err != nil {
+		return nil, fmt.Errorf("sops not found in PATH; install it to decrypt Secrets (https://github.com/getsops/sops)")
+	}
+	file, err := writeTemp("butler-secret-*.yaml", data)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file)
+	return run(ctx, nil, "sops", "--decrypt", file)
+}
+
+// fetchCert gets the controller's public sealing certificate through the
+// API server proxy, as kubeseal --fetch-cert does. The
+// SEALED_SECRETS_CONTROLLER_NAMESPACE and _NAME variables kubeseal reads
+// override the platform config.
+func fetchCert(ctx context.Context, c *client.Client, cfg platform.SealedSecrets) ([]byte, error) {
+	namespace := firstNonEmpty(os.Getenv("SEALED_SECRETS_CONTROLLER_NAMESPACE"), cfg.Namespace, DefaultControllerNamespace)
+	name := firstNonEmpty(os.Getenv("SEALED_SECRETS_CONTROLLER_NAME"), cfg.ControllerName, DefaultControllerName)
+
+	cert, err := c.Clientset.CoreV1().Services(namespace).ProxyGet("http", name, "", "/v1/cert.pem", nil).DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching sealing certificate from %s/%s (set secretEncryption.sealedSecrets in the %s ConfigMap if the controller is elsewhere): %w",
+			namespace, name, platform.ConfigMapName, err)
+	}
+	return cert, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// writeTemp writes data to a new file only the user can read
+func writeTemp(pattern string, data []byte) (string, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("creating temporary file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("writing temporary file: %w", err)
+	}
+	return f.Name(), nil
+}
+
+func run(ctx context.Context, stdin []byte, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w, output: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
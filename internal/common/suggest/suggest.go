@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package suggest finds near matches for mistyped names, for "did you mean"
+// hints on cluster names and subcommands.
+package suggest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// maxSuggestions caps how many matches are offered
+const maxSuggestions = 3
+
+// Distance returns the Levenshtein edit distance between a and b
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// Closest returns the candidates within reach of target, best first. A
+// candidate is in reach if it's at most a third of target's length away
+// (at least one edit) or starts with target. An empty target matches
+// nothing.
+func Closest(target string, candidates []string) []string {
+	target = strings.ToLower(target)
+	if target == "" {
+		return nil
+	}
+	limit := max(1, len(target)/3)
+
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+	seen := map[string]bool{}
+	for _, c := range candidates {
+		if seen[c] || c == "" {
+			continue
+		}
+		seen[c] = true
+		d := Distance(target, strings.ToLower(c))
+		if d <= limit || strings.HasPrefix(strings.ToLower(c), target) {
+			matches = append(matches, match{c, d})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	var names []string
+	for i := 0; i < len(matches) && i < maxSuggestions; i++ {
+		names = append(names, matches[i].name)
+	}
+	return names
+}
+
+// Hint formats suggestions the way cobra does, or "" if there are none
+func Hint(suggestions []string) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+	return "\n\nDid you mean this?\n\t" + strings.Join(suggestions, "\n\t")
+}
+
+// RegisterCommands makes every command with subcommands reject unknown
+// subcommands with a "did you mean" hint. Cobra only does this for the
+// root; nested groups like 'cluster' otherwise print help and exit 0.
+// Call it after all subcommands are added.
+func RegisterCommands(cmd *cobra.Command) {
+	for _, sub := range cmd.Commands() {
+		RegisterCommands(sub)
+	}
+	if !cmd.HasSubCommands() || cmd.Args != nil {
+		return
+	}
+
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return nil
+		}
+		var names []string
+		for _, sub := range cmd.Commands() {
+			if !sub.IsAvailableCommand() {
+				continue
+			}
+			names = append(names, sub.Name())
+			names = append(names, sub.Aliases...)
+		}
+		return fmt.Errorf("unknown command %q for %q%s", args[0], cmd.CommandPath(), Hint(Closest(args[0], names)))
+	}
+	if !cmd.Runnable() {
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		}
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	for _, tt := range []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"create", "create", 0},
+		{"crate", "create", 1},
+		{"craete", "create", 2},
+		{"kitten", "sitting", 3},
+		{"über", "uber", 1},
+	} {
+		if got := Distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := Distance(tt.b, tt.a); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestClosest(t *testing.T) {
+	commands := []string{"create", "list", "get", "describe", "scale", "destroy", "delete", "export", "kubeconfig"}
+	for _, tt := range []struct {
+		name       string
+		target     string
+		candidates []string
+		want       []string
+	}{
+		{"typo", "crate", commands, []string{"create"}},
+		{"case", "LIST", commands, []string{"list"}},
+		{"prefix", "kube", commands, []string{"kubeconfig"}},
+		{"short names allow one edit", "gt", commands, []string{"get"}},
+		{"prefixes by distance", "de", commands, []string{"delete", "destroy", "describe"}},
+		{"ties sort by name", "xx", []string{"xb", "xa", "ax"}, []string{"ax", "xa", "xb"}},
+		{"best first", "delte", commands, []string{"delete"}},
+		{"at most three", "d", []string{"da", "db", "dc", "dd"}, []string{"da", "db", "dc"}},
+		{"duplicates once", "lst", []string{"list", "list"}, []string{"list"}},
+		{"empty candidates skipped", "a", []string{"", "b"}, []string{"b"}},
+		{"nothing close", "frobnicate", commands, nil},
+		{"no target", "", commands, nil},
+		{"no candidates", "create", nil, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Closest(tt.target, tt.candidates); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Closest(%q) = %q, want %q", tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHint(t *testing.T) {
+	if got := Hint(nil); got != "" {
+		t.Errorf("Hint(nil) = %q, want empty", got)
+	}
+	if got, want := Hint([]string{"create", "crate"}), "\n\nDid you mean this?\n\tcreate\n\tcrate"; got != want {
+		t.Errorf("Hint = %q, want %q", got, want)
+	}
+}
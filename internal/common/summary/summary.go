@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package summary records what a long-running command did and prints it as
+// a footer once the command finishes. The same record can be printed as
+// JSON or YAML so scripts get a result object instead of scraping logs.
+package summary
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/output"
+)
+
+// Result values
+const (
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+)
+
+// Resource actions
+const (
+	ActionCreated = "created"
+	ActionChanged = "changed"
+	ActionDeleted = "deleted"
+)
+
+// Summary is the result of a single command run
+type Summary struct {
+	Command         string     `json:"command"`
+	Result          string     `json:"result"`
+	Error           string     `json:"error,omitempty"`
+	StartedAt       time.Time  `json:"startedAt"`
+	DurationSeconds float64    `json:"durationSeconds"`
+	Phases          []Phase    `json:"phases,omitempty"`
+	Resources       []Resource `json:"resources,omitempty"`
+	Artifacts       []Artifact `json:"artifacts,omitempty"`
+	NextSteps       []string   `json:"nextSteps,omitempty"`
+
+	now     func() time.Time
+	current int
+}
+
+// Phase is a named step of the command and how long it took
+type Phase struct {
+	Name            string  `json:"name"`
+	DurationSeconds float64 `json:"durationSeconds"`
+
+	started time.Time
+}
+
+// Resource is an object the command created, changed or deleted
+type Resource struct {
+	Action    string `json:"action"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// Artifact is a file the command wrote
+type Artifact struct {
+	Description string `json:"description"`
+	Path        string `json:"path"`
+}
+
+// New starts a summary for command
+func New(command string) *Summary {
+	s := &Summary{Command: command, now: time.Now, current: -1}
+	s.StartedAt = s.now()
+	return s
+}
+
+// Phase ends the running phase, if any, and starts a new one
+func (s *Summary) Phase(name string) {
+	now := s.now()
+	s.endPhase(now)
+	s.Phases = append(s.Phases, Phase{Name: name, started: now})
+	s.current = len(s.Phases) - 1
+}
+
+func (s *Summary) endPhase(now time.Time) {
+	if s.current < 0 {
+		return
+	}
+	p := &s.Phases[s.current]
+	p.DurationSeconds = seconds(now.Sub(p.started))
+	s.current = -1
+}
+
+// Created records a resource the command created
+func (s *Summary) Created(kind, namespace, name string) {
+	s.resource(ActionCreated, kind, namespace, name)
+}
+
+// Changed records a resource the command modified
+func (s *Summary) Changed(kind, namespace, name string) {
+	s.resource(ActionChanged, kind, namespace, name)
+}
+
+// Deleted records a resource the command removed
+func (s *Summary) Deleted(kind, namespace, name string) {
+	s.resource(ActionDeleted, kind, namespace, name)
+}
+
+func (s *Summary) resource(action, kind, namespace, name string) {
+	s.Resources = append(s.Resources, Resource{Action: action, Kind: kind, Namespace: namespace, Name: name})
+}
+
+// Artifact records a file the command wrote
+func (s *Summary) Artifact(description, path string) {
+	if path == "" {
+		return
+	}
+	s.Artifacts = append(s.Artifacts, Artifact{Description: description, Path: path})
+}
+
+// Next adds a suggested follow-up command
+func (s *Summary) Next(command string) {
+	s.NextSteps = append(s.NextSteps, command)
+}
+
+// Finish ends the running phase and records the outcome of the command
+func (s *Summary) Finish(err error) {
+	now := s.now()
+	s.endPhase(now)
+	s.DurationSeconds = seconds(now.Sub(s.StartedAt))
+	s.Result = ResultSucceeded
+	if err != nil {
+		s.Result = ResultFailed
+		s.Error = err.Error()
+	}
+}
+
+// Print writes the summary to w. JSON and YAML print the whole record; any
+// other format prints the human-readable footer.
+func (s *Summary) Print(w io.Writer, format output.Format) error {
+	switch format {
+	case output.FormatJSON:
+		return output.PrintJSON(w, s)
+	case output.FormatYAML:
+		return output.PrintYAML(w, s)
+	}
+
+	status := output.PhaseReady.Render("✓")
+	if s.Result == ResultFailed {
+		status = output.PhaseFailed.Render("✗")
+	}
+	fmt.Fprintf(w, "\n%s %s %s in %s\n", status, s.Command, s.Result, formatSeconds(s.DurationSeconds))
+	if s.Error != "" {
+		fmt.Fprintf(w, "  Error: %s\n", s.Error)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if len(s.Phases) > 0 {
+		fmt.Fprintln(tw, "\nPhases:")
+		for _, p := range s.Phases {
+			fmt.Fprintf(tw, "  %s\t%s\n", p.Name, formatSeconds(p.DurationSeconds))
+		}
+	}
+	if len(s.Resources) > 0 {
+		fmt.Fprintln(tw, "\nResources:")
+		for _, r := range s.Resources {
+			name := r.Name
+			if r.Namespace != "" {
+				name = r.Namespace + "/" + r.Name
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", r.Action, r.Kind, name)
+		}
+	}
+	if len(s.Artifacts) > 0 {
+		fmt.Fprintln(tw, "\nArtifacts:")
+		for _, a := range s.Artifacts {
+			fmt.Fprintf(tw, "  %s\t%s\n", a.Description, a.Path)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(s.NextSteps) > 0 {
+		fmt.Fprintln(w, "\nNext steps:")
+		for _, step := range s.NextSteps {
+			fmt.Fprintf(w, "  %s\n", strings.ReplaceAll(step, "\n", "\n  "))
+		}
+	}
+	return nil
+}
+
+func seconds(d time.Duration) float64 {
+	return d.Round(time.Millisecond).Seconds()
+}
+
+func formatSeconds(s float64) string {
+	d := time.Duration(s * float64(time.Second))
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package talosctl runs talosctl against Talos nodes.
+//
+// The talosconfig comes from a flag, $TALOSCONFIG, the one saved under
+// ~/.butler by bootstrap, or raw data such as a cluster's talosconfig
+// Secret. Sealed and in-memory talosconfigs are written to a temporary file
+// for talosctl; Close removes it.
+package talosctl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/credstore"
+)
+
+// Talosctl runs talosctl with a resolved talosconfig
+type Talosctl struct {
+	talosconfig string
+
+	// temporary is a plain copy of a sealed or in-memory talosconfig
+	temporary string
+}
+
+// New resolves the talosconfig from path, $TALOSCONFIG, or the single
+// talosconfig saved by bootstrap under ~/.butler. A sealed talosconfig is
+// unsealed to a temporary file.
+func New(path string) (*Talosctl, error) {
+	if err := lookPath(); err != nil {
+		return nil, err
+	}
+
+	if path == "" {
+		path = os.Getenv("TALOSCONFIG")
+	}
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("getting home directory: %w", err)
+		}
+		matches, _ := filepath.Glob(filepath.Join(home, ".butler", "*-talosconfig"))
+		sealed, _ := filepath.Glob(filepath.Join(home, ".butler", "*-talosconfig"+credstore.SealedExt))
+		matches = append(matches, sealed...)
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("no talosconfig found; pass --talosconfig or set TALOSCONFIG")
+		case 1:
+			path = matches[0]
+		default:
+			return nil, fmt.Errorf("multiple talosconfigs in ~/.butler; pass --talosconfig to choose one")
+		}
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("reading talosconfig: %w", err)
+	}
+	if !credstore.IsSealed(path) {
+		return &Talosctl{talosconfig: path}, nil
+	}
+
+	data, err := credstore.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading talosconfig: %w", err)
+	}
+	return FromData(data)
+}
+
+// FromData uses a talosconfig held in memory, e.g. from a Secret
+func FromData(data []byte) (*Talosctl, error) {
+	if err := lookPath(); err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "butler-talosconfig-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary talosconfig: %w", err)
+	}
+	defer tmp.Close()
+	if _, err := tmp.Write(data); err != nil {
+		os.Remove(tmp.Name())
+		return nil, fmt.Errorf("writing temporary talosconfig: %w", err)
+	}
+	return &Talosctl{talosconfig: tmp.Name(), temporary: tmp.Name()}, nil
+}
+
+func lookPath() error {
+	if _, err := exec.LookPath("talosctl"); err != nil {
+		return fmt.Errorf("talosctl not found in PATH (https://www.talos.dev/latest/talos-guides/install/talosctl/)")
+	}
+	return nil
+}
+
+// Close removes the temporary talosconfig copy, if any
+func (t *Talosctl) Close() {
+	if t.temporary != "" {
+		os.Remove(t.temporary)
+	}
+}
+
+// Run runs a talosctl command against one node, used as its own endpoint
+func (t *Talosctl) Run(ctx context.Context, node string, args ...string) ([]byte, error) {
+	subcommand := args[0]
+	args = append([]string{"--talosconfig", t.talosconfig, "--nodes", node, "--endpoints", node}, args...)
+	cmd := exec.CommandContext(ctx, "talosctl", args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("talosctl %s: %w, output: %s", subcommand, err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// ReadMachineConfig returns the first document of a node's machine config.
+// Newer Talos versions append further documents; see ReadMachineConfigDocuments.
+func (t *Talosctl) ReadMachineConfig(ctx context.Context, node string) ([]byte, error) {
+	docs, err := t.ReadMachineConfigDocuments(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+	return docs[0], nil
+}
+
+// ReadMachineConfigDocuments returns every document of a node's machine config
+func (t *Talosctl) ReadMachineConfigDocuments(ctx context.Context, node string) ([][]byte, error) {
+	out, err := t.Run(ctx, node, "read", "/system/state/config.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("reading machine config from %s: %w", node, err)
+	}
+	var docs [][]byte
+	for _, doc := range strings.Split(string(out), "\n---") {
+		docs = append(docs, []byte(doc))
+	}
+	return docs, nil
+}
+
+// PatchMachineConfig applies a strategic merge patch to a node's machine config
+func (t *Talosctl) PatchMachineConfig(ctx context.Context, node, patch string) error {
+	_, err := t.Run(ctx, node, "patch", "machineconfig", "--mode", "auto", "--patch", patch)
+	return err
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package version holds the build version of the CLIs, set at link time
+// with -ldflags "-X github.com/butlerdotdev/butler/internal/common/version.Version=..."
+package version
+
+import "runtime/debug"
+
+var (
+	// Version is the release the binary was built as
+	Version = "v0.1.0-dev"
+
+	// Commit is the git SHA the binary was built from
+	Commit = ""
+
+	// Date is when the binary was built
+	Date = ""
+)
+
+// GitCommit returns Commit, falling back to the revision the Go toolchain
+// stamped into the binary, or "unknown"
+func GitCommit() string {
+	if Commit != "" {
+		return Commit
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range info.Settings {
+			if s.Key == "vcs.revision" && s.Value != "" {
+				return s.Value
+			}
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package waiter polls for a condition with backoff, a timeout and progress
+// reporting, and turns Ctrl-C into context cancellation.
+//
+// Every wait loop in butlerctl and butleradm goes through Until so timeouts
+// and interrupts end them the same way: a TimeoutError naming what was being
+// waited for and the last status seen, or an "interrupted" error wrapping
+// context.Canceled.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// DefaultInterval is used when Options.Interval is unset
+const DefaultInterval = 5 * time.Second
+
+// ConditionFunc checks whether the wait is over. status is a short
+// description of the current state for progress output and timeout errors.
+// A non-nil error ends the wait; transient failures should be reported
+// through status instead.
+type ConditionFunc func(ctx context.Context) (done bool, status string, err error)
+
+// Options configures a wait
+type Options struct {
+	// Description completes "waiting for ...", e.g. "cluster my-app to be Ready"
+	Description string
+
+	// Interval is the delay between checks
+	Interval time.Duration
+
+	// MaxInterval enables backoff: the delay doubles after each check up to
+	// MaxInterval. Zero keeps the interval fixed.
+	MaxInterval time.Duration
+
+	// Timeout bounds the whole wait. Zero relies on ctx alone.
+	Timeout time.Duration
+
+	// Progress is called with the status whenever it changes
+	Progress func(status string, elapsed time.Duration)
+}
+
+// TimeoutError is returned when the timeout expires before the condition holds
+type TimeoutError struct {
+	Description string
+	Timeout     time.Duration
+	Status      string
+}
+
+func (e *TimeoutError) Error() string {
+	msg := fmt.Sprintf("timed out after %v waiting for %s", e.Timeout, e.Description)
+	if e.Status != "" {
+		msg += fmt.Sprintf(" (last status: %s)", e.Status)
+	}
+	return msg
+}
+
+// Unwrap lets errors.Is match context.DeadlineExceeded
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// Until checks condition immediately and then after each interval until it
+// reports done, returns an error, the timeout expires or ctx is cancelled
+func Until(ctx context.Context, opts Options, condition ConditionFunc) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	parent := ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	lastStatus := ""
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return stopped(parent, opts, time.Since(start), lastStatus)
+		case <-timer.C:
+		}
+
+		done, status, err := condition(ctx)
+		if err != nil {
+			// Requests cut short by the deadline or Ctrl-C surface as
+			// whatever the client library makes of it; report the cause
+			if ctx.Err() != nil {
+				return stopped(parent, opts, time.Since(start), lastStatus)
+			}
+			return err
+		}
+		if status != "" && status != lastStatus {
+			lastStatus = status
+			if opts.Progress != nil {
+				opts.Progress(status, time.Since(start).Round(time.Second))
+			}
+		}
+		if done {
+			return nil
+		}
+
+		timer.Reset(interval)
+		if opts.MaxInterval > 0 {
+			interval = min(interval*2, opts.MaxInterval)
+		}
+	}
+}
+
+// stopped explains why the wait ended early: our own timeout, a deadline
+// set by the caller, or cancellation
+func stopped(parent context.Context, opts Options, elapsed time.Duration, status string) error {
+	if parent.Err() == nil || errors.Is(parent.Err(), context.DeadlineExceeded) {
+		timeout := opts.Timeout
+		if parent.Err() != nil || timeout == 0 {
+			timeout = elapsed.Round(time.Second)
+		}
+		return &TimeoutError{Description: opts.Description, Timeout: timeout, Status: status}
+	}
+	return fmt.Errorf("interrupted while waiting for %s: %w", opts.Description, parent.Err())
+}
+
+// Sleep waits for d or until ctx is done, returning ctx's error in the
+// latter case
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Interrupted reports whether err came from Ctrl-C or another cancellation
+// rather than a failure
+func Interrupted(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// InterruptContext returns a context cancelled by the first SIGINT or
+// SIGTERM, calling onInterrupt when that happens. A second signal gets the
+// default behaviour and kills the process, so a stuck cleanup can always be
+// abandoned.
+func InterruptContext(parent context.Context, onInterrupt func()) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sigCh:
+			signal.Stop(sigCh)
+			if onInterrupt != nil {
+				onInterrupt()
+			}
+			cancel()
+		case <-ctx.Done():
+			signal.Stop(sigCh)
+		}
+	}()
+
+	return ctx, cancel
+}
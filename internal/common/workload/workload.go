@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workload applies manifests into tenant clusters, such as the
+// namespaces, RBAC, quotas and agents every new cluster starts with.
+//
+// Objects of any kind are applied with server-side apply. Namespaces and
+// CustomResourceDefinitions go first so the objects that need them can be
+// mapped and created in the same run.
+package workload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/envsubst"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// FieldManager is the server-side apply field manager for workloads
+const FieldManager = "butlerctl"
+
+// kindOrder lists the kinds applied before everything else
+var kindOrder = map[string]int{
+	"Namespace":                0,
+	"CustomResourceDefinition": 1,
+}
+
+// LoadFiles reads every manifest in the given files and directories,
+// expanding ${VAR} references first. Directories are walked recursively
+// and their .yaml, .yml and .json files read in lexical order.
+func LoadFiles(paths []string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+
+	for _, path := range paths {
+		var files []string
+		err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if p == path && !d.IsDir() {
+				files = append(files, p)
+				return nil
+			}
+			switch strings.ToLower(filepath.Ext(p)) {
+			case ".yaml", ".yml", ".json":
+				if !d.IsDir() {
+					files = append(files, p)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		sort.Strings(files)
+
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s: %w", file, err)
+			}
+			data, err = envsubst.Expand(data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			parsed, err := Parse(data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			objs = append(objs, parsed...)
+		}
+	}
+
+	return objs, nil
+}
+
+// Parse decodes a stream of YAML or JSON documents. Every object must have
+// an apiVersion, kind and name.
+func Parse(data []byte) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		var obj map[string]interface{}
+		if err := decoder.Decode(&obj); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("parsing: %w", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+
+		u := &unstructured.Unstructured{Object: obj}
+		if u.GetAPIVersion() == "" || u.GetKind() == "" {
+			return nil, fmt.Errorf("document %d is missing apiVersion or kind", len(objs)+1)
+		}
+		if u.GetName() == "" {
+			return nil, fmt.Errorf("%s is missing metadata.name", u.GetKind())
+		}
+		objs = append(objs, u)
+	}
+
+	return objs, nil
+}
+
+// Apply server-side applies objs to the cluster, Namespaces and CRDs
+// first. It stops at the first failure.
+func Apply(ctx context.Context, c *client.Client, objs []*unstructured.Unstructured) error {
+	ordered := make([]*unstructured.Unstructured, len(objs))
+	copy(ordered, objs)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return order(ordered[i]) < order(ordered[j])
+	})
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.Clientset.Discovery()))
+	force := true
+
+	for _, obj := range ordered {
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) {
+			// The kind may come from a CRD applied earlier in this run
+			mapper.Reset()
+			mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		}
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		data, err := obj.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("encoding %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		resource := c.Dynamic.Resource(mapping.Resource)
+		var ri dynamic.ResourceInterface = resource
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			namespace := obj.GetNamespace()
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			ri = resource.Namespace(namespace)
+		}
+
+		if _, err := ri.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+			FieldManager: FieldManager,
+			Force:        &force,
+		}); err != nil {
+			return fmt.Errorf("applying %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// order returns an object's position in the apply order
+func order(obj *unstructured.Unstructured) int {
+	if o, ok := kindOrder[obj.GetKind()]; ok {
+		return o
+	}
+	return len(kindOrder)
+}
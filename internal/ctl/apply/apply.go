@@ -0,0 +1,421 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apply implements butlerctl apply for manifest directories.
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/policy"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/butlerdotdev/butler/internal/ctl/queue"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// FieldManager is the server-side apply field manager used by butlerctl
+const FieldManager = "butlerctl"
+
+// ApplyOptions holds options for the apply command.
+type ApplyOptions struct {
+	// Sources
+	Filenames []string
+	Recursive bool
+
+	// Namespace for namespaced manifests that do not set one
+	Namespace string
+
+	// Behavior
+	Prune  bool
+	DryRun bool
+	Yes    bool
+
+	// Policy controls platform policy enforcement
+	Policy policy.Options
+
+	// Output
+	Output io.Writer
+	Logger *log.Logger
+}
+
+// DefaultApplyOptions returns ApplyOptions with sensible defaults.
+func DefaultApplyOptions(logger *log.Logger) *ApplyOptions {
+	return &ApplyOptions{
+		Output: os.Stdout,
+		Logger: logger,
+	}
+}
+
+// Validate checks that the options are usable.
+func (o *ApplyOptions) Validate() error {
+	if len(o.Filenames) == 0 {
+		return fmt.Errorf("at least one file or directory is required (-f)")
+	}
+	return nil
+}
+
+// NewApplyCmd creates the apply command.
+func NewApplyCmd(logger *log.Logger) *cobra.Command {
+	opts := DefaultApplyOptions(logger)
+
+	cmd := &cobra.Command{
+		Use:   "apply -f PATH",
+		Short: "Apply TenantCluster and Team manifests from files or directories",
+		Long: `Apply Butler manifests from files or a directory tree.
+
+Supported kinds are applied in dependency order, regardless of file layout:
+  Namespace → Team → ResourceQuota → ProviderConfig → TenantCluster
+
+Each resource is applied with server-side apply, so re-running apply on an
+unchanged directory is a no-op. A per-file summary is printed at the end and
+the command fails if any resource failed.
+
+${VAR} and ${VAR:-fallback} references are replaced from the environment
+before parsing; a reference to an unset variable without a fallback fails
+the file. Write $${VAR} for a literal ${VAR}.
+
+With --prune, TenantClusters that exist in the namespaces of the applied
+TenantClusters but are absent from the manifests are deleted. This makes the
+directory the source of truth for the fleet. Pruning is skipped if any
+resource failed, and refused if the manifests hold no TenantCluster.
+
+Examples:
+  # Apply a single file
+  butlerctl apply -f clusters/payments.yaml
+
+  # Apply a directory tree
+  butlerctl apply -R -f fleet/
+
+  # Preview changes (server-side dry run)
+  butlerctl apply -R -f fleet/ --dry-run
+
+  # Make the directory authoritative, deleting clusters not in it
+  butlerctl apply -R -f fleet/ --prune`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runApply(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Filenames, "filename", "f", nil, "Manifest file or directory (repeatable)")
+	cmd.Flags().BoolVarP(&opts.Recursive, "recursive", "R", false, "Process directories recursively")
+	cmd.Flags().StringVarP(&opts.Namespace, "namespace", "n", "", "Namespace for TenantClusters that do not set one (default: butler-tenants)")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete TenantClusters absent from the manifests")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Send requests as server-side dry runs")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt for --prune")
+	policy.AddFlags(cmd, &opts.Policy)
+
+	queue.Enable(cmd, logger)
+
+	return cmd
+}
+
+// result is one row of the apply summary
+type result struct {
+	file   string
+	kind   string
+	name   string
+	action string
+	err    error
+}
+
+// runApply executes the apply operation.
+func runApply(ctx context.Context, opts *ApplyOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	files, err := collectFiles(opts.Filenames, opts.Recursive)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no manifest files found (use -R to descend into subdirectories)")
+	}
+
+	manifests := loadManifests(files)
+	if len(manifests) == 0 {
+		opts.Logger.Warn("no resources found", "files", len(files))
+		return nil
+	}
+	sortManifests(manifests)
+
+	// Prune scope comes from the applied TenantClusters; without any, every
+	// cluster in the default namespace would look absent
+	if opts.Prune && !hasTenantCluster(manifests) {
+		return fmt.Errorf("--prune requires at least one TenantCluster in the manifests")
+	}
+
+	if err := cluster.RequireManagementCluster(ctx); err != nil {
+		return err
+	}
+
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	platformCfg, err := platform.Load(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	defaultNS, _ := (&cluster.NamespaceFlags{Namespace: opts.Namespace}).ResolveNamespace()
+
+	// Apply in dependency order, recording every outcome
+	results := make([]result, 0, len(manifests))
+	applied := map[string]bool{}
+	pruneNamespaces := map[string]bool{}
+	for _, m := range manifests {
+		r := result{file: m.file, kind: m.obj.GetKind(), name: m.obj.GetName(), err: m.err}
+		if r.err == nil {
+			if m.info.namespaced && m.obj.GetNamespace() == "" {
+				if m.obj.GetKind() == "ProviderConfig" {
+					m.obj.SetNamespace(cluster.ButlerSystemNamespace)
+				} else {
+					m.obj.SetNamespace(defaultNS)
+				}
+			}
+			r.action, r.err = applyManifest(ctx, c, opts, platformCfg, m)
+		}
+		if r.err == nil && m.obj.GetKind() == "TenantCluster" {
+			applied[m.key()] = true
+			pruneNamespaces[m.obj.GetNamespace()] = true
+		}
+		results = append(results, r)
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.err != nil {
+			failed++
+		}
+	}
+
+	if opts.Prune {
+		if failed > 0 {
+			opts.Logger.Warn("skipping prune because some resources failed to apply", "failed", failed)
+		} else {
+			pruned, err := prune(ctx, c, opts, applied, pruneNamespaces)
+			if err != nil {
+				return err
+			}
+			for _, r := range pruned {
+				if r.err != nil {
+					failed++
+				}
+			}
+			results = append(results, pruned...)
+		}
+	}
+
+	if err := printSummary(opts.Output, results); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d resources failed", failed, len(results))
+	}
+	return nil
+}
+
+// applyManifest server-side applies a single manifest and returns the action taken.
+func applyManifest(ctx context.Context, c *client.Client, opts *ApplyOptions, cfg *platform.Config, m *manifest) (string, error) {
+	var ri dynamic.ResourceInterface = c.Dynamic.Resource(m.info.gvr)
+	if m.info.namespaced {
+		ri = c.Dynamic.Resource(m.info.gvr).Namespace(m.obj.GetNamespace())
+	}
+
+	existing, err := ri.Get(ctx, m.obj.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		existing = nil
+	} else if err != nil {
+		return "", fmt.Errorf("getting current state: %w", err)
+	}
+
+	if m.obj.GetKind() == "TenantCluster" {
+		input := policy.Input{Operation: policy.OperationCreate, Object: m.obj.Object}
+		if existing == nil {
+			if err := cfg.Conventions.Check(m.obj.GetName(), m.obj.GetLabels(), m.obj.GetAnnotations()); err != nil {
+				return "", err
+			}
+		} else {
+			input.Operation = policy.OperationUpdate
+			input.OldObject = existing.Object
+		}
+		if err := cluster.CheckAPIServerSettings(&cfg.APIServer, m.obj); err != nil {
+			return "", err
+		}
+		if err := policy.Enforce(ctx, c, opts.Logger, opts.Policy, input); err != nil {
+			return "", err
+		}
+	}
+
+	data, err := json.Marshal(m.obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("marshaling: %w", err)
+	}
+
+	force := true
+	// Fields the served schema doesn't define fail the apply instead of
+	// being dropped
+	patchOpts := metav1.PatchOptions{FieldManager: FieldManager, Force: &force, FieldValidation: metav1.FieldValidationStrict}
+	if opts.DryRun {
+		patchOpts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	updated, err := ri.Patch(ctx, m.obj.GetName(), types.ApplyPatchType, data, patchOpts)
+	if err != nil {
+		return "", err
+	}
+
+	action := "configured"
+	switch {
+	case existing == nil:
+		action = "created"
+	case updated.GetResourceVersion() == existing.GetResourceVersion():
+		action = "unchanged"
+	}
+	if opts.DryRun {
+		action += " (dry run)"
+	}
+	opts.Logger.Debug("applied", "kind", m.obj.GetKind(), "name", m.key(), "action", action)
+	return action, nil
+}
+
+// hasTenantCluster reports whether any manifest parsed as a TenantCluster
+func hasTenantCluster(manifests []*manifest) bool {
+	for _, m := range manifests {
+		if m.err == nil && m.obj.GetKind() == "TenantCluster" {
+			return true
+		}
+	}
+	return false
+}
+
+// prune deletes TenantClusters in the given namespaces that were not applied.
+func prune(ctx context.Context, c *client.Client, opts *ApplyOptions, applied map[string]bool, namespaces map[string]bool) ([]result, error) {
+	var candidates []unstructured.Unstructured
+	for ns := range namespaces {
+		list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("listing TenantClusters in namespace %s: %w", ns, err)
+		}
+		for _, tc := range list.Items {
+			if !applied[tc.GetNamespace()+"/"+tc.GetName()] {
+				candidates = append(candidates, tc)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].GetNamespace()+"/"+candidates[i].GetName() < candidates[j].GetNamespace()+"/"+candidates[j].GetName()
+	})
+
+	if !opts.DryRun && !opts.Yes {
+		if err := confirmPrune(candidates); err != nil {
+			return nil, err
+		}
+	}
+
+	deleteOpts := metav1.DeleteOptions{}
+	if opts.DryRun {
+		deleteOpts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	results := make([]result, 0, len(candidates))
+	for _, tc := range candidates {
+		r := result{file: "-", kind: "TenantCluster", name: tc.GetName(), action: "pruned"}
+		if opts.DryRun {
+			r.action = "pruned (dry run)"
+		}
+
+		r.err = policy.Enforce(ctx, c, opts.Logger, opts.Policy, policy.Input{
+			Operation: policy.OperationDelete,
+			Object:    tc.Object,
+		})
+		if r.err == nil {
+			r.err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(tc.GetNamespace()).Delete(ctx, tc.GetName(), deleteOpts)
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// confirmPrune asks the user to confirm deleting the pruned clusters.
+func confirmPrune(candidates []unstructured.Unstructured) error {
+	fmt.Fprintf(os.Stderr, "\n%s The following TenantClusters are not in the manifests and will be DESTROYED:\n", output.Danger("WARNING:"))
+	for _, tc := range candidates {
+		fmt.Fprintf(os.Stderr, "  • %s/%s\n", tc.GetNamespace(), tc.GetName())
+	}
+	if err := prompt.ConfirmExact("\nType 'yes' to continue: ", "yes"); err != nil {
+		return fmt.Errorf("prune cancelled: %w", err)
+	}
+	return nil
+}
+
+// printSummary writes the per-resource outcome table and any errors.
+func printSummary(w io.Writer, results []result) error {
+	table := output.NewTable(w, "FILE", "KIND", "NAME", "RESULT")
+	for _, r := range results {
+		action := output.Success(r.action)
+		if r.err != nil {
+			action = output.Danger("failed")
+		}
+		table.AddRow(r.file, orDash(r.kind), orDash(r.name), action)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, fmt.Sprintf("  %s (%s %s): %v", r.file, orDash(r.kind), orDash(r.name), r.err))
+		}
+	}
+	if len(errs) > 0 {
+		fmt.Fprintf(w, "\nErrors:\n%s\n", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// orDash returns "-" for empty strings.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
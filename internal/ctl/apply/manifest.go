@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/envsubst"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// kindInfo describes how a supported kind is applied
+type kindInfo struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+
+	// order controls dependency ordering; lower values are applied first
+	order int
+}
+
+// supportedKinds lists the kinds apply understands, in dependency order:
+// namespaces and teams first, then quotas, providers and finally clusters.
+var supportedKinds = map[string]kindInfo{
+	"Namespace":      {gvr: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, order: 0},
+	"Team":           {gvr: client.TeamGVR, order: 1},
+	"ResourceQuota":  {gvr: schema.GroupVersionResource{Version: "v1", Resource: "resourcequotas"}, namespaced: true, order: 2},
+	"ProviderConfig": {gvr: client.ProviderConfigGVR, namespaced: true, order: 3},
+	"TenantCluster":  {gvr: client.TenantClusterGVR, namespaced: true, order: 4},
+}
+
+// manifest is a single resource read from a file
+type manifest struct {
+	file string
+	obj  *unstructured.Unstructured
+	info kindInfo
+
+	// err is set when the document could not be parsed or is unsupported
+	err error
+}
+
+// key identifies a manifest in the summary and prune set
+func (m *manifest) key() string {
+	return m.obj.GetNamespace() + "/" + m.obj.GetName()
+}
+
+// collectFiles expands the -f arguments into a sorted list of manifest files
+func collectFiles(paths []string, recursive bool) ([]string, error) {
+	var files []string
+
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		if !fi.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				// Only descend into subdirectories with -R
+				if p != path && !recursive {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if isManifestFile(p) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("walking %s: %w", path, err)
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// isManifestFile reports whether a file looks like a Kubernetes manifest
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// loadManifests reads every document in the given files, expanding ${VAR}
+// references first.
+// Documents that fail to parse are returned with err set so they show up in
+// the per-file summary instead of aborting the whole run.
+func loadManifests(files []string) []*manifest {
+	var manifests []*manifest
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err == nil {
+			data, err = envsubst.Expand(data)
+		}
+		if err != nil {
+			manifests = append(manifests, &manifest{file: file, obj: &unstructured.Unstructured{}, err: err})
+			continue
+		}
+
+		decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+		for {
+			var obj map[string]interface{}
+			if err := decoder.Decode(&obj); err != nil {
+				if err != io.EOF {
+					manifests = append(manifests, &manifest{file: file, obj: &unstructured.Unstructured{}, err: fmt.Errorf("parsing: %w", err)})
+				}
+				break
+			}
+			if len(obj) == 0 {
+				continue
+			}
+
+			m := &manifest{file: file, obj: &unstructured.Unstructured{Object: obj}}
+			m.info, m.err = resolveKind(m.obj)
+			manifests = append(manifests, m)
+		}
+	}
+
+	return manifests
+}
+
+// resolveKind maps a manifest to its supported kind
+func resolveKind(obj *unstructured.Unstructured) (kindInfo, error) {
+	info, ok := supportedKinds[obj.GetKind()]
+	if !ok {
+		return kindInfo{}, fmt.Errorf("unsupported kind %q", obj.GetKind())
+	}
+
+	gv, err := schema.ParseGroupVersion(obj.GetAPIVersion())
+	if err != nil {
+		return kindInfo{}, fmt.Errorf("invalid apiVersion %q: %w", obj.GetAPIVersion(), err)
+	}
+	if gv.Group != info.gvr.Group {
+		return kindInfo{}, fmt.Errorf("unexpected apiVersion %q for kind %s", obj.GetAPIVersion(), obj.GetKind())
+	}
+
+	if obj.GetName() == "" {
+		return kindInfo{}, fmt.Errorf("%s is missing metadata.name", obj.GetKind())
+	}
+
+	return info, nil
+}
+
+// sortManifests orders manifests so dependencies are applied first.
+// Within a kind, file order is preserved.
+func sortManifests(manifests []*manifest) {
+	sort.SliceStable(manifests, func(i, j int) bool {
+		return manifests[i].info.order < manifests[j].info.order
+	})
+}
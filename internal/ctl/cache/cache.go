@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache implements butlerctl commands for the local cache.
+package cache
+
+import (
+	"github.com/butlerdotdev/butler/internal/common/kubecache"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+)
+
+// NewCacheCmd creates the cache parent command
+func NewCacheCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the local cache",
+		Long: `Manage the local butlerctl cache.
+
+Kubeconfigs fetched by 'butlerctl cluster kubeconfig' are kept encrypted
+under ~/.butler/cache/kubeconfigs/ so repeated calls don't round-trip to the
+management cluster. Entries are reused for 15 minutes by default; set
+BUTLER_KUBECONFIG_CACHE_TTL to change this ("0" disables the cache).
+
+Commands that require a management cluster also remember, for 5 minutes per
+kubeconfig context, that the cluster passed detection. Pass --no-cache to
+any command to bypass both caches.
+
+Commands:
+  clear  Remove all cached kubeconfigs and cluster checks
+
+Examples:
+  # Drop all cached credentials
+  butlerctl cache clear`,
+	}
+
+	cmd.AddCommand(newClearCmd(logger))
+
+	return cmd
+}
+
+// newClearCmd creates the cache clear command
+func newClearCmd(logger *log.Logger) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear",
+		Short: "Remove all cached kubeconfigs and cluster checks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runClear(logger)
+		},
+	}
+}
+
+func runClear(logger *log.Logger) error {
+	removed, err := kubecache.Clear()
+	if err != nil {
+		return err
+	}
+	logger.Success("kubeconfig cache cleared", "entries", removed)
+	return nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// accessNamespace holds the ServiceAccounts backing time-boxed credentials
+	// inside each tenant cluster
+	accessNamespace = "butler-access"
+
+	// minCredentialTTL is the shortest token lifetime the API server accepts
+	minCredentialTTL = 10 * time.Minute
+)
+
+// accessRoles maps --role values to tenant ClusterRoles
+var accessRoles = map[string]string{
+	"admin": "cluster-admin",
+	"edit":  "edit",
+	"view":  "view",
+}
+
+// scopedCredential is a minted time-boxed kubeconfig
+type scopedCredential struct {
+	kubeconfig     []byte
+	serviceAccount string
+	expiresAt      time.Time
+}
+
+// mintScopedKubeconfig uses the tenant admin kubeconfig to issue a token for a
+// role-bound ServiceAccount valid for ttl, and returns a kubeconfig using it.
+func mintScopedKubeconfig(ctx context.Context, clusterName string, adminKubeconfig []byte, role string, ttl time.Duration) (*scopedCredential, error) {
+	clusterRole, ok := accessRoles[role]
+	if !ok {
+		return nil, fmt.Errorf("unknown role %q (valid: admin, edit, view)", role)
+	}
+	if ttl < minCredentialTTL {
+		return nil, fmt.Errorf("--expires must be at least %s", minCredentialTTL)
+	}
+
+	tenant, err := client.NewFromBytes(adminKubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to tenant cluster: %w", err)
+	}
+
+	saName := "butler-access-" + role
+
+	// Namespace, ServiceAccount and binding are shared by all credentials of a role
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: accessNamespace}}
+	if _, err := tenant.Clientset.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("creating namespace %s: %w", accessNamespace, err)
+	}
+
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: saName, Namespace: accessNamespace}}
+	if _, err := tenant.Clientset.CoreV1().ServiceAccounts(accessNamespace).Create(ctx, sa, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("creating ServiceAccount %s: %w", saName, err)
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: saName},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      saName,
+			Namespace: accessNamespace,
+		}},
+	}
+	if _, err := tenant.Clientset.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("creating ClusterRoleBinding %s: %w", saName, err)
+	}
+
+	seconds := int64(ttl.Seconds())
+	token, err := tenant.Clientset.CoreV1().ServiceAccounts(accessNamespace).CreateToken(ctx, saName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &seconds},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("requesting token: %w", err)
+	}
+
+	// Reuse the admin kubeconfig's cluster entry with the token as the user
+	adminConfig, err := clientcmd.Load(adminKubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("parsing tenant kubeconfig: %w", err)
+	}
+	var tenantCluster *api.Cluster
+	for _, cluster := range adminConfig.Clusters {
+		tenantCluster = cluster
+		break
+	}
+	if tenantCluster == nil {
+		return nil, fmt.Errorf("tenant kubeconfig contains no clusters")
+	}
+
+	userName := clusterName + "-" + role
+	config := api.NewConfig()
+	config.Clusters[clusterName] = tenantCluster
+	config.AuthInfos[userName] = &api.AuthInfo{Token: token.Status.Token}
+	config.Contexts[clusterName] = &api.Context{Cluster: clusterName, AuthInfo: userName}
+	config.CurrentContext = clusterName
+
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, fmt.Errorf("writing kubeconfig: %w", err)
+	}
+
+	return &scopedCredential{
+		kubeconfig:     data,
+		serviceAccount: accessNamespace + "/" + saName,
+		expiresAt:      token.Status.ExpirationTimestamp.Time,
+	}, nil
+}
+
+// currentUser returns the management cluster identity of the caller
+func currentUser(ctx context.Context, c *client.Client) string {
+	review, err := c.Clientset.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil || review.Status.UserInfo.Username == "" {
+		return "unknown"
+	}
+	return review.Status.UserInfo.Username
+}
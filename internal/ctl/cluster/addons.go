@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Choices for --cni and --default-storage-class
+var (
+	cniChoices          = []string{"cilium", "calico"}
+	storageClassChoices = []string{"longhorn", StorageProviderCSI}
+)
+
+// StorageProviderCSI selects the infrastructure provider's CSI driver as
+// the default StorageClass
+const StorageProviderCSI = "provider-csi"
+
+// providerCSIAddons are the AddonDefinitions holding each provider's CSI
+// driver
+var providerCSIAddons = map[string]string{
+	"harvester": "harvester-csi-driver",
+	"nutanix":   "nutanix-csi-storage",
+	"proxmox":   "proxmox-csi-plugin",
+}
+
+// validateAddonChoices checks --cni and --default-storage-class against
+// the values the CLI knows; platform support is checked by resolveAddons.
+func (o *CreateOptions) validateAddonChoices() error {
+	if o.CNI != "" && !slices.Contains(cniChoices, o.CNI) {
+		return fmt.Errorf("invalid --cni %q: must be one of %s", o.CNI, strings.Join(cniChoices, ", "))
+	}
+	if o.DefaultStorageClass != "" && !slices.Contains(storageClassChoices, o.DefaultStorageClass) {
+		return fmt.Errorf("invalid --default-storage-class %q: must be one of %s", o.DefaultStorageClass, strings.Join(storageClassChoices, ", "))
+	}
+	return nil
+}
+
+// resolveAddons checks that the platform offers the chosen CNI and storage
+// addons and records the chart versions to request.
+func (o *CreateOptions) resolveAddons(ctx context.Context, c *client.Client) error {
+	if o.CNI == "" && o.DefaultStorageClass == "" {
+		return nil
+	}
+	catalog, err := platform.LoadAddons(ctx, c)
+	if err != nil {
+		return err
+	}
+	o.addonVersions = map[string]string{}
+
+	if o.CNI != "" {
+		version, err := catalog.Version("cni", o.CNI)
+		if err != nil {
+			return fmt.Errorf("--cni %s: %w", o.CNI, err)
+		}
+		o.addonVersions["cni"] = version
+	}
+
+	if o.DefaultStorageClass != "" {
+		addon := o.DefaultStorageClass
+		if addon == StorageProviderCSI {
+			pc, err := c.GetProviderConfig(ctx, ButlerSystemNamespace, o.Provider)
+			if err != nil {
+				return fmt.Errorf("getting ProviderConfig %s: %w", o.Provider, err)
+			}
+			providerType, _, _ := unstructured.NestedString(pc.Object, "spec", "provider")
+			if addon = providerCSIAddons[providerType]; addon == "" {
+				return fmt.Errorf("--default-storage-class %s is not supported for %s providers", StorageProviderCSI, orDefault(providerType, "unknown"))
+			}
+		}
+		version, err := catalog.Version("storage", addon)
+		if err != nil {
+			return fmt.Errorf("--default-storage-class %s: %w", o.DefaultStorageClass, err)
+		}
+		o.addonVersions["storage"] = version
+	}
+	return nil
+}
+
+// buildAddons returns spec.addons for the chosen addon options, or nil
+// when the controller defaults apply
+func buildAddons(opts *CreateOptions) map[string]interface{} {
+	addons := map[string]interface{}{}
+	if opts.CNI != "" {
+		addons["cni"] = map[string]interface{}{
+			"provider": opts.CNI,
+			"version":  opts.addonVersions["cni"],
+		}
+	}
+	if opts.DefaultStorageClass != "" {
+		addons["storage"] = map[string]interface{}{
+			"provider": opts.DefaultStorageClass,
+			"version":  opts.addonVersions["storage"],
+		}
+	}
+	if opts.DisableMetalLB {
+		addons["loadBalancer"] = map[string]interface{}{
+			"enabled": false,
+		}
+	}
+	if len(addons) == 0 {
+		return nil
+	}
+	return addons
+}
+
+// loadBalancerEnabled reports whether the cluster gets the MetalLB addon
+func loadBalancerEnabled(tc *unstructured.Unstructured) bool {
+	enabled, found, _ := unstructured.NestedBool(tc.Object, "spec", "addons", "loadBalancer", "enabled")
+	return !found || enabled
+}
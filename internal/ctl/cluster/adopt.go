@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	controlPlaneRoleLabel = "node-role.kubernetes.io/control-plane"
+
+	// adoptControllerVersion is the first butler-controller release that
+	// leaves adopted TenantClusters unprovisioned and fills in their status
+	// from the admin kubeconfig Secret
+	adoptControllerVersion = "v0.4.0"
+)
+
+// kubernetesVersionPattern extracts vX.Y.Z from distribution versions such
+// as v1.30.2+k3s1 or v1.29.4-eks-036c24b
+var kubernetesVersionPattern = regexp.MustCompile(`^v\d+\.\d+\.\d+`)
+
+type adoptOptions struct {
+	namespace            string
+	kubeconfig           string
+	managementKubeconfig string
+	owner                string
+	contact              string
+}
+
+// newAdoptCmd creates the cluster adopt command
+func newAdoptCmd(logger *log.Logger) *cobra.Command {
+	opts := &adoptOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "adopt NAME --kubeconfig FILE",
+		Short: "Register an existing cluster as a tenant cluster",
+		Long: `Register a Kubernetes cluster created outside Butler as a tenant cluster.
+
+The cluster's admin kubeconfig is stored in the <name>-admin-kubeconfig
+Secret and a TenantCluster marked with the butler.butlerlabs.dev/adopted
+annotation is created for it. Butler does not manage an adopted cluster's
+machines, so 'cluster scale' is refused. Kubeconfig distribution, access,
+addons, health and export work as for any other cluster.
+
+The Kubernetes version and worker count are read from the cluster itself.
+Destroying an adopted cluster only unregisters it from Butler.
+
+Adoption needs butler-controller v0.4.0 or newer, which leaves
+adopted clusters unprovisioned and records their status. Older controllers
+would provision the TenantCluster as a new cluster, so they are refused.
+
+Examples:
+  # Adopt a cluster from its kubeconfig
+  butlerctl cluster adopt legacy-prod --kubeconfig legacy-prod.yaml
+
+  # Adopt into a team namespace and record its owner
+  butlerctl cluster adopt legacy-prod --kubeconfig legacy-prod.yaml -n team-payments --owner payments`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAdopt(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", DefaultTenantNamespace, "namespace for the TenantCluster")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "admin kubeconfig of the cluster to adopt (required)")
+	cmd.Flags().StringVar(&opts.managementKubeconfig, "management-kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.owner, "owner", "", "Team or user (email) that owns the cluster")
+	cmd.Flags().StringVar(&opts.contact, "contact", "", "how to reach the owner (e.g. Slack channel or email)")
+	_ = cmd.MarkFlagRequired("kubeconfig")
+
+	return cmd
+}
+
+func runAdopt(ctx context.Context, logger *log.Logger, name string, opts *adoptOptions) error {
+	if !isValidClusterName(name) {
+		return fmt.Errorf("invalid cluster name %q: must be lowercase alphanumeric with hyphens, 1-63 characters", name)
+	}
+
+	kubeconfig, err := os.ReadFile(opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("reading kubeconfig: %w", err)
+	}
+	tenant, err := client.NewFromBytes(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("inspecting cluster", "server", tenant.Config.Host)
+	version, err := tenant.Clientset.Discovery().ServerVersion()
+	if err != nil {
+		return fmt.Errorf("connecting to cluster at %s: %w", tenant.Config.Host, err)
+	}
+	k8sVersion := kubernetesVersionPattern.FindString(version.GitVersion)
+	if k8sVersion == "" {
+		return fmt.Errorf("unrecognized Kubernetes version %q", version.GitVersion)
+	}
+
+	nodes, err := tenant.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing nodes: %w", err)
+	}
+	var workers int64
+	for _, node := range nodes.Items {
+		if _, cp := node.Labels[controlPlaneRoleLabel]; !cp {
+			workers++
+		}
+	}
+
+	var c *client.Client
+	if opts.managementKubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.managementKubeconfig)
+	} else {
+		if err := RequireManagementCluster(ctx); err != nil {
+			return err
+		}
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+	if err := platform.RequireController(ctx, c, platform.ControllerDeployment, adoptControllerVersion, "cluster adopt"); err != nil {
+		return err
+	}
+
+	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return fmt.Errorf("TenantCluster %q already exists in namespace %q", name, opts.namespace)
+	}
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("checking for existing cluster: %w", err)
+	}
+
+	secretName := name + "-admin-kubeconfig"
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        secretName,
+			Namespace:   opts.namespace,
+			Annotations: map[string]string{lifecycle.AdoptedAnnotation: "true"},
+		},
+		Data: map[string][]byte{"admin.conf": kubeconfig},
+	}
+	if _, err := c.Clientset.CoreV1().Secrets(opts.namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("storing kubeconfig: %w", err)
+	}
+
+	annotations := map[string]string{lifecycle.AdoptedAnnotation: "true"}
+	if opts.owner != "" {
+		annotations[lifecycle.OwnerAnnotation] = opts.owner
+	}
+	if opts.contact != "" {
+		annotations[lifecycle.ContactAnnotation] = opts.contact
+	}
+
+	// The CRD requires at least one worker; control-plane-only clusters
+	// record one so the TenantCluster validates
+	replicas := workers
+	if replicas < MinWorkers {
+		replicas = MinWorkers
+	}
+
+	tc := &unstructured.Unstructured{}
+	tc.SetAPIVersion("butler.butlerlabs.dev/v1alpha1")
+	tc.SetKind("TenantCluster")
+	tc.SetName(name)
+	tc.SetNamespace(opts.namespace)
+	tc.SetAnnotations(annotations)
+	tc.Object["spec"] = map[string]interface{}{
+		"kubernetesVersion": k8sVersion,
+		"workers": map[string]interface{}{
+			"replicas": replicas,
+		},
+	}
+
+	logger.Info("creating TenantCluster", "name", name, "namespace", opts.namespace)
+	if _, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.namespace).Create(ctx, tc, metav1.CreateOptions{}); err != nil {
+		// Don't leave the kubeconfig behind without its cluster
+		_ = c.Clientset.CoreV1().Secrets(opts.namespace).Delete(context.WithoutCancel(ctx), secretName, metav1.DeleteOptions{})
+		return fmt.Errorf("creating TenantCluster: %w", err)
+	}
+
+	logger.Success("cluster adopted", "name", name, "version", k8sVersion, "workers", workers)
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  View cluster:   butlerctl cluster get %s -n %s\n", name, opts.namespace)
+	fmt.Printf("  Get kubeconfig: butlerctl cluster kubeconfig %s -n %s --merge\n", name, opts.namespace)
+	return nil
+}
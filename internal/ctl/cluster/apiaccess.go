@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/netcheck"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// API access modes accepted by cluster create --api-access
+const (
+	APIAccessLoadBalancer = "lb"
+	APIAccessVIP          = "vip"
+	APIAccessNodePort     = "nodeport"
+	APIAccessPrivate      = "private"
+)
+
+// apiAccessServiceTypes maps each API access mode to the Service type of
+// the hosted control plane endpoint
+var apiAccessServiceTypes = map[string]string{
+	APIAccessLoadBalancer: "LoadBalancer",
+	APIAccessVIP:          "LoadBalancer",
+	APIAccessNodePort:     "NodePort",
+	APIAccessPrivate:      "ClusterIP",
+}
+
+// apiAccessModes lists the modes in help order
+var apiAccessModes = []string{APIAccessLoadBalancer, APIAccessVIP, APIAccessNodePort, APIAccessPrivate}
+
+// DefaultTunnelPort is the local port private cluster kubeconfigs expect
+// the API server tunnel on
+const DefaultTunnelPort = 16443
+
+// validateAPIAccess checks --api-access and --api-vip.
+func (o *CreateOptions) validateAPIAccess() error {
+	if o.APIAccess == "" {
+		if o.APIVIP != "" {
+			return fmt.Errorf("--api-vip requires --api-access %s", APIAccessVIP)
+		}
+		return nil
+	}
+	if _, ok := apiAccessServiceTypes[o.APIAccess]; !ok {
+		return fmt.Errorf("invalid --api-access %q: must be one of %s", o.APIAccess, strings.Join(apiAccessModes, ", "))
+	}
+	if o.APIAccess != APIAccessVIP {
+		if o.APIVIP != "" {
+			return fmt.Errorf("--api-vip requires --api-access %s", APIAccessVIP)
+		}
+		return nil
+	}
+	if o.APIVIP == "" {
+		return fmt.Errorf("--api-access %s requires --api-vip", APIAccessVIP)
+	}
+	if _, err := netcheck.ParseIP(o.APIVIP); err != nil {
+		return fmt.Errorf("--api-vip: %w", err)
+	}
+	return nil
+}
+
+// applyAPIAccess sets how the hosted API server is exposed on the
+// controlPlane section. A fixed VIP and the endpoint's DNS name are added
+// to the certificate SANs.
+func (o *CreateOptions) applyAPIAccess(controlPlane map[string]interface{}) {
+	var sans []interface{}
+	if o.APIAccess != "" {
+		controlPlane["serviceType"] = apiAccessServiceTypes[o.APIAccess]
+	}
+	if o.APIAccess == APIAccessVIP {
+		controlPlane["loadBalancerIP"] = o.APIVIP
+		sans = append(sans, o.APIVIP)
+	}
+	if o.apiHostname != "" {
+		sans = append(sans, o.apiHostname)
+	}
+	if len(sans) > 0 {
+		controlPlane["certSANs"] = sans
+	}
+}
+
+// isPrivateCluster reports whether a cluster's API server is only exposed
+// inside the management cluster
+func isPrivateCluster(tc *unstructured.Unstructured) bool {
+	return GetNestedString(tc.Object, "spec", "controlPlane", "serviceType") == apiAccessServiceTypes[APIAccessPrivate]
+}
+
+// tunnelCommand is the port-forward through the management cluster that
+// private cluster kubeconfigs rely on. The hosted control plane Service is
+// named after the cluster.
+func tunnelCommand(tenantNamespace, name string, port int) string {
+	return fmt.Sprintf("kubectl port-forward -n %s svc/%s %d:6443", tenantNamespace, name, port)
+}
+
+// routeThroughTunnel points every cluster entry of a kubeconfig at the
+// local tunnel port. The original host is kept as the TLS server name so
+// the API server certificate still verifies.
+func routeThroughTunnel(kubeconfig []byte, port int) ([]byte, error) {
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+	for name, cluster := range cfg.Clusters {
+		u, err := url.Parse(cluster.Server)
+		if err != nil {
+			return nil, fmt.Errorf("parsing server of cluster %s: %w", name, err)
+		}
+		if cluster.TLSServerName == "" {
+			cluster.TLSServerName = u.Hostname()
+		}
+		u.Host = net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+		cluster.Server = u.String()
+	}
+	return clientcmd.Write(*cfg)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// reservedAPIServerArgs are API server flags Butler sets itself, or that
+// have their own create flags. They are refused even if the platform
+// allowlist matches them.
+var reservedAPIServerArgs = []string{
+	"advertise-address", "anonymous-auth", "authorization-", "client-ca-file",
+	"etcd-", "feature-gates", "oidc-", "secure-port", "service-account-",
+	"service-cluster-ip-range", "tls-", "token-auth-file",
+}
+
+// APIServerOptions passes feature gates and extra flags through to a
+// cluster's API server, within the platform's apiServer allowlist.
+type APIServerOptions struct {
+	// FeatureGates maps gate names to "true" or "false"
+	FeatureGates map[string]string
+
+	// ExtraArgs are KEY=VALUE API server flags
+	ExtraArgs []string
+}
+
+// addAPIServerFlags adds --feature-gates and --apiserver-extra-arg.
+func addAPIServerFlags(cmd *cobra.Command, o *APIServerOptions) {
+	cmd.Flags().StringToStringVar(&o.FeatureGates, "feature-gates", nil, "API server feature gates (NAME=true|false, comma-separated or repeated; must be allowed by the platform)")
+	cmd.Flags().StringArrayVar(&o.ExtraArgs, "apiserver-extra-arg", nil, "Extra API server flag (KEY=VALUE, repeatable; must be allowed by the platform)")
+}
+
+// IsSet reports whether any API server setting was given.
+func (o *APIServerOptions) IsSet() bool {
+	return len(o.FeatureGates) > 0 || len(o.ExtraArgs) > 0
+}
+
+// parse checks the syntax of the settings and returns them as the
+// controlPlane featureGates and apiServerExtraArgs maps.
+func (o *APIServerOptions) parse() (map[string]bool, map[string]string, error) {
+	gates := make(map[string]bool, len(o.FeatureGates))
+	for name, value := range o.FeatureGates {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil || name == "" {
+			return nil, nil, fmt.Errorf("invalid --feature-gates entry %s=%s: expected NAME=true|false", name, value)
+		}
+		gates[name] = enabled
+	}
+
+	args := make(map[string]string, len(o.ExtraArgs))
+	for _, arg := range o.ExtraArgs {
+		key, value, ok := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !ok || !kubeletArgPattern.MatchString(key) {
+			return nil, nil, fmt.Errorf("invalid --apiserver-extra-arg %q: expected KEY=VALUE", arg)
+		}
+		args[key] = value
+	}
+	return gates, args, nil
+}
+
+// Validate checks the syntax of the API server settings.
+func (o *APIServerOptions) Validate() error {
+	_, _, err := o.parse()
+	return err
+}
+
+// Check enforces the platform allowlist and Butler's reserved flags.
+func (o *APIServerOptions) Check(allowed *platform.APIServer) error {
+	gates, args, err := o.parse()
+	if err != nil {
+		return err
+	}
+	return checkAPIServerSettings(allowed, sortedKeys(gates), sortedKeys(args))
+}
+
+// checkAPIServerSettings checks feature gate and flag names against the
+// platform allowlist. Reserved flags are refused whatever it says.
+func checkAPIServerSettings(allowed *platform.APIServer, gates, args []string) error {
+	for _, name := range gates {
+		if err := allowed.CheckFeatureGate(name); err != nil {
+			return err
+		}
+	}
+	for _, name := range args {
+		for _, reserved := range reservedAPIServerArgs {
+			if name == reserved || (strings.HasSuffix(reserved, "-") && strings.HasPrefix(name, reserved)) {
+				return fmt.Errorf("API server flag --%s is managed by Butler and cannot be overridden", name)
+			}
+		}
+		if err := allowed.CheckExtraArg(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply adds the settings to the controlPlane section. Values are expected
+// to have passed Validate.
+func (o *APIServerOptions) apply(controlPlane map[string]interface{}) {
+	gates, args, err := o.parse()
+	if err != nil {
+		return
+	}
+	if len(gates) > 0 {
+		m := map[string]interface{}{}
+		for k, v := range gates {
+			m[k] = v
+		}
+		controlPlane["featureGates"] = m
+	}
+	if len(args) > 0 {
+		m := map[string]interface{}{}
+		for k, v := range args {
+			m[k] = v
+		}
+		controlPlane["apiServerExtraArgs"] = m
+	}
+}
+
+// summary describes the settings for the creation summary.
+func (o *APIServerOptions) summary() string {
+	gates, args, _ := o.parse()
+	var parts []string
+	for _, name := range sortedKeys(gates) {
+		parts = append(parts, fmt.Sprintf("%s=%t", name, gates[name]))
+	}
+	for _, name := range sortedKeys(args) {
+		parts = append(parts, "--"+name+"="+args[name])
+	}
+	return strings.Join(parts, " ")
+}
+
+// CheckAPIServerSettings checks a TenantCluster's feature gates and extra
+// API server flags against the platform allowlist.
+func CheckAPIServerSettings(allowed *platform.APIServer, tc *unstructured.Unstructured) error {
+	gates, args := currentAPIServerSettings(tc)
+	return checkAPIServerSettings(allowed, sortedKeys(gates), sortedKeys(args))
+}
+
+// currentAPIServerSettings reads a TenantCluster's feature gates and extra
+// API server flags.
+func currentAPIServerSettings(tc *unstructured.Unstructured) (map[string]interface{}, map[string]interface{}) {
+	gates, _, _ := unstructured.NestedMap(tc.Object, "spec", "controlPlane", "featureGates")
+	args, _, _ := unstructured.NestedMap(tc.Object, "spec", "controlPlane", "apiServerExtraArgs")
+	return gates, args
+}
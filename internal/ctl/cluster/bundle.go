@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/redact"
+	"github.com/butlerdotdev/butler/internal/common/secretcrypt"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// exportDoc is one YAML document of a cluster export
+type exportDoc struct {
+	// file names the document inside a --bundle directory
+	file string
+	data []byte
+}
+
+// clusterExport is everything exported for one TenantCluster
+type clusterExport struct {
+	tc   *unstructured.Unstructured
+	docs []exportDoc
+}
+
+// stream joins the documents into a single multi-document YAML file
+func (e *clusterExport) stream() []byte {
+	var buf bytes.Buffer
+	for i, doc := range e.docs {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(doc.data)
+	}
+	return buf.Bytes()
+}
+
+// collectExport builds the documents for a cluster: the TenantCluster, then
+// its addon HelmReleases and encrypted Secrets when requested.
+func collectExport(ctx context.Context, c *client.Client, enc *secretcrypt.Encrypter, tc *unstructured.Unstructured, opts *ExportOptions) (*clusterExport, error) {
+	cleaned := cleanForExport(tc, opts)
+	for _, field := range stripCredentials(cleaned) {
+		opts.Logger.Warn("removed credential from export", "cluster", tc.GetName(), "field", field)
+	}
+	if opts.ForRecreate {
+		if opts.tcSchema != nil {
+			stripDefaults(cleaned, opts.tcSchema)
+		}
+		diffs, err := verifyRoundTrip(ctx, c, tc, cleaned)
+		if err != nil {
+			return nil, err
+		}
+		for _, diff := range diffs {
+			opts.Logger.Warn("field does not round-trip", "cluster", tc.GetName(), "field", diff)
+		}
+		if len(diffs) == 0 {
+			opts.Logger.Success("export re-creates cleanly", "cluster", tc.GetName())
+		}
+	}
+
+	data, err := yaml.Marshal(cleaned)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling YAML for %s: %w", tc.GetName(), err)
+	}
+	export := &clusterExport{tc: tc, docs: []exportDoc{{file: "tenantcluster.yaml", data: data}}}
+	refs := secretRefsIn(tc.Object, tc.GetNamespace())
+
+	if opts.IncludeAddons {
+		releases, err := listAddonReleases(ctx, c, tc)
+		if err != nil {
+			return nil, err
+		}
+		for i := range releases {
+			hr := &releases[i]
+			cleaned := cleanResource(hr)
+			for _, field := range stripCredentials(cleaned) {
+				opts.Logger.Warn("removed credential from export", "helmRelease", hr.GetName(), "field", field)
+			}
+			for _, field := range sensitiveFields(cleaned["spec"], "spec") {
+				opts.Logger.Warn("exported HelmRelease holds a credential in plain text; consider valuesFrom", "helmRelease", hr.GetName(), "field", field)
+			}
+			data, err := yaml.Marshal(cleaned)
+			if err != nil {
+				return nil, fmt.Errorf("marshaling HelmRelease %s: %w", hr.GetName(), err)
+			}
+			export.docs = append(export.docs, exportDoc{file: "helmrelease-" + hr.GetName() + ".yaml", data: data})
+			refs = append(refs, secretRefsIn(hr.Object, hr.GetNamespace())...)
+		}
+	}
+
+	if enc != nil {
+		seen := map[string]bool{}
+		for _, ref := range refs {
+			seen[ref] = true
+		}
+		for _, ref := range sortedKeys(seen) {
+			namespace, name, _ := strings.Cut(ref, "/")
+			secret, err := c.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+			if errors.IsNotFound(err) {
+				opts.Logger.Warn("referenced Secret not found, skipping", "cluster", tc.GetName(), "secretRef", ref)
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("getting Secret %s: %w", ref, err)
+			}
+
+			encrypted, err := enc.Encrypt(ctx, secret)
+			if err != nil {
+				return nil, err
+			}
+			export.docs = append(export.docs, exportDoc{file: "secret-" + name + ".yaml", data: encrypted})
+		}
+	}
+
+	return export, nil
+}
+
+// listAddonReleases returns the Flux HelmReleases in the cluster's tenant
+// namespace. A management cluster without Flux has none.
+func listAddonReleases(ctx context.Context, c *client.Client, tc *unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	namespace := GetNestedString(tc.Object, "status", "tenantNamespace")
+	if namespace == "" {
+		namespace = tc.GetNamespace()
+	}
+	list, err := c.Dynamic.Resource(client.HelmReleaseGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing HelmReleases in %s: %w", namespace, err)
+	}
+	return list.Items, nil
+}
+
+// secretRefsIn returns the Secrets an object points at, as namespace/name:
+// any *SecretRef or credentialsRef field with a name, such as
+// status.kubeconfigSecretRef or a HelmRelease's spec.kubeConfig.secretRef,
+// and Secret entries in valuesFrom
+func secretRefsIn(obj map[string]interface{}, namespace string) []string {
+	var refs []string
+	var walk func(key string, v interface{})
+	walk = func(key string, v interface{}) {
+		switch val := v.(type) {
+		case map[string]interface{}:
+			name, _ := val["name"].(string)
+			lower := strings.ToLower(key)
+			isRef := strings.HasSuffix(lower, "secretref") || lower == "credentialsref" ||
+				(key == "valuesFrom" && val["kind"] == "Secret")
+			if isRef && name != "" {
+				ns, _ := val["namespace"].(string)
+				if ns == "" {
+					ns = namespace
+				}
+				refs = append(refs, ns+"/"+name)
+			}
+			for k, item := range val {
+				walk(k, item)
+			}
+		case []interface{}:
+			for _, item := range val {
+				walk(key, item)
+			}
+		}
+	}
+	for _, section := range []string{"spec", "status"} {
+		walk(section, obj[section])
+	}
+	return refs
+}
+
+// cleanResource strips server-managed fields and status from an exported
+// object other than the TenantCluster. The spec, including HelmRelease
+// values, is kept as it is.
+func cleanResource(obj *unstructured.Unstructured) map[string]interface{} {
+	meta := map[string]interface{}{
+		"name":      obj.GetName(),
+		"namespace": obj.GetNamespace(),
+	}
+	if labels := filterUserLabels(toInterfaceMap(obj.GetLabels())); len(labels) > 0 {
+		meta["labels"] = labels
+	}
+	if annotations := filterUserAnnotations(toInterfaceMap(obj.GetAnnotations())); len(annotations) > 0 {
+		meta["annotations"] = annotations
+	}
+
+	out := map[string]interface{}{
+		"apiVersion": obj.GetAPIVersion(),
+		"kind":       obj.GetKind(),
+		"metadata":   meta,
+	}
+	if spec, ok := obj.Object["spec"]; ok {
+		out["spec"] = spec
+	}
+	return out
+}
+
+// sensitiveFields returns the paths of non-empty strings under keys that
+// name a credential, such as spec.values.auth.password
+func sensitiveFields(v interface{}, path string) []string {
+	var fields []string
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(val) {
+			if str, ok := val[k].(string); ok {
+				if str != "" && redact.SensitiveKey(k) {
+					fields = append(fields, path+"."+k)
+				}
+				continue
+			}
+			fields = append(fields, sensitiveFields(val[k], path+"."+k)...)
+		}
+	case []interface{}:
+		for i, item := range val {
+			fields = append(fields, sensitiveFields(item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+	return fields
+}
+
+func toInterfaceMap(m map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+// writeBundle writes a cluster's documents to dir with a kustomization.yaml
+// listing them
+func writeBundle(dir string, export *clusterExport) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating directory %s: %w", dir, err)
+	}
+	var resources []string
+	for _, doc := range export.docs {
+		mode := os.FileMode(0644)
+		if strings.HasPrefix(doc.file, "secret-") {
+			mode = 0600
+		}
+		if err := os.WriteFile(filepath.Join(dir, doc.file), doc.data, mode); err != nil {
+			return fmt.Errorf("writing %s: %w", doc.file, err)
+		}
+		resources = append(resources, doc.file)
+	}
+	return writeKustomization(dir, resources)
+}
+
+// writeKustomization writes a kustomization.yaml with the given resources
+func writeKustomization(dir string, resources []string) error {
+	data, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	})
+	if err != nil {
+		return fmt.Errorf("encoding kustomization: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "kustomization.yaml"), data, 0644); err != nil {
+		return fmt.Errorf("writing kustomization.yaml: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// compute is an amount of worker compute
+type compute struct {
+	Workers  int64
+	CPU      int64
+	MemoryMB int64
+}
+
+func (r compute) add(o compute) compute {
+	return compute{Workers: r.Workers + o.Workers, CPU: r.CPU + o.CPU, MemoryMB: r.MemoryMB + o.MemoryMB}
+}
+
+func (r compute) String() string {
+	return fmt.Sprintf("%d CPU, %s", r.CPU, formatMemory(int32(r.MemoryMB)))
+}
+
+// workerCompute returns the compute of n workers of a TenantCluster
+func workerCompute(tc *unstructured.Unstructured, n int64) compute {
+	cpu := GetNestedInt64(tc.Object, "spec", "workers", "machineTemplate", "cpu")
+	memoryMB, _ := parseMemoryToMB(GetNestedString(tc.Object, "spec", "workers", "machineTemplate", "memory"))
+	return compute{Workers: n, CPU: n * cpu, MemoryMB: n * int64(memoryMB)}
+}
+
+// clusterCompute returns the compute a TenantCluster's workers request
+func clusterCompute(tc *unstructured.Unstructured) compute {
+	replicas := GetNestedInt64(tc.Object, "spec", "workers", "replicas")
+	if replicas == 0 {
+		replicas = 1
+	}
+	return workerCompute(tc, replicas)
+}
+
+// checkScaleCapacity reports what scaling a cluster up to target workers
+// would overcommit: the provider's reported capacity or the owning Team's
+// resource limits. Checks whose data is unavailable (no capacity in the
+// ProviderConfig status, no limits on the Team, or no permission to read
+// them) are skipped.
+func checkScaleCapacity(ctx context.Context, c *client.Client, logger *log.Logger, tc *unstructured.Unstructured, target int64) []string {
+	current := GetNestedInt64(tc.Object, "spec", "workers", "replicas")
+	if current == 0 {
+		current = 1
+	}
+	if target <= current {
+		return nil
+	}
+	extra := workerCompute(tc, target-current)
+
+	clusters, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Debug("skipping capacity checks: cannot list clusters", "error", err)
+		return nil
+	}
+
+	var problems []string
+	if p := checkProviderCapacity(ctx, c, logger, tc, clusters.Items, extra); p != "" {
+		problems = append(problems, p)
+	}
+	if p := checkTeamLimits(ctx, c, logger, tc, clusters.Items, extra); p != "" {
+		problems = append(problems, p)
+	}
+	return problems
+}
+
+// checkProviderCapacity compares the clusters placed on the provider plus
+// the extra workers with the capacity its controller reports in
+// status.capacity
+func checkProviderCapacity(ctx context.Context, c *client.Client, logger *log.Logger, tc *unstructured.Unstructured, clusters []unstructured.Unstructured, extra compute) string {
+	provider := GetNestedString(tc.Object, "spec", "providerConfigRef", "name")
+	if provider == "" {
+		return ""
+	}
+	pc, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace(ButlerSystemNamespace).Get(ctx, provider, metav1.GetOptions{})
+	if err != nil {
+		logger.Debug("skipping provider capacity check", "provider", provider, "error", err)
+		return ""
+	}
+	capacity, ok := providerCapacity(pc)
+	if !ok {
+		logger.Debug("skipping provider capacity check: capacity not reported", "provider", provider)
+		return ""
+	}
+
+	var allocated compute
+	for i := range clusters {
+		if GetNestedString(clusters[i].Object, "spec", "providerConfigRef", "name") == provider {
+			allocated = allocated.add(clusterCompute(&clusters[i]))
+		}
+	}
+	needed := allocated.add(extra)
+	if needed.CPU <= capacity.CPU && needed.MemoryMB <= capacity.MemoryMB {
+		return ""
+	}
+
+	free := compute{CPU: max(capacity.CPU-allocated.CPU, 0), MemoryMB: max(capacity.MemoryMB-allocated.MemoryMB, 0)}
+	return fmt.Sprintf("provider %s: %d more workers need %s but only %s is free (capacity %s, allocated %s)",
+		provider, extra.Workers, extra, free, capacity, allocated)
+}
+
+// providerCapacity reads status.capacity {cpu, memory} of a ProviderConfig
+func providerCapacity(pc *unstructured.Unstructured) (compute, bool) {
+	capacity, found, _ := unstructured.NestedMap(pc.Object, "status", "capacity")
+	if !found {
+		return compute{}, false
+	}
+	cpu, ok := intValue(capacity["cpu"])
+	if !ok {
+		return compute{}, false
+	}
+	memory, _ := capacity["memory"].(string)
+	memoryMB, err := parseMemoryToMB(memory)
+	if err != nil {
+		return compute{}, false
+	}
+	return compute{CPU: cpu, MemoryMB: int64(memoryMB)}, true
+}
+
+// checkTeamLimits compares the owning Team's clusters plus the extra
+// workers with spec.resourceLimits (maxWorkers, maxCPU, maxMemory)
+func checkTeamLimits(ctx context.Context, c *client.Client, logger *log.Logger, tc *unstructured.Unstructured, clusters []unstructured.Unstructured, extra compute) string {
+	owner := tc.GetAnnotations()[lifecycle.OwnerAnnotation]
+	if owner == "" {
+		return ""
+	}
+	team, err := c.Dynamic.Resource(client.TeamGVR).Get(ctx, owner, metav1.GetOptions{})
+	if err != nil {
+		// Owner is a user, or Teams aren't readable
+		logger.Debug("skipping team limit check", "owner", owner, "error", err)
+		return ""
+	}
+	limits, found, _ := unstructured.NestedMap(team.Object, "spec", "resourceLimits")
+	if !found {
+		return ""
+	}
+
+	var used compute
+	for i := range clusters {
+		if clusters[i].GetAnnotations()[lifecycle.OwnerAnnotation] == owner {
+			used = used.add(clusterCompute(&clusters[i]))
+		}
+	}
+	needed := used.add(extra)
+
+	var exceeded []string
+	if maxWorkers, ok := intValue(limits["maxWorkers"]); ok && needed.Workers > maxWorkers {
+		exceeded = append(exceeded, fmt.Sprintf("%d of %d workers", needed.Workers, maxWorkers))
+	}
+	if maxCPU, ok := intValue(limits["maxCPU"]); ok && needed.CPU > maxCPU {
+		exceeded = append(exceeded, fmt.Sprintf("%d of %d CPU", needed.CPU, maxCPU))
+	}
+	if s, ok := limits["maxMemory"].(string); ok {
+		if maxMB, err := parseMemoryToMB(s); err == nil && needed.MemoryMB > int64(maxMB) {
+			exceeded = append(exceeded, fmt.Sprintf("%s of %s memory", formatMemory(int32(needed.MemoryMB)), formatMemory(maxMB)))
+		}
+	}
+	if len(exceeded) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("team %s quota: would use %s", owner, joinAnd(exceeded))
+}
+
+// intValue reads a number that may be encoded as an integer, float or string
+func intValue(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case float64:
+		return int64(n), true
+	case string:
+		i, err := strconv.ParseInt(n, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+func joinAnd(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	}
+	out := items[0]
+	for _, item := range items[1 : len(items)-1] {
+		out += ", " + item
+	}
+	return out + " and " + items[len(items)-1]
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/access"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/summary"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// leftoverGrace is how long resources may take to go away on their own
+	// after the TenantCluster is gone, e.g. a terminating tenant namespace
+	leftoverGrace = 30 * time.Second
+
+	// cleanupTimeout bounds the wait for removed leftovers to disappear
+	cleanupTimeout = 2 * time.Minute
+)
+
+// ipAddressPoolGVR is the MetalLB address pool resource
+var ipAddressPoolGVR = schema.GroupVersionResource{
+	Group:    "metallb.io",
+	Version:  "v1beta1",
+	Resource: "ipaddresspools",
+}
+
+// tenantNamespaceResources are the kinds left in a tenant namespace when
+// a controller misses a finalizer
+var tenantNamespaceResources = []struct {
+	kind string
+	gvr  schema.GroupVersionResource
+}{
+	{"Cluster", client.ClusterGVR},
+	{"MachineDeployment", client.MachineDeploymentGVR},
+	{"Machine", client.MachineGVR},
+	{"TenantControlPlane", client.TenantControlPlaneGVR},
+}
+
+// destroyedCluster is what a destroyed cluster may leave behind in, taken
+// from its TenantCluster before deletion
+type destroyedCluster struct {
+	name            string
+	tenantNamespace string
+	lbPool          string // "start-end", if the cluster had one
+}
+
+// leftover is a resource of a destroyed cluster that still exists
+type leftover struct {
+	kind      string
+	namespace string
+	name      string
+	status    string
+
+	// gvr is unset for Namespaces, which are deleted through the Clientset
+	gvr schema.GroupVersionResource
+
+	// deleting is set once deletion started; finalizers block it
+	deleting   bool
+	finalizers []string
+}
+
+// kubectlResource names the leftover's resource for kubectl
+func (l *leftover) kubectlResource() string {
+	if l.gvr.Resource == "" {
+		return "namespace"
+	}
+	return l.gvr.Resource + "." + l.gvr.Group
+}
+
+// manualCommand returns the kubectl command that removes the leftover
+func (l *leftover) manualCommand() string {
+	ns := ""
+	if l.namespace != "" {
+		ns = "-n " + l.namespace + " "
+	}
+	if len(l.finalizers) > 0 {
+		return fmt.Sprintf(`kubectl %spatch %s %s --type merge -p '{"metadata":{"finalizers":null}}'`, ns, l.kubectlResource(), l.name)
+	}
+	return fmt.Sprintf("kubectl %sdelete %s %s", ns, l.kubectlResource(), l.name)
+}
+
+// findLeftovers lists what a destroyed cluster left behind: its tenant
+// namespace and the CAPI and Steward objects in it, MachineRequests
+// labelled for it and a MetalLB pool holding its address range
+func findLeftovers(ctx context.Context, c *client.Client, dc *destroyedCluster) ([]leftover, error) {
+	var found []leftover
+
+	if dc.tenantNamespace != "" {
+		ns, err := c.Clientset.CoreV1().Namespaces().Get(ctx, dc.tenantNamespace, metav1.GetOptions{})
+		switch {
+		case errors.IsNotFound(err):
+		case err != nil:
+			return nil, fmt.Errorf("getting namespace %s: %w", dc.tenantNamespace, err)
+		default:
+			l := leftover{kind: "Namespace", name: ns.Name, status: "exists", deleting: ns.DeletionTimestamp != nil}
+			if l.deleting {
+				l.status = fmt.Sprintf("terminating for %s", output.FormatAge(ns.DeletionTimestamp.Time))
+			}
+			found = append(found, l)
+
+			for _, r := range tenantNamespaceResources {
+				list, err := c.Dynamic.Resource(r.gvr).Namespace(dc.tenantNamespace).List(ctx, metav1.ListOptions{})
+				if errors.IsNotFound(err) {
+					continue // API not installed
+				}
+				if err != nil {
+					return nil, fmt.Errorf("listing %s in %s: %w", r.gvr.Resource, dc.tenantNamespace, err)
+				}
+				for i := range list.Items {
+					found = append(found, newLeftover(r.kind, r.gvr, &list.Items[i]))
+				}
+			}
+		}
+	}
+
+	requests, err := c.Dynamic.Resource(client.MachineRequestGVR).List(ctx, metav1.ListOptions{
+		LabelSelector: access.ClusterLabel + "=" + dc.name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing MachineRequests: %w", err)
+	}
+	for i := range requests.Items {
+		found = append(found, newLeftover("MachineRequest", client.MachineRequestGVR, &requests.Items[i]))
+	}
+
+	if dc.lbPool != "" {
+		pools, err := c.Dynamic.Resource(ipAddressPoolGVR).List(ctx, metav1.ListOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("listing MetalLB IPAddressPools: %w", err)
+		}
+		if err == nil {
+			for i := range pools.Items {
+				pool := &pools.Items[i]
+				addresses, _, _ := unstructured.NestedStringSlice(pool.Object, "spec", "addresses")
+				for _, a := range addresses {
+					if strings.ReplaceAll(a, " ", "") == dc.lbPool {
+						found = append(found, newLeftover("IPAddressPool", ipAddressPoolGVR, pool))
+						break
+					}
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		// The namespace goes last: its contents are removed first
+		return found[i].kind != "Namespace" && found[j].kind == "Namespace"
+	})
+	return found, nil
+}
+
+func newLeftover(kind string, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) leftover {
+	l := leftover{kind: kind, namespace: obj.GetNamespace(), name: obj.GetName(), status: "exists", gvr: gvr}
+	if ts := obj.GetDeletionTimestamp(); ts != nil {
+		l.deleting = true
+		l.finalizers = obj.GetFinalizers()
+		l.status = fmt.Sprintf("deleting for %s", output.FormatAge(ts.Time))
+		if len(l.finalizers) > 0 {
+			l.status += ", finalizers: " + strings.Join(l.finalizers, ", ")
+		}
+	}
+	return l
+}
+
+// removeLeftovers deletes leftovers not already being deleted, then waits
+// for all of them to disappear. Finalizers are never removed: a stuck
+// finalizer usually means the provider still holds a VM or disk.
+func removeLeftovers(ctx context.Context, c *client.Client, logger *log.Logger, dc *destroyedCluster, leftovers []leftover) ([]leftover, error) {
+	for i := range leftovers {
+		l := &leftovers[i]
+		if l.deleting {
+			continue
+		}
+		var err error
+		if l.gvr.Resource == "" {
+			err = c.Clientset.CoreV1().Namespaces().Delete(ctx, l.name, metav1.DeleteOptions{})
+		} else {
+			err = c.Dynamic.Resource(l.gvr).Namespace(l.namespace).Delete(ctx, l.name, metav1.DeleteOptions{})
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Warn("could not delete leftover", "kind", l.kind, "name", l.name, "error", err)
+			continue
+		}
+		logger.Info("deleted leftover", "kind", l.kind, "namespace", l.namespace, "name", l.name)
+	}
+
+	return settleLeftovers(ctx, c, logger, dc, leftovers, cleanupTimeout)
+}
+
+// settleLeftovers waits up to timeout for leftovers to disappear and
+// returns those that remain
+func settleLeftovers(ctx context.Context, c *client.Client, logger *log.Logger, dc *destroyedCluster, leftovers []leftover, timeout time.Duration) ([]leftover, error) {
+	remaining := leftovers
+	err := waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("leftovers of %s to be removed", dc.name),
+		Interval:    5 * time.Second,
+		Timeout:     timeout,
+	}, func(ctx context.Context) (bool, string, error) {
+		found, err := findLeftovers(ctx, c, dc)
+		if err != nil {
+			return false, "", err
+		}
+		remaining = found
+		return len(found) == 0, fmt.Sprintf("%d remaining", len(found)), nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return remaining, err
+		}
+		logger.Debug("leftovers remain", "error", err)
+	}
+	return remaining, nil
+}
+
+// recordRemovedLeftovers adds the leftovers that are no longer found to the
+// summary as deleted
+func recordRemovedLeftovers(result *summary.Summary, found, remaining []leftover) {
+	left := make(map[string]bool, len(remaining))
+	for _, l := range remaining {
+		left[l.kind+"/"+l.namespace+"/"+l.name] = true
+	}
+	for _, l := range found {
+		if !left[l.kind+"/"+l.namespace+"/"+l.name] {
+			result.Deleted(l.kind, l.namespace, l.name)
+		}
+	}
+}
+
+// printLeftovers lists leftovers and the commands that remove them
+func printLeftovers(w io.Writer, leftovers []leftover) {
+	fmt.Fprintln(w)
+	table := output.NewTable(w, "KIND", "NAMESPACE", "NAME", "STATUS")
+	for _, l := range leftovers {
+		table.AddRow(l.kind, orDefault(l.namespace, "-"), l.name, l.status)
+	}
+	table.Flush()
+
+	fmt.Fprintln(w, "\nTo clean up manually:")
+	for _, l := range leftovers {
+		fmt.Fprintln(w, "  "+l.manualCommand())
+	}
+	fmt.Fprintln(w)
+}
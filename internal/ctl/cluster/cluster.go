@@ -20,11 +20,14 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/butlerdotdev/butler/internal/common/client"
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
 // NewClusterCmd creates the cluster parent command
@@ -39,13 +42,22 @@ Butler uses Steward for hosted control planes (resource-efficient) with
 worker nodes running on your infrastructure provider.
 
 Commands:
-  create      Create a new tenant cluster
-  list        List all tenant clusters
-  get         Get details of a specific cluster
-  scale       Scale worker node count
-  export      Export cluster config as clean YAML
-  kubeconfig  Download kubeconfig for cluster access
-  destroy     Permanently destroy a cluster
+  create        Create a new tenant cluster
+  list          List all tenant clusters
+  get           Get details of a specific cluster (alias: describe)
+  scale         Scale worker node count
+  set-provider  Move a cluster to another ProviderConfig of the same type
+  export        Export cluster config as clean YAML
+  kubeconfig    Download kubeconfig for cluster access
+  destroy       Permanently destroy a cluster
+  orphaned      List clusters whose owner no longer exists
+  adopt         Register an existing cluster as a tenant cluster
+  machines      List the machines backing a cluster
+  wait          Wait for a cluster to reach a condition
+  open          Open a cluster's page in the Butler Console
+  logs          Show logs of a cluster's control plane
+  snapshot      Snapshot and restore a cluster's control plane state
+  schedule      Scale a cluster on a schedule
 
 Examples:
   # Create a new cluster
@@ -71,10 +83,20 @@ Examples:
 	cmd.AddCommand(newListCmd(logger))
 	cmd.AddCommand(NewCreateCmd(logger))
 	cmd.AddCommand(NewScaleCmd(logger))
+	cmd.AddCommand(NewUpdateCmd(logger))
+	cmd.AddCommand(NewSetProviderCmd(logger))
 	cmd.AddCommand(NewExportCmd(logger))
 	cmd.AddCommand(newKubeconfigCmd(logger))
 	cmd.AddCommand(newGetCmd(logger))
 	cmd.AddCommand(NewDestroyCmd(logger))
+	cmd.AddCommand(newOrphanedCmd(logger))
+	cmd.AddCommand(newAdoptCmd(logger))
+	cmd.AddCommand(newMachinesCmd(logger))
+	cmd.AddCommand(NewWaitCmd(logger))
+	cmd.AddCommand(newOpenCmd(logger))
+	cmd.AddCommand(newLogsCmd(logger))
+	cmd.AddCommand(newSnapshotCmd(logger))
+	cmd.AddCommand(newScheduleCmd(logger))
 
 	return cmd
 }
@@ -88,8 +110,9 @@ func newGetCmd(logger *log.Logger) *cobra.Command {
 	)
 
 	cmd := &cobra.Command{
-		Use:   "get NAME",
-		Short: "Get details of a tenant cluster",
+		Use:     "get NAME",
+		Aliases: []string{"describe"},
+		Short:   "Get details of a tenant cluster",
 		Long: `Get detailed information about a specific tenant cluster.
 
 Displays cluster configuration, status, worker nodes, and installed addons.
@@ -117,6 +140,10 @@ Examples:
 }
 
 func runGet(ctx context.Context, logger *log.Logger, name, namespace, outputFormat, kubeconfigPath string) error {
+	if err := validateOutputFormat(outputFormat); err != nil {
+		return err
+	}
+
 	// Connect to management cluster
 	var c *client.Client
 	var err error
@@ -131,15 +158,19 @@ func runGet(ctx context.Context, logger *log.Logger, name, namespace, outputForm
 
 	// Get TenantCluster
 	tc, err := c.GetTenantCluster(ctx, namespace, name)
+	if errors.IsNotFound(err) {
+		return ClusterNotFoundError(ctx, c, namespace, name)
+	}
 	if err != nil {
 		return fmt.Errorf("getting TenantCluster %s/%s: %w", namespace, name, err)
 	}
 
 	// For YAML/JSON output, print the raw resource
-	if outputFormat == "yaml" || outputFormat == "json" {
-		// TODO: Implement proper yaml/json output
-		fmt.Printf("Output format %s not yet implemented\n", outputFormat)
-		return nil
+	switch outputFormat {
+	case "json":
+		return output.PrintJSON(os.Stdout, tc.Object)
+	case "yaml":
+		return output.PrintYAML(os.Stdout, tc.Object)
 	}
 
 	// Extract info
@@ -150,14 +181,7 @@ func runGet(ctx context.Context, logger *log.Logger, name, namespace, outputForm
 	if info.CreationTime != "" {
 		t, err := time.Parse(time.RFC3339, info.CreationTime)
 		if err == nil {
-			duration := time.Since(t)
-			if duration < time.Hour {
-				age = fmt.Sprintf("%dm", int(duration.Minutes()))
-			} else if duration < 24*time.Hour {
-				age = fmt.Sprintf("%dh", int(duration.Hours()))
-			} else {
-				age = fmt.Sprintf("%dd", int(duration.Hours()/24))
-			}
+			age = output.FormatAge(t)
 		}
 	}
 
@@ -170,7 +194,17 @@ func runGet(ctx context.Context, logger *log.Logger, name, namespace, outputForm
 	fmt.Printf("Endpoint:         %s\n", orDefault(info.Endpoint, "<pending>"))
 	fmt.Printf("Tenant Namespace: %s\n", orDefault(info.TenantNamespace, "<pending>"))
 	fmt.Printf("Provider Config:  %s\n", orDefault(info.ProviderConfig, "<default>"))
+	fmt.Printf("Owner:            %s\n", orDefault(info.Owner, "<none>"))
+	if info.Contact != "" {
+		fmt.Printf("Contact:          %s\n", info.Contact)
+	}
+	if info.ExpiresAt != "" {
+		fmt.Printf("Expires:          %s\n", info.ExpiresAt)
+	}
 	fmt.Printf("Age:              %s\n", orDefault(age, "<unknown>"))
+	if url := consoleClusterURL(loadConsole(ctx, c, logger), namespace, name); url != "" {
+		fmt.Printf("Console:          %s\n", url)
+	}
 
 	// Print conditions if available
 	conditions, found, _ := unstructuredNestedSlice(tc.Object, "status", "conditions")
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client/fake"
+	"github.com/butlerdotdev/butler/internal/common/cmdtest"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/ctl/cmd"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// created is when the fixture clusters were created; it is replaced with
+// a placeholder so golden files don't depend on the clock
+var created = time.Now().Add(-50 * time.Hour).UTC().Truncate(time.Second)
+
+func tenantCluster(namespace, name, phase string, ready, desired int64) *unstructured.Unstructured {
+	tc := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "butler.butlerlabs.dev/v1alpha1",
+		"kind":       "TenantCluster",
+		"metadata": map[string]interface{}{
+			"name":              name,
+			"namespace":         namespace,
+			"creationTimestamp": created.Format(time.RFC3339),
+			"labels":            map[string]interface{}{"team": "platform"},
+			"annotations":       map[string]interface{}{"butler.butlerlabs.dev/owner": "jane@example.com"},
+		},
+		"spec": map[string]interface{}{
+			"kubernetesVersion": "v1.30.2",
+			"providerConfigRef": map[string]interface{}{"name": "harvester"},
+			"workers":           map[string]interface{}{"replicas": desired},
+		},
+		"status": map[string]interface{}{
+			"phase":                phase,
+			"tenantNamespace":      namespace + "-" + name,
+			"controlPlaneEndpoint": "10.40.0.10:6443",
+			"observedState": map[string]interface{}{
+				"workers": map[string]interface{}{"ready": ready, "desired": desired},
+			},
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "reason": "ClusterReady"},
+			},
+		},
+	}}
+	return tc
+}
+
+func fixtures() []runtime.Object {
+	return []runtime.Object{
+		tenantCluster("butler-tenants", "alpha", "Ready", 3, 3),
+		tenantCluster("butler-tenants", "bravo", "Provisioning", 0, 2),
+		tenantCluster("team-a", "charlie", "Ready", 1, 1),
+	}
+}
+
+// run executes butlerctl against the fixtures and returns its normalized
+// stdout
+func run(t *testing.T, args ...string) []byte {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	res, err := cmdtest.Run(cmd.NewRootCmd(log.New("butlerctl")), fake.NewClient(fixtures()...), args...)
+	if err != nil {
+		t.Fatalf("butlerctl %v: %v\n%s", args, err, res.Stderr)
+	}
+	return bytes.ReplaceAll(res.Stdout, []byte(created.Format(time.RFC3339)), []byte("CREATED"))
+}
+
+func TestList(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		args []string
+	}{
+		{"table", []string{"cluster", "list"}},
+		{"all-namespaces", []string{"cluster", "list", "-A"}},
+		{"json", []string{"cluster", "list", "-A", "-o", "json"}},
+		{"yaml", []string{"cluster", "list", "-A", "-o", "yaml"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := run(t, tt.args...)
+			cmdtest.Golden(t, filepath.Join("testdata", "list-"+tt.name+".golden"), got)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		args []string
+	}{
+		{"table", []string{"cluster", "get", "alpha"}},
+		{"json", []string{"cluster", "get", "charlie", "-n", "team-a", "-o", "json"}},
+		{"yaml", []string{"cluster", "get", "charlie", "-n", "team-a", "-o", "yaml"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := run(t, tt.args...)
+			cmdtest.Golden(t, filepath.Join("testdata", "get-"+tt.name+".golden"), got)
+		})
+	}
+}
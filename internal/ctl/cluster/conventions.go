@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// applyConventions enforces the platform naming and metadata conventions on tc.
+// Labels and annotations passed on the command line are merged first; anything
+// still missing is prompted for when stdin is a terminal, otherwise filled from
+// the requirement's default template or reported as an error.
+func applyConventions(conv *platform.Conventions, tc *unstructured.Unstructured, labels, annotations map[string]string) error {
+	if err := conv.ValidateName(tc.GetName()); err != nil {
+		return err
+	}
+
+	tcLabels := mergeStringMaps(tc.GetLabels(), labels)
+	tcAnnotations := mergeStringMaps(tc.GetAnnotations(), annotations)
+
+	data := platform.TemplateData{Name: tc.GetName(), Namespace: tc.GetNamespace()}
+	interactive := prompt.Interactive()
+
+	var missing []string
+	fill := func(kind string, reqs []platform.Requirement, values map[string]string) error {
+		for i := range reqs {
+			req := &reqs[i]
+			value := values[req.Key]
+
+			if value == "" {
+				def, err := req.DefaultValue(data)
+				if err != nil {
+					return err
+				}
+				if interactive {
+					value, err = promptRequirement(kind, req, def)
+					if err != nil {
+						return err
+					}
+				} else {
+					value = def
+				}
+			}
+
+			if value == "" {
+				missing = append(missing, fmt.Sprintf("--%s %s=<value>", kind, req.Key))
+				continue
+			}
+			if err := req.Validate(value); err != nil {
+				return fmt.Errorf("%s %w", kind, err)
+			}
+			values[req.Key] = value
+		}
+		return nil
+	}
+
+	if err := fill("label", conv.RequiredLabels, tcLabels); err != nil {
+		return err
+	}
+	if err := fill("annotation", conv.RequiredAnnotations, tcAnnotations); err != nil {
+		return err
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("platform conventions require additional metadata; specify:\n  %s", strings.Join(missing, "\n  "))
+	}
+
+	if len(tcLabels) > 0 {
+		tc.SetLabels(tcLabels)
+	}
+	if len(tcAnnotations) > 0 {
+		tc.SetAnnotations(tcAnnotations)
+	}
+	return nil
+}
+
+// promptRequirement asks the user for a required label or annotation value.
+// Invalid answers are re-asked until the value validates.
+func promptRequirement(kind string, req *platform.Requirement, def string) (string, error) {
+	for {
+		question := fmt.Sprintf("%s %s", kind, req.Key)
+		if req.Description != "" {
+			question = fmt.Sprintf("%s (%s)", question, req.Description)
+		}
+		if len(req.Values) > 0 {
+			question = fmt.Sprintf("%s [%s]", question, strings.Join(req.Values, "/"))
+		}
+		if def != "" {
+			question = fmt.Sprintf("%s [default: %s]", question, def)
+		}
+		value, err := prompt.ReadLine(question + ": ")
+		if err != nil {
+			return "", fmt.Errorf("reading %s %s: %w", kind, req.Key, err)
+		}
+		if value == "" {
+			value = def
+		}
+
+		if err := req.Validate(value); err != nil {
+			fmt.Fprintf(prompt.Output, "  %v\n", err)
+			continue
+		}
+		return value, nil
+	}
+}
+
+// mergeStringMaps returns a copy of base with overrides applied on top.
+func mergeStringMaps(base, overrides map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(overrides))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
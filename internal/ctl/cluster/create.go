@@ -20,16 +20,31 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
+	"net/netip"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/butlerdotdev/butler/internal/common/apidns"
 	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/cost"
+	"github.com/butlerdotdev/butler/internal/common/envsubst"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/netcheck"
 	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/policy"
+	"github.com/butlerdotdev/butler/internal/common/redact"
+	"github.com/butlerdotdev/butler/internal/common/summary"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/butlerdotdev/butler/internal/ctl/queue"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
@@ -51,6 +66,12 @@ type CreateOptions struct {
 	MemoryMB int32
 	DiskGB   int32
 
+	// Worker node settings: labels and taints (KEY[=VALUE]:EFFECT) the
+	// nodes register with, and extra kubelet arguments (KEY=VALUE)
+	NodeLabels  map[string]string
+	NodeTaints  []string
+	KubeletArgs []string
+
 	// OS Image (provider-specific: UUID for Nutanix, namespace/name for Harvester)
 	ImageRef string
 
@@ -68,17 +89,80 @@ type CreateOptions struct {
 	// Control plane (optional)
 	ControlPlaneReplicas int32
 
+	// APIAccess selects how the API server is exposed (lb, vip, nodeport
+	// or private); APIVIP is the fixed address for vip
+	APIAccess string
+	APIVIP    string
+
+	// OIDC configures the API server for single sign-on
+	OIDC OIDCOptions
+
+	// APIServer passes feature gates and flags through to the API server,
+	// within the platform allowlist
+	APIServer APIServerOptions
+
+	// apiHostname is the API endpoint's DNS name from the platform dns
+	// settings, if any
+	apiHostname string
+
+	// Addon choices, checked against the platform's AddonDefinitions.
+	// Empty values leave the controller defaults (Cilium, no storage).
+	CNI                 string
+	DefaultStorageClass string
+	DisableMetalLB      bool
+
+	// addonVersions holds the chart versions resolved for the chosen
+	// addons, keyed by spec.addons section
+	addonVersions map[string]string
+
+	// Workloads applied into the cluster once it is Ready: manifest files
+	// or directories, and a platform profile
+	ApplyOnCreate []string
+	Profile       string
+
+	// workloads holds the objects loaded from ApplyOnCreate and Profile
+	workloads []*unstructured.Unstructured
+
 	// Behavior flags
 	Wait    bool
 	Timeout time.Duration
 	DryRun  bool
 
+	// OutputFormat selects the --output format (yaml or json) of the
+	// --dry-run manifest, or of the result summary when creating
+	OutputFormat string
+
 	// File-based creation
 	Filename string
 
+	// Metadata applied to the TenantCluster (merged with platform conventions)
+	Labels      map[string]string
+	Annotations map[string]string
+
+	// Ownership recorded as annotations; Owner is a Team name or user email
+	Owner   string
+	Contact string
+
+	// TTL makes the cluster ephemeral: it is destroyed by 'butleradm gc run'
+	// once this long has passed after creation
+	TTL time.Duration
+
+	// Budget is the cluster's spending limit, e.g. 500/month; see cost.ParseBudget
+	Budget string
+
+	// Policy controls platform policy enforcement
+	Policy policy.Options
+
+	// SetFlags records which defaultable flags were given on the command
+	// line; platform defaults only fill in the rest
+	SetFlags map[string]bool
+
 	// Output
 	Output io.Writer
 	Logger *log.Logger
+
+	// result records what the run did for the closing summary
+	result *summary.Summary
 }
 
 // DefaultCreateOptions returns CreateOptions with sensible defaults.
@@ -113,59 +197,136 @@ func (o *CreateOptions) Validate() error {
 		return fmt.Errorf("invalid cluster name %q: must be lowercase alphanumeric, may contain '-', max 63 chars", o.Name)
 	}
 
-	if o.Workers < 1 || o.Workers > 10 {
-		return fmt.Errorf("workers must be between 1 and 10, got %d", o.Workers)
+	// Worker shape limits are platform-configurable; see checkLimits
+	if o.Workers < 0 || o.CPU < 0 || o.MemoryMB < 0 || o.DiskGB < 0 {
+		return fmt.Errorf("workers, cpu, memory and disk must not be negative")
 	}
 
-	if o.CPU < 1 || o.CPU > 128 {
-		return fmt.Errorf("cpu must be between 1 and 128, got %d", o.CPU)
+	if err := o.validateNodeSettings(); err != nil {
+		return err
 	}
 
-	if o.MemoryMB < 2048 {
-		return fmt.Errorf("memory must be at least 2048MB (2Gi), got %dMB", o.MemoryMB)
+	// Kubernetes version format
+	if !strings.HasPrefix(o.KubernetesVersion, "v") {
+		return fmt.Errorf("kubernetes version must start with 'v', got %q", o.KubernetesVersion)
 	}
 
-	if o.DiskGB < 20 {
-		return fmt.Errorf("disk must be at least 20GB, got %dGB", o.DiskGB)
+	if err := o.validateAddonChoices(); err != nil {
+		return err
+	}
+	if err := o.validateAPIAccess(); err != nil {
+		return err
+	}
+	if err := o.OIDC.Validate(); err != nil {
+		return err
+	}
+	if err := o.APIServer.Validate(); err != nil {
+		return err
 	}
 
-	// Kubernetes version format
-	if !strings.HasPrefix(o.KubernetesVersion, "v") {
-		return fmt.Errorf("kubernetes version must start with 'v', got %q", o.KubernetesVersion)
+	// Pod and service networks, checked with the controller defaults for
+	// whichever is unset
+	pods, services, err := netcheck.ValidateClusterNetworks(orDefault(o.PodCIDR, DefaultPodCIDR), orDefault(o.ServiceCIDR, DefaultServiceCIDR))
+	if err != nil {
+		return err
 	}
 
 	// Load balancer pool is required for MetalLB
+	if o.DisableMetalLB {
+		if o.LBPoolStart != "" || o.LBPoolEnd != "" {
+			return fmt.Errorf("--lb-pool has no effect with --disable-metallb")
+		}
+		return nil
+	}
 	if o.LBPoolStart == "" || o.LBPoolEnd == "" {
-		return fmt.Errorf("load balancer IP pool is required; specify --lb-pool-start and --lb-pool-end (or use --lb-pool START-END)")
+		return fmt.Errorf("load balancer IP pool is required; specify --lb-pool-start and --lb-pool-end (or use --lb-pool START-END), or --disable-metallb")
 	}
 
 	// Validate IP formats
-	if !isValidIP(o.LBPoolStart) {
-		return fmt.Errorf("invalid IP address for --lb-pool-start: %q", o.LBPoolStart)
+	start, err := netcheck.ParseIP(o.LBPoolStart)
+	if err != nil {
+		return fmt.Errorf("--lb-pool-start: %w", err)
+	}
+	end, err := netcheck.ParseIP(o.LBPoolEnd)
+	if err != nil {
+		return fmt.Errorf("--lb-pool-end: %w", err)
 	}
-	if !isValidIP(o.LBPoolEnd) {
-		return fmt.Errorf("invalid IP address for --lb-pool-end: %q", o.LBPoolEnd)
+	pool, err := netcheck.NewPool(start, end)
+	if err != nil {
+		return fmt.Errorf("invalid load balancer pool: %w", err)
+	}
+	for _, network := range []netip.Prefix{pods, services} {
+		if pool.Overlaps(network) {
+			return fmt.Errorf("load balancer pool %s overlaps cluster network %s", pool, network)
+		}
 	}
 
 	return nil
 }
 
-// isValidIP checks if a string is a valid IPv4 address.
-func isValidIP(ip string) bool {
-	parts := strings.Split(ip, ".")
-	if len(parts) != 4 {
-		return false
+// platformDefaultFlags are the create flags the platform config can default
+var platformDefaultFlags = []string{"k8s-version", "workers", "cpu", "memory", "disk", "pod-cidr", "service-cidr"}
+
+// applyPlatformDefaults fills in flags the user didn't set from the
+// defaults of the platform config.
+func (o *CreateOptions) applyPlatformDefaults(d *platform.Defaults) error {
+	if d.KubernetesVersion != "" && !o.SetFlags["k8s-version"] {
+		o.KubernetesVersion = d.KubernetesVersion
+	}
+	if d.Workers != 0 && !o.SetFlags["workers"] {
+		o.Workers = int32(d.Workers)
 	}
-	for _, part := range parts {
-		var num int
-		if _, err := fmt.Sscanf(part, "%d", &num); err != nil {
-			return false
+	if d.CPU != 0 && !o.SetFlags["cpu"] {
+		o.CPU = int32(d.CPU)
+	}
+	if d.Memory != "" && !o.SetFlags["memory"] {
+		memMB, err := parseMemoryToMB(d.Memory)
+		if err != nil {
+			return fmt.Errorf("invalid platform default memory %q: %w", d.Memory, err)
 		}
-		if num < 0 || num > 255 {
-			return false
+		o.MemoryMB = memMB
+	}
+	if d.Disk != "" && !o.SetFlags["disk"] {
+		diskGB, err := parseDiskToGB(d.Disk)
+		if err != nil {
+			return fmt.Errorf("invalid platform default disk %q: %w", d.Disk, err)
 		}
+		o.DiskGB = diskGB
+	}
+	if d.PodCIDR != "" && !o.SetFlags["pod-cidr"] {
+		o.PodCIDR = d.PodCIDR
+	}
+	if d.ServiceCIDR != "" && !o.SetFlags["service-cidr"] {
+		o.ServiceCIDR = d.ServiceCIDR
+	}
+	if d.ControlPlaneReplicas != 0 {
+		o.ControlPlaneReplicas = int32(d.ControlPlaneReplicas)
+	}
+	return nil
+}
+
+// checkLimits validates the worker shape against the platform limits.
+func (o *CreateOptions) checkLimits(limits *platform.Limits) error {
+	if err := limits.CheckWorkers(int64(o.Workers)); err != nil {
+		return err
+	}
+	if err := limits.CheckCPU(int64(o.CPU)); err != nil {
+		return err
+	}
+	if err := limits.CheckMemoryMB(int64(o.MemoryMB)); err != nil {
+		return err
+	}
+	return limits.CheckDiskGB(int64(o.DiskGB))
+}
+
+// validateOutputFormat checks the --output value.
+func validateOutputFormat(format string) error {
+	switch format {
+	case "", "yaml", "json":
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q (valid: yaml, json)", format)
 	}
-	return true
 }
 
 // isValidClusterName validates cluster name against DNS-1123 subdomain rules.
@@ -191,7 +352,9 @@ Butler will provision the cluster using the specified provider configuration,
 including control plane (via Steward) and worker nodes.
 
 The --lb-pool flag (or --lb-pool-start/--lb-pool-end) is required to configure
-the IP range for LoadBalancer services (MetalLB).
+the IP range for LoadBalancer services (MetalLB). Flags left unset take the
+platform defaults from the butler-platform ConfigMap; see the README for the
+options in detail.
 
 Examples:
   # Create a cluster with a single LoadBalancer IP
@@ -211,6 +374,13 @@ Examples:
     --image 41720566-c4a7-4300-a60a-b2786ebfa8bd \
     --k8s-version v1.30.2
 
+  # Supply labels required by platform conventions and record ownership
+  butlerctl cluster create prd-payments --lb-pool 10.127.14.40 \
+    --label cost-center=4711 --owner team-payments
+
+  # Ephemeral cluster destroyed after three days
+  butlerctl cluster create pr-1234 --lb-pool 10.127.14.40 --ttl 72h
+
   # Create from a YAML file
   butlerctl cluster create -f cluster.yaml
 
@@ -218,7 +388,10 @@ Examples:
   butlerctl cluster create my-cluster --lb-pool 10.127.14.40 --wait
 
   # Preview what would be created (dry-run)
-  butlerctl cluster create my-cluster --lb-pool 10.127.14.40 --dry-run`,
+  butlerctl cluster create my-cluster --lb-pool 10.127.14.40 --dry-run
+
+  # Emit the dry-run as JSON for policy checks (OPA/Conftest)
+  butlerctl cluster create my-cluster --lb-pool 10.127.14.40 --dry-run -o json | conftest test -`,
 		Args:              cobra.MaximumNArgs(1),
 		ValidArgsFunction: cobra.NoFileCompletions,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -232,6 +405,11 @@ Examples:
 				opts.Namespace = ns
 			}
 
+			opts.SetFlags = map[string]bool{}
+			for _, name := range platformDefaultFlags {
+				opts.SetFlags[name] = cmd.Flags().Changed(name)
+			}
+
 			return runCreate(cmd.Context(), opts)
 		},
 	}
@@ -240,32 +418,68 @@ Examples:
 	cmd.Flags().StringVarP(&opts.Provider, "provider", "p", "", "ProviderConfig name (auto-detected if only one exists)")
 
 	// Machine configuration
-	cmd.Flags().Int32VarP(&opts.Workers, "workers", "w", opts.Workers, "Number of worker nodes (1-10)")
-	cmd.Flags().Int32Var(&opts.CPU, "cpu", opts.CPU, "CPU cores per worker (1-128)")
-	cmd.Flags().StringVar(&memoryFlag, "memory", "8Gi", "Memory per worker (e.g., 8Gi, 16384Mi)")
-	cmd.Flags().StringVar(&diskFlag, "disk", "50Gi", "Disk size per worker (e.g., 50Gi, 100Gi)")
-	cmd.Flags().StringVar(&opts.ImageRef, "image", "", "OS image reference (UUID for Nutanix, namespace/name for Harvester)")
+	cmd.Flags().Int32VarP(&opts.Workers, "workers", "w", opts.Workers, "Number of worker nodes (bounded by platform limits)")
+	cmd.Flags().Int32Var(&opts.CPU, "cpu", opts.CPU, "CPU cores per worker (bounded by platform limits)")
+	cmd.Flags().StringVar(&memoryFlag, "memory", "8Gi", "Memory per worker (e.g., 8Gi, 1.5Gi, 16384Mi)")
+	cmd.Flags().StringVar(&diskFlag, "disk", "50Gi", "Disk size per worker (e.g., 50Gi, 1.5Ti)")
+	cmd.Flags().StringVar(&opts.ImageRef, "image", "", "OS image reference (UUID for Nutanix, namespace/name for Harvester, template VMID for Proxmox; see 'butlerctl images list')")
+	_ = cmd.RegisterFlagCompletionFunc("image", completeImages)
+	cmd.Flags().StringToStringVar(&opts.NodeLabels, "node-label", nil, "Label for worker nodes (KEY=VALUE, repeatable)")
+	cmd.Flags().StringArrayVar(&opts.NodeTaints, "node-taint", nil, "Taint for worker nodes (KEY[=VALUE]:EFFECT with NoSchedule, PreferNoSchedule or NoExecute, repeatable)")
+	cmd.Flags().StringArrayVar(&opts.KubeletArgs, "kubelet-arg", nil, "Extra kubelet argument for worker nodes (KEY=VALUE, repeatable)")
 
 	// Kubernetes version
 	cmd.Flags().StringVar(&opts.KubernetesVersion, "k8s-version", opts.KubernetesVersion, "Kubernetes version")
+	_ = cmd.RegisterFlagCompletionFunc("k8s-version", CompleteKubernetesVersions)
 
 	// Networking
-	cmd.Flags().StringVar(&opts.PodCIDR, "pod-cidr", "", "Pod network CIDR (default: 10.244.0.0/16)")
-	cmd.Flags().StringVar(&opts.ServiceCIDR, "service-cidr", "", "Service network CIDR (default: 10.96.0.0/12)")
-	cmd.Flags().StringVar(&lbPoolFlag, "lb-pool", "", "LoadBalancer IP pool (SINGLE_IP or START-END range)")
+	cmd.Flags().StringVar(&opts.PodCIDR, "pod-cidr", "", "Pod network CIDR (default: "+DefaultPodCIDR+")")
+	cmd.Flags().StringVar(&opts.ServiceCIDR, "service-cidr", "", "Service network CIDR (default: "+DefaultServiceCIDR+")")
+	cmd.Flags().StringVar(&lbPoolFlag, "lb-pool", "", "LoadBalancer IP pool (SINGLE_IP, START-END range or CIDR)")
 	cmd.Flags().StringVar(&opts.LBPoolStart, "lb-pool-start", "", "LoadBalancer pool start IP")
 	cmd.Flags().StringVar(&opts.LBPoolEnd, "lb-pool-end", "", "LoadBalancer pool end IP")
 
+	cmd.Flags().StringVar(&opts.APIAccess, "api-access", "", "How the API server is exposed ("+strings.Join(apiAccessModes, ", ")+"; default: lb); private is reachable only through the management cluster")
+	cmd.Flags().StringVar(&opts.APIVIP, "api-vip", "", "Fixed API server address for --api-access vip, added to the certificate SANs")
+	addOIDCFlags(cmd, &opts.OIDC)
+	addAPIServerFlags(cmd, &opts.APIServer)
+
+	// Addons
+	cmd.Flags().StringVar(&opts.CNI, "cni", "", "CNI to install ("+strings.Join(cniChoices, ", ")+"; default: "+defaultCNIProvider+"); must be offered by the platform")
+	cmd.Flags().StringVar(&opts.DefaultStorageClass, "default-storage-class", "", "Storage addon providing the default StorageClass ("+strings.Join(storageClassChoices, ", ")+"); must be offered by the platform")
+	cmd.Flags().BoolVar(&opts.DisableMetalLB, "disable-metallb", false, "Don't install MetalLB (no --lb-pool needed)")
+
 	// Namespace
 	cmd.Flags().StringVarP(&opts.Namespace, "namespace", "n", opts.Namespace, "Namespace for the TenantCluster")
 
 	// Behavior
-	cmd.Flags().BoolVar(&opts.Wait, "wait", false, "Wait for cluster to reach Ready status")
+	cmd.Flags().BoolVar(&opts.Wait, "wait", false, "Wait for cluster to reach Ready status, showing each provisioning step")
 	cmd.Flags().DurationVar(&opts.Timeout, "timeout", opts.Timeout, "Timeout when using --wait")
 	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Preview the TenantCluster without creating it")
+	cmd.Flags().StringVarP(&opts.OutputFormat, "output", "o", "", "Print the dry-run manifest or the result summary as yaml or json")
 
 	// File-based
-	cmd.Flags().StringVarP(&opts.Filename, "filename", "f", "", "Create from YAML file")
+	cmd.Flags().StringVarP(&opts.Filename, "filename", "f", "", "Create from YAML file (${VAR} references are expanded)")
+
+	// Metadata
+	cmd.Flags().StringToStringVarP(&opts.Labels, "label", "l", nil, "Label to set on the cluster (KEY=VALUE, repeatable; labels required by the platform are prompted for)")
+	cmd.Flags().StringToStringVar(&opts.Annotations, "annotation", nil, "Annotation to set on the cluster (KEY=VALUE, repeatable; annotations required by the platform are prompted for)")
+	cmd.Flags().StringVar(&opts.Owner, "owner", "", "Team name or user email that owns the cluster")
+	cmd.Flags().StringVar(&opts.Contact, "contact", "", "How to reach the owner (e.g. email, Slack channel, pager)")
+	cmd.Flags().DurationVar(&opts.TTL, "ttl", 0, "Destroy the cluster automatically after this long (e.g. 72h, minimum 1h; enforced by 'butleradm gc run')")
+	cmd.Flags().StringVar(&opts.Budget, "budget", "", "Spending limit tracked by 'butlerctl cost' (e.g. 500/month)")
+
+	// Workloads
+	cmd.Flags().StringArrayVar(&opts.ApplyOnCreate, "apply-on-create", nil, "Manifest file or directory to apply once the cluster is Ready (repeatable, implies --wait)")
+	cmd.Flags().StringVar(&opts.Profile, "profile", "", "Platform profile (under profiles in the butler-platform ConfigMap) whose workloads are applied once the cluster is Ready (implies --wait)")
+
+	// Policy
+	policy.AddFlags(cmd, &opts.Policy)
+
+	// Offline queueing
+	queue.Enable(cmd, logger)
+
+	hideControllerFlags(cmd)
 
 	return cmd
 }
@@ -277,8 +491,34 @@ var (
 	lbPoolFlag string
 )
 
-// runCreate executes the create operation.
+// runCreate executes the create operation and, once anything was created,
+// prints the result summary. With --output json or yaml the summary is the
+// only thing written to stdout; progress output moves to stderr.
 func runCreate(ctx context.Context, opts *CreateOptions) error {
+	if err := validateOutputFormat(opts.OutputFormat); err != nil {
+		return err
+	}
+
+	stdout := opts.Output
+	if opts.OutputFormat != "" && !opts.DryRun {
+		opts.Output = os.Stderr
+	}
+	opts.result = summary.New("butlerctl cluster create")
+	opts.result.Phase("validate")
+
+	err := createCluster(ctx, opts)
+	if opts.DryRun || len(opts.result.Resources) == 0 {
+		return err
+	}
+	opts.result.Finish(err)
+	if printErr := opts.result.Print(stdout, output.Format(opts.OutputFormat)); printErr != nil && err == nil {
+		return printErr
+	}
+	return err
+}
+
+// createCluster validates the options and creates the TenantCluster.
+func createCluster(ctx context.Context, opts *CreateOptions) error {
 	// Parse memory and disk flags
 	if memoryFlag != "" {
 		memMB, err := parseMemoryToMB(memoryFlag)
@@ -305,6 +545,17 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 		opts.LBPoolEnd = end
 	}
 
+	if opts.TTL != 0 && opts.TTL < MinTTL {
+		return fmt.Errorf("--ttl must be at least %s, got %s", MinTTL, opts.TTL)
+	}
+	if opts.Budget != "" {
+		budget, err := cost.ParseBudget(opts.Budget)
+		if err != nil {
+			return fmt.Errorf("invalid --budget: %w", err)
+		}
+		opts.Budget = budget.String()
+	}
+
 	// Verify we're connected to a management cluster
 	if err := RequireManagementCluster(ctx); err != nil {
 		return err
@@ -316,19 +567,56 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 		return fmt.Errorf("creating client: %w", err)
 	}
 
+	// Load platform conventions (naming pattern, required labels/annotations)
+	platformCfg, err := platform.Load(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	// Load post-create workloads before creating anything
+	if err := opts.loadWorkloads(ctx, c, platformCfg); err != nil {
+		return err
+	}
+
 	// If filename provided, create from file
 	if opts.Filename != "" {
-		return createFromFile(ctx, c, opts)
+		return createFromFile(ctx, c, opts, platformCfg)
+	}
+
+	// Fill unset flags from the platform defaults
+	defaults, err := platform.LoadDefaults(ctx, c)
+	if err != nil {
+		return err
+	}
+	if err := opts.applyPlatformDefaults(defaults); err != nil {
+		return err
 	}
 
 	// Validate options
 	if err := opts.Validate(); err != nil {
 		return err
 	}
+	versions, err := platform.LoadKubernetesVersions(ctx, c)
+	if err != nil {
+		return err
+	}
+	if err := versions.Check(opts.KubernetesVersion); err != nil {
+		return err
+	}
+	limits, err := platform.LoadLimits(ctx, c)
+	if err != nil {
+		return err
+	}
+	if err := opts.checkLimits(limits); err != nil {
+		return err
+	}
+	if err := opts.APIServer.Check(&platformCfg.APIServer); err != nil {
+		return err
+	}
 
 	// Auto-detect provider if not specified
 	if opts.Provider == "" {
-		provider, err := autoDetectProvider(ctx, c, opts.Logger)
+		provider, err := AutoDetectProvider(ctx, c, opts.Logger)
 		if err != nil {
 			return err
 		}
@@ -340,14 +628,41 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 		}
 	}
 
+	if err := opts.resolveAddons(ctx, c); err != nil {
+		return err
+	}
+
+	// Name the API endpoint in the platform's DNS zone
+	if opts.APIAccess != APIAccessPrivate {
+		opts.apiHostname, err = platformCfg.DNS.APIHostname(platform.TemplateData{Name: opts.Name, Namespace: opts.Namespace})
+		if err != nil {
+			return err
+		}
+	}
+
 	// Build the TenantCluster resource
 	tc := buildTenantCluster(opts)
+	opts.Annotations = lifecycleAnnotations(opts)
+	if opts.apiHostname != "" {
+		opts.Annotations = mergeStringMaps(opts.Annotations, map[string]string{apidns.HostnameAnnotation: opts.apiHostname})
+	}
+	if err := applyConventions(&platformCfg.Conventions, tc, opts.Labels, opts.Annotations); err != nil {
+		return err
+	}
 
 	// Dry-run: just print and exit
 	if opts.DryRun {
 		return printDryRun(opts, tc)
 	}
 
+	// Evaluate platform policies before mutating anything
+	if err := policy.Enforce(ctx, c, opts.Logger, opts.Policy, policy.Input{
+		Operation: policy.OperationCreate,
+		Object:    tc.Object,
+	}); err != nil {
+		return err
+	}
+
 	// Check if cluster already exists
 	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
 	if err == nil {
@@ -362,31 +677,43 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 
 	// Create the TenantCluster
 	opts.Logger.Info("creating TenantCluster", "name", opts.Name, "namespace", opts.Namespace)
+	opts.result.Phase("create")
 
-	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Create(ctx, tc, metav1.CreateOptions{})
+	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Create(ctx, tc, metav1.CreateOptions{FieldValidation: fieldValidation})
 	if err != nil {
-		return fmt.Errorf("creating TenantCluster: %w", err)
+		return fmt.Errorf("creating TenantCluster: %w", unsupportedOptionsError(err))
 	}
 
 	opts.Logger.Success("TenantCluster created", "name", opts.Name)
+	opts.result.Created("TenantCluster", opts.Namespace, opts.Name)
 
-	// Wait for Ready if requested
-	if opts.Wait {
-		if err := waitForReady(ctx, c, opts); err != nil {
-			return err
-		}
-	} else {
-		fmt.Fprintf(opts.Output, "\nNext steps:\n")
-		fmt.Fprintf(opts.Output, "  Watch progress: butlerctl cluster get %s\n", opts.Name)
-		fmt.Fprintf(opts.Output, "  Get kubeconfig: butlerctl cluster kubeconfig %s --merge\n", opts.Name)
+	return finishCreate(ctx, c, opts)
+}
+
+// finishCreate waits for the new cluster if requested and records the
+// follow-up commands in the summary.
+func finishCreate(ctx context.Context, c *client.Client, opts *CreateOptions) error {
+	ref := opts.Name
+	if opts.Namespace != DefaultTenantNamespace {
+		ref += " -n " + opts.Namespace
+	}
+	if !opts.Wait {
+		opts.result.Next("butlerctl cluster get " + ref)
+		opts.result.Next("butlerctl cluster kubeconfig " + ref + " --merge")
+		return nil
 	}
 
+	if err := waitForReady(ctx, c, opts); err != nil {
+		opts.result.Next("butlerctl cluster get " + ref)
+		return err
+	}
+	opts.result.Next("butlerctl cluster kubeconfig " + ref + " --merge")
 	return nil
 }
 
-// autoDetectProvider finds the provider to use.
+// AutoDetectProvider finds the provider to use.
 // Returns an error if no providers exist or multiple exist without --provider flag.
-func autoDetectProvider(ctx context.Context, c *client.Client, logger *log.Logger) (string, error) {
+func AutoDetectProvider(ctx context.Context, c *client.Client, logger *log.Logger) (string, error) {
 	list, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace(ButlerSystemNamespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return "", fmt.Errorf("listing ProviderConfigs: %w", err)
@@ -430,8 +757,8 @@ func buildTenantCluster(opts *CreateOptions) *unstructured.Unstructured {
 	// Build machineTemplate
 	machineTemplate := map[string]interface{}{
 		"cpu":      int64(opts.CPU),
-		"memory":   fmt.Sprintf("%dMi", opts.MemoryMB),
-		"diskSize": fmt.Sprintf("%dGi", opts.DiskGB),
+		"memory":   formatMemory(opts.MemoryMB),
+		"diskSize": formatDisk(opts.DiskGB),
 	}
 
 	// Add OS imageRef if specified
@@ -441,6 +768,8 @@ func buildTenantCluster(opts *CreateOptions) *unstructured.Unstructured {
 		}
 	}
 
+	opts.applyNodeSettings(machineTemplate)
+
 	// Build spec
 	spec := map[string]interface{}{
 		"kubernetesVersion": opts.KubernetesVersion,
@@ -473,10 +802,21 @@ func buildTenantCluster(opts *CreateOptions) *unstructured.Unstructured {
 	}
 
 	// Add control plane if non-default
+	controlPlane := map[string]interface{}{}
 	if opts.ControlPlaneReplicas != 1 {
-		spec["controlPlane"] = map[string]interface{}{
-			"replicas": int64(opts.ControlPlaneReplicas),
-		}
+		controlPlane["replicas"] = int64(opts.ControlPlaneReplicas)
+	}
+	opts.applyAPIAccess(controlPlane)
+	if opts.OIDC.IsSet() {
+		controlPlane["oidc"] = opts.OIDC.spec()
+	}
+	opts.APIServer.apply(controlPlane)
+	if len(controlPlane) > 0 {
+		spec["controlPlane"] = controlPlane
+	}
+
+	if addons := buildAddons(opts); addons != nil {
+		spec["addons"] = addons
 	}
 
 	tc.Object["spec"] = spec
@@ -490,23 +830,74 @@ func printCreationSummary(opts *CreateOptions) {
 	fmt.Fprintf(opts.Output, "  Kubernetes:  %s\n", opts.KubernetesVersion)
 	fmt.Fprintf(opts.Output, "  Workers:     %d × (%d CPU, %s RAM, %s disk)\n",
 		opts.Workers, opts.CPU, formatMemory(opts.MemoryMB), formatDisk(opts.DiskGB))
-	if opts.LBPoolStart == opts.LBPoolEnd {
+	if opts.DisableMetalLB {
+		fmt.Fprintf(opts.Output, "  LB Pool:     none (MetalLB disabled)\n")
+	} else if opts.LBPoolStart == opts.LBPoolEnd {
 		fmt.Fprintf(opts.Output, "  LB Pool:     %s\n", opts.LBPoolStart)
 	} else {
 		fmt.Fprintf(opts.Output, "  LB Pool:     %s - %s\n", opts.LBPoolStart, opts.LBPoolEnd)
 	}
+	switch opts.APIAccess {
+	case APIAccessVIP:
+		fmt.Fprintf(opts.Output, "  API access:  vip %s\n", opts.APIVIP)
+	case "":
+	default:
+		fmt.Fprintf(opts.Output, "  API access:  %s\n", opts.APIAccess)
+	}
+	if opts.apiHostname != "" {
+		fmt.Fprintf(opts.Output, "  API DNS:     %s\n", opts.apiHostname)
+	}
+	if opts.OIDC.IsSet() {
+		fmt.Fprintf(opts.Output, "  OIDC:        %s (client %s)\n", opts.OIDC.IssuerURL, opts.OIDC.ClientID)
+	}
+	if opts.APIServer.IsSet() {
+		fmt.Fprintf(opts.Output, "  API server:  %s\n", opts.APIServer.summary())
+	}
+	if opts.CNI != "" {
+		fmt.Fprintf(opts.Output, "  CNI:         %s %s\n", opts.CNI, opts.addonVersions["cni"])
+	}
+	if opts.DefaultStorageClass != "" {
+		fmt.Fprintf(opts.Output, "  Storage:     %s %s (default StorageClass)\n", opts.DefaultStorageClass, opts.addonVersions["storage"])
+	}
 	if opts.ImageRef != "" {
 		fmt.Fprintf(opts.Output, "  Image:       %s\n", opts.ImageRef)
 	}
+	if len(opts.NodeLabels) > 0 {
+		fmt.Fprintf(opts.Output, "  Node labels: %s\n", formatKeyValues(opts.NodeLabels))
+	}
+	if len(opts.NodeTaints) > 0 {
+		fmt.Fprintf(opts.Output, "  Node taints: %s\n", strings.Join(opts.NodeTaints, ", "))
+	}
+	if len(opts.KubeletArgs) > 0 {
+		fmt.Fprintf(opts.Output, "  Kubelet:     %s\n", strings.Join(opts.KubeletArgs, " "))
+	}
+	if len(opts.workloads) > 0 {
+		fmt.Fprintf(opts.Output, "  Workloads:   %s\n", workloadSummary(opts))
+	}
+	if opts.Owner != "" {
+		fmt.Fprintf(opts.Output, "  Owner:       %s\n", opts.Owner)
+	}
+	if opts.TTL > 0 {
+		fmt.Fprintf(opts.Output, "  Expires:     %s\n", time.Now().Add(opts.TTL).Local().Format(time.RFC1123))
+	}
+	if opts.Budget != "" {
+		fmt.Fprintf(opts.Output, "  Budget:      %s\n", opts.Budget)
+	}
 	fmt.Fprintln(opts.Output)
 }
 
 // printDryRun outputs the YAML that would be created.
+// With --output json the TenantCluster is written as a single JSON document
+// and no comment header, so the output can be piped straight into a policy engine.
 func printDryRun(opts *CreateOptions, tc *unstructured.Unstructured) error {
+	if opts.OutputFormat == "json" {
+		return output.PrintJSON(opts.Output, tc.Object)
+	}
+
 	fmt.Fprintf(opts.Output, "# Dry-run: TenantCluster that would be created\n")
 	fmt.Fprintf(opts.Output, "# Use 'butlerctl cluster create %s' to create it\n\n", opts.Name)
 
-	data, err := yaml.Marshal(tc.Object)
+	data, err := yaml.Marshal(redact.Value(tc.Object))
 	if err != nil {
 		return fmt.Errorf("marshaling to YAML: %w", err)
 	}
@@ -517,77 +908,106 @@ func printDryRun(opts *CreateOptions, tc *unstructured.Unstructured) error {
 // waitForReady polls until the cluster reaches Ready status.
 func waitForReady(ctx context.Context, c *client.Client, opts *CreateOptions) error {
 	opts.Logger.Info("waiting for cluster to be Ready", "timeout", opts.Timeout)
-
-	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
-	defer cancel()
-
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
+	opts.result.Phase("wait for Ready")
 
 	startTime := time.Now()
-	lastPhase := ""
-
-	for {
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("timeout waiting for cluster to be Ready after %v", opts.Timeout)
-		case <-ticker.C:
-			tc, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
-			if err != nil {
-				opts.Logger.Warn("error checking cluster status", "error", err)
-				continue
+	var tc *unstructured.Unstructured
+	progress := newProgressRenderer(opts.Output, opts.Logger)
+
+	err := waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("cluster %s to be Ready", opts.Name),
+		Interval:    10 * time.Second,
+		Timeout:     opts.Timeout,
+		Progress: func(phase string, elapsed time.Duration) {
+			if !progress.tracksPhase() {
+				opts.Logger.Info("cluster phase changed", "phase", phase, "elapsed", elapsed)
 			}
+		},
+	}, func(ctx context.Context) (bool, string, error) {
+		var err error
+		tc, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
+		if err != nil {
+			opts.Logger.Warn("error checking cluster status", "error", err)
+			progress.interrupt()
+			return false, "", nil
+		}
 
-			phase := GetNestedString(tc.Object, "status", "phase")
-			elapsed := time.Since(startTime).Round(time.Second)
+		phase := GetNestedString(tc.Object, "status", "phase")
+		elapsed := time.Since(startTime).Round(time.Second)
+		progress.update(opts.Name, phase, elapsed, clusterProgress(ctx, c, tc))
+		if phase == "Failed" {
+			return false, phase, fmt.Errorf("cluster provisioning failed: %s", readyConditionMessage(tc))
+		}
+		return phase == "Ready", phase, nil
+	})
+	if err != nil {
+		return err
+	}
 
-			// Log phase transitions
-			if phase != lastPhase {
-				opts.Logger.Info("cluster phase changed", "phase", phase, "elapsed", elapsed)
-				lastPhase = phase
-			}
+	opts.Logger.Success("cluster is Ready", "elapsed", time.Since(startTime).Round(time.Second))
 
-			switch phase {
-			case "Ready":
-				opts.Logger.Success("cluster is Ready", "elapsed", elapsed)
-
-				// Get endpoint for display
-				info := ExtractTenantClusterInfo(tc)
-				EnrichWithControlPlaneEndpoint(ctx, c, &info)
-
-				fmt.Fprintf(opts.Output, "\nCluster %s is ready!\n", opts.Name)
-				if info.Endpoint != "" {
-					fmt.Fprintf(opts.Output, "  API Server: %s\n", info.Endpoint)
-				}
-				fmt.Fprintf(opts.Output, "\nGet kubeconfig:\n")
-				fmt.Fprintf(opts.Output, "  butlerctl cluster kubeconfig %s --merge\n", opts.Name)
-				return nil
-
-			case "Failed":
-				// Try to get error message from conditions
-				conditions, _, _ := unstructured.NestedSlice(tc.Object, "status", "conditions")
-				errMsg := "unknown error"
-				for _, c := range conditions {
-					cond, ok := c.(map[string]interface{})
-					if ok && cond["type"] == "Ready" && cond["status"] == "False" {
-						if msg, ok := cond["message"].(string); ok {
-							errMsg = msg
-						}
-						break
-					}
-				}
-				return fmt.Errorf("cluster provisioning failed: %s", errMsg)
-			}
+	if len(opts.workloads) > 0 {
+		opts.result.Phase("apply workloads")
+	}
+	if err := applyWorkloads(ctx, c, opts); err != nil {
+		return err
+	}
+
+	// Get endpoint for display
+	info := ExtractTenantClusterInfo(tc)
+	EnrichWithControlPlaneEndpoint(ctx, c, &info)
+
+	if hostname := apidns.Hostname(tc); hostname != "" {
+		if published, err := apidns.Publish(ctx, c, tc); err != nil || !published {
+			opts.Logger.Warn("could not publish API DNS name; an admin can retry with 'butleradm dns sync'", "hostname", hostname, "error", err)
+		} else {
+			info.Endpoint = hostname + ":6443"
 		}
 	}
+
+	fmt.Fprintf(opts.Output, "\nCluster %s is ready!\n", opts.Name)
+	if info.Endpoint != "" {
+		fmt.Fprintf(opts.Output, "  API Server: %s\n", info.Endpoint)
+	}
+	return nil
+}
+
+// lifecycleAnnotations returns opts.Annotations with --owner, --contact,
+// --ttl and --budget added
+func lifecycleAnnotations(opts *CreateOptions) map[string]string {
+	if opts.Owner == "" && opts.Contact == "" && opts.TTL == 0 && opts.Budget == "" {
+		return opts.Annotations
+	}
+	annotations := make(map[string]string, len(opts.Annotations)+4)
+	for k, v := range opts.Annotations {
+		annotations[k] = v
+	}
+	if opts.Owner != "" {
+		annotations[lifecycle.OwnerAnnotation] = opts.Owner
+	}
+	if opts.Contact != "" {
+		annotations[lifecycle.ContactAnnotation] = opts.Contact
+	}
+	if opts.TTL > 0 {
+		annotations[lifecycle.ExpiresAtAnnotation] = lifecycle.FormatExpiry(time.Now().Add(opts.TTL))
+	}
+	if opts.Budget != "" {
+		annotations[cost.BudgetAnnotation] = opts.Budget
+	}
+	return annotations
 }
 
-// createFromFile creates a TenantCluster from a YAML file.
-func createFromFile(ctx context.Context, c *client.Client, opts *CreateOptions) error {
+// createFromFile creates a TenantCluster from a YAML file, expanding ${VAR}
+// references first.
+func createFromFile(ctx context.Context, c *client.Client, opts *CreateOptions, cfg *platform.Config) error {
 	data, err := os.ReadFile(opts.Filename)
 	if err != nil {
 		return fmt.Errorf("reading file %s: %w", opts.Filename, err)
 	}
+	data, err = envsubst.Expand(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", opts.Filename, err)
+	}
 
 	tc := &unstructured.Unstructured{}
 	if err := yaml.Unmarshal(data, &tc.Object); err != nil {
@@ -606,89 +1026,85 @@ func createFromFile(ctx context.Context, c *client.Client, opts *CreateOptions)
 		tc.SetNamespace(namespace)
 	}
 
+	opts.Annotations = lifecycleAnnotations(opts)
+	if err := applyConventions(&cfg.Conventions, tc, opts.Labels, opts.Annotations); err != nil {
+		return err
+	}
+	if err := CheckAPIServerSettings(&cfg.APIServer, tc); err != nil {
+		return fmt.Errorf("%s: %w", opts.Filename, err)
+	}
+
 	if opts.DryRun {
+		if opts.OutputFormat == "json" {
+			return output.PrintJSON(opts.Output, tc.Object)
+		}
 		fmt.Fprintf(opts.Output, "# Dry-run: Would create TenantCluster from %s\n\n", opts.Filename)
-		data, _ := yaml.Marshal(tc.Object)
+		data, _ := yaml.Marshal(redact.Value(tc.Object))
 		fmt.Fprintln(opts.Output, string(data))
 		return nil
 	}
 
+	if err := policy.Enforce(ctx, c, opts.Logger, opts.Policy, policy.Input{
+		Operation: policy.OperationCreate,
+		Object:    tc.Object,
+	}); err != nil {
+		return err
+	}
+
 	opts.Logger.Info("creating TenantCluster from file", "file", opts.Filename, "name", name, "namespace", namespace)
+	opts.result.Phase("create")
 
-	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace).Create(ctx, tc, metav1.CreateOptions{})
+	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace).Create(ctx, tc, metav1.CreateOptions{FieldValidation: fieldValidation})
 	if err != nil {
-		return fmt.Errorf("creating TenantCluster: %w", err)
+		return fmt.Errorf("creating TenantCluster: %w", unsupportedOptionsError(err))
 	}
 
 	opts.Logger.Success("TenantCluster created from file", "name", name)
+	opts.result.Created("TenantCluster", namespace, name)
 
-	if opts.Wait {
-		opts.Name = name
-		opts.Namespace = namespace
-		return waitForReady(ctx, c, opts)
-	}
-
-	return nil
+	opts.Name = name
+	opts.Namespace = namespace
+	return finishCreate(ctx, c, opts)
 }
 
-// parseMemoryToMB converts memory strings like "8Gi" or "8192Mi" to MB.
+// parseMemoryToMB converts memory quantities like "8Gi", "1.5Gi" or "512M"
+// to MiB, rounding up. A bare number is taken as MiB.
 func parseMemoryToMB(s string) (int32, error) {
-	s = strings.TrimSpace(s)
-
-	if strings.HasSuffix(s, "Gi") {
-		val := strings.TrimSuffix(s, "Gi")
-		var gi int32
-		if _, err := fmt.Sscanf(val, "%d", &gi); err != nil {
-			return 0, fmt.Errorf("invalid Gi value: %s", val)
-		}
-		return gi * 1024, nil
-	}
-
-	if strings.HasSuffix(s, "Mi") {
-		val := strings.TrimSuffix(s, "Mi")
-		var mi int32
-		if _, err := fmt.Sscanf(val, "%d", &mi); err != nil {
-			return 0, fmt.Errorf("invalid Mi value: %s", val)
-		}
-		return mi, nil
-	}
-
-	// Try parsing as plain number (assume MB)
-	var mb int32
-	if _, err := fmt.Sscanf(s, "%d", &mb); err != nil {
-		return 0, fmt.Errorf("must specify unit (e.g., 8Gi or 8192Mi)")
-	}
-	return mb, nil
+	return parseQuantity(s, 1<<20, "e.g., 8Gi, 1.5Gi or 8192Mi")
 }
 
-// parseDiskToGB converts disk strings like "50Gi" to GB.
+// parseDiskToGB converts disk quantities like "50Gi", "1.5Ti" or "100G" to
+// GiB, rounding up. A bare number is taken as GiB.
 func parseDiskToGB(s string) (int32, error) {
+	return parseQuantity(s, 1<<30, "e.g., 50Gi or 1.5Ti")
+}
+
+// parseQuantity parses a Kubernetes resource quantity and returns it in
+// multiples of unit bytes, rounded up
+func parseQuantity(s string, unit int64, example string) (int32, error) {
 	s = strings.TrimSpace(s)
 
-	if strings.HasSuffix(s, "Gi") {
-		val := strings.TrimSuffix(s, "Gi")
-		var gi int32
-		if _, err := fmt.Sscanf(val, "%d", &gi); err != nil {
-			return 0, fmt.Errorf("invalid Gi value: %s", val)
+	// Bare numbers predate quantity parsing and are already in units
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if n < 0 {
+			return 0, fmt.Errorf("must not be negative")
 		}
-		return gi, nil
+		return int32(n), nil
 	}
 
-	if strings.HasSuffix(s, "Ti") {
-		val := strings.TrimSuffix(s, "Ti")
-		var ti int32
-		if _, err := fmt.Sscanf(val, "%d", &ti); err != nil {
-			return 0, fmt.Errorf("invalid Ti value: %s", val)
-		}
-		return ti * 1024, nil
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("not a valid quantity (%s)", example)
+	}
+	if q.Sign() < 0 {
+		return 0, fmt.Errorf("must not be negative")
 	}
 
-	// Try parsing as plain number (assume GB)
-	var gb int32
-	if _, err := fmt.Sscanf(s, "%d", &gb); err != nil {
-		return 0, fmt.Errorf("must specify unit (e.g., 50Gi)")
+	// Compare before converting, as Value overflows for huge quantities
+	if q.Cmp(*resource.NewQuantity(math.MaxInt32*unit, resource.BinarySI)) > 0 {
+		return 0, fmt.Errorf("too large")
 	}
-	return gb, nil
+	return int32((q.Value() + unit - 1) / unit), nil
 }
 
 // formatMemory formats MB to human-readable string.
@@ -708,31 +1124,12 @@ func formatDisk(gb int32) string {
 }
 
 // parseLBPool parses the --lb-pool flag.
-// Accepts either a single IP ("10.127.14.40") or a range ("10.127.14.40-10.127.14.50").
+// Accepts a single IP ("10.127.14.40"), a range ("10.127.14.40-10.127.14.50")
+// or a CIDR ("10.127.14.32/28").
 func parseLBPool(s string) (start, end string, err error) {
-	s = strings.TrimSpace(s)
-
-	// Check for range format (START-END)
-	if strings.Contains(s, "-") {
-		parts := strings.SplitN(s, "-", 2)
-		if len(parts) != 2 {
-			return "", "", fmt.Errorf("invalid range format, expected START-END")
-		}
-		start = strings.TrimSpace(parts[0])
-		end = strings.TrimSpace(parts[1])
-
-		if !isValidIP(start) {
-			return "", "", fmt.Errorf("invalid start IP: %s", start)
-		}
-		if !isValidIP(end) {
-			return "", "", fmt.Errorf("invalid end IP: %s", end)
-		}
-		return start, end, nil
-	}
-
-	// Single IP - use same for start and end
-	if !isValidIP(s) {
-		return "", "", fmt.Errorf("invalid IP address: %s", s)
+	pool, err := netcheck.ParsePool(s)
+	if err != nil {
+		return "", "", err
 	}
-	return s, s, nil
+	return pool.Start.String(), pool.End.String(), nil
 }
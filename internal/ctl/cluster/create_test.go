@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import "testing"
+
+func TestParseMemoryToMB(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    int32
+		wantErr bool
+	}{
+		{"8Gi", 8192, false},
+		{"1.5Gi", 1536, false},
+		{"8192Mi", 8192, false},
+		{" 16Gi ", 16384, false},
+		{"8192", 8192, false},
+		{"0", 0, false},
+		{"512M", 489, false},
+		{"1Ki", 1, false},
+		{"1", 1, false},
+		{"-1", 0, true},
+		{"-1Gi", 0, true},
+		{"", 0, true},
+		{"8GB", 0, true},
+		{"eight", 0, true},
+		{"3000000000000Gi", 0, true},
+		{"9223372036854775807", 0, true},
+		{"1e30", 0, true},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseMemoryToMB(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMemoryToMB(%q) error = %v, want error %t", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseMemoryToMB(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDiskToGB(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    int32
+		wantErr bool
+	}{
+		{"50Gi", 50, false},
+		{"1.5Ti", 1536, false},
+		{"100G", 94, false},
+		{"100", 100, false},
+		{"512Mi", 1, false},
+		{"-50Gi", 0, true},
+		{"50 Gi", 0, true},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseDiskToGB(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDiskToGB(%q) error = %v, want error %t", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseDiskToGB(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatMemory(t *testing.T) {
+	for _, tt := range []struct {
+		mb   int32
+		want string
+	}{
+		{8192, "8Gi"},
+		{1536, "1536Mi"},
+		{512, "512Mi"},
+		{0, "0Mi"},
+	} {
+		if got := formatMemory(tt.mb); got != tt.want {
+			t.Errorf("formatMemory(%d) = %q, want %q", tt.mb, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit bounds how far Next and Prev look for a matching minute
+const cronSearchLimit = 366 * 24 * time.Hour
+
+// CronSchedule is a parsed five-field cron expression (minute hour
+// day-of-month month day-of-week) evaluated in a time zone
+type CronSchedule struct {
+	minutes  [60]bool
+	hours    [24]bool
+	days     [32]bool
+	months   [13]bool
+	weekdays [7]bool
+
+	// anyDay and anyWeekday record unrestricted fields: as in cron, a day
+	// matches either field when both are restricted
+	anyDay     bool
+	anyWeekday bool
+
+	Location *time.Location
+}
+
+var cronMonths = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var cronWeekdays = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// ParseCron parses a cron expression like "0 8 * * 1-5" or "30 20 * * mon-fri"
+// in the given time zone
+func ParseCron(expr, timezone string) (*CronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected five fields (minute hour day month weekday)", expr)
+	}
+
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+		}
+	}
+
+	s := &CronSchedule{
+		Location:   loc,
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}
+	parts := []struct {
+		name     string
+		set      []bool
+		min, max int
+		names    map[string]int
+	}{
+		{"minute", s.minutes[:], 0, 59, nil},
+		{"hour", s.hours[:], 0, 23, nil},
+		{"day", s.days[:], 1, 31, nil},
+		{"month", s.months[:], 1, 12, cronMonths},
+		{"weekday", s.weekdays[:], 0, 7, cronWeekdays},
+	}
+	for i, p := range parts {
+		if err := parseCronField(fields[i], p.set, p.min, p.max, p.names); err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %s: %w", expr, p.name, err)
+		}
+	}
+	return s, nil
+}
+
+// parseCronField marks the values of one field: *, a value, a range a-b,
+// any of them with a /step, or a comma-separated list. Weekday 7 is Sunday.
+func parseCronField(field string, set []bool, min, max int, names map[string]int) error {
+	value := func(s string) (int, error) {
+		if n, ok := names[strings.ToLower(s)]; ok {
+			return n, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < min || n > max {
+			return 0, fmt.Errorf("%q is not between %d and %d", s, min, max)
+		}
+		return n, nil
+	}
+
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+
+		lo, hi := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = value(from); err != nil {
+				return err
+			}
+			if hi, err = value(to); err != nil {
+				return err
+			}
+			if lo > hi {
+				return fmt.Errorf("range %q runs backwards", rangePart)
+			}
+		default:
+			n, err := value(rangePart)
+			if err != nil {
+				return err
+			}
+			lo = n
+			if !hasStep {
+				hi = n
+			}
+		}
+
+		for n := lo; n <= hi; n += step {
+			set[n%len(set)] = true
+		}
+	}
+	return nil
+}
+
+// matches reports whether the schedule fires in the minute starting at t
+func (s *CronSchedule) matches(t time.Time) bool {
+	if !s.minutes[t.Minute()] || !s.hours[t.Hour()] || !s.months[t.Month()] {
+		return false
+	}
+	day, weekday := s.days[t.Day()], s.weekdays[t.Weekday()]
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekday
+	case s.anyWeekday:
+		return day
+	}
+	return day || weekday
+}
+
+// Next returns the first time the schedule fires after t, or the zero time
+// if it doesn't fire within a year
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.In(s.Location).Truncate(time.Minute).Add(time.Minute)
+	for end := t.Add(cronSearchLimit); t.Before(end); t = t.Add(time.Minute) {
+		if s.matches(t) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// Prev returns the last time the schedule fired at or before t, or the
+// zero time if it didn't fire within a year
+func (s *CronSchedule) Prev(t time.Time) time.Time {
+	t = t.In(s.Location).Truncate(time.Minute)
+	for end := t.Add(-cronSearchLimit); t.After(end); t = t.Add(-time.Minute) {
+		if s.matches(t) {
+			return t
+		}
+	}
+	return time.Time{}
+}
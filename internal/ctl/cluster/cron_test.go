@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+	"time"
+)
+
+func mustTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	at, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return at
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		expr     string
+		timezone string
+	}{
+		{"empty", "", ""},
+		{"four fields", "* * * *", ""},
+		{"six fields", "0 * * * * *", ""},
+		{"minute out of range", "60 * * * *", ""},
+		{"hour out of range", "* 24 * * *", ""},
+		{"day zero", "* * 0 * *", ""},
+		{"day out of range", "* * 32 * *", ""},
+		{"month out of range", "* * * 13 *", ""},
+		{"weekday out of range", "* * * * 8", ""},
+		{"zero step", "*/0 * * * *", ""},
+		{"negative step", "*/-5 * * * *", ""},
+		{"backwards range", "30-10 * * * *", ""},
+		{"backwards names", "* * * * fri-mon", ""},
+		{"unknown name", "* * * * funday", ""},
+		{"empty list item", "1,,2 * * * *", ""},
+		{"open range", "-5 * * * *", ""},
+		{"month name as weekday", "* * * * jan", ""},
+		{"unknown timezone", "0 8 * * *", "Mars/Olympus"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCron(tt.expr, tt.timezone); err == nil {
+				t.Errorf("ParseCron(%q, %q) succeeded, want an error", tt.expr, tt.timezone)
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		expr     string
+		timezone string
+		from     string
+		want     string
+	}{
+		{"weekdays skip the weekend", "0 8 * * mon-fri", "", "2026-10-16T09:00:00Z", "2026-10-19T08:00:00Z"},
+		{"numeric weekdays", "0 8 * * 1-5", "", "2026-10-16T09:00:00Z", "2026-10-19T08:00:00Z"},
+		{"firing time itself is excluded", "0 8 * * *", "", "2026-10-16T08:00:00Z", "2026-10-17T08:00:00Z"},
+		{"seconds are ignored", "0 8 * * *", "", "2026-10-16T07:59:59Z", "2026-10-16T08:00:00Z"},
+		{"step", "*/15 * * * *", "", "2026-10-16T10:07:30Z", "2026-10-16T10:15:00Z"},
+		{"step from a value", "5/20 * * * *", "", "2026-10-16T10:06:00Z", "2026-10-16T10:25:00Z"},
+		{"stepped range", "0 8-18/5 * * *", "", "2026-10-16T09:00:00Z", "2026-10-16T13:00:00Z"},
+		{"list", "0 9,17 * * *", "", "2026-10-16T10:00:00Z", "2026-10-16T17:00:00Z"},
+		{"weekday 7 is sunday", "0 0 * * 7", "", "2026-10-16T12:00:00Z", "2026-10-18T00:00:00Z"},
+		{"range ending on 7", "0 0 * * 6-7", "", "2026-10-16T12:00:00Z", "2026-10-17T00:00:00Z"},
+		{"names ignore case", "0 12 * JAN-MAR SUN", "", "2026-10-16T12:00:00Z", "2027-01-03T12:00:00Z"},
+		{"day or weekday when both are restricted", "0 0 1 * mon", "", "2026-10-16T12:00:00Z", "2026-10-19T00:00:00Z"},
+		{"stepped day is restricted", "0 0 */10 * mon", "", "2026-10-16T12:00:00Z", "2026-10-19T00:00:00Z"},
+		{"end of month", "0 0 31 * *", "", "2026-10-31T00:00:00Z", "2026-12-31T00:00:00Z"},
+		{"leap day beyond a year", "0 0 29 2 *", "", "2026-10-16T12:00:00Z", ""},
+		{"timezone in summer time", "30 20 * * *", "Europe/Berlin", "2026-10-16T12:00:00Z", "2026-10-16T18:30:00Z"},
+		{"timezone after the clocks go back", "30 20 * * *", "Europe/Berlin", "2026-10-25T12:00:00Z", "2026-10-25T19:30:00Z"},
+		{"time skipped by the clocks going forward", "30 2 * * *", "Europe/Berlin", "2026-03-28T12:00:00Z", "2026-03-30T00:30:00Z"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr, tt.timezone)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := cron.Next(mustTime(t, tt.from))
+			if tt.want == "" {
+				if !got.IsZero() {
+					t.Errorf("Next = %s, want the zero time", got)
+				}
+				return
+			}
+			if want := mustTime(t, tt.want); !got.Equal(want) {
+				t.Errorf("Next = %s, want %s", got.UTC().Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestCronPrev(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		expr string
+		from string
+		want string
+	}{
+		{"weekdays skip the weekend", "0 8 * * mon-fri", "2026-10-19T07:00:00Z", "2026-10-16T08:00:00Z"},
+		{"firing minute itself is included", "0 8 * * *", "2026-10-16T08:00:30Z", "2026-10-16T08:00:00Z"},
+		{"earlier the same day", "0 9,17 * * *", "2026-10-16T16:59:00Z", "2026-10-16T09:00:00Z"},
+		{"leap day beyond a year", "0 0 29 2 *", "2026-10-16T12:00:00Z", ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := cron.Prev(mustTime(t, tt.from))
+			if tt.want == "" {
+				if !got.IsZero() {
+					t.Errorf("Prev = %s, want the zero time", got)
+				}
+				return
+			}
+			if want := mustTime(t, tt.want); !got.Equal(want) {
+				t.Errorf("Prev = %s, want %s", got.UTC().Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
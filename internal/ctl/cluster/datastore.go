@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/etcdkv"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// tenantDatastore is the etcd DataStore a hosted control plane keeps its
+// state in, and the key prefix that belongs to the tenant
+type tenantDatastore struct {
+	name      string
+	prefix    string
+	endpoints []string
+	tls       *tls.Config
+}
+
+// resolveDatastore finds the DataStore and key prefix of a cluster's
+// TenantControlPlane. Steward shares a DataStore between tenants, each
+// under its own schema prefix.
+func resolveDatastore(ctx context.Context, c *client.Client, tenantNS, name string) (*tenantDatastore, error) {
+	tcp, err := c.Dynamic.Resource(client.TenantControlPlaneGVR).Namespace(tenantNS).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting TenantControlPlane %s/%s: %w", tenantNS, name, err)
+	}
+
+	dsName := GetNestedString(tcp.Object, "status", "storage", "dataStoreName")
+	if dsName == "" {
+		dsName = GetNestedString(tcp.Object, "spec", "dataStore")
+	}
+	if dsName == "" {
+		return nil, fmt.Errorf("TenantControlPlane %s/%s reports no DataStore", tenantNS, name)
+	}
+	schema := GetNestedString(tcp.Object, "status", "storage", "setup", "schema")
+	if schema == "" {
+		schema = tenantNS + "_" + name
+	}
+
+	ds, err := c.Dynamic.Resource(client.DataStoreGVR).Get(ctx, dsName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting DataStore %s: %w", dsName, err)
+	}
+	if driver := GetNestedString(ds.Object, "spec", "driver"); driver != "etcd" {
+		return nil, fmt.Errorf("DataStore %s uses the %s driver; snapshots support etcd only", dsName, driver)
+	}
+	endpoints, _, _ := unstructured.NestedStringSlice(ds.Object, "spec", "endpoints")
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("DataStore %s lists no endpoints", dsName)
+	}
+
+	tlsConfig, err := datastoreTLS(ctx, c, ds)
+	if err != nil {
+		return nil, fmt.Errorf("DataStore %s: %w", dsName, err)
+	}
+
+	return &tenantDatastore{
+		name:      dsName,
+		prefix:    "/" + schema + "/",
+		endpoints: endpoints,
+		tls:       tlsConfig,
+	}, nil
+}
+
+// datastoreTLS builds the client TLS config from the DataStore's CA and
+// client certificate
+func datastoreTLS(ctx context.Context, c *client.Client, ds *unstructured.Unstructured) (*tls.Config, error) {
+	content := func(path ...string) ([]byte, error) {
+		ref, _, _ := unstructured.NestedMap(ds.Object, append([]string{"spec", "tlsConfig"}, path...)...)
+		return contentRef(ctx, c, ref)
+	}
+
+	caPEM, err := content("certificateAuthority", "certificate")
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+	certPEM, err := content("clientCertificate", "certificate")
+	if err != nil {
+		return nil, fmt.Errorf("reading client certificate: %w", err)
+	}
+	keyPEM, err := content("clientCertificate", "privateKey")
+	if err != nil {
+		return nil, fmt.Errorf("reading client key: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no CA certificate found")
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("loading client certificate: %w", err)
+	}
+	return &tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// contentRef reads a Kamaji ContentRef: inline base64 content or a key of
+// a Secret
+func contentRef(ctx context.Context, c *client.Client, ref map[string]interface{}) ([]byte, error) {
+	if inline, ok := ref["content"].(string); ok && inline != "" {
+		return base64.StdEncoding.DecodeString(inline)
+	}
+	secretRef, _ := ref["secretReference"].(map[string]interface{})
+	name, _ := secretRef["name"].(string)
+	namespace, _ := secretRef["namespace"].(string)
+	keyPath, _ := secretRef["keyPath"].(string)
+	if name == "" || keyPath == "" {
+		return nil, fmt.Errorf("neither content nor a secretReference is set")
+	}
+	secret, err := c.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting Secret %s/%s: %w", namespace, name, err)
+	}
+	data, ok := secret.Data[keyPath]
+	if !ok {
+		return nil, fmt.Errorf("Secret %s/%s has no %s key", namespace, name, keyPath)
+	}
+	return data, nil
+}
+
+// connect port-forwards to the first reachable etcd member and returns a
+// client for it. The returned stop function closes the tunnel.
+func (d *tenantDatastore) connect(ctx context.Context, c *client.Client) (*etcdkv.Client, func(), error) {
+	var errs []string
+	for _, endpoint := range d.endpoints {
+		kv, stop, err := d.connectEndpoint(ctx, c, endpoint)
+		if err == nil {
+			return kv, stop, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", endpoint, err))
+	}
+	return nil, nil, fmt.Errorf("no etcd member of DataStore %s is reachable:\n  %s", d.name, strings.Join(errs, "\n  "))
+}
+
+func (d *tenantDatastore) connectEndpoint(ctx context.Context, c *client.Client, endpoint string) (*etcdkv.Client, func(), error) {
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing endpoint: %w", err)
+	}
+	namespace, pod, err := datastorePod(ctx, c, host)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	local, stop, err := forwardPort(ctx, c, namespace, pod, port)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The member's certificate names its in-cluster host, not localhost
+	tlsConfig := d.tls.Clone()
+	tlsConfig.ServerName = host
+	return etcdkv.New("https://127.0.0.1:"+strconv.Itoa(int(local)), tlsConfig), stop, nil
+}
+
+// datastorePod maps an in-cluster etcd host name to a pod: a StatefulSet
+// pod name (pod.service.namespace.svc) or any ready pod behind a Service
+// (service.namespace.svc)
+func datastorePod(ctx context.Context, c *client.Client, host string) (string, string, error) {
+	parts := strings.Split(host, ".")
+	switch {
+	case len(parts) >= 4 && parts[3] == "svc":
+		return parts[2], parts[0], nil
+	case len(parts) >= 3 && parts[2] == "svc":
+		endpoints, err := c.Clientset.CoreV1().Endpoints(parts[1]).Get(ctx, parts[0], metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("getting endpoints of Service %s/%s: %w", parts[1], parts[0], err)
+		}
+		for _, subset := range endpoints.Subsets {
+			for _, addr := range subset.Addresses {
+				if ref := addr.TargetRef; ref != nil && ref.Kind == "Pod" {
+					return parts[1], ref.Name, nil
+				}
+			}
+		}
+		return "", "", fmt.Errorf("Service %s/%s has no ready pods", parts[1], parts[0])
+	}
+	return "", "", fmt.Errorf("%s is not an in-cluster service address", host)
+}
+
+// forwardPort opens a tunnel from a random local port to a pod port
+func forwardPort(ctx context.Context, c *client.Client, namespace, pod, port string) (uint16, func(), error) {
+	p, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, pod, metav1.GetOptions{})
+	if err != nil {
+		return 0, nil, fmt.Errorf("getting pod %s/%s: %w", namespace, pod, err)
+	}
+	if p.Status.Phase != corev1.PodRunning {
+		return 0, nil, fmt.Errorf("pod %s/%s is %s", namespace, pod, p.Status.Phase)
+	}
+
+	transport, upgrader, err := spdy.RoundTripperFor(c.Config)
+	if err != nil {
+		return 0, nil, fmt.Errorf("creating port-forward transport: %w", err)
+	}
+	url := c.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").Namespace(namespace).Name(pod).SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stopCh := make(chan struct{})
+	readyCh := make(chan struct{})
+	fw, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{"0:" + port}, stopCh, readyCh, io.Discard, io.Discard)
+	if err != nil {
+		return 0, nil, fmt.Errorf("creating port-forward: %w", err)
+	}
+	errCh := make(chan error, 1)
+	go func() { errCh <- fw.ForwardPorts() }()
+
+	stop := func() { close(stopCh) }
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return 0, nil, fmt.Errorf("port-forwarding to pod %s/%s: %w", namespace, pod, err)
+	case <-ctx.Done():
+		stop()
+		return 0, nil, ctx.Err()
+	}
+
+	ports, err := fw.GetPorts()
+	if err != nil || len(ports) == 0 {
+		stop()
+		return 0, nil, fmt.Errorf("port-forwarding to pod %s/%s: no local port", namespace, pod)
+	}
+	return ports[0].Local, stop, nil
+}
@@ -17,16 +17,21 @@ limitations under the License.
 package cluster
 
 import (
-	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
 	"github.com/butlerdotdev/butler/internal/common/log"
 	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/common/summary"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/butlerdotdev/butler/internal/ctl/queue"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,11 +50,21 @@ type DestroyOptions struct {
 	NoWait  bool // Don't wait for deletion to complete
 	Timeout time.Duration
 
+	// VerifyCleanup removes resources the cluster left behind
+	VerifyCleanup bool
+
+	// OutputFormat prints the result summary as yaml or json
+	OutputFormat string
+
 	// Future RBAC fields (not implemented yet)
 	// Team        string // Team owning this cluster
 	// RequireRole string // Minimum role required (owner, admin, member)
 
+	Output io.Writer
 	Logger *log.Logger
+
+	// result records what the run did for the closing summary
+	result *summary.Summary
 }
 
 // DefaultDestroyOptions returns DestroyOptions with sensible defaults.
@@ -57,6 +72,7 @@ func DefaultDestroyOptions(logger *log.Logger) *DestroyOptions {
 	return &DestroyOptions{
 		Namespace: DefaultTenantNamespace,
 		Timeout:   10 * time.Minute,
+		Output:    os.Stdout,
 		Logger:    logger,
 	}
 }
@@ -85,6 +101,19 @@ This will permanently delete:
 The cluster's workloads, persistent volumes, and any data stored within
 the cluster will be permanently lost unless externally backed up.
 
+Once the TenantCluster is gone, destroy checks for resources left behind:
+the tenant namespace and the CAPI and Steward objects in it, MachineRequests
+labelled for the cluster and a MetalLB IPAddressPool holding its LB pool.
+They are listed with the kubectl commands that remove them; with
+--verify-cleanup, destroy removes them itself and fails if any remain.
+Finalizers are never stripped automatically, as a stuck finalizer usually
+means the provider still holds a VM or disk.
+
+A summary closes the run with the phases and their durations, the deleted
+resources and the commands for anything left to clean up. With -o json or
+-o yaml it is printed as a result object on stdout and all other output
+goes to stderr.
+
 Examples:
   # Destroy with confirmation prompt (recommended)
   butlerctl cluster destroy my-cluster
@@ -96,7 +125,13 @@ Examples:
   butlerctl cluster destroy my-cluster --force --no-wait
 
   # Destroy with custom timeout
-  butlerctl cluster destroy my-cluster --force --timeout 20m`,
+  butlerctl cluster destroy my-cluster --force --timeout 20m
+
+  # Destroy and remove anything the controllers left behind
+  butlerctl cluster destroy my-cluster --force --verify-cleanup
+
+  # Destroy from a pipeline and keep the result
+  butlerctl cluster destroy ci-42 --force --verify-cleanup -o json > result.json`,
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: completeClusterNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -109,15 +144,44 @@ Examples:
 	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt (dangerous)")
 	cmd.Flags().BoolVar(&opts.NoWait, "no-wait", false, "Don't wait for deletion to complete")
 	cmd.Flags().DurationVar(&opts.Timeout, "timeout", opts.Timeout, "Timeout when waiting for deletion")
+	cmd.Flags().BoolVar(&opts.VerifyCleanup, "verify-cleanup", false, "Remove resources the cluster left behind once it is destroyed")
+	cmd.MarkFlagsMutuallyExclusive("no-wait", "verify-cleanup")
+	cmd.Flags().StringVarP(&opts.OutputFormat, "output", "o", "", "Print the result summary as yaml or json")
 
 	// Aliases: --yes is common in other tools
 	cmd.Flags().BoolVarP(&opts.Force, "yes", "y", false, "Skip confirmation prompt (alias for --force)")
 
+	queue.Enable(cmd, logger)
+
 	return cmd
 }
 
-// runDestroy executes the destroy operation.
+// runDestroy executes the destroy operation and, once the TenantCluster was
+// deleted, prints the result summary.
 func runDestroy(ctx context.Context, opts *DestroyOptions) error {
+	if err := validateOutputFormat(opts.OutputFormat); err != nil {
+		return err
+	}
+
+	stdout := opts.Output
+	if opts.OutputFormat != "" {
+		opts.Output = os.Stderr
+	}
+
+	err := destroyCluster(ctx, opts)
+	if opts.result == nil || len(opts.result.Resources) == 0 {
+		return err
+	}
+	opts.result.Finish(err)
+	if printErr := opts.result.Print(stdout, output.Format(opts.OutputFormat)); printErr != nil && err == nil {
+		return printErr
+	}
+	return err
+}
+
+// destroyCluster confirms and deletes the TenantCluster, then waits for it
+// to go away.
+func destroyCluster(ctx context.Context, opts *DestroyOptions) error {
 	// First, verify we're connected to a management cluster
 	if err := RequireManagementCluster(ctx); err != nil {
 		return err
@@ -132,7 +196,7 @@ func runDestroy(ctx context.Context, opts *DestroyOptions) error {
 	tc, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return fmt.Errorf("TenantCluster %q not found in namespace %q", opts.Name, opts.Namespace)
+			return ClusterNotFoundError(ctx, c, opts.Namespace, opts.Name)
 		}
 		return fmt.Errorf("getting TenantCluster: %w", err)
 	}
@@ -150,7 +214,7 @@ func runDestroy(ctx context.Context, opts *DestroyOptions) error {
 	// }
 
 	// Show detailed destruction summary
-	printDestructionSummary(opts, &info)
+	printDestructionSummary(opts.Output, &info)
 
 	// Confirm destruction unless forced
 	if !opts.Force {
@@ -159,66 +223,71 @@ func runDestroy(ctx context.Context, opts *DestroyOptions) error {
 		}
 	}
 
+	if lifecycle.Adopted(tc.GetAnnotations()) {
+		opts.Logger.Info("cluster was adopted; it is unregistered from Butler but its machines are left running")
+	}
 	opts.Logger.Info("destroying tenant cluster", "name", opts.Name, "namespace", opts.Namespace)
+	opts.result = summary.New("butlerctl cluster destroy")
+
+	dc := &destroyedCluster{name: opts.Name, tenantNamespace: info.TenantNamespace}
+	if start := GetNestedString(tc.Object, "spec", "networking", "loadBalancerPool", "start"); start != "" {
+		dc.lbPool = start + "-" + GetNestedString(tc.Object, "spec", "networking", "loadBalancerPool", "end")
+	}
 
 	// Delete the TenantCluster CR - controller handles cleanup
+	opts.result.Phase("delete")
 	err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Delete(ctx, opts.Name, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("deleting TenantCluster: %w", err)
 	}
 
 	opts.Logger.Success("destruction initiated", "name", opts.Name)
+	opts.result.Deleted("TenantCluster", opts.Namespace, opts.Name)
 
 	if opts.NoWait {
-		fmt.Println("\nCluster destruction has been initiated.")
-		fmt.Println("The controller will clean up all resources in the background.")
-		fmt.Println("\nUse 'butlerctl cluster list' to monitor progress.")
+		fmt.Fprintln(opts.Output, "\nCluster destruction has been initiated.")
+		fmt.Fprintln(opts.Output, "The controller will clean up all resources in the background.")
+		opts.result.Next("butlerctl cluster list")
 		return nil
 	}
 
-	return waitForDestruction(ctx, c, opts)
+	opts.result.Phase("wait for deletion")
+	if err := waitForDestruction(ctx, c, opts); err != nil {
+		return err
+	}
+	opts.result.Phase("verify cleanup")
+	return verifyCleanup(ctx, c, opts, dc)
 }
 
 // printDestructionSummary shows what will be destroyed.
-func printDestructionSummary(opts *DestroyOptions, info *TenantClusterInfo) {
-	fmt.Println()
-	fmt.Println(output.ColorizePhase("⚠️  CLUSTER DESTRUCTION WARNING"))
-	fmt.Println(strings.Repeat("═", 50))
-	fmt.Println()
-	fmt.Printf("Cluster:    %s\n", output.ColorizePhase(info.Name))
-	fmt.Printf("Namespace:  %s\n", info.Namespace)
-	fmt.Printf("Phase:      %s\n", output.ColorizePhase(info.Phase))
-	fmt.Printf("K8s:        %s\n", info.KubernetesVersion)
-	fmt.Printf("Workers:    %d node(s)\n", info.WorkersReady)
+func printDestructionSummary(w io.Writer, info *TenantClusterInfo) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, output.ColorizePhase("⚠️  CLUSTER DESTRUCTION WARNING"))
+	fmt.Fprintln(w, strings.Repeat("═", 50))
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Cluster:    %s\n", output.ColorizePhase(info.Name))
+	fmt.Fprintf(w, "Namespace:  %s\n", info.Namespace)
+	fmt.Fprintf(w, "Phase:      %s\n", output.ColorizePhase(info.Phase))
+	fmt.Fprintf(w, "K8s:        %s\n", info.KubernetesVersion)
+	fmt.Fprintf(w, "Workers:    %d node(s)\n", info.WorkersReady)
 	if info.Endpoint != "" {
-		fmt.Printf("Endpoint:   %s\n", info.Endpoint)
+		fmt.Fprintf(w, "Endpoint:   %s\n", info.Endpoint)
 	}
-	fmt.Println()
-	fmt.Println("The following will be permanently deleted:")
-	fmt.Println("  • All worker node VMs and their local storage")
-	fmt.Println("  • Hosted control plane pods")
-	fmt.Println("  • All Kubernetes workloads in the cluster")
-	fmt.Println("  • All PersistentVolumes and PersistentVolumeClaims")
-	fmt.Println("  • Tenant namespace:", info.TenantNamespace)
-	fmt.Println()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "The following will be permanently deleted:")
+	fmt.Fprintln(w, "  • All worker node VMs and their local storage")
+	fmt.Fprintln(w, "  • Hosted control plane pods")
+	fmt.Fprintln(w, "  • All Kubernetes workloads in the cluster")
+	fmt.Fprintln(w, "  • All PersistentVolumes and PersistentVolumeClaims")
+	fmt.Fprintln(w, "  • Tenant namespace:", info.TenantNamespace)
+	fmt.Fprintln(w)
 }
 
 // confirmDestruction requires the user to type the cluster name.
 func confirmDestruction(name string) error {
-	fmt.Printf("To confirm destruction, type the cluster name: ")
-
-	reader := bufio.NewReader(os.Stdin)
-	input, err := reader.ReadString('\n')
-	if err != nil {
-		return fmt.Errorf("reading confirmation: %w", err)
-	}
-
-	input = strings.TrimSpace(input)
-	if input != name {
-		fmt.Println()
-		return fmt.Errorf("destruction cancelled: you typed %q, expected %q", input, name)
+	if err := prompt.ConfirmExact("To confirm destruction, type the cluster name: ", name); err != nil {
+		return fmt.Errorf("destruction cancelled: %w", err)
 	}
-
 	return nil
 }
 
@@ -226,43 +295,69 @@ func confirmDestruction(name string) error {
 func waitForDestruction(ctx context.Context, c *client.Client, opts *DestroyOptions) error {
 	opts.Logger.Info("waiting for destruction to complete", "timeout", opts.Timeout)
 
-	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
-	defer cancel()
+	startTime := time.Now()
 
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
+	err := waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("cluster %s to be destroyed", opts.Name),
+		Interval:    5 * time.Second,
+		Timeout:     opts.Timeout,
+		Progress: func(phase string, elapsed time.Duration) {
+			opts.Logger.Info("destruction progress", "phase", phase, "elapsed", elapsed)
+		},
+	}, func(ctx context.Context) (bool, string, error) {
+		tc, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return true, "", nil
+		}
+		if err != nil {
+			opts.Logger.Warn("error checking cluster status", "error", err)
+			return false, "", nil
+		}
+		return false, GetNestedString(tc.Object, "status", "phase"), nil
+	})
+	if err != nil {
+		return err
+	}
 
-	startTime := time.Now()
-	lastPhase := ""
-
-	for {
-		select {
-		case <-ctx.Done():
-			if ctx.Err() == context.DeadlineExceeded {
-				return fmt.Errorf("timeout waiting for cluster destruction after %v", opts.Timeout)
-			}
-			return ctx.Err()
-
-		case <-ticker.C:
-			tc, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
-			if err != nil {
-				if errors.IsNotFound(err) {
-					elapsed := time.Since(startTime).Round(time.Second)
-					opts.Logger.Success("cluster destroyed", "elapsed", elapsed)
-					fmt.Println("\n✓ Cluster has been completely destroyed.")
-					return nil
-				}
-				opts.Logger.Warn("error checking cluster status", "error", err)
-				continue
-			}
-
-			// Check phase for progress updates
-			phase := GetNestedString(tc.Object, "status", "phase")
-			if phase != lastPhase {
-				elapsed := time.Since(startTime).Round(time.Second)
-				opts.Logger.Info("destruction progress", "phase", phase, "elapsed", elapsed)
-				lastPhase = phase
-			}
+	opts.Logger.Success("cluster destroyed", "elapsed", time.Since(startTime).Round(time.Second))
+	return nil
+}
+
+// verifyCleanup reports, and with --verify-cleanup removes, resources the
+// destroyed cluster left behind
+func verifyCleanup(ctx context.Context, c *client.Client, opts *DestroyOptions, dc *destroyedCluster) error {
+	leftovers, err := findLeftovers(ctx, c, dc)
+	if err == nil && len(leftovers) > 0 {
+		if opts.VerifyCleanup {
+			opts.Logger.Info("removing leftover resources", "count", len(leftovers))
+			found := leftovers
+			leftovers, err = removeLeftovers(ctx, c, opts.Logger, dc, leftovers)
+			recordRemovedLeftovers(opts.result, found, leftovers)
+		} else {
+			leftovers, err = settleLeftovers(ctx, c, opts.Logger, dc, leftovers, leftoverGrace)
 		}
 	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		opts.Logger.Warn("could not check for leftover resources", "error", err)
+		return nil
+	}
+
+	if len(leftovers) == 0 {
+		fmt.Fprintln(opts.Output, "\n✓ Cluster has been completely destroyed.")
+		return nil
+	}
+
+	opts.Logger.Warn("cluster destroyed, but resources were left behind", "count", len(leftovers))
+	printLeftovers(opts.Output, leftovers)
+	for _, l := range leftovers {
+		opts.result.Next(l.manualCommand())
+	}
+	if opts.VerifyCleanup {
+		return fmt.Errorf("%d resource(s) of cluster %s could not be removed", len(leftovers), opts.Name)
+	}
+	fmt.Fprintln(opts.Output, "Pass --verify-cleanup to have destroy remove leftovers itself.")
+	return nil
 }
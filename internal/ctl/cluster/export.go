@@ -25,10 +25,11 @@ import (
 
 	"github.com/butlerdotdev/butler/internal/common/client"
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/objectstore"
+	"github.com/butlerdotdev/butler/internal/common/secretcrypt"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"sigs.k8s.io/yaml"
 )
 
 // ExportOptions holds options for the export command.
@@ -38,14 +39,31 @@ type ExportOptions struct {
 	Namespace    string
 	AllClusters  bool
 	AllNamespace bool
+	Selector     string
 
 	// Output control
 	OutputPath    string
 	AsName        string // Rename the exported cluster
 	IncludeStatus bool
 
+	// ForRecreate strips schema-defaulted fields and verifies the export
+	// with a server-side dry-run create
+	ForRecreate bool
+
+	// Related resources and bundling
+	IncludeSecrets string // sealed or sops; plain Secrets are never exported
+	IncludeAddons  bool
+	Bundle         string // Directory for a kustomize bundle
+
+	// Object storage destination (s3://bucket/path or gs://bucket/path)
+	To      string
+	Storage objectstore.Options
+
 	// Internal
 	Logger *log.Logger
+
+	// tcSchema is the TenantCluster OpenAPI schema, loaded for ForRecreate
+	tcSchema map[string]interface{}
 }
 
 // DefaultExportOptions returns ExportOptions with sensible defaults.
@@ -75,6 +93,24 @@ The output strips:
 - metadata.resourceVersion, uid, creationTimestamp, generation
 - metadata.managedFields (the 50+ lines of noise)
 - status (unless --include-status is specified)
+- Secret data and credential references such as status.kubeconfigSecretRef,
+  each with a warning
+
+Nothing else is masked: the spec and HelmRelease values are exported
+exactly as stored. HelmRelease values that look like plain-text credentials
+are reported with a warning.
+
+--include-addons adds the Flux HelmReleases in the cluster's tenant
+namespace. --include-secrets adds the Secrets the cluster and those
+releases reference, encrypted with kubeseal (sealed) or sops; plain Secrets
+are never written. --bundle writes everything to a directory with a
+kustomization.yaml, a package that 'kubectl apply -k' recreates.
+
+--for-recreate also strips fields equal to their CRD defaults, then creates
+each exported cluster in a server-side dry run under a generated name. The
+export fails if the server would reject it, and spec fields that come back
+different from the live cluster, such as ones set by controllers, are
+reported as not round-tripping.
 
 Examples:
   # Export to stdout
@@ -89,6 +125,24 @@ Examples:
   # Export all clusters to a directory
   butlerctl cluster export --all -o clusters/
 
+  # Export only production clusters
+  butlerctl cluster export --all -l environment=prod -o prod-clusters/
+
+  # Export all clusters to S3, one object per cluster
+  butlerctl cluster export --all -A --to s3://acme-backups/clusters
+
+  # Export to MinIO with credentials from the management cluster
+  butlerctl cluster export --all --to s3://butler/clusters \
+    --storage-endpoint https://minio.example.com \
+    --storage-credentials-secret butler-system/backup-storage
+
+  # Complete package with addons and sealed Secrets
+  butlerctl cluster export my-cluster --include-addons \
+    --include-secrets sealed --bundle my-cluster/
+
+  # Check the export would re-create the cluster on a fresh platform
+  butlerctl cluster export my-cluster --for-recreate -o my-cluster.yaml
+
   # Include status for debugging
   butlerctl cluster export my-cluster --include-status`,
 		Args:              cobra.MaximumNArgs(1),
@@ -113,7 +167,14 @@ Examples:
 	cmd.Flags().StringVar(&opts.AsName, "as", "", "Rename the cluster in the exported YAML")
 	cmd.Flags().BoolVar(&opts.AllClusters, "all", false, "Export all clusters in namespace")
 	cmd.Flags().BoolVarP(&opts.AllNamespace, "all-namespaces", "A", false, "Export from all namespaces (with --all)")
+	cmd.Flags().StringVarP(&opts.Selector, "selector", "l", "", "Label selector to filter clusters (with --all)")
 	cmd.Flags().BoolVar(&opts.IncludeStatus, "include-status", false, "Include status in output (excluded by default)")
+	cmd.Flags().BoolVar(&opts.ForRecreate, "for-recreate", false, "Strip defaulted fields and verify the output creates cleanly (server-side dry run)")
+	cmd.Flags().StringVar(&opts.IncludeSecrets, "include-secrets", "", "Include referenced Secrets, encrypted: sealed or sops")
+	cmd.Flags().BoolVar(&opts.IncludeAddons, "include-addons", false, "Include addon HelmReleases from the tenant namespace")
+	cmd.Flags().StringVar(&opts.Bundle, "bundle", "", "Write a directory with a kustomization.yaml")
+	cmd.Flags().StringVar(&opts.To, "to", "", "Upload to object storage: s3://bucket/path or gs://bucket/path")
+	opts.Storage.AddFlags(cmd)
 
 	return cmd
 }
@@ -127,6 +188,28 @@ func runExport(ctx context.Context, opts *ExportOptions) error {
 	if opts.AsName != "" && opts.AllClusters {
 		return fmt.Errorf("--as cannot be used with --all")
 	}
+	if opts.Selector != "" && !opts.AllClusters {
+		return fmt.Errorf("--selector requires --all")
+	}
+	if opts.IncludeSecrets != "" {
+		if err := secretcrypt.Validate(opts.IncludeSecrets); err != nil {
+			return err
+		}
+	}
+	if opts.ForRecreate && opts.IncludeStatus {
+		return fmt.Errorf("--for-recreate cannot be used with --include-status")
+	}
+	if opts.Bundle != "" && (opts.OutputPath != "" || opts.To != "") {
+		return fmt.Errorf("--bundle cannot be used with --output or --to")
+	}
+	if opts.To != "" {
+		if opts.OutputPath != "" {
+			return fmt.Errorf("--to cannot be used with --output")
+		}
+		if !objectstore.Remote(opts.To) {
+			return fmt.Errorf("--to must be s3://bucket/path or gs://bucket/path; use --output for local files")
+		}
+	}
 
 	c, err := client.NewFromDefault()
 	if err != nil {
@@ -153,25 +236,60 @@ func runExport(ctx context.Context, opts *ExportOptions) error {
 		clusters = []unstructured.Unstructured{*tc}
 	}
 
+	if opts.ForRecreate {
+		opts.tcSchema = loadTenantClusterSchema(ctx, c, opts)
+	}
+
+	var enc *secretcrypt.Encrypter
+	if opts.IncludeSecrets != "" {
+		enc, err = secretcrypt.New(ctx, c, opts.IncludeSecrets)
+		if err != nil {
+			return err
+		}
+		defer enc.Close()
+	}
+
+	exports := make([]*clusterExport, 0, len(clusters))
+	for i := range clusters {
+		export, err := collectExport(ctx, c, enc, &clusters[i], opts)
+		if err != nil {
+			return err
+		}
+		exports = append(exports, export)
+	}
+
 	// Export
+	if opts.Bundle != "" {
+		return exportBundles(exports, opts)
+	}
+	if opts.To != "" {
+		if err := opts.Storage.LoadCredentials(ctx, c); err != nil {
+			return err
+		}
+		store, err := objectstore.Open(opts.To, &opts.Storage)
+		if err != nil {
+			return err
+		}
+		return exportToStore(ctx, store, exports, opts)
+	}
 	if opts.AllClusters && opts.OutputPath != "" {
-		return exportMultipleToDir(clusters, opts)
+		return exportMultipleToDir(exports, opts)
 	}
 
-	return exportClusters(clusters, opts)
+	return exportClusters(exports, opts)
 }
 
 // listClustersForExport lists clusters based on export options.
 func listClustersForExport(ctx context.Context, c *client.Client, opts *ExportOptions) ([]unstructured.Unstructured, error) {
 	if opts.AllNamespace {
-		list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+		list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{LabelSelector: opts.Selector})
 		if err != nil {
 			return nil, fmt.Errorf("listing TenantClusters: %w", err)
 		}
 		return list.Items, nil
 	}
 
-	list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).List(ctx, metav1.ListOptions{})
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).List(ctx, metav1.ListOptions{LabelSelector: opts.Selector})
 	if err != nil {
 		return nil, fmt.Errorf("listing TenantClusters in namespace %s: %w", opts.Namespace, err)
 	}
@@ -179,21 +297,14 @@ func listClustersForExport(ctx context.Context, c *client.Client, opts *ExportOp
 }
 
 // exportClusters exports clusters to stdout or a single file.
-func exportClusters(clusters []unstructured.Unstructured, opts *ExportOptions) error {
+func exportClusters(exports []*clusterExport, opts *ExportOptions) error {
 	var output strings.Builder
 
-	for i, tc := range clusters {
-		cleaned := cleanForExport(&tc, opts)
-
-		data, err := yaml.Marshal(cleaned)
-		if err != nil {
-			return fmt.Errorf("marshaling YAML: %w", err)
-		}
-
+	for i, export := range exports {
 		if i > 0 {
 			output.WriteString("---\n")
 		}
-		output.Write(data)
+		output.Write(export.stream())
 	}
 
 	// Write output
@@ -206,43 +317,87 @@ func exportClusters(clusters []unstructured.Unstructured, opts *ExportOptions) e
 		return fmt.Errorf("writing file %s: %w", opts.OutputPath, err)
 	}
 
-	opts.Logger.Success("exported to file", "path", opts.OutputPath, "clusters", len(clusters))
+	opts.Logger.Success("exported to file", "path", opts.OutputPath, "clusters", len(exports))
 	return nil
 }
 
 // exportMultipleToDir exports multiple clusters to individual files in a directory.
-func exportMultipleToDir(clusters []unstructured.Unstructured, opts *ExportOptions) error {
+func exportMultipleToDir(exports []*clusterExport, opts *ExportOptions) error {
 	// Create directory if needed
 	if err := os.MkdirAll(opts.OutputPath, 0755); err != nil {
 		return fmt.Errorf("creating directory %s: %w", opts.OutputPath, err)
 	}
 
-	for _, tc := range clusters {
-		cleaned := cleanForExport(&tc, opts)
-
-		data, err := yaml.Marshal(cleaned)
-		if err != nil {
-			return fmt.Errorf("marshaling YAML for %s: %w", tc.GetName(), err)
+	for _, export := range exports {
+		path := filepath.Join(opts.OutputPath, exportFileName(export.tc, opts))
+		if err := os.WriteFile(path, export.stream(), 0644); err != nil {
+			return fmt.Errorf("writing file %s: %w", path, err)
 		}
 
-		filename := fmt.Sprintf("%s.yaml", tc.GetName())
-		// Include namespace in filename if exporting from multiple namespaces
-		if opts.AllNamespace {
-			filename = fmt.Sprintf("%s-%s.yaml", tc.GetNamespace(), tc.GetName())
+		opts.Logger.Info("exported", "cluster", export.tc.GetName(), "file", path)
+	}
+
+	opts.Logger.Success("exported clusters", "count", len(exports), "directory", opts.OutputPath)
+	return nil
+}
+
+// exportToStore uploads each cluster as its own object.
+func exportToStore(ctx context.Context, store objectstore.Store, exports []*clusterExport, opts *ExportOptions) error {
+	for _, export := range exports {
+		data := export.stream()
+		key := exportFileName(export.tc, opts)
+		if err := store.Put(ctx, key, data); err != nil {
+			return err
 		}
 
-		path := filepath.Join(opts.OutputPath, filename)
-		if err := os.WriteFile(path, data, 0644); err != nil {
-			return fmt.Errorf("writing file %s: %w", path, err)
+		opts.Logger.Info("exported", "cluster", export.tc.GetName(), "object", key, "sha256", objectstore.Checksum(data))
+	}
+
+	opts.Logger.Success("exported clusters", "count", len(exports), "location", store.String())
+	return nil
+}
+
+// exportBundles writes a kustomize bundle. A single cluster's files go
+// straight into the directory; with --all each cluster gets a subdirectory
+// and the top-level kustomization lists them.
+func exportBundles(exports []*clusterExport, opts *ExportOptions) error {
+	if !opts.AllClusters {
+		if err := writeBundle(opts.Bundle, exports[0]); err != nil {
+			return err
 		}
+		opts.Logger.Success("exported bundle", "cluster", exports[0].tc.GetName(), "directory", opts.Bundle, "files", len(exports[0].docs))
+		return nil
+	}
 
-		opts.Logger.Info("exported", "cluster", tc.GetName(), "file", path)
+	var dirs []string
+	for _, export := range exports {
+		dir := strings.TrimSuffix(exportFileName(export.tc, opts), ".yaml")
+		if err := writeBundle(filepath.Join(opts.Bundle, dir), export); err != nil {
+			return err
+		}
+		dirs = append(dirs, dir)
+		opts.Logger.Info("exported", "cluster", export.tc.GetName(), "directory", filepath.Join(opts.Bundle, dir))
+	}
+	if err := writeKustomization(opts.Bundle, dirs); err != nil {
+		return err
 	}
 
-	opts.Logger.Success("exported clusters", "count", len(clusters), "directory", opts.OutputPath)
+	opts.Logger.Success("exported bundles", "count", len(exports), "directory", opts.Bundle)
 	return nil
 }
 
+// exportFileName names a cluster's file, including the namespace when
+// exporting from multiple namespaces.
+func exportFileName(tc *unstructured.Unstructured, opts *ExportOptions) string {
+	if opts.AllNamespace {
+		return fmt.Sprintf("%s-%s.yaml", tc.GetNamespace(), tc.GetName())
+	}
+	if opts.AsName != "" {
+		return fmt.Sprintf("%s.yaml", opts.AsName)
+	}
+	return fmt.Sprintf("%s.yaml", tc.GetName())
+}
+
 // cleanForExport removes noise from a TenantCluster for clean export.
 // This is the core value-add over 'kubectl get -o yaml'.
 func cleanForExport(tc *unstructured.Unstructured, opts *ExportOptions) map[string]interface{} {
@@ -291,9 +446,55 @@ func cleanForExport(tc *unstructured.Unstructured, opts *ExportOptions) map[stri
 		delete(obj, "status")
 	}
 
+	normalizeMachineTemplate(obj)
+
 	return obj
 }
 
+// credentialFields are the known references to credential Secrets: a
+// provider's spec.credentialsRef and a cluster's status.kubeconfigSecretRef.
+// --include-secrets exports what they point at, encrypted.
+var credentialFields = [][]string{{"spec", "credentialsRef"}, {"status", "kubeconfigSecretRef"}}
+
+// stripCredentials removes Secret data and the known credential references
+// from an exported object and returns the fields it removed. Everything
+// else, including values that merely look sensitive, is exported as is.
+func stripCredentials(obj map[string]interface{}) []string {
+	var removed []string
+	fields := credentialFields
+	if obj["kind"] == "Secret" {
+		fields = append([][]string{{"data"}, {"stringData"}}, fields...)
+	}
+	for _, path := range fields {
+		if _, found, _ := unstructured.NestedFieldNoCopy(obj, path...); found {
+			unstructured.RemoveNestedField(obj, path...)
+			removed = append(removed, strings.Join(path, "."))
+		}
+	}
+	return removed
+}
+
+// normalizeMachineTemplate rewrites worker memory and disk quantities in
+// their shortest form, e.g. 8192Mi as 8Gi, so exports diff cleanly.
+// Values that don't parse are left as they are.
+func normalizeMachineTemplate(obj map[string]interface{}) {
+	template, found, _ := unstructured.NestedMap(obj, "spec", "workers", "machineTemplate")
+	if !found {
+		return
+	}
+	if memory, ok := template["memory"].(string); ok {
+		if mb, err := parseMemoryToMB(memory); err == nil {
+			template["memory"] = formatMemory(mb)
+		}
+	}
+	if disk, ok := template["diskSize"].(string); ok {
+		if gb, err := parseDiskToGB(disk); err == nil {
+			template["diskSize"] = formatDisk(gb)
+		}
+	}
+	_ = unstructured.SetNestedMap(obj, template, "spec", "workers", "machineTemplate")
+}
+
 // filterUserLabels removes system-managed labels.
 func filterUserLabels(labels map[string]interface{}) map[string]interface{} {
 	result := make(map[string]interface{})
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantClusters are written with strict field validation, so a field the
+// installed CRD doesn't define fails the request instead of being dropped.
+// Options that need a newer butler-controller then fail loudly, as do
+// values outside the CRD's enums.
+const fieldValidation = metav1.FieldValidationStrict
+
+// optionFields maps TenantCluster fields, or values, that only newer
+// controllers serve to the flags that set them
+var optionFields = map[string]string{
+	"spec.workers.machineTemplate.nodeLabels":       "--node-label",
+	"spec.workers.machineTemplate.nodeTaints":       "--node-taint",
+	"spec.workers.machineTemplate.kubeletExtraArgs": "--kubelet-arg",
+	"spec.addons.cni.provider":                      "--cni",
+	"spec.addons.storage.provider":                  "--default-storage-class",
+	"spec.addons.loadBalancer.enabled":              "--disable-metallb",
+	"spec.controlPlane.loadBalancerIP":              "--api-vip",
+	"spec.controlPlane.oidc":                        "--oidc-*",
+	"spec.controlPlane.featureGates":                "--feature-gates",
+	"spec.controlPlane.apiServerExtraArgs":          "--apiserver-extra-arg",
+}
+
+// controllerFlags set the fields in optionFields. The TenantCluster CRD
+// shipped with bootstrap doesn't define those fields yet, so the flags are
+// hidden until it does.
+var controllerFlags = []string{
+	"node-label", "node-taint", "kubelet-arg",
+	"disable-metallb",
+	"api-vip",
+	"oidc-issuer-url", "oidc-client-id", "oidc-groups-claim",
+	"feature-gates", "apiserver-extra-arg",
+}
+
+// hideControllerFlags hides the controllerFlags a command has
+func hideControllerFlags(cmd *cobra.Command) {
+	for _, name := range controllerFlags {
+		if cmd.Flags().Lookup(name) != nil {
+			_ = cmd.Flags().MarkHidden(name)
+		}
+	}
+}
+
+// unsupportedOptionsError explains a request the TenantCluster schema
+// rejected in terms of the flags that set the rejected fields. Other errors
+// are returned unchanged.
+func unsupportedOptionsError(err error) error {
+	flags := map[string]bool{}
+	for _, rejected := range client.RejectedFields(err) {
+		for field, flag := range optionFields {
+			if rejected == field || strings.HasPrefix(rejected, field+".") {
+				flags[flag] = true
+			}
+		}
+	}
+	if len(flags) == 0 {
+		return err
+	}
+
+	names := make([]string, 0, len(flags))
+	for flag := range flags {
+		names = append(names, flag)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("the TenantCluster CRD on this platform doesn't support %s; it needs a butler-controller release that does: %w",
+		strings.Join(names, ", "), err)
+}
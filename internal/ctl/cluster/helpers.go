@@ -20,9 +20,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/kubecache"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/suggest"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -38,6 +43,18 @@ const (
 
 	// EnvButlerNamespace allows overriding the default namespace via environment
 	EnvButlerNamespace = "BUTLER_NAMESPACE"
+
+	// MinWorkers is the smallest spec.workers.replicas the CRD accepts.
+	// Platform limits are read from the platform config; see platform.LoadLimits.
+	MinWorkers = 1
+
+	// DefaultPodCIDR and DefaultServiceCIDR are the cluster networks the
+	// controller uses when a TenantCluster doesn't set them
+	DefaultPodCIDR     = "10.244.0.0/16"
+	DefaultServiceCIDR = "10.96.0.0/12"
+
+	// MinTTL is the shortest lifetime accepted by create --ttl
+	MinTTL = time.Hour
 )
 
 // NamespaceFlags holds namespace-related flag values
@@ -103,6 +120,10 @@ type TenantClusterInfo struct {
 	TenantNamespace   string
 	ProviderConfig    string
 	CreationTime      string
+	Labels            map[string]string
+	Owner             string
+	Contact           string
+	ExpiresAt         string
 }
 
 // ExtractTenantClusterInfo extracts display information from an unstructured TenantCluster
@@ -129,6 +150,10 @@ func ExtractTenantClusterInfo(tc *unstructured.Unstructured) TenantClusterInfo {
 		TenantNamespace:   GetNestedString(obj, "status", "tenantNamespace"),
 		ProviderConfig:    GetNestedString(obj, "spec", "providerConfigRef", "name"),
 		CreationTime:      tc.GetCreationTimestamp().UTC().Format(time.RFC3339),
+		Labels:            tc.GetLabels(),
+		Owner:             tc.GetAnnotations()[lifecycle.OwnerAnnotation],
+		Contact:           tc.GetAnnotations()[lifecycle.ContactAnnotation],
+		ExpiresAt:         tc.GetAnnotations()[lifecycle.ExpiresAtAnnotation],
 	}
 }
 
@@ -247,6 +272,10 @@ If you don't have a management cluster set up yet, run:
 	return msg
 }
 
+// managementCheckTTL is how long a positive RequireManagementCluster result
+// is reused for the same context
+const managementCheckTTL = 5 * time.Minute
+
 // RequireManagementCluster verifies we're connected to a management cluster.
 // This prevents confusing errors when users accidentally run commands against
 // a tenant cluster.
@@ -255,6 +284,10 @@ If you don't have a management cluster set up yet, run:
 //   - butler-system namespace must exist
 //   - TenantCluster CRD must be registered
 //   - butler-controller deployment should exist (warning if not)
+//
+// The checks run concurrently, and a positive result is cached per
+// kubeconfig context and API server for managementCheckTTL (bypassed with
+// --no-cache).
 func RequireManagementCluster(ctx context.Context) error {
 	c, err := client.NewFromDefault()
 	if err != nil {
@@ -264,20 +297,47 @@ func RequireManagementCluster(ctx context.Context) error {
 	// Get current context for error message
 	currentContext := getCurrentContext()
 
+	cacheKey := currentContext + "|" + c.Config.Host
+	if kubecache.Verified(cacheKey, managementCheckTTL) {
+		return nil
+	}
+
+	var nsErr, crdErr error
+	var wg sync.WaitGroup
+	wg.Add(3)
+
 	// Check 1: butler-system namespace exists
-	_, err = c.Clientset.CoreV1().Namespaces().Get(ctx, ButlerSystemNamespace, metav1.GetOptions{})
-	if err != nil {
+	go func() {
+		defer wg.Done()
+		_, nsErr = c.Clientset.CoreV1().Namespaces().Get(ctx, ButlerSystemNamespace, metav1.GetOptions{})
+	}()
+
+	// Check 2: TenantCluster CRD exists (try to list, if CRD doesn't exist we get an error)
+	go func() {
+		defer wg.Done()
+		_, crdErr = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(DefaultTenantNamespace).List(ctx, metav1.ListOptions{Limit: 1})
+	}()
+
+	// Check 3: butler-controller deployment exists (soft check - just for validation)
+	go func() {
+		defer wg.Done()
+		// This is a soft warning - CRDs might exist from a previous install
+		// but controller might not be running. Still allow the operation.
+		_, _ = c.Clientset.AppsV1().Deployments(ButlerSystemNamespace).Get(ctx, "butler-controller", metav1.GetOptions{})
+	}()
+
+	wg.Wait()
+
+	if nsErr != nil {
 		return &ManagementClusterError{
 			CurrentContext: currentContext,
 			Reason:         fmt.Sprintf("The '%s' namespace does not exist.\nThis namespace is present on Butler management clusters.", ButlerSystemNamespace),
 		}
 	}
 
-	// Check 2: TenantCluster CRD exists (try to list, if CRD doesn't exist we get an error)
-	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(DefaultTenantNamespace).List(ctx, metav1.ListOptions{Limit: 1})
-	if err != nil {
+	if crdErr != nil {
 		// Check if it's a "resource not found" type error (CRD doesn't exist)
-		errStr := err.Error()
+		errStr := crdErr.Error()
 		if contains(errStr, "the server could not find the requested resource") ||
 			contains(errStr, "no matches for kind") {
 			return &ManagementClusterError{
@@ -285,15 +345,13 @@ func RequireManagementCluster(ctx context.Context) error {
 				Reason:         "The TenantCluster CRD is not registered.\nButler CRDs are only installed on management clusters.",
 			}
 		}
-		// Other errors (like network issues) - don't treat as wrong cluster
+		// Other errors (like network issues) - don't treat as wrong cluster,
+		// but don't cache an unconfirmed result either
+		return nil
 	}
 
-	// Check 3: butler-controller deployment exists (soft check - just for validation)
-	_, err = c.Clientset.AppsV1().Deployments(ButlerSystemNamespace).Get(ctx, "butler-controller", metav1.GetOptions{})
-	if err != nil {
-		// This is a soft warning - CRDs might exist from a previous install
-		// but controller might not be running. Still allow the operation.
-	}
+	// Caching is best-effort
+	_ = kubecache.MarkVerified(cacheKey)
 
 	return nil
 }
@@ -301,10 +359,13 @@ func RequireManagementCluster(ctx context.Context) error {
 // getCurrentContext returns the current kubectl context name.
 func getCurrentContext() string {
 	// Try to get from KUBECONFIG or default location
-	kubeconfigPath := os.Getenv("KUBECONFIG")
+	kubeconfigPath := ""
+	if list := filepath.SplitList(os.Getenv("KUBECONFIG")); len(list) > 0 {
+		kubeconfigPath = list[0]
+	}
 	if kubeconfigPath == "" {
 		home, _ := os.UserHomeDir()
-		kubeconfigPath = home + "/.kube/config"
+		kubeconfigPath = filepath.Join(home, ".kube", "config")
 	}
 
 	// Read the kubeconfig to get current-context
@@ -377,3 +438,15 @@ func trimSpace(s string) string {
 	}
 	return s[start:end]
 }
+
+// ClusterNotFoundError reports a missing TenantCluster, suggesting clusters
+// in the same namespace with similar names
+func ClusterNotFoundError(ctx context.Context, c *client.Client, namespace, name string) error {
+	var names []string
+	if list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace).List(ctx, metav1.ListOptions{}); err == nil {
+		for _, tc := range list.Items {
+			names = append(names, tc.GetName())
+		}
+	}
+	return fmt.Errorf("TenantCluster %q not found in namespace %q%s", name, namespace, suggest.Hint(suggest.Closest(name, names)))
+}
@@ -21,12 +21,21 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/butlerdotdev/butler/internal/common/access"
+	"github.com/butlerdotdev/butler/internal/common/apidns"
 	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/kubecache"
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/paths"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
 	"github.com/spf13/cobra"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
 )
@@ -36,7 +45,13 @@ type kubeconfigOptions struct {
 	outputPath     string
 	merge          bool
 	setContext     bool
+	noBackup       bool
+	contextName    string
+	overwrite      bool
 	kubeconfigPath string
+	expires        time.Duration
+	role           string
+	tunnelPort     int
 }
 
 // newKubeconfigCmd creates the cluster kubeconfig command
@@ -50,9 +65,41 @@ func newKubeconfigCmd(logger *log.Logger) *cobra.Command {
 
 By default, outputs the kubeconfig to stdout for piping.
 Use --output to save to a file, or --merge to add to your default kubeconfig.
+Merging holds kubectl's <file>.lock while it rewrites the file, so parallel
+merges don't clobber each other, and first copies the file to
+<file>.butler-backup unless --no-backup is given.
+
+Merged entries are named after the cluster unless --context-name or the
+kubeconfig.contextName setting in the butler-platform ConfigMap says
+otherwise; both accept a Go template such as
+"butler-{{ .Namespace }}-{{ .Name }}". If a context or cluster entry of that
+name already points at another server, you are asked before it is
+replaced; non-interactively the merge fails unless --overwrite is given.
+A tenant kubeconfig with several contexts (e.g. OIDC and certificate users)
+is merged whole, each entry keeping its own name behind a "<context>-"
+prefix; the tenant's current context becomes the current one.
 
 The kubeconfig is fetched from the management cluster, where it's stored
-in a Secret within the tenant cluster's dedicated namespace.
+in a Secret within the tenant cluster's dedicated namespace. Fetched
+kubeconfigs are cached encrypted under ~/.butler/cache/kubeconfigs/ for
+15 minutes (BUTLER_KUBECONFIG_CACHE_TTL, "0" disables); if the management
+cluster is unreachable, an expired cache entry is used with a warning.
+Use the global --no-cache flag to always fetch, or 'butlerctl cache clear'
+to drop the cache.
+
+Clusters with an API DNS name (dns.zone in the platform config) get a
+kubeconfig that uses the name instead of the endpoint address, once the
+name resolves.
+
+Clusters created with --api-access private have no API endpoint outside
+the management cluster. Their kubeconfig points at 127.0.0.1:--tunnel-port
+and the command prints the 'kubectl port-forward' to run against the
+management cluster to open that tunnel.
+
+With --expires, a time-boxed credential is minted instead: a ServiceAccount
+token bound to the requested --role that stops working after the given
+duration. Issued credentials are recorded on the management cluster and can
+be audited with 'butleradm access list'.
 
 Examples:
   # Output kubeconfig to stdout (for piping)
@@ -67,6 +114,12 @@ Examples:
   # Merge without switching context
   butlerctl cluster kubeconfig my-cluster --merge --set-context=false
 
+  # Merge under a namespaced context name
+  butlerctl cluster kubeconfig my-cluster --merge --context-name 'butler-{{ .Namespace }}-{{ .Name }}'
+
+  # Time-boxed read-only access for the next 8 hours
+  butlerctl cluster kubeconfig my-cluster --expires 8h --role view --merge
+
   # Use a specific management cluster kubeconfig
   butlerctl cluster kubeconfig my-cluster --kubeconfig ~/.butler/butler-ntnx-kubeconfig`,
 		Args: cobra.ExactArgs(1),
@@ -79,7 +132,13 @@ Examples:
 	cmd.Flags().StringVarP(&opts.outputPath, "output", "o", "", "output file path (use - for stdout, default)")
 	cmd.Flags().BoolVar(&opts.merge, "merge", false, "merge into default kubeconfig (~/.kube/config)")
 	cmd.Flags().BoolVar(&opts.setContext, "set-context", true, "set as current context when merging (only with --merge)")
+	cmd.Flags().BoolVar(&opts.noBackup, "no-backup", false, "don't back up the kubeconfig before merging (only with --merge)")
+	cmd.Flags().StringVar(&opts.contextName, "context-name", "", "context name or Go template for merged entries (only with --merge)")
+	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, "replace a same-named context that points at another server (only with --merge)")
 	cmd.Flags().StringVar(&opts.kubeconfigPath, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().DurationVar(&opts.expires, "expires", 0, "mint a credential valid only for this duration (e.g. 8h, minimum 10m)")
+	cmd.Flags().StringVar(&opts.role, "role", "admin", "role for --expires credentials (admin, edit, view)")
+	cmd.Flags().IntVar(&opts.tunnelPort, "tunnel-port", DefaultTunnelPort, "local port of the API server tunnel for private clusters")
 
 	return cmd
 }
@@ -97,51 +156,62 @@ func runKubeconfig(ctx context.Context, logger *log.Logger, clusterName string,
 		return fmt.Errorf("connecting to management cluster: %w", err)
 	}
 
-	// Get the TenantCluster to find the tenant namespace
-	tc, err := c.GetTenantCluster(ctx, opts.namespace, clusterName)
+	// Fetch the admin kubeconfig Steward stores for the cluster
+	kubeconfigData, err := fetchKubeconfig(ctx, logger, c, opts.namespace, clusterName)
 	if err != nil {
-		return fmt.Errorf("getting TenantCluster %s/%s: %w", opts.namespace, clusterName, err)
-	}
-
-	// Extract tenant namespace from status
-	tenantNS := GetNestedString(tc.Object, "status", "tenantNamespace")
-	if tenantNS == "" {
-		return fmt.Errorf("TenantCluster %s does not have a tenant namespace yet (phase: %s)",
-			clusterName, GetNestedString(tc.Object, "status", "phase"))
+		return err
 	}
 
-	// The kubeconfig secret follows Steward's pattern: <name>-admin-kubeconfig
-	secretName := clusterName + "-admin-kubeconfig"
-
-	// Fetch the secret from the tenant namespace
-	secret, err := c.Clientset.CoreV1().Secrets(tenantNS).Get(ctx, secretName, metav1.GetOptions{})
-	if err != nil {
-		return fmt.Errorf("getting kubeconfig secret %s/%s: %w", tenantNS, secretName, err)
+	// Private clusters are reached through a port-forward via the
+	// management cluster; others by their DNS name once it resolves
+	if tc, err := c.GetTenantCluster(ctx, opts.namespace, clusterName); err == nil {
+		kubeconfigData, err = routeKubeconfig(ctx, logger, tc, kubeconfigData, opts.tunnelPort)
+		if err != nil {
+			return err
+		}
 	}
 
-	// Steward stores kubeconfig in 'admin.conf' key
-	kubeconfigData, ok := secret.Data["admin.conf"]
-	if !ok {
-		// Try alternative keys
-		kubeconfigData, ok = secret.Data["kubeconfig"]
-		if !ok {
-			kubeconfigData, ok = secret.Data["value"]
-			if !ok {
-				return fmt.Errorf("kubeconfig secret %s/%s does not contain kubeconfig data (keys: admin.conf, kubeconfig, or value)",
-					tenantNS, secretName)
-			}
+	// Swap the admin kubeconfig for a time-boxed credential if requested
+	if opts.expires > 0 {
+		cred, err := mintScopedKubeconfig(ctx, clusterName, kubeconfigData, opts.role, opts.expires)
+		if err != nil {
+			return err
+		}
+		kubeconfigData = cred.kubeconfig
+
+		record := &access.Record{
+			Cluster:        clusterName,
+			Namespace:      opts.namespace,
+			IssuedTo:       currentUser(ctx, c),
+			Role:           opts.role,
+			ServiceAccount: cred.serviceAccount,
+			IssuedAt:       time.Now(),
+			ExpiresAt:      cred.expiresAt,
 		}
+		if err := access.Save(ctx, c, record); err != nil {
+			logger.Warn("credential issued but could not be recorded for audit", "error", err)
+		}
+		logger.Info("issued time-boxed credential", "role", opts.role, "expires", cred.expiresAt.Local().Format(time.RFC1123))
 	}
 
 	// Handle merge mode
 	if opts.merge {
-		return mergeKubeconfig(logger, clusterName, kubeconfigData, opts.setContext)
+		cfg, err := platform.Load(ctx, c)
+		if err != nil {
+			logger.Debug("could not load platform config", "error", err)
+			cfg = &platform.Config{}
+		}
+		contextName, err := cfg.Kubeconfig.RenderContextName(opts.contextName, platform.TemplateData{Name: clusterName, Namespace: opts.namespace})
+		if err != nil {
+			return err
+		}
+		return mergeKubeconfig(logger, contextName, kubeconfigData, opts)
 	}
 
 	// Handle file output
 	if opts.outputPath != "" && opts.outputPath != "-" {
 		// Expand ~ if present
-		outputPath := expandPath(opts.outputPath)
+		outputPath := paths.Expand(opts.outputPath)
 
 		// Ensure directory exists
 		dir := filepath.Dir(outputPath)
@@ -155,7 +225,7 @@ func runKubeconfig(ctx context.Context, logger *log.Logger, clusterName string,
 		}
 
 		logger.Success("kubeconfig saved", "path", outputPath)
-		logger.Info("Use: export KUBECONFIG=" + outputPath)
+		logger.Info("Use: " + paths.ExportHint("KUBECONFIG", outputPath))
 		return nil
 	}
 
@@ -164,8 +234,77 @@ func runKubeconfig(ctx context.Context, logger *log.Logger, clusterName string,
 	return nil
 }
 
+// routeKubeconfig points a tenant kubeconfig at the tunnel for private
+// clusters, or at the API endpoint's DNS name once it resolves. The name is
+// published by cluster create and 'butleradm dns sync'.
+func routeKubeconfig(ctx context.Context, logger *log.Logger, tc *unstructured.Unstructured, kubeconfig []byte, tunnelPort int) ([]byte, error) {
+	if isPrivateCluster(tc) {
+		tenantNamespace := orDefault(GetNestedString(tc.Object, "status", "tenantNamespace"), tc.GetNamespace())
+		logger.Info("private cluster: keep this tunnel running while using the kubeconfig",
+			"command", tunnelCommand(tenantNamespace, tc.GetName(), tunnelPort))
+		return routeThroughTunnel(kubeconfig, tunnelPort)
+	}
+
+	hostname := apidns.Hostname(tc)
+	if hostname == "" {
+		return kubeconfig, nil
+	}
+	if !apidns.Resolves(ctx, hostname) {
+		logger.Warn("API DNS name doesn't resolve yet; using the endpoint address (an admin can publish it with 'butleradm dns sync')", "hostname", hostname)
+		return kubeconfig, nil
+	}
+	return apidns.RewriteServer(kubeconfig, hostname)
+}
+
+// fetchKubeconfig returns the tenant admin kubeconfig, serving it from the
+// local cache while fresh and falling back to a stale entry when the
+// management cluster cannot be reached
+func fetchKubeconfig(ctx context.Context, logger *log.Logger, c *client.Client, namespace, name string) ([]byte, error) {
+	cache, err := kubecache.New()
+	if err != nil {
+		return nil, err
+	}
+	if !cache.Enabled() {
+		return c.GetTenantKubeconfig(ctx, namespace, name)
+	}
+
+	server := c.Config.Host
+	cached, err := cache.Get(server, namespace, name)
+	if err != nil {
+		logger.Debug("ignoring unreadable cache entry", "error", err)
+		cached = nil
+	}
+	if cached != nil && cached.Fresh(cache.TTL) {
+		logger.Debug("using cached kubeconfig", "cluster", name, "age", time.Since(cached.FetchedAt).Round(time.Second))
+		return cached.Kubeconfig, nil
+	}
+
+	data, err := c.GetTenantKubeconfig(ctx, namespace, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The cluster is gone; don't keep serving its credentials
+			if err := cache.Delete(server, namespace, name); err != nil {
+				logger.Debug("could not drop cache entry", "error", err)
+			}
+			return nil, err
+		}
+		if cached != nil {
+			logger.Warn("management cluster unavailable, using cached kubeconfig",
+				"cluster", name, "age", time.Since(cached.FetchedAt).Round(time.Second), "error", err)
+			return cached.Kubeconfig, nil
+		}
+		return nil, err
+	}
+
+	if err := cache.Put(server, namespace, name, data); err != nil {
+		logger.Debug("could not cache kubeconfig", "error", err)
+	}
+	return data, nil
+}
+
 // mergeKubeconfig merges the tenant kubeconfig into the active kubeconfig
-func mergeKubeconfig(logger *log.Logger, clusterName string, kubeconfigData []byte, setCurrentContext bool) error {
+// under contextName
+func mergeKubeconfig(logger *log.Logger, contextName string, kubeconfigData []byte, opts *kubeconfigOptions) error {
 	// Parse the tenant kubeconfig
 	tenantConfig, err := clientcmd.Load(kubeconfigData)
 	if err != nil {
@@ -177,11 +316,10 @@ func mergeKubeconfig(logger *log.Logger, clusterName string, kubeconfigData []by
 	var targetPath string
 	if kubeconfigEnv := os.Getenv("KUBECONFIG"); kubeconfigEnv != "" {
 		// KUBECONFIG can have multiple paths; use the first one
-		paths := strings.Split(kubeconfigEnv, string(os.PathListSeparator))
-		for _, p := range paths {
+		for _, p := range filepath.SplitList(kubeconfigEnv) {
 			p = strings.TrimSpace(p)
 			if p != "" {
-				targetPath = expandPath(p)
+				targetPath = paths.Expand(p)
 				break
 			}
 		}
@@ -190,6 +328,20 @@ func mergeKubeconfig(logger *log.Logger, clusterName string, kubeconfigData []by
 		targetPath = clientcmd.RecommendedHomeFile
 	}
 
+	// Hold the lock from read to write so concurrent merges, ours or
+	// kubectl's, see each other's changes
+	unlock, err := lockKubeconfig(targetPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if !opts.noBackup {
+		if err := backupKubeconfig(targetPath); err != nil {
+			return err
+		}
+	}
+
 	// Load the target kubeconfig
 	targetConfig, err := clientcmd.LoadFromFile(targetPath)
 	if err != nil {
@@ -201,30 +353,9 @@ func mergeKubeconfig(logger *log.Logger, clusterName string, kubeconfigData []by
 		}
 	}
 
-	// Determine names for the merged entries
-	// Use the cluster name as the context name for clarity
-	contextName := clusterName
-	clusterEntryName := clusterName
-	userName := clusterName + "-admin"
-
-	// Find the first cluster from tenant config (Steward typically creates one)
-	var tenantCluster *api.Cluster
-	for _, cluster := range tenantConfig.Clusters {
-		tenantCluster = cluster
-		break
-	}
-	if tenantCluster == nil {
-		return fmt.Errorf("tenant kubeconfig contains no clusters")
-	}
-
-	// Find the first user from tenant config
-	var tenantUser *api.AuthInfo
-	for _, user := range tenantConfig.AuthInfos {
-		tenantUser = user
-		break
-	}
-	if tenantUser == nil {
-		return fmt.Errorf("tenant kubeconfig contains no users")
+	entries, current, err := planMerge(tenantConfig, contextName)
+	if err != nil {
+		return err
 	}
 
 	// Initialize maps if nil (safety check)
@@ -238,46 +369,248 @@ func mergeKubeconfig(logger *log.Logger, clusterName string, kubeconfigData []by
 		targetConfig.Contexts = make(map[string]*api.Context)
 	}
 
-	// Add/update cluster entry
-	targetConfig.Clusters[clusterEntryName] = tenantCluster
-
-	// Add/update user entry
-	targetConfig.AuthInfos[userName] = tenantUser
+	// Don't silently repoint an entry at a different cluster
+	for _, e := range entries {
+		conflict := mergeConflict(targetConfig, e.context, e.clusterName, e.cluster.Server)
+		if conflict == "" || opts.overwrite {
+			continue
+		}
+		if !prompt.Interactive() {
+			return fmt.Errorf("%s; pass --context-name to use another name or --overwrite to replace it", conflict)
+		}
+		ok, err := prompt.Confirm(conflict + ". Overwrite?")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("merge cancelled; pass --context-name to use another name")
+		}
+	}
 
-	// Add/update context entry
-	targetConfig.Contexts[contextName] = &api.Context{
-		Cluster:  clusterEntryName,
-		AuthInfo: userName,
+	// Add/update the cluster, user and context entries
+	for _, e := range entries {
+		targetConfig.Clusters[e.clusterName] = e.cluster
+		targetConfig.AuthInfos[e.userName] = e.user
+		targetConfig.Contexts[e.context] = &api.Context{
+			Cluster:   e.clusterName,
+			AuthInfo:  e.userName,
+			Namespace: e.namespace,
+		}
 	}
 
 	// Set as current context if requested
-	if setCurrentContext {
-		targetConfig.CurrentContext = contextName
+	if opts.setContext {
+		targetConfig.CurrentContext = current
 	}
 
 	// Write back to kubeconfig
-	if err := clientcmd.WriteToFile(*targetConfig, targetPath); err != nil {
-		return fmt.Errorf("writing kubeconfig to %s: %w", targetPath, err)
+	data, err := clientcmd.Write(*targetConfig)
+	if err != nil {
+		return fmt.Errorf("encoding kubeconfig: %w", err)
+	}
+	if err := writeKubeconfigAtomic(targetPath, data); err != nil {
+		return err
 	}
 
-	logger.Success("kubeconfig merged", "context", contextName, "file", targetPath)
-	if setCurrentContext {
-		logger.Info("Current context set to: " + contextName)
+	for _, e := range entries {
+		logger.Success("kubeconfig merged", "context", e.context, "cluster", e.cluster.Server, "user", e.userName, "file", targetPath)
+	}
+	if opts.setContext {
+		logger.Info("Current context set to: " + current)
 	} else {
-		logger.Info("Use: kubectl config use-context " + contextName)
+		logger.Info("Use: kubectl config use-context " + current)
 	}
 
 	return nil
 }
 
-// expandPath expands ~ to home directory
-func expandPath(path string) string {
-	if len(path) > 0 && path[0] == '~' {
-		home, err := os.UserHomeDir()
+// mergeEntry is one context from the tenant kubeconfig, renamed for the
+// target kubeconfig
+type mergeEntry struct {
+	context     string
+	clusterName string
+	cluster     *api.Cluster
+	userName    string
+	user        *api.AuthInfo
+	namespace   string
+}
+
+// planMerge names the tenant kubeconfig's contexts for merging. A single
+// context, the usual Steward admin kubeconfig, becomes contextName with a
+// <contextName>-admin user. With several, e.g. an OIDC and a certificate
+// user, every context, cluster and user keeps its own name behind a
+// "<contextName>-" prefix. It also returns which merged context matches the
+// tenant's current context.
+func planMerge(tenant *api.Config, contextName string) ([]mergeEntry, string, error) {
+	if len(tenant.Clusters) == 0 {
+		return nil, "", fmt.Errorf("tenant kubeconfig contains no clusters")
+	}
+	if len(tenant.AuthInfos) == 0 {
+		return nil, "", fmt.Errorf("tenant kubeconfig contains no users")
+	}
+
+	// Without contexts, pair the first cluster with the first user
+	if len(tenant.Contexts) == 0 {
+		clusterName := sortedKeys(tenant.Clusters)[0]
+		userName := sortedKeys(tenant.AuthInfos)[0]
+		tenant.Contexts = map[string]*api.Context{
+			contextName: {Cluster: clusterName, AuthInfo: userName},
+		}
+		tenant.CurrentContext = contextName
+	}
+
+	names := sortedKeys(tenant.Contexts)
+	var entries []mergeEntry
+	current := ""
+	for _, name := range names {
+		ctx := tenant.Contexts[name]
+		cluster, ok := tenant.Clusters[ctx.Cluster]
+		if !ok {
+			return nil, "", fmt.Errorf("tenant context %q references missing cluster %q", name, ctx.Cluster)
+		}
+		user, ok := tenant.AuthInfos[ctx.AuthInfo]
+		if !ok {
+			return nil, "", fmt.Errorf("tenant context %q references missing user %q", name, ctx.AuthInfo)
+		}
+
+		e := mergeEntry{
+			context:     contextName,
+			clusterName: contextName,
+			cluster:     cluster,
+			userName:    contextName + "-admin",
+			user:        user,
+			namespace:   ctx.Namespace,
+		}
+		if len(names) > 1 {
+			e.context = contextName + "-" + name
+			e.clusterName = contextName + "-" + ctx.Cluster
+			e.userName = contextName + "-" + ctx.AuthInfo
+		}
+		entries = append(entries, e)
+
+		if name == tenant.CurrentContext || current == "" {
+			current = e.context
+		}
+	}
+	return entries, current, nil
+}
+
+// sortedKeys returns a map's keys in order, so merges are deterministic
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mergeConflict describes an existing context or cluster entry of the given
+// names that points at a server other than server, or returns ""
+func mergeConflict(cfg *api.Config, contextName, clusterName, server string) string {
+	if ctx, ok := cfg.Contexts[contextName]; ok {
+		if existing, ok := cfg.Clusters[ctx.Cluster]; ok && existing.Server != server {
+			return fmt.Sprintf("context %q already exists and points at %s", contextName, existing.Server)
+		}
+	}
+	if existing, ok := cfg.Clusters[clusterName]; ok && existing.Server != server {
+		return fmt.Sprintf("cluster entry %q already exists and points at %s", clusterName, existing.Server)
+	}
+	return ""
+}
+
+const (
+	// kubeconfigLockTimeout is how long a merge waits for another writer
+	kubeconfigLockTimeout = 10 * time.Second
+
+	// kubeconfigStaleLock is the age past which a lock file is taken to be
+	// left over from a crashed process
+	kubeconfigStaleLock = 2 * time.Minute
+)
+
+// lockKubeconfig takes the advisory <path>.lock file that kubectl and
+// client-go also use, waiting for other writers to finish. The returned
+// func releases it.
+func lockKubeconfig(path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("creating directory %s: %w", filepath.Dir(path), err)
+	}
+
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(kubeconfigLockTimeout)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("locking %s: %w", path, err)
+		}
+
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > kubeconfigStaleLock {
+			os.Remove(lockPath)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%s is locked by another process; remove %s if no kubectl or butlerctl is running", path, lockPath)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// backupKubeconfig copies the kubeconfig to <path>.butler-backup before a
+// merge. A missing kubeconfig has nothing to back up.
+func backupKubeconfig(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading kubeconfig for backup: %w", err)
+	}
+	if err := writeKubeconfigAtomic(path+".butler-backup", data); err != nil {
+		return fmt.Errorf("backing up kubeconfig: %w", err)
+	}
+	return nil
+}
+
+// writeKubeconfigAtomic writes through a temporary file in the same
+// directory and renames it into place, so readers never see a partial file.
+// A symlinked kubeconfig is written through to its target, and an existing
+// file keeps its mode.
+func writeKubeconfigAtomic(path string, data []byte) error {
+	mode := os.FileMode(0600)
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+		info, err := os.Stat(path)
 		if err != nil {
-			return path
+			return fmt.Errorf("reading %s: %w", path, err)
 		}
-		return filepath.Join(home, path[1:])
+		mode = info.Mode().Perm()
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("resolving %s: %w", path, err)
 	}
-	return path
+
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("creating temporary file in %s: %w", dir, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return fmt.Errorf("setting permissions on %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("saving %s: %w", path, err)
+	}
+	return nil
 }
@@ -21,13 +21,18 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/butlerdotdev/butler/internal/common/client"
 	"github.com/butlerdotdev/butler/internal/common/log"
 	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -36,6 +41,8 @@ type listOptions struct {
 	nsFlags      NamespaceFlags
 	outputFormat string
 	kubeconfig   string
+	selector     string
+	labelColumns []string
 }
 
 // newListCmd creates the cluster list command
@@ -50,6 +57,9 @@ func newListCmd(logger *log.Logger) *cobra.Command {
 
 By default, lists clusters in the butler-tenants namespace.
 Use -n to specify a different namespace, or -A to list across all namespaces.
+Clusters are fetched in pages and table rows are printed as each page
+arrives. If RBAC does not allow listing across all namespaces, -A lists
+every readable namespace in parallel instead.
 
 Examples:
   # List clusters in default namespace
@@ -61,9 +71,13 @@ Examples:
   # List clusters across all namespaces
   butlerctl cluster list -A
 
-  # Output in wide format (includes endpoint, provider)
+  # Output in wide format (includes endpoint, provider, owner, the labels
+  # required by platform conventions and the console link)
   butlerctl cluster list -o wide
 
+  # Filter by label and show label values as columns
+  butlerctl cluster list -l environment=prod -L cost-center,owner
+
   # Output as JSON
   butlerctl cluster list -o json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -74,6 +88,8 @@ Examples:
 	AddNamespaceFlags(cmd, &opts.nsFlags)
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, wide, json, yaml)")
 	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig file")
+	cmd.Flags().StringVarP(&opts.selector, "selector", "l", "", "label selector to filter on (e.g. environment=prod,cost-center)")
+	cmd.Flags().StringSliceVarP(&opts.labelColumns, "label-columns", "L", nil, "labels to show as columns (comma-separated)")
 
 	return cmd
 }
@@ -98,49 +114,26 @@ func runList(ctx context.Context, logger *log.Logger, opts *listOptions) error {
 
 	// Resolve namespace
 	namespace, allNamespaces := opts.nsFlags.ResolveNamespace()
-
-	// List TenantClusters
-	var clusters []unstructured.Unstructured
-
 	if allNamespaces {
-		// List across all namespaces
-		list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return fmt.Errorf("listing TenantClusters: %w", err)
-		}
-		clusters = list.Items
-	} else {
-		// List in specific namespace
-		list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return fmt.Errorf("listing TenantClusters in namespace %s: %w", namespace, err)
-		}
-		clusters = list.Items
-	}
-
-	// Sort by namespace, then name
-	sort.Slice(clusters, func(i, j int) bool {
-		if clusters[i].GetNamespace() != clusters[j].GetNamespace() {
-			return clusters[i].GetNamespace() < clusters[j].GetNamespace()
-		}
-		return clusters[i].GetName() < clusters[j].GetName()
-	})
-
-	// Extract info for all clusters and enrich with MachineDeployment data
-	infos := make([]TenantClusterInfo, len(clusters))
-	for i := range clusters {
-		infos[i] = ExtractTenantClusterInfo(&clusters[i])
-		// Enrich with actual worker status from MachineDeployment
-		EnrichWithMachineDeploymentStatus(ctx, c, &infos[i])
-		// Enrich with control plane endpoint from CAPI Cluster
-		EnrichWithControlPlaneEndpoint(ctx, c, &infos[i])
+		namespace = metav1.NamespaceAll
 	}
+	lister := &clusterLister{client: c, selector: opts.selector, logger: logger}
 
 	// Create printer and output
 	printer := output.NewPrinter(format, os.Stdout)
 
-	// For JSON/YAML, output the raw list
+	// For JSON/YAML, collect every page and output the sorted list
 	if format == output.FormatJSON || format == output.FormatYAML {
+		var infos []TenantClusterInfo
+		err := lister.list(ctx, namespace, func(page []TenantClusterInfo) error {
+			infos = append(infos, page...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		sortClusterInfos(infos)
+
 		// Create a cleaned up structure for output
 		outputData := make([]map[string]interface{}, len(infos))
 		for i, info := range infos {
@@ -157,76 +150,275 @@ func runList(ctx context.Context, logger *log.Logger, opts *listOptions) error {
 				"tenantNamespace": info.TenantNamespace,
 				"providerConfig":  info.ProviderConfig,
 				"creationTime":    info.CreationTime,
+				"owner":           info.Owner,
+				"contact":         info.Contact,
+				"expiresAt":       info.ExpiresAt,
+				"labels":          info.Labels,
 			}
 		}
 		return printer.Print(outputData, nil)
 	}
 
-	// Table output
+	// Wide output also surfaces the labels required by platform conventions
+	// and, when the Console addon is installed, each cluster's console page
+	labelColumns := opts.labelColumns
+	var console *platform.Console
+	if format == output.FormatWide {
+		platformCfg, err := platform.Load(ctx, c)
+		if err != nil {
+			logger.Debug("could not load platform config", "error", err)
+		} else {
+			for _, key := range platformCfg.Conventions.LabelKeys() {
+				if !slices.Contains(labelColumns, key) {
+					labelColumns = append(labelColumns, key)
+				}
+			}
+			if platformCfg.ResolveConsole(ctx, c) {
+				console = &platformCfg.Console
+			}
+		}
+	}
+
+	// Table output, streamed a page at a time
 	return printer.Print(nil, func(w io.Writer) error {
-		return printClusterTable(w, infos, format == output.FormatWide, allNamespaces)
+		wide := format == output.FormatWide
+		table := output.NewTable(w, clusterTableHeaders(wide, allNamespaces, labelColumns, console != nil)...)
+		table.SetPriority("NAME", 2)
+		table.SetPriority("PHASE", 1)
+		table.SetPriority("ENDPOINT", -1)
+		table.SetPriority("CONSOLE", -1)
+		err := lister.list(ctx, namespace, func(page []TenantClusterInfo) error {
+			for _, tc := range page {
+				table.AddRow(clusterTableRow(tc, wide, allNamespaces, labelColumns, console)...)
+			}
+			return table.Flush()
+		})
+		if err != nil {
+			return err
+		}
+		// Ensure the header is printed even when nothing matched
+		return table.Flush()
+	})
+}
+
+const (
+	// listPageSize is the number of TenantClusters requested per List call
+	listPageSize = 100
+
+	// listConcurrency bounds parallel namespace listing and enrichment
+	listConcurrency = 10
+)
+
+// clusterLister lists TenantClusters page by page
+type clusterLister struct {
+	client   *client.Client
+	selector string
+	logger   *log.Logger
+}
+
+// list calls fn with each page of enriched, sorted clusters in namespace
+// (all namespaces if empty). When RBAC forbids a cluster-wide list, each
+// namespace is listed in parallel instead and namespaces the caller cannot
+// read are skipped. fn is never called concurrently.
+func (l *clusterLister) list(ctx context.Context, namespace string, fn func([]TenantClusterInfo) error) error {
+	err := l.listPages(ctx, namespace, fn)
+	if namespace != metav1.NamespaceAll || !apierrors.IsForbidden(err) {
+		return err
+	}
+
+	namespaces, nsErr := l.client.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if nsErr != nil {
+		// Neither cluster-wide listing works; report the original error
+		return err
+	}
+	l.logger.Debug("cluster-wide list forbidden, listing namespaces individually", "namespaces", len(namespaces.Items))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, listConcurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+
+	for _, ns := range namespaces.Items {
+		wg.Add(1)
+		go func(ns string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			err := l.listPages(ctx, ns, func(page []TenantClusterInfo) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if firstErr != nil {
+					return firstErr
+				}
+				return fn(page)
+			})
+			if apierrors.IsForbidden(err) {
+				l.logger.Debug("skipping namespace", "namespace", ns, "error", err)
+				return
+			}
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}(ns.Name)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// listPages lists one namespace (or all) with limit/continue pagination
+func (l *clusterLister) listPages(ctx context.Context, namespace string, fn func([]TenantClusterInfo) error) error {
+	resource := l.client.Dynamic.Resource(client.TenantClusterGVR)
+	opts := metav1.ListOptions{LabelSelector: l.selector, Limit: listPageSize}
+
+	for {
+		var list *unstructured.UnstructuredList
+		var err error
+		if namespace == metav1.NamespaceAll {
+			list, err = resource.List(ctx, opts)
+		} else {
+			list, err = resource.Namespace(namespace).List(ctx, opts)
+		}
+		if err != nil {
+			if namespace == metav1.NamespaceAll {
+				return fmt.Errorf("listing TenantClusters: %w", err)
+			}
+			return fmt.Errorf("listing TenantClusters in namespace %s: %w", namespace, err)
+		}
+
+		if len(list.Items) > 0 {
+			infos := make([]TenantClusterInfo, len(list.Items))
+			for i := range list.Items {
+				infos[i] = ExtractTenantClusterInfo(&list.Items[i])
+			}
+			enrichClusterInfos(ctx, l.client, infos)
+			sortClusterInfos(infos)
+			if err := fn(infos); err != nil {
+				return err
+			}
+		}
+
+		opts.Continue = list.GetContinue()
+		if opts.Continue == "" {
+			return nil
+		}
+	}
+}
+
+// enrichClusterInfos fills in worker and endpoint status from the CAPI
+// objects of each cluster in parallel
+func enrichClusterInfos(ctx context.Context, c *client.Client, infos []TenantClusterInfo) {
+	sem := make(chan struct{}, listConcurrency)
+	var wg sync.WaitGroup
+	for i := range infos {
+		wg.Add(1)
+		go func(info *TenantClusterInfo) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			// Enrich with actual worker status from MachineDeployment
+			EnrichWithMachineDeploymentStatus(ctx, c, info)
+			// Enrich with control plane endpoint from CAPI Cluster
+			EnrichWithControlPlaneEndpoint(ctx, c, info)
+		}(&infos[i])
+	}
+	wg.Wait()
+}
+
+// sortClusterInfos sorts by namespace, then name
+func sortClusterInfos(infos []TenantClusterInfo) {
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Namespace != infos[j].Namespace {
+			return infos[i].Namespace < infos[j].Namespace
+		}
+		return infos[i].Name < infos[j].Name
 	})
 }
 
-func printClusterTable(w io.Writer, clusters []TenantClusterInfo, wide, showNamespace bool) error {
-	// Build headers based on options
+func clusterTableHeaders(wide, showNamespace bool, labelColumns []string, showConsole bool) []string {
 	headers := []string{"NAME"}
 	if showNamespace {
 		headers = append(headers, "NAMESPACE")
 	}
 	headers = append(headers, "PHASE", "K8S VERSION", "WORKERS", "AGE")
 	if wide {
-		headers = append(headers, "ENDPOINT", "PROVIDER")
+		headers = append(headers, "ENDPOINT", "PROVIDER", "OWNER")
 	}
+	for _, key := range labelColumns {
+		headers = append(headers, strings.ToUpper(key))
+	}
+	if showConsole {
+		headers = append(headers, "CONSOLE")
+	}
+	return headers
+}
 
-	table := output.NewTable(w, headers...)
-
-	for _, tc := range clusters {
-		// Format phase with color
-		phase := output.ColorizePhase(tc.Phase)
+func clusterTableRow(tc TenantClusterInfo, wide, showNamespace bool, labelColumns []string, console *platform.Console) []string {
+	// Format phase with color
+	phase := output.ColorizePhase(tc.Phase)
 
-		// Format workers
-		workers := output.FormatWorkers(tc.WorkersReady, tc.WorkersDesired)
-		if tc.WorkersDesired == 0 {
-			// Try to get from spec if status not populated
-			workers = "-"
-		}
+	// Format workers
+	workers := output.FormatWorkers(tc.WorkersReady, tc.WorkersDesired)
+	if tc.WorkersDesired == 0 {
+		// Try to get from spec if status not populated
+		workers = "-"
+	}
 
-		// Parse and format age
-		var age string
-		if tc.CreationTime != "" {
-			t, err := parseTime(tc.CreationTime)
-			if err == nil {
-				age = output.FormatAge(t)
-			} else {
-				age = "<unknown>"
-			}
+	// Parse and format age
+	var age string
+	if tc.CreationTime != "" {
+		t, err := parseTime(tc.CreationTime)
+		if err == nil {
+			age = output.FormatAge(t)
 		} else {
 			age = "<unknown>"
 		}
+	} else {
+		age = "<unknown>"
+	}
 
-		// Build row
-		row := []string{tc.Name}
-		if showNamespace {
-			row = append(row, tc.Namespace)
+	// Build row
+	row := []string{tc.Name}
+	if showNamespace {
+		row = append(row, tc.Namespace)
+	}
+	row = append(row, phase, tc.KubernetesVersion, workers, age)
+	if wide {
+		endpoint := tc.Endpoint
+		if endpoint == "" {
+			endpoint = "-"
 		}
-		row = append(row, phase, tc.KubernetesVersion, workers, age)
-		if wide {
-			endpoint := tc.Endpoint
-			if endpoint == "" {
-				endpoint = "-"
-			}
-			provider := tc.ProviderConfig
-			if provider == "" {
-				provider = "-"
-			}
-			row = append(row, endpoint, provider)
+		provider := tc.ProviderConfig
+		if provider == "" {
+			provider = "-"
 		}
-
-		table.AddRow(row...)
+		owner := tc.Owner
+		if owner == "" {
+			owner = "-"
+		}
+		row = append(row, endpoint, provider, owner)
 	}
-
-	return table.Flush()
+	for _, key := range labelColumns {
+		value := tc.Labels[key]
+		if value == "" {
+			value = "-"
+		}
+		row = append(row, value)
+	}
+	if console != nil {
+		row = append(row, orDefault(consoleClusterURL(console, tc.Namespace, tc.Name), "-"))
+	}
+	return row
 }
 
 func parseTime(s string) (time.Time, error) {
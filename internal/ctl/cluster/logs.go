@@ -0,0 +1,282 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// controlPlaneComponents maps --component names to the containers of a
+// hosted control plane pod
+var controlPlaneComponents = map[string]string{
+	"apiserver":          "kube-apiserver",
+	"controller-manager": "kube-controller-manager",
+	"scheduler":          "kube-scheduler",
+	"konnectivity":       "konnectivity-server",
+}
+
+type logsOptions struct {
+	namespace  string
+	kubeconfig string
+	components []string
+	since      time.Duration
+	tail       int64
+	follow     bool
+	timestamps bool
+}
+
+// logSource is one control plane container to read logs from
+type logSource struct {
+	pod       string
+	container string
+	component string
+}
+
+// newLogsCmd creates the cluster logs command
+func newLogsCmd(logger *log.Logger) *cobra.Command {
+	opts := &logsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "logs NAME",
+		Short: "Show logs of a cluster's control plane",
+		Long: `Show logs of a tenant cluster's hosted control plane.
+
+The control plane (API server, controller manager, scheduler and
+konnectivity server) runs as pods in the cluster's tenant namespace on the
+management cluster. Reading their logs needs only list on pods and get on
+pods/log in that tenant namespace, which operators can grant with a
+namespaced Role instead of access to the rest of the management cluster.
+
+Each line is prefixed with the pod and component it came from. With several
+control plane replicas, every replica is shown.
+
+Components:
+  apiserver, controller-manager, scheduler, konnectivity
+
+Examples:
+  # Last 100 lines from every component
+  butlerctl cluster logs my-cluster
+
+  # Follow API server errors from the last 10 minutes
+  butlerctl cluster logs my-cluster --component apiserver --since 10m -f
+
+  # Scheduler and controller manager with timestamps
+  butlerctl cluster logs my-cluster -c scheduler,controller-manager --timestamps`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogs(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", DefaultTenantNamespace, "namespace of the TenantCluster")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringSliceVarP(&opts.components, "component", "c", nil, "components to show (default: all)")
+	cmd.Flags().DurationVar(&opts.since, "since", 0, "only logs newer than this, e.g. 10m")
+	cmd.Flags().Int64Var(&opts.tail, "tail", 100, "lines to show per container before following (-1: all)")
+	cmd.Flags().BoolVarP(&opts.follow, "follow", "f", false, "stream new log lines")
+	cmd.Flags().BoolVar(&opts.timestamps, "timestamps", false, "include timestamps")
+
+	cmd.RegisterFlagCompletionFunc("component", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return componentNames(), cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func runLogs(ctx context.Context, logger *log.Logger, name string, opts *logsOptions) error {
+	containers, err := selectedContainers(opts.components)
+	if err != nil {
+		return err
+	}
+
+	var c *client.Client
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	tc, err := c.GetTenantCluster(ctx, opts.namespace, name)
+	if errors.IsNotFound(err) {
+		return ClusterNotFoundError(ctx, c, opts.namespace, name)
+	}
+	if err != nil {
+		return fmt.Errorf("getting TenantCluster %s/%s: %w", opts.namespace, name, err)
+	}
+	tenantNS := GetNestedString(tc.Object, "status", "tenantNamespace")
+	if tenantNS == "" {
+		return fmt.Errorf("TenantCluster %s has no control plane yet (phase: %s)", name, GetNestedString(tc.Object, "status", "phase"))
+	}
+
+	sources, err := controlPlaneSources(ctx, c, tenantNS, name, containers)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		logger.Info("no control plane pods found", "cluster", name, "tenantNamespace", tenantNS)
+		return nil
+	}
+
+	logOpts := &corev1.PodLogOptions{
+		Follow:     opts.follow,
+		Timestamps: opts.timestamps,
+	}
+	if opts.tail >= 0 {
+		logOpts.TailLines = &opts.tail
+	}
+	if opts.since > 0 {
+		seconds := int64(opts.since.Seconds())
+		logOpts.SinceSeconds = &seconds
+	}
+
+	// Without --follow, print each container in turn; with it, interleave
+	// the streams as lines arrive
+	w := &prefixWriter{out: os.Stdout}
+	if !opts.follow {
+		for _, src := range sources {
+			if err := streamLogs(ctx, c, tenantNS, src, logOpts, w); err != nil {
+				logger.Warn("could not read logs", "pod", src.pod, "component", src.component, "error", err)
+			}
+		}
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for _, src := range sources {
+		wg.Add(1)
+		go func(src logSource) {
+			defer wg.Done()
+			if err := streamLogs(ctx, c, tenantNS, src, logOpts, w); err != nil && ctx.Err() == nil {
+				logger.Warn("log stream ended", "pod", src.pod, "component", src.component, "error", err)
+			}
+		}(src)
+	}
+	wg.Wait()
+	return nil
+}
+
+// selectedContainers resolves --component values to container names
+func selectedContainers(components []string) (map[string]string, error) {
+	if len(components) == 0 {
+		return controlPlaneComponents, nil
+	}
+	selected := map[string]string{}
+	for _, comp := range components {
+		comp = strings.ToLower(strings.TrimSpace(comp))
+		container, ok := controlPlaneComponents[comp]
+		if !ok {
+			return nil, fmt.Errorf("unknown component %q: must be one of %s", comp, strings.Join(componentNames(), ", "))
+		}
+		selected[comp] = container
+	}
+	return selected, nil
+}
+
+func componentNames() []string {
+	names := make([]string, 0, len(controlPlaneComponents))
+	for name := range controlPlaneComponents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// controlPlaneSources finds the cluster's control plane pods in its tenant
+// namespace. Steward runs them as a Deployment named after the cluster, so
+// pods are <name>-<hash>-<suffix>; only those running control plane
+// containers are considered so workloads sharing the namespace never show.
+func controlPlaneSources(ctx context.Context, c *client.Client, tenantNS, name string, containers map[string]string) ([]logSource, error) {
+	pods, err := c.Clientset.CoreV1().Pods(tenantNS).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing control plane pods in %s: %w", tenantNS, err)
+	}
+
+	var sources []logSource
+	for _, pod := range pods.Items {
+		suffix, ok := strings.CutPrefix(pod.Name, name+"-")
+		if !ok || strings.Count(suffix, "-") != 1 {
+			continue
+		}
+		for _, ctr := range pod.Spec.Containers {
+			for comp, container := range containers {
+				if ctr.Name == container {
+					sources = append(sources, logSource{pod: pod.Name, container: ctr.Name, component: comp})
+				}
+			}
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool {
+		if sources[i].component != sources[j].component {
+			return sources[i].component < sources[j].component
+		}
+		return sources[i].pod < sources[j].pod
+	})
+	return sources, nil
+}
+
+// streamLogs copies one container's logs to w, line by line
+func streamLogs(ctx context.Context, c *client.Client, namespace string, src logSource, opts *corev1.PodLogOptions, w *prefixWriter) error {
+	podOpts := *opts
+	podOpts.Container = src.container
+
+	stream, err := c.Clientset.CoreV1().Pods(namespace).GetLogs(src.pod, &podOpts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	prefix := output.Dim(fmt.Sprintf("[%s/%s]", src.pod, src.component)) + " "
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		w.writeLine(prefix, scanner.Text())
+	}
+	return scanner.Err()
+}
+
+// prefixWriter serializes lines from concurrent log streams
+type prefixWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *prefixWriter) writeLine(prefix, line string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	fmt.Fprintln(w.out, prefix+line)
+}
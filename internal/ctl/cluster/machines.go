@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/access"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// capiClusterNameLabel links CAPI Machines to their Cluster
+	capiClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+
+	// capiControlPlaneLabel marks control plane Machines
+	capiControlPlaneLabel = "cluster.x-k8s.io/control-plane"
+)
+
+type machinesOptions struct {
+	namespace    string
+	kubeconfig   string
+	outputFormat string
+}
+
+// MachineInfo describes one machine backing a tenant cluster
+type MachineInfo struct {
+	Name           string `json:"name"`
+	MachineRequest string `json:"machineRequest,omitempty"`
+	Role           string `json:"role"`
+	Phase          string `json:"phase"`
+	ProviderID     string `json:"providerID,omitempty"`
+	IP             string `json:"ip,omitempty"`
+	Node           string `json:"node,omitempty"`
+	CreationTime   string `json:"creationTime"`
+	Message        string `json:"message,omitempty"`
+}
+
+// newMachinesCmd creates the cluster machines command
+func newMachinesCmd(logger *log.Logger) *cobra.Command {
+	opts := &machinesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "machines NAME",
+		Short: "List the machines backing a cluster",
+		Long: `List the machines backing a tenant cluster.
+
+Shows each CAPI Machine and the Butler MachineRequest for its VM: role,
+phase, provider VM ID, IP, node and age, plus the failure or condition
+message of machines that are stuck.
+
+Examples:
+  # See which VM is holding up a cluster
+  butlerctl cluster machines my-cluster
+
+  # JSON for scripts
+  butlerctl cluster machines my-cluster -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMachines(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", DefaultTenantNamespace, "namespace of the TenantCluster")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+func runMachines(ctx context.Context, logger *log.Logger, name string, opts *machinesOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var c *client.Client
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	tc, err := c.GetTenantCluster(ctx, opts.namespace, name)
+	if errors.IsNotFound(err) {
+		return ClusterNotFoundError(ctx, c, opts.namespace, name)
+	}
+	if err != nil {
+		return fmt.Errorf("getting TenantCluster %s/%s: %w", opts.namespace, name, err)
+	}
+	tenantNS := GetNestedString(tc.Object, "status", "tenantNamespace")
+	if tenantNS == "" {
+		return fmt.Errorf("TenantCluster %s has no machines yet (phase: %s)", name, GetNestedString(tc.Object, "status", "phase"))
+	}
+
+	machines, err := listMachines(ctx, c, tenantNS, name)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable && len(machines) == 0 {
+		logger.Info("no machines found", "cluster", name, "tenantNamespace", tenantNS)
+		return nil
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(machines, func(w io.Writer) error {
+		table := output.NewTable(w, "NAME", "ROLE", "PHASE", "PROVIDER ID", "IP", "NODE", "AGE", "MESSAGE")
+		table.SetPriority("NAME", 1)
+		table.SetPriority("PROVIDER ID", -1)
+		for _, m := range machines {
+			created, _ := time.Parse(time.RFC3339, m.CreationTime)
+			table.AddRow(m.Name, m.Role, output.ColorizePhase(orDefault(m.Phase, "Unknown")), orDefault(m.ProviderID, "-"),
+				orDefault(m.IP, "-"), orDefault(m.Node, "-"), output.FormatAge(created), m.Message)
+		}
+		return table.Flush()
+	})
+}
+
+// listMachines joins a cluster's CAPI Machines with its MachineRequests.
+// MachineRequests without a Machine are listed on their own.
+func listMachines(ctx context.Context, c *client.Client, namespace, cluster string) ([]MachineInfo, error) {
+	capiMachines, err := c.Dynamic.Resource(client.MachineGVR).Namespace(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: capiClusterNameLabel + "=" + cluster,
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("listing Machines: %w", err)
+	}
+
+	requests, err := c.Dynamic.Resource(client.MachineRequestGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("listing MachineRequests: %w", err)
+	}
+
+	byName := map[string]*unstructured.Unstructured{}
+	if requests != nil {
+		for i := range requests.Items {
+			mr := &requests.Items[i]
+			if mr.GetLabels()[access.ClusterLabel] != cluster && !strings.HasPrefix(mr.GetName(), cluster+"-") {
+				continue
+			}
+			byName[mr.GetName()] = mr
+			if machineName := GetNestedString(mr.Object, "spec", "machineName"); machineName != "" {
+				byName[machineName] = mr
+			}
+		}
+	}
+
+	machines := []MachineInfo{}
+	matched := map[*unstructured.Unstructured]bool{}
+	if capiMachines != nil {
+		for i := range capiMachines.Items {
+			m := &capiMachines.Items[i]
+			info := machineInfo(m)
+
+			mr := byName[m.GetName()]
+			if mr == nil {
+				mr = byName[GetNestedString(m.Object, "spec", "infrastructureRef", "name")]
+			}
+			if mr != nil {
+				matched[mr] = true
+				req := machineRequestInfo(mr)
+				info.MachineRequest = mr.GetName()
+				info.ProviderID = orDefault(info.ProviderID, req.ProviderID)
+				info.IP = orDefault(info.IP, req.IP)
+				info.Message = orDefault(info.Message, req.Message)
+			}
+			machines = append(machines, info)
+		}
+	}
+
+	for _, mr := range byName {
+		if matched[mr] {
+			continue
+		}
+		matched[mr] = true
+		machines = append(machines, machineRequestInfo(mr))
+	}
+
+	sort.Slice(machines, func(i, j int) bool {
+		if machines[i].Role != machines[j].Role {
+			return machines[i].Role < machines[j].Role
+		}
+		return machines[i].Name < machines[j].Name
+	})
+	return machines, nil
+}
+
+func machineInfo(m *unstructured.Unstructured) MachineInfo {
+	role := "worker"
+	if _, ok := m.GetLabels()[capiControlPlaneLabel]; ok {
+		role = "control-plane"
+	}
+
+	ip := ""
+	addresses, _, _ := unstructured.NestedSlice(m.Object, "status", "addresses")
+	for _, a := range addresses {
+		addr, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if addr["type"] == "InternalIP" || ip == "" && addr["type"] == "ExternalIP" {
+			ip, _ = addr["address"].(string)
+		}
+	}
+
+	return MachineInfo{
+		Name:         m.GetName(),
+		Role:         role,
+		Phase:        GetNestedString(m.Object, "status", "phase"),
+		ProviderID:   GetNestedString(m.Object, "spec", "providerID"),
+		IP:           ip,
+		Node:         GetNestedString(m.Object, "status", "nodeRef", "name"),
+		CreationTime: m.GetCreationTimestamp().UTC().Format(time.RFC3339),
+		Message:      failureMessage(m.Object),
+	}
+}
+
+func machineRequestInfo(mr *unstructured.Unstructured) MachineInfo {
+	return MachineInfo{
+		Name:           orDefault(GetNestedString(mr.Object, "spec", "machineName"), mr.GetName()),
+		MachineRequest: mr.GetName(),
+		Role:           orDefault(GetNestedString(mr.Object, "spec", "role"), "worker"),
+		Phase:          GetNestedString(mr.Object, "status", "phase"),
+		ProviderID:     GetNestedString(mr.Object, "status", "providerID"),
+		IP:             GetNestedString(mr.Object, "status", "ipAddress"),
+		CreationTime:   mr.GetCreationTimestamp().UTC().Format(time.RFC3339),
+		Message:        failureMessage(mr.Object),
+	}
+}
+
+// failureMessage returns status.failureMessage, or else the message of the
+// first condition that isn't True
+func failureMessage(obj map[string]interface{}) string {
+	if msg := GetNestedString(obj, "status", "failureMessage"); msg != "" {
+		return msg
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["status"] == "True" {
+			continue
+		}
+		if msg, _ := cond["message"].(string); msg != "" {
+			return fmt.Sprintf("%s: %s", cond["type"], msg)
+		}
+	}
+	return ""
+}
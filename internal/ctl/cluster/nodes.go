@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Taint effects accepted by --node-taint
+var taintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
+// kubeletArgPattern matches kubelet flag names, without the leading dashes
+var kubeletArgPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// validateNodeLabels checks worker node labels against the Kubernetes
+// label syntax.
+func validateNodeLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid --node-label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid --node-label value %q for %s: %s", value, key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// parseNodeTaint parses a taint in kubectl syntax, KEY[=VALUE]:EFFECT,
+// into its machineTemplate form.
+func parseNodeTaint(s string) (map[string]interface{}, error) {
+	spec, effect, ok := strings.Cut(s, ":")
+	if !ok || spec == "" {
+		return nil, fmt.Errorf("invalid --node-taint %q: expected KEY[=VALUE]:EFFECT", s)
+	}
+	key, value, _ := strings.Cut(spec, "=")
+
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid --node-taint key %q: %s", key, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid --node-taint value %q for %s: %s", value, key, strings.Join(errs, "; "))
+	}
+	valid := false
+	for _, e := range taintEffects {
+		if effect == e {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid --node-taint effect %q for %s: must be one of %s", effect, key, strings.Join(taintEffects, ", "))
+	}
+
+	taint := map[string]interface{}{"key": key, "effect": effect}
+	if value != "" {
+		taint["value"] = value
+	}
+	return taint, nil
+}
+
+// parseKubeletArgs turns KEY=VALUE kubelet arguments into a map. Keys may
+// be given with or without their leading dashes. Only the first '=' splits,
+// so values like feature-gates=A=true,B=false survive intact.
+func parseKubeletArgs(args []string) (map[string]string, error) {
+	parsed := make(map[string]string, len(args))
+	for _, arg := range args {
+		key, value, ok := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --kubelet-arg %q: expected KEY=VALUE", arg)
+		}
+		if !kubeletArgPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid --kubelet-arg %q: %q is not a kubelet flag name", arg, key)
+		}
+		parsed[key] = value
+	}
+	return parsed, nil
+}
+
+// validateNodeSettings checks the --node-label, --node-taint and
+// --kubelet-arg values.
+func (o *CreateOptions) validateNodeSettings() error {
+	if err := validateNodeLabels(o.NodeLabels); err != nil {
+		return err
+	}
+	for _, t := range o.NodeTaints {
+		if _, err := parseNodeTaint(t); err != nil {
+			return err
+		}
+	}
+	_, err := parseKubeletArgs(o.KubeletArgs)
+	return err
+}
+
+// applyNodeSettings adds node labels, taints and kubelet arguments to a
+// worker machineTemplate. Values are expected to have passed
+// validateNodeSettings; anything that doesn't parse is skipped.
+func (o *CreateOptions) applyNodeSettings(machineTemplate map[string]interface{}) {
+	if len(o.NodeLabels) > 0 {
+		labels := map[string]interface{}{}
+		for k, v := range o.NodeLabels {
+			labels[k] = v
+		}
+		machineTemplate["nodeLabels"] = labels
+	}
+
+	var taints []interface{}
+	for _, t := range o.NodeTaints {
+		if taint, err := parseNodeTaint(t); err == nil {
+			taints = append(taints, taint)
+		}
+	}
+	if len(taints) > 0 {
+		machineTemplate["nodeTaints"] = taints
+	}
+
+	if args, err := parseKubeletArgs(o.KubeletArgs); err == nil && len(args) > 0 {
+		extraArgs := map[string]interface{}{}
+		for k, v := range args {
+			extraArgs[k] = v
+		}
+		machineTemplate["kubeletExtraArgs"] = extraArgs
+	}
+}
+
+// formatKeyValues renders a map as sorted KEY=VALUE pairs for summaries
+func formatKeyValues(m map[string]string) string {
+	pairs := make([]string, 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
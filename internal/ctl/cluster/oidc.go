@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// OIDCOptions configures OIDC authentication on a cluster's API server,
+// set in spec.controlPlane.oidc.
+type OIDCOptions struct {
+	IssuerURL   string
+	ClientID    string
+	GroupsClaim string
+}
+
+// addOIDCFlags adds the --oidc-* flags to a command.
+func addOIDCFlags(cmd *cobra.Command, o *OIDCOptions) {
+	cmd.Flags().StringVar(&o.IssuerURL, "oidc-issuer-url", "", "OIDC issuer URL the API server trusts (https)")
+	cmd.Flags().StringVar(&o.ClientID, "oidc-client-id", "", "OIDC client ID tokens must be issued for")
+	cmd.Flags().StringVar(&o.GroupsClaim, "oidc-groups-claim", "", "OIDC token claim holding the user's groups")
+}
+
+// IsSet reports whether any OIDC setting was given.
+func (o *OIDCOptions) IsSet() bool {
+	return o.IssuerURL != "" || o.ClientID != "" || o.GroupsClaim != ""
+}
+
+// Validate checks a complete OIDC configuration: the API server needs
+// both an https issuer and a client ID.
+func (o *OIDCOptions) Validate() error {
+	if !o.IsSet() {
+		return nil
+	}
+	if o.IssuerURL == "" || o.ClientID == "" {
+		return fmt.Errorf("--oidc-issuer-url and --oidc-client-id must be set together")
+	}
+	u, err := url.Parse(o.IssuerURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("invalid --oidc-issuer-url %q: must be an https URL", o.IssuerURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("invalid --oidc-issuer-url %q: must not have a query or fragment", o.IssuerURL)
+	}
+	return nil
+}
+
+// spec returns the controlPlane.oidc section.
+func (o *OIDCOptions) spec() map[string]interface{} {
+	oidc := map[string]interface{}{
+		"issuerURL": o.IssuerURL,
+		"clientID":  o.ClientID,
+	}
+	if o.GroupsClaim != "" {
+		oidc["groupsClaim"] = o.GroupsClaim
+	}
+	return oidc
+}
+
+// currentOIDC reads a TenantCluster's OIDC settings.
+func currentOIDC(tc *unstructured.Unstructured) OIDCOptions {
+	return OIDCOptions{
+		IssuerURL:   GetNestedString(tc.Object, "spec", "controlPlane", "oidc", "issuerURL"),
+		ClientID:    GetNestedString(tc.Object, "spec", "controlPlane", "oidc", "clientID"),
+		GroupsClaim: GetNestedString(tc.Object, "spec", "controlPlane", "oidc", "groupsClaim"),
+	}
+}
+
+// merge returns current with the settings given in o replacing its own.
+func (o *OIDCOptions) merge(current OIDCOptions) OIDCOptions {
+	merged := current
+	if o.IssuerURL != "" {
+		merged.IssuerURL = o.IssuerURL
+	}
+	if o.ClientID != "" {
+		merged.ClientID = o.ClientID
+	}
+	if o.GroupsClaim != "" {
+		merged.GroupsClaim = o.GroupsClaim
+	}
+	return merged
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+type openOptions struct {
+	namespace  string
+	kubeconfig string
+	print      bool
+}
+
+// newOpenCmd creates the cluster open command
+func newOpenCmd(logger *log.Logger) *cobra.Command {
+	opts := &openOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "open NAME",
+		Short: "Open a cluster's page in the Butler Console",
+		Long: `Open a tenant cluster's page in the Butler Console.
+
+The console URL comes from console.url in the butler-platform ConfigMap, or
+is discovered from the butler-console Ingress or LoadBalancer Service in
+butler-system. Fails if the Console addon isn't installed.
+
+Examples:
+  # Launch the browser
+  butlerctl cluster open my-cluster
+
+  # Just print the link, e.g. over SSH
+  butlerctl cluster open my-cluster --print`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOpen(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", DefaultTenantNamespace, "namespace of the TenantCluster")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().BoolVar(&opts.print, "print", false, "print the URL instead of opening a browser")
+
+	return cmd
+}
+
+func runOpen(ctx context.Context, logger *log.Logger, name string, opts *openOptions) error {
+	var c *client.Client
+	var err error
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	if _, err := c.GetTenantCluster(ctx, opts.namespace, name); errors.IsNotFound(err) {
+		return ClusterNotFoundError(ctx, c, opts.namespace, name)
+	} else if err != nil {
+		return fmt.Errorf("getting TenantCluster %s/%s: %w", opts.namespace, name, err)
+	}
+
+	console := loadConsole(ctx, c, logger)
+	if console == nil {
+		return fmt.Errorf("the Butler Console is not installed or its URL is unknown; set console.url in the %s ConfigMap", platform.ConfigMapName)
+	}
+	url, err := console.ClusterURL(platform.TemplateData{Name: name, Namespace: opts.namespace})
+	if err != nil {
+		return err
+	}
+
+	if opts.print {
+		fmt.Println(url)
+		return nil
+	}
+
+	logger.Info("opening console", "url", url)
+	if err := openBrowser(url); err != nil {
+		return fmt.Errorf("opening browser (use --print to just show the URL): %w", err)
+	}
+	return nil
+}
+
+// loadConsole returns the platform's console settings, or nil when no
+// console is known
+func loadConsole(ctx context.Context, c *client.Client, logger *log.Logger) *platform.Console {
+	cfg, err := platform.Load(ctx, c)
+	if err != nil {
+		logger.Debug("could not load platform config", "error", err)
+		return nil
+	}
+	if !cfg.ResolveConsole(ctx, c) {
+		return nil
+	}
+	return &cfg.Console
+}
+
+// consoleClusterURL returns the console page for a cluster, or "" if there
+// is no console
+func consoleClusterURL(console *platform.Console, namespace, name string) string {
+	if console == nil {
+		return ""
+	}
+	url, err := console.ClusterURL(platform.TemplateData{Name: name, Namespace: namespace})
+	if err != nil {
+		return ""
+	}
+	return url
+}
+
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type orphanedOptions struct {
+	nsFlags        NamespaceFlags
+	kubeconfig     string
+	outputFormat   string
+	includeUnowned bool
+}
+
+// OrphanedCluster is a cluster whose recorded owner no longer exists
+type OrphanedCluster struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Owner     string `json:"owner,omitempty"`
+	Contact   string `json:"contact,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+// newOrphanedCmd creates the cluster orphaned command
+func newOrphanedCmd(logger *log.Logger) *cobra.Command {
+	opts := &orphanedOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "orphaned",
+		Short: "List clusters whose owner no longer exists",
+		Long: `List tenant clusters whose owning Team or user no longer exists.
+
+The owner is read from the butler.butlerlabs.dev/owner annotation set by
+'cluster create --owner'. An owner containing '@' is a user: it must match a
+Butler User (by email) that is not disabled, or appear as a member of a Team.
+Any other owner must name an existing Team.
+
+Examples:
+  # Report orphaned clusters across the platform
+  butlerctl cluster orphaned -A
+
+  # Also list clusters without a recorded owner
+  butlerctl cluster orphaned -A --include-unowned
+
+  # JSON for cleanup automation
+  butlerctl cluster orphaned -A -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOrphaned(cmd.Context(), logger, opts)
+		},
+	}
+
+	AddNamespaceFlags(cmd, &opts.nsFlags)
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to kubeconfig file")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.Flags().BoolVar(&opts.includeUnowned, "include-unowned", false, "also list clusters without an owner annotation")
+
+	return cmd
+}
+
+func runOrphaned(ctx context.Context, logger *log.Logger, opts *orphanedOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var c *client.Client
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	owners, err := loadOwners(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	namespace, allNamespaces := opts.nsFlags.ResolveNamespace()
+	if allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	lister := &clusterLister{client: c, logger: logger}
+
+	orphans := []OrphanedCluster{}
+	err = lister.list(ctx, namespace, func(page []TenantClusterInfo) error {
+		for _, info := range page {
+			reason := owners.missing(info.Owner)
+			if reason == "" || (info.Owner == "" && !opts.includeUnowned) {
+				continue
+			}
+			orphans = append(orphans, OrphanedCluster{
+				Name:      info.Name,
+				Namespace: info.Namespace,
+				Owner:     info.Owner,
+				Contact:   info.Contact,
+				Reason:    reason,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable && len(orphans) == 0 {
+		logger.Success("no orphaned clusters found")
+		return nil
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(orphans, func(w io.Writer) error {
+		table := output.NewTable(w, "NAME", "NAMESPACE", "OWNER", "CONTACT", "REASON")
+		for _, o := range orphans {
+			table.AddRow(o.Name, o.Namespace, orDefault(o.Owner, "-"), orDefault(o.Contact, "-"), o.Reason)
+		}
+		return table.Flush()
+	})
+}
+
+// ownerIndex holds the Teams and users that can own clusters
+type ownerIndex struct {
+	teams         map[string]bool
+	users         map[string]bool
+	disabledUsers map[string]bool
+}
+
+// loadOwners indexes Teams, their members and Butler Users
+func loadOwners(ctx context.Context, c *client.Client) (*ownerIndex, error) {
+	idx := &ownerIndex{
+		teams:         map[string]bool{},
+		users:         map[string]bool{},
+		disabledUsers: map[string]bool{},
+	}
+
+	teams, err := c.Dynamic.Resource(client.TeamGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Teams: %w", err)
+	}
+	for _, team := range teams.Items {
+		idx.teams[team.GetName()] = true
+		members, _, _ := unstructured.NestedSlice(team.Object, "spec", "access", "users")
+		for _, m := range members {
+			if member, ok := m.(map[string]interface{}); ok {
+				idx.users[strings.ToLower(GetNestedString(member, "name"))] = true
+			}
+		}
+	}
+
+	users, err := c.Dynamic.Resource(client.UserGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Users: %w", err)
+	}
+	for _, user := range users.Items {
+		email := strings.ToLower(GetNestedString(user.Object, "spec", "email"))
+		if email == "" {
+			continue
+		}
+		if GetNestedBool(user.Object, "spec", "disabled") {
+			idx.disabledUsers[email] = true
+			continue
+		}
+		idx.users[email] = true
+	}
+
+	return idx, nil
+}
+
+// missing returns why owner does not resolve, or "" if it does
+func (idx *ownerIndex) missing(owner string) string {
+	switch {
+	case owner == "":
+		return "no owner recorded"
+	case strings.Contains(owner, "@"):
+		email := strings.ToLower(owner)
+		if idx.users[email] {
+			return ""
+		}
+		if idx.disabledUsers[email] {
+			return "user is disabled"
+		}
+		return "user not found"
+	case idx.teams[owner]:
+		return ""
+	}
+	return "team not found"
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Addons the checklist tracks when the spec doesn't name a provider
+const (
+	defaultCNIProvider          = "cilium"
+	defaultLoadBalancerProvider = "metallb"
+)
+
+// progressStep is one part of provisioning shown by create --wait
+type progressStep struct {
+	name   string
+	done   bool
+	detail string
+}
+
+// clusterProgress reads the provisioning steps of a TenantCluster from its
+// status, CAPI Cluster, Machines and MachineRequests
+func clusterProgress(ctx context.Context, c *client.Client, tc *unstructured.Unstructured) []progressStep {
+	info := ExtractTenantClusterInfo(tc)
+	EnrichWithMachineDeploymentStatus(ctx, c, &info)
+	EnrichWithControlPlaneEndpoint(ctx, c, &info)
+
+	controlPlane := progressStep{name: "Control plane ready", done: controlPlaneReady(ctx, c, &info), detail: info.Endpoint}
+
+	machines := progressStep{name: "Machines provisioned"}
+	if info.TenantNamespace != "" {
+		var provisioned int64
+		if list, err := listMachines(ctx, c, info.TenantNamespace, info.Name); err == nil {
+			for _, m := range list {
+				if m.Role == "worker" && (m.Phase == "Running" || m.Phase == "Provisioned") {
+					provisioned++
+				}
+			}
+		}
+		machines.done = provisioned >= info.WorkersDesired
+		machines.detail = fmt.Sprintf("%d/%d", provisioned, info.WorkersDesired)
+	}
+
+	workers := progressStep{
+		name:   "Workers ready",
+		done:   info.WorkersDesired > 0 && info.WorkersReady >= info.WorkersDesired,
+		detail: fmt.Sprintf("%d/%d", info.WorkersReady, info.WorkersDesired),
+	}
+
+	cniProvider := orDefault(GetNestedString(tc.Object, "spec", "addons", "cni", "provider"), defaultCNIProvider)
+	cni := progressStep{name: "CNI installed"}
+	cni.done, cni.detail = addonProgress(tc, cniProvider)
+
+	steps := []progressStep{controlPlane, machines, workers, cni}
+	if !loadBalancerEnabled(tc) {
+		return steps
+	}
+
+	lbProvider := orDefault(GetNestedString(tc.Object, "spec", "addons", "loadBalancer", "provider"), defaultLoadBalancerProvider)
+	lb := progressStep{name: "LB pool configured"}
+	lb.done, lb.detail = addonProgress(tc, lbProvider)
+	start := GetNestedString(tc.Object, "spec", "networking", "loadBalancerPool", "start")
+	end := GetNestedString(tc.Object, "spec", "networking", "loadBalancerPool", "end")
+	if start != "" {
+		lb.detail += ", " + start + "-" + end
+	}
+
+	return append(steps, lb)
+}
+
+// controlPlaneReady reports whether the CAPI Cluster says the hosted
+// control plane is up
+func controlPlaneReady(ctx context.Context, c *client.Client, info *TenantClusterInfo) bool {
+	if info.TenantNamespace == "" {
+		return false
+	}
+	cluster, err := c.Dynamic.Resource(client.ClusterGVR).Namespace(info.TenantNamespace).Get(ctx, info.Name, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+	if GetNestedBool(cluster.Object, "status", "controlPlaneReady") {
+		return true
+	}
+	conditions, _, _ := unstructured.NestedSlice(cluster.Object, "status", "conditions")
+	for _, cond := range conditions {
+		cm, ok := cond.(map[string]interface{})
+		if ok && (cm["type"] == "ControlPlaneReady" || cm["type"] == "ControlPlaneAvailable") {
+			return cm["status"] == "True"
+		}
+	}
+	return false
+}
+
+// addonProgress reports whether an addon is healthy, with its status as
+// the detail
+func addonProgress(tc *unstructured.Unstructured, name string) (bool, string) {
+	addons, _, _ := unstructured.NestedSlice(tc.Object, "status", "observedState", "addons")
+	for _, a := range addons {
+		addon, ok := a.(map[string]interface{})
+		if !ok || !strings.EqualFold(fmt.Sprint(addon["name"]), name) {
+			continue
+		}
+		status, _ := addon["status"].(string)
+		return status == "Healthy", name + " " + strings.ToLower(status)
+	}
+	return false, name + " pending"
+}
+
+// progressRenderer shows provisioning steps. On a terminal the checklist
+// is redrawn in place; otherwise each step is logged once it completes.
+type progressRenderer struct {
+	out    io.Writer
+	logger *log.Logger
+	tty    bool
+	lines  int
+	done   map[string]bool
+}
+
+func newProgressRenderer(out io.Writer, logger *log.Logger) *progressRenderer {
+	return &progressRenderer{
+		out:    out,
+		logger: logger,
+		tty:    out == os.Stdout && output.IsTTY(),
+		done:   map[string]bool{},
+	}
+}
+
+// update shows the latest steps
+func (r *progressRenderer) update(name, phase string, elapsed time.Duration, steps []progressStep) {
+	if !r.tty {
+		for _, s := range steps {
+			if s.done && !r.done[s.name] {
+				r.done[s.name] = true
+				r.logger.Info(strings.ToLower(s.name), "detail", s.detail, "elapsed", elapsed)
+			}
+		}
+		return
+	}
+
+	width := 0
+	for _, s := range steps {
+		width = max(width, len(s.name))
+	}
+
+	var b strings.Builder
+	if r.lines > 0 {
+		fmt.Fprintf(&b, "\033[%dA", r.lines)
+	}
+	fmt.Fprintf(&b, "\033[2K%s %s %s\n", output.Bold("Cluster "+name+":"), output.ColorizePhase(orDefault(phase, "Pending")), output.Dim("("+elapsed.String()+")"))
+	for _, s := range steps {
+		icon := output.StatusPending.String()
+		if s.done {
+			icon = output.StatusOK.String()
+		}
+		fmt.Fprintf(&b, "\033[2K  %s %-*s  %s\n", icon, width, s.name, output.Dim(s.detail))
+	}
+	r.lines = len(steps) + 1
+	fmt.Fprint(r.out, b.String())
+}
+
+// interrupt makes the next update draw below whatever was printed since
+func (r *progressRenderer) interrupt() {
+	r.lines = 0
+}
+
+// tracksPhase reports whether the checklist shows the phase, so phase
+// changes needn't be logged
+func (r *progressRenderer) tracksPhase() bool {
+	return r.tty
+}
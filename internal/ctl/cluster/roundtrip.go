@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var crdGVR = schema.GroupVersionResource{
+	Group:    "apiextensions.k8s.io",
+	Version:  "v1",
+	Resource: "customresourcedefinitions",
+}
+
+// loadTenantClusterSchema returns the OpenAPI schema of the TenantCluster
+// version being exported, or nil if the CRD can't be read
+func loadTenantClusterSchema(ctx context.Context, c *client.Client, opts *ExportOptions) map[string]interface{} {
+	name := client.TenantClusterGVR.Resource + "." + client.TenantClusterGVR.Group
+	crd, err := c.Dynamic.Resource(crdGVR).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		opts.Logger.Warn("cannot read the TenantCluster CRD; defaulted fields are kept", "error", err)
+		return nil
+	}
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		version, ok := v.(map[string]interface{})
+		if !ok || version["name"] != client.TenantClusterGVR.Version {
+			continue
+		}
+		s, _, _ := unstructured.NestedMap(version, "schema", "openAPIV3Schema")
+		return s
+	}
+	return nil
+}
+
+// stripDefaults removes fields whose value equals their schema default, so
+// a re-created cluster picks up the platform's defaults of the day rather
+// than pinning today's. Objects emptied this way are kept, as the server
+// only defaults fields of objects that are present.
+func stripDefaults(obj map[string]interface{}, s map[string]interface{}) {
+	props, _ := s["properties"].(map[string]interface{})
+	for key, value := range obj {
+		prop, ok := props[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch val := value.(type) {
+		case map[string]interface{}:
+			stripDefaults(val, prop)
+		case []interface{}:
+			if items, ok := prop["items"].(map[string]interface{}); ok {
+				for _, item := range val {
+					if m, ok := item.(map[string]interface{}); ok {
+						stripDefaults(m, items)
+					}
+				}
+			}
+		}
+		if def, ok := prop["default"]; ok && sameValue(value, def) {
+			delete(obj, key)
+		}
+	}
+}
+
+// verifyRoundTrip creates the exported cluster in a server-side dry run
+// under a generated name and compares the result with the live spec. It
+// fails if the server rejects the export and returns the spec fields that
+// come back different.
+func verifyRoundTrip(ctx context.Context, c *client.Client, live *unstructured.Unstructured, exported map[string]interface{}) ([]string, error) {
+	data, err := json.Marshal(exported)
+	if err != nil {
+		return nil, fmt.Errorf("encoding export of %s: %w", live.GetName(), err)
+	}
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("decoding export of %s: %w", live.GetName(), err)
+	}
+	obj.SetGenerateName(obj.GetName() + "-")
+	obj.SetName("")
+
+	created, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{
+		DryRun: []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export of %s would not create cleanly: %w", live.GetName(), err)
+	}
+
+	var diffs []string
+	diffValues("spec", live.Object["spec"], created.Object["spec"], &diffs)
+	sort.Strings(diffs)
+	return diffs, nil
+}
+
+// diffValues records the paths where a and b differ
+func diffValues(path string, a, b interface{}, diffs *[]string) {
+	am, aok := a.(map[string]interface{})
+	bm, bok := b.(map[string]interface{})
+	if aok && bok {
+		keys := map[string]bool{}
+		for k := range am {
+			keys[k] = true
+		}
+		for k := range bm {
+			keys[k] = true
+		}
+		for k := range keys {
+			diffValues(path+"."+k, am[k], bm[k], diffs)
+		}
+		return
+	}
+
+	al, aok := a.([]interface{})
+	bl, bok := b.([]interface{})
+	if aok && bok && len(al) == len(bl) {
+		for i := range al {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), al[i], bl[i], diffs)
+		}
+		return
+	}
+
+	if !sameValue(a, b) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: live %s, re-created %s", path, describeValue(a), describeValue(b)))
+	}
+}
+
+// sameValue compares two field values, treating equal numbers of different
+// types and equal quantities such as 8192Mi and 8Gi as the same
+func sameValue(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	if af, ok := toFloat(a); ok {
+		bf, ok := toFloat(b)
+		return ok && af == bf
+	}
+	as, aok := a.(string)
+	bs, bok := b.(string)
+	if !aok || !bok {
+		return false
+	}
+	aq, err := resource.ParseQuantity(as)
+	if err != nil {
+		return false
+	}
+	bq, err := resource.ParseQuantity(bs)
+	if err != nil {
+		return false
+	}
+	return aq.Cmp(bq) == 0
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func describeValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "unset"
+	case string:
+		return fmt.Sprintf("%q", val)
+	}
+	return fmt.Sprintf("%v", v)
+}
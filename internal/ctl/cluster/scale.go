@@ -20,13 +20,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
 	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/policy"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/common/providerapi"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/butlerdotdev/butler/internal/ctl/queue"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -34,10 +43,18 @@ import (
 type ScaleOptions struct {
 	Name      string
 	Namespace string
-	Workers   int32
-	Wait      bool
-	Timeout   time.Duration
-	Logger    *log.Logger
+	// Workers is the target count; -1 removes just DeleteMachines
+	Workers int32
+	Wait    bool
+	Timeout time.Duration
+	Force   bool
+	Policy  policy.Options
+	Logger  *log.Logger
+
+	// DeleteMachines names worker Machines (or their nodes) to remove
+	DeleteMachines []string
+	DryRun         bool // Show the machines a scale-down removes, then stop
+	Yes            bool // Skip the scale-down confirmation
 }
 
 // DefaultScaleOptions returns ScaleOptions with sensible defaults.
@@ -55,8 +72,8 @@ func (o *ScaleOptions) Validate() error {
 		return fmt.Errorf("cluster name is required")
 	}
 
-	if o.Workers < 1 || o.Workers > 10 {
-		return fmt.Errorf("workers must be between 1 and 10, got %d", o.Workers)
+	if o.Workers < 0 && !(o.Workers == -1 && len(o.DeleteMachines) > 0) {
+		return fmt.Errorf("workers must not be negative, got %d", o.Workers)
 	}
 
 	return nil
@@ -74,6 +91,18 @@ func NewScaleCmd(logger *log.Logger) *cobra.Command {
 This command adjusts the worker node count by patching spec.workers.replicas.
 Scaling up provisions new nodes; scaling down terminates excess nodes gracefully.
 
+Before scaling down, the worker machines to be removed are listed with their
+IPs, nodes and the pods that will be rescheduled, and you are asked to
+confirm (skip with --yes). They are chosen as CAPI would: machines named
+with --delete-machine, then unhealthy ones, then by the MachineDeployment's
+delete policy (newest first unless it is Oldest). The choice is pinned with
+the cluster.x-k8s.io/delete-machine annotation, so exactly these machines
+are removed. --dry-run shows the list without scaling.
+
+Before scaling up, the new workers are checked against the capacity the
+provider reports and the owning Team's resource limits. A scale that would
+overcommit either is refused, with the numbers, unless --force is given.
+
 Examples:
   # Scale to 3 workers
   butlerctl cluster scale my-cluster --workers 3
@@ -82,12 +111,25 @@ Examples:
   butlerctl cluster scale my-cluster --workers 5 --wait
 
   # Scale down with timeout
-  butlerctl cluster scale my-cluster --workers 1 --wait --timeout 5m`,
+  butlerctl cluster scale my-cluster --workers 1 --wait --timeout 5m
+
+  # See which machines a scale-down would remove
+  butlerctl cluster scale my-cluster --workers 2 --dry-run
+
+  # Remove one specific worker
+  butlerctl cluster scale my-cluster --delete-machine my-cluster-workers-7x2kq`,
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: completeClusterNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Name = args[0]
 
+			if !cmd.Flags().Changed("workers") {
+				if len(opts.DeleteMachines) == 0 {
+					return fmt.Errorf("--workers or --delete-machine is required")
+				}
+				opts.Workers = -1
+			}
+
 			// Resolve namespace from flag
 			if ns, _ := cmd.Flags().GetString("namespace"); ns != "" {
 				opts.Namespace = ns
@@ -97,13 +139,17 @@ Examples:
 		},
 	}
 
-	cmd.Flags().Int32VarP(&opts.Workers, "workers", "w", 0, "Target number of worker nodes (required)")
+	cmd.Flags().Int32VarP(&opts.Workers, "workers", "w", 0, "Target number of worker nodes")
 	cmd.Flags().StringVarP(&opts.Namespace, "namespace", "n", opts.Namespace, "Namespace of the TenantCluster")
 	cmd.Flags().BoolVar(&opts.Wait, "wait", false, "Wait for scaling to complete")
 	cmd.Flags().DurationVar(&opts.Timeout, "timeout", opts.Timeout, "Timeout when using --wait")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Scale even if it exceeds provider capacity or team quota")
+	cmd.Flags().StringSliceVar(&opts.DeleteMachines, "delete-machine", nil, "Worker machine or node to remove when scaling down (repeatable; scales down by one each without --workers)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show which machines a scale-down would remove without scaling")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the scale-down confirmation")
+	policy.AddFlags(cmd, &opts.Policy)
 
-	// Mark workers as required
-	_ = cmd.MarkFlagRequired("workers")
+	queue.Enable(cmd, logger)
 
 	return cmd
 }
@@ -137,11 +183,64 @@ func completeClusterNames(cmd *cobra.Command, args []string, toComplete string)
 	return names, cobra.ShellCompDirectiveNoFileComp
 }
 
+// CompleteKubernetesVersions completes --k8s-version with the versions the
+// platform supports, newest first.
+func CompleteKubernetesVersions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	versions, err := platform.LoadKubernetesVersions(context.Background(), c)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return versions.Supported, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+}
+
+// completeImages completes --image with the OS images of the --provider
+// ProviderConfig, or of the only one.
+func completeImages(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	ctx := context.Background()
+
+	list, err := c.Dynamic.Resource(client.ProviderConfigGVR).Namespace(ButlerSystemNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	provider, _ := cmd.Flags().GetString("provider")
+	var pc *unstructured.Unstructured
+	for i := range list.Items {
+		if list.Items[i].GetName() == provider || (provider == "" && len(list.Items) == 1) {
+			pc = &list.Items[i]
+		}
+	}
+	if pc == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	images, err := providerapi.ListImages(ctx, c, pc, 10*time.Second)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	refs := make([]string, 0, len(images))
+	for _, img := range images {
+		refs = append(refs, img.Ref+"\t"+img.Name)
+	}
+	return refs, cobra.ShellCompDirectiveNoFileComp
+}
+
 // runScale executes the scale operation.
 func runScale(ctx context.Context, opts *ScaleOptions) error {
 	if err := opts.Validate(); err != nil {
 		return err
 	}
+	// A machine named twice is still removed once
+	opts.DeleteMachines = uniqueNames(opts.DeleteMachines)
 
 	// Verify we're connected to a management cluster
 	if err := RequireManagementCluster(ctx); err != nil {
@@ -157,10 +256,13 @@ func runScale(ctx context.Context, opts *ScaleOptions) error {
 	tc, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return fmt.Errorf("TenantCluster %q not found in namespace %q", opts.Name, opts.Namespace)
+			return ClusterNotFoundError(ctx, c, opts.Namespace, opts.Name)
 		}
 		return fmt.Errorf("getting TenantCluster: %w", err)
 	}
+	if lifecycle.Adopted(tc.GetAnnotations()) {
+		return fmt.Errorf("TenantCluster %q was adopted; Butler does not manage its machines", opts.Name)
+	}
 
 	// Get current replica count
 	currentReplicas := GetNestedInt64(tc.Object, "spec", "workers", "replicas")
@@ -169,6 +271,24 @@ func runScale(ctx context.Context, opts *ScaleOptions) error {
 	}
 
 	targetReplicas := int64(opts.Workers)
+	if targetReplicas < 0 {
+		targetReplicas = currentReplicas - int64(len(opts.DeleteMachines))
+	}
+	if len(opts.DeleteMachines) > 0 && targetReplicas > currentReplicas-int64(len(opts.DeleteMachines)) {
+		return fmt.Errorf("--delete-machine removes %d worker(s) of %d; --workers must be at most %d",
+			len(opts.DeleteMachines), currentReplicas, currentReplicas-int64(len(opts.DeleteMachines)))
+	}
+	if targetReplicas < 0 {
+		return fmt.Errorf("cluster %s has only %d worker(s)", opts.Name, currentReplicas)
+	}
+
+	limits, err := platform.LoadLimits(ctx, c)
+	if err != nil {
+		return err
+	}
+	if err := limits.CheckWorkers(targetReplicas); err != nil {
+		return err
+	}
 
 	// Check if already at target
 	if currentReplicas == targetReplicas {
@@ -184,6 +304,44 @@ func runScale(ctx context.Context, opts *ScaleOptions) error {
 		operation = "Scaling down"
 	}
 
+	// Evaluate platform policies against the scaled cluster
+	scaled := tc.DeepCopy()
+	if err := unstructured.SetNestedField(scaled.Object, targetReplicas, "spec", "workers", "replicas"); err != nil {
+		return fmt.Errorf("building scaled TenantCluster: %w", err)
+	}
+	if err := policy.Enforce(ctx, c, opts.Logger, opts.Policy, policy.Input{
+		Operation: policy.OperationScale,
+		Object:    scaled.Object,
+		OldObject: tc.Object,
+	}); err != nil {
+		return err
+	}
+
+	// Refuse to overcommit the provider or the team's quota
+	if problems := checkScaleCapacity(ctx, c, opts.Logger, tc, targetReplicas); len(problems) > 0 {
+		if !opts.Force {
+			return fmt.Errorf("scaling %s to %d workers would overcommit resources:\n  %s\nre-run with --force to scale anyway",
+				opts.Name, targetReplicas, strings.Join(problems, "\n  "))
+		}
+		for _, p := range problems {
+			opts.Logger.Warn("overcommitting (--force)", "reason", p)
+		}
+	}
+
+	var pinned []*unstructured.Unstructured
+	if targetReplicas < currentReplicas {
+		pinned, err = confirmScaleDown(ctx, c, opts, tc, currentReplicas-targetReplicas)
+		if err != nil {
+			return err
+		}
+		if opts.DryRun {
+			return nil
+		}
+	} else if opts.DryRun {
+		opts.Logger.Info("dry run: would scale up", "name", opts.Name, "from", currentReplicas, "to", targetReplicas)
+		return nil
+	}
+
 	opts.Logger.Info(fmt.Sprintf("%s cluster", operation),
 		"name", opts.Name,
 		"from", currentReplicas,
@@ -213,6 +371,12 @@ func runScale(ctx context.Context, opts *ScaleOptions) error {
 		metav1.PatchOptions{},
 	)
 	if err != nil {
+		// Unmarked, the machines would be removed by the next scale-down
+		// however it was planned
+		tenantNS := GetNestedString(tc.Object, "status", "tenantNamespace")
+		if unpinErr := unpinScaleDown(context.WithoutCancel(ctx), c, tenantNS, pinned); unpinErr != nil {
+			opts.Logger.Warn("could not unmark machines for deletion", "machines", scaleDownNames(pinned), "error", unpinErr)
+		}
 		return fmt.Errorf("patching TenantCluster: %w", err)
 	}
 
@@ -229,54 +393,84 @@ func runScale(ctx context.Context, opts *ScaleOptions) error {
 	return nil
 }
 
-// waitForScale polls until the desired number of workers are ready.
-func waitForScale(ctx context.Context, c *client.Client, opts *ScaleOptions, targetReplicas int64) error {
-	opts.Logger.Info("waiting for workers to be ready", "target", targetReplicas, "timeout", opts.Timeout)
+// confirmScaleDown shows the machines a scale-down removes, asks for
+// confirmation and pins them for deletion, returning the Machines it
+// marked. With --dry-run it only shows them.
+func confirmScaleDown(ctx context.Context, c *client.Client, opts *ScaleOptions, tc *unstructured.Unstructured, count int64) ([]*unstructured.Unstructured, error) {
+	tenantNS := GetNestedString(tc.Object, "status", "tenantNamespace")
+	if tenantNS == "" {
+		if len(opts.DeleteMachines) > 0 {
+			return nil, fmt.Errorf("TenantCluster %s has no machines yet (phase: %s)", opts.Name, GetNestedString(tc.Object, "status", "phase"))
+		}
+		return nil, nil
+	}
 
-	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
-	defer cancel()
+	plan, err := planScaleDown(ctx, c, tenantNS, opts.Name, count, opts.DeleteMachines)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan) == 0 {
+		return nil, nil
+	}
 
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
+	machines, podErr := describeScaleDown(ctx, c, opts.Namespace, opts.Name, plan)
+	if podErr != nil {
+		opts.Logger.Warn("could not list pods on the machines to be removed", "error", podErr)
+	}
+	printScaleDownSummary(machines, podErr == nil)
+	if opts.DryRun {
+		return nil, nil
+	}
 
-	startTime := time.Now()
-	lastReady := int64(-1)
-
-	for {
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("timeout waiting for scale to complete after %v", opts.Timeout)
-
-		case <-ticker.C:
-			// Get current cluster info
-			tc, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
-			if err != nil {
-				opts.Logger.Warn("error checking cluster status", "error", err)
-				continue
-			}
+	if !opts.Yes {
+		ok, err := prompt.Confirm(fmt.Sprintf("Remove %d worker machine(s)?", len(plan)))
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("scale-down cancelled")
+		}
+	}
 
-			info := ExtractTenantClusterInfo(tc)
-			EnrichWithMachineDeploymentStatus(ctx, c, &info)
+	pinned, err := pinScaleDown(ctx, c, tenantNS, plan)
+	if err != nil {
+		return nil, err
+	}
+	opts.Logger.Info("machines marked for deletion", "machines", scaleDownNames(plan))
+	return pinned, nil
+}
 
-			ready := info.WorkersReady
-			desired := info.WorkersDesired
-			if desired == 0 {
-				desired = targetReplicas
-			}
+// waitForScale polls until the desired number of workers are ready.
+func waitForScale(ctx context.Context, c *client.Client, opts *ScaleOptions, targetReplicas int64) error {
+	opts.Logger.Info("waiting for workers to be ready", "target", targetReplicas, "timeout", opts.Timeout)
 
-			// Log progress on changes
-			if ready != lastReady {
-				elapsed := time.Since(startTime).Round(time.Second)
-				opts.Logger.Info("scaling progress", "ready", ready, "desired", targetReplicas, "elapsed", elapsed)
-				lastReady = ready
-			}
+	startTime := time.Now()
 
-			// Check if complete
-			if ready == targetReplicas {
-				elapsed := time.Since(startTime).Round(time.Second)
-				opts.Logger.Success("scaling complete", "workers", ready, "elapsed", elapsed)
-				return nil
-			}
+	err := waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("cluster %s to scale to %d workers", opts.Name, targetReplicas),
+		Interval:    5 * time.Second,
+		Timeout:     opts.Timeout,
+		Progress: func(status string, elapsed time.Duration) {
+			opts.Logger.Info("scaling progress", "ready", status, "elapsed", elapsed)
+		},
+	}, func(ctx context.Context) (bool, string, error) {
+		// Get current cluster info
+		tc, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Get(ctx, opts.Name, metav1.GetOptions{})
+		if err != nil {
+			opts.Logger.Warn("error checking cluster status", "error", err)
+			return false, "", nil
 		}
+
+		info := ExtractTenantClusterInfo(tc)
+		EnrichWithMachineDeploymentStatus(ctx, c, &info)
+
+		ready := info.WorkersReady
+		return ready == targetReplicas, fmt.Sprintf("%d/%d", ready, targetReplicas), nil
+	})
+	if err != nil {
+		return err
 	}
+
+	opts.Logger.Success("scaling complete", "workers", targetReplicas, "elapsed", time.Since(startTime).Round(time.Second))
+	return nil
 }
@@ -0,0 +1,292 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// deleteMachineAnnotation makes CAPI remove a Machine first when its
+	// MachineSet scales down
+	deleteMachineAnnotation = "cluster.x-k8s.io/delete-machine"
+
+	// mirrorPodAnnotation marks static pods, which leave with their node
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// scaleDownMachine is a worker Machine a scale-down removes
+type scaleDownMachine struct {
+	MachineInfo
+	// Pods are the non-DaemonSet pods running on its node
+	Pods []string
+}
+
+// planScaleDown picks the worker Machines a scale-down by count removes,
+// the way CAPI does: the named ones, then Machines already marked for
+// deletion, then unhealthy ones, then by the MachineDeployment's
+// deletePolicy. For the default Random policy CAPI's choice can't be
+// predicted, so the newest are picked; the plan is pinned with the
+// delete-machine annotation before scaling, so CAPI removes exactly these.
+func planScaleDown(ctx context.Context, c *client.Client, tenantNS, cluster string, count int64, named []string) ([]*unstructured.Unstructured, error) {
+	list, err := c.Dynamic.Resource(client.MachineGVR).Namespace(tenantNS).List(ctx, metav1.ListOptions{
+		LabelSelector: capiClusterNameLabel + "=" + cluster + ",!" + capiControlPlaneLabel,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing Machines: %w", err)
+	}
+	workers := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		workers = append(workers, &list.Items[i])
+	}
+
+	var plan []*unstructured.Unstructured
+	picked := map[string]bool{}
+	for _, name := range named {
+		m := findWorker(workers, name)
+		if m == nil {
+			return nil, fmt.Errorf("worker machine %q not found in cluster %s", name, cluster)
+		}
+		if !picked[m.GetName()] {
+			picked[m.GetName()] = true
+			plan = append(plan, m)
+		}
+	}
+
+	newestFirst := true
+	for _, md := range []string{cluster + "-workers", cluster + "-md-0"} {
+		obj, err := c.Dynamic.Resource(client.MachineDeploymentGVR).Namespace(tenantNS).Get(ctx, md, metav1.GetOptions{})
+		if err == nil {
+			newestFirst = GetNestedString(obj.Object, "spec", "strategy", "rollingUpdate", "deletePolicy") != "Oldest"
+			break
+		}
+	}
+
+	candidates := make([]*unstructured.Unstructured, 0, len(workers))
+	for _, m := range workers {
+		if !picked[m.GetName()] {
+			candidates = append(candidates, m)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, pj := deletePriority(candidates[i]), deletePriority(candidates[j])
+		if pi != pj {
+			return pi > pj
+		}
+		ti, tj := candidates[i].GetCreationTimestamp(), candidates[j].GetCreationTimestamp()
+		if newestFirst {
+			return tj.Before(&ti)
+		}
+		return ti.Before(&tj)
+	})
+	for _, m := range candidates {
+		if int64(len(plan)) >= count {
+			break
+		}
+		plan = append(plan, m)
+	}
+	return plan, nil
+}
+
+// findWorker matches a Machine by its name or its node's
+func findWorker(workers []*unstructured.Unstructured, name string) *unstructured.Unstructured {
+	for _, m := range workers {
+		if m.GetName() == name || GetNestedString(m.Object, "status", "nodeRef", "name") == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// deletePriority ranks Machines as CAPI does before applying the delete
+// policy: marked or deleting, then failed or without a node, then the rest
+func deletePriority(m *unstructured.Unstructured) int {
+	switch {
+	case m.GetDeletionTimestamp() != nil:
+		return 3
+	case m.GetAnnotations()[deleteMachineAnnotation] != "":
+		return 3
+	case GetNestedString(m.Object, "status", "failureReason") != "" || GetNestedString(m.Object, "status", "failureMessage") != "":
+		return 2
+	case GetNestedString(m.Object, "status", "nodeRef", "name") == "":
+		return 1
+	}
+	return 0
+}
+
+// describeScaleDown adds the pods that would be evicted to each Machine.
+// The pods are listed from the tenant cluster; if it can't be reached, the
+// Machines are returned without them and the error is reported.
+func describeScaleDown(ctx context.Context, c *client.Client, namespace, cluster string, plan []*unstructured.Unstructured) ([]scaleDownMachine, error) {
+	machines := make([]scaleDownMachine, 0, len(plan))
+	for _, m := range plan {
+		machines = append(machines, scaleDownMachine{MachineInfo: machineInfo(m)})
+	}
+
+	tenant, err := c.NewForTenant(ctx, namespace, cluster)
+	if err != nil {
+		return machines, err
+	}
+	for i := range machines {
+		if machines[i].Node == "" {
+			continue
+		}
+		pods, err := tenant.Clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			FieldSelector: "spec.nodeName=" + machines[i].Node,
+		})
+		if err != nil {
+			return machines, fmt.Errorf("listing pods on %s: %w", machines[i].Node, err)
+		}
+		for _, pod := range pods.Items {
+			if evictable(&pod) {
+				machines[i].Pods = append(machines[i].Pods, pod.Namespace+"/"+pod.Name)
+			}
+		}
+		sort.Strings(machines[i].Pods)
+	}
+	return machines, nil
+}
+
+// evictable reports whether draining a node moves the pod elsewhere:
+// running pods not owned by a DaemonSet and not static
+func evictable(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+		return false
+	}
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return true
+}
+
+// printScaleDownSummary shows the Machines a scale-down removes and the
+// pods that will be rescheduled
+func printScaleDownSummary(machines []scaleDownMachine, podsKnown bool) {
+	fmt.Println()
+	fmt.Printf("The following %d worker machine(s) will be removed:\n\n", len(machines))
+	table := output.NewTable(os.Stdout, "MACHINE", "IP", "NODE", "PHASE", "PODS")
+	for _, m := range machines {
+		pods := "?"
+		if podsKnown {
+			pods = fmt.Sprint(len(m.Pods))
+		}
+		table.AddRow(m.Name, orDefault(m.IP, "-"), orDefault(m.Node, "-"), output.ColorizePhase(orDefault(m.Phase, "Unknown")), pods)
+	}
+	table.Flush()
+
+	for _, m := range machines {
+		if len(m.Pods) == 0 {
+			continue
+		}
+		fmt.Printf("\nPods to be rescheduled from %s:\n", m.Node)
+		for _, pod := range m.Pods {
+			fmt.Println("  " + pod)
+		}
+	}
+	fmt.Println()
+}
+
+// pinScaleDown marks the planned Machines for deletion so CAPI removes
+// them rather than ones of its own choosing. It returns the Machines it
+// marked; ones already marked are left to whoever marked them. If marking
+// fails, the Machines marked so far are unmarked again.
+func pinScaleDown(ctx context.Context, c *client.Client, tenantNS string, plan []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{deleteMachineAnnotation: "true"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var pinned []*unstructured.Unstructured
+	for _, m := range plan {
+		if m.GetAnnotations()[deleteMachineAnnotation] != "" {
+			continue
+		}
+		if _, err := c.Dynamic.Resource(client.MachineGVR).Namespace(tenantNS).Patch(ctx, m.GetName(), types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			if unpinErr := unpinScaleDown(context.WithoutCancel(ctx), c, tenantNS, pinned); unpinErr != nil {
+				return nil, fmt.Errorf("marking Machine %s for deletion: %w (and unmarking %s: %v)", m.GetName(), err, scaleDownNames(pinned), unpinErr)
+			}
+			return nil, fmt.Errorf("marking Machine %s for deletion: %w", m.GetName(), err)
+		}
+		pinned = append(pinned, m)
+	}
+	return pinned, nil
+}
+
+// unpinScaleDown removes the delete-machine annotation pinScaleDown added
+func unpinScaleDown(ctx context.Context, c *client.Client, tenantNS string, pinned []*unstructured.Unstructured) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{deleteMachineAnnotation: nil},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	var failed []string
+	for _, m := range pinned {
+		if _, err := c.Dynamic.Resource(client.MachineGVR).Namespace(tenantNS).Patch(ctx, m.GetName(), types.MergePatchType, patch, metav1.PatchOptions{}); err != nil && !errors.IsNotFound(err) {
+			failed = append(failed, fmt.Sprintf("%s: %v", m.GetName(), err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("unmarking Machines: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// uniqueNames drops repeated names, keeping the first of each
+func uniqueNames(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	var unique []string
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	return unique
+}
+
+// scaleDownNames lists Machine names for messages
+func scaleDownNames(plan []*unstructured.Unstructured) string {
+	names := make([]string, len(plan))
+	for i, m := range plan {
+		names[i] = m.GetName()
+	}
+	return strings.Join(names, ", ")
+}
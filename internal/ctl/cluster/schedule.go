@@ -0,0 +1,899 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// ScaleSchedulesAnnotation holds a TenantCluster's scale schedules as JSON
+	ScaleSchedulesAnnotation = "butler.butlerlabs.dev/scale-schedules"
+
+	// ScaleScheduleAppliedAnnotation records the last schedule firing
+	// applied to a TenantCluster, as NAME@RFC3339
+	ScaleScheduleAppliedAnnotation = "butler.butlerlabs.dev/scale-schedule-applied"
+
+	// schedulerName names the scheduler CronJob, its ServiceAccount and RBAC
+	schedulerName = "butler-scale-scheduler"
+
+	// schedulerFieldManager is the server-side apply field manager for the
+	// scheduler's resources
+	schedulerFieldManager = "butlerctl"
+
+	// schedulerCron is how often the scheduler CronJob looks for due
+	// schedules, which bounds how late a scale can be applied
+	schedulerCron = "*/5 * * * *"
+
+	// DefaultSchedulerImage runs the scheduler CronJob
+	DefaultSchedulerImage = "ghcr.io/butlerdotdev/butlerctl:latest"
+
+	// schedulerUID is the non-root user the scheduler Job runs as
+	schedulerUID = 65532
+)
+
+// ScaleSchedule scales a cluster's workers whenever its cron schedule fires
+type ScaleSchedule struct {
+	Name     string `json:"name"`
+	Schedule string `json:"schedule"`
+	Timezone string `json:"timezone,omitempty"`
+	Workers  int64  `json:"workers"`
+}
+
+// Parsed returns the schedule's cron expression
+func (s *ScaleSchedule) Parsed() (*CronSchedule, error) {
+	return ParseCron(s.Schedule, s.Timezone)
+}
+
+// ScheduleInfo is a scale schedule as shown by schedule list
+type ScheduleInfo struct {
+	Cluster   string    `json:"cluster"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Schedule  string    `json:"schedule"`
+	Timezone  string    `json:"timezone"`
+	Workers   int64     `json:"workers"`
+	Next      time.Time `json:"next,omitempty"`
+}
+
+// newScheduleCmd creates the cluster schedule command
+func newScheduleCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Scale clusters on a schedule",
+		Long: `Scale tenant clusters' workers on a recurring schedule.
+
+Schedules are cron expressions stored on the TenantCluster. A CronJob on
+the management cluster, installed once by a platform admin with
+'schedule install', checks every few minutes for schedules that have fired
+and sets the cluster's worker count. Scaling by hand between firings is
+left alone until the next one.
+
+Commands:
+  scale    Add or replace a scale schedule
+  list     List scale schedules and when they next fire
+  remove   Remove a scale schedule
+  run      Apply schedules that have fired (what the CronJob runs)
+  install  Install the scheduler CronJob on the management cluster
+
+Examples:
+  # 5 workers in office hours, 1 overnight
+  butlerctl cluster schedule scale my-cluster --name day --cron "0 8 * * mon-fri" --workers 5
+  butlerctl cluster schedule scale my-cluster --name night --cron "0 20 * * *" --workers 1
+
+  # Show schedules across namespaces
+  butlerctl cluster schedule list -A`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newScheduleScaleCmd(logger))
+	cmd.AddCommand(newScheduleListCmd(logger))
+	cmd.AddCommand(newScheduleRemoveCmd(logger))
+	cmd.AddCommand(newScheduleRunCmd(logger))
+	cmd.AddCommand(newScheduleInstallCmd(logger))
+
+	return cmd
+}
+
+type scheduleScaleOptions struct {
+	namespace  string
+	kubeconfig string
+	schedule   ScaleSchedule
+}
+
+func newScheduleScaleCmd(logger *log.Logger) *cobra.Command {
+	opts := &scheduleScaleOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "scale NAME --cron SCHEDULE --workers COUNT",
+		Short: "Add or replace a scale schedule",
+		Long: `Scale a cluster to a worker count whenever a cron schedule fires.
+
+--cron takes five fields: minute, hour, day of month, month and day of
+week, with *, lists (1,15), ranges (mon-fri) and steps (*/2). It is read in
+--timezone. A schedule with the same --name is replaced; the default name
+is to-COUNT.
+
+Examples:
+  # Scale up at 08:00 on weekdays, Berlin time
+  butlerctl cluster schedule scale my-cluster --name day --cron "0 8 * * mon-fri" \
+    --timezone Europe/Berlin --workers 5
+
+  # Scale down every evening
+  butlerctl cluster schedule scale my-cluster --name night --cron "0 20 * * *" \
+    --timezone Europe/Berlin --workers 1`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runScheduleScale(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", DefaultTenantNamespace, "namespace of the TenantCluster")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.schedule.Name, "name", "", "schedule name (default: to-COUNT)")
+	cmd.Flags().StringVar(&opts.schedule.Schedule, "cron", "", "cron schedule, e.g. \"0 8 * * mon-fri\" (required)")
+	cmd.Flags().StringVar(&opts.schedule.Timezone, "timezone", "UTC", "IANA time zone the schedule is expressed in")
+	cmd.Flags().Int64VarP(&opts.schedule.Workers, "workers", "w", 0, "worker count to scale to (required)")
+	_ = cmd.MarkFlagRequired("cron")
+	_ = cmd.MarkFlagRequired("workers")
+
+	return cmd
+}
+
+func runScheduleScale(ctx context.Context, logger *log.Logger, name string, opts *scheduleScaleOptions) error {
+	s := opts.schedule
+	if s.Workers < 0 {
+		return fmt.Errorf("workers must not be negative, got %d", s.Workers)
+	}
+	if s.Name == "" {
+		s.Name = fmt.Sprintf("to-%d", s.Workers)
+	}
+	cron, err := s.Parsed()
+	if err != nil {
+		return err
+	}
+
+	c, err := scheduleClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+	limits, err := platform.LoadLimits(ctx, c)
+	if err != nil {
+		return err
+	}
+	if err := limits.CheckWorkers(s.Workers); err != nil {
+		return err
+	}
+
+	tc, err := getScheduledCluster(ctx, c, opts.namespace, name)
+	if err != nil {
+		return err
+	}
+	if lifecycle.Adopted(tc.GetAnnotations()) {
+		return fmt.Errorf("TenantCluster %q was adopted; Butler does not manage its machines", name)
+	}
+	schedules, err := scaleSchedules(tc)
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i := range schedules {
+		if schedules[i].Name == s.Name {
+			schedules[i] = s
+			replaced = true
+		}
+	}
+	if !replaced {
+		schedules = append(schedules, s)
+	}
+
+	// Only firings from now on apply; the current worker count stays
+	annotations := map[string]interface{}{
+		ScaleScheduleAppliedAnnotation: s.Name + "@" + time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := patchScaleSchedules(ctx, c, tc, schedules, annotations); err != nil {
+		return err
+	}
+
+	logger.Success("scale schedule set", "cluster", name, "schedule", s.Name, "cron", s.Schedule, "timezone", cron.Location.String(), "workers", s.Workers)
+	if next := cron.Next(time.Now()); !next.IsZero() {
+		logger.Info("next scale", "at", next.Format(time.RFC3339))
+	}
+	warnSchedulerMissing(ctx, c, logger)
+	return nil
+}
+
+type scheduleListOptions struct {
+	nsFlags      NamespaceFlags
+	kubeconfig   string
+	outputFormat string
+}
+
+func newScheduleListCmd(logger *log.Logger) *cobra.Command {
+	opts := &scheduleListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [NAME]",
+		Short: "List scale schedules and when they next fire",
+		Long: `List the scale schedules of a cluster, or of every cluster in a namespace.
+
+Examples:
+  butlerctl cluster schedule list my-cluster
+  butlerctl cluster schedule list -A -o json`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := output.ParseFormat(opts.outputFormat)
+			if err != nil {
+				return err
+			}
+			c, err := scheduleClient(opts.kubeconfig)
+			if err != nil {
+				return err
+			}
+
+			namespace, allNamespaces := opts.nsFlags.ResolveNamespace()
+			var clusters []unstructured.Unstructured
+			if len(args) > 0 {
+				tc, err := getScheduledCluster(cmd.Context(), c, namespace, args[0])
+				if err != nil {
+					return err
+				}
+				clusters = append(clusters, *tc)
+			} else {
+				if allNamespaces {
+					namespace = metav1.NamespaceAll
+				}
+				list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace).List(cmd.Context(), metav1.ListOptions{})
+				if err != nil {
+					return fmt.Errorf("listing TenantClusters: %w", err)
+				}
+				clusters = list.Items
+			}
+
+			infos := collectSchedules(clusters, logger, time.Now())
+			return output.NewPrinter(format, os.Stdout).Print(infos, func(w io.Writer) error {
+				if len(infos) == 0 {
+					fmt.Fprintln(w, "No scale schedules found")
+					return nil
+				}
+				headers := []string{"CLUSTER", "NAME", "SCHEDULE", "TIMEZONE", "WORKERS", "NEXT"}
+				if allNamespaces {
+					headers = append([]string{"NAMESPACE"}, headers...)
+				}
+				table := output.NewTable(w, headers...)
+				for _, s := range infos {
+					next := "-"
+					if !s.Next.IsZero() {
+						next = s.Next.Format("Mon 2006-01-02 15:04 MST")
+					}
+					row := []string{s.Cluster, s.Name, s.Schedule, s.Timezone, strconv.FormatInt(s.Workers, 10), next}
+					if allNamespaces {
+						row = append([]string{s.Namespace}, row...)
+					}
+					table.AddRow(row...)
+				}
+				return table.Flush()
+			})
+		},
+	}
+
+	AddNamespaceFlags(cmd, &opts.nsFlags)
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+// collectSchedules flattens the clusters' schedules, sorted by cluster
+// and next firing. Clusters with unreadable schedules are skipped.
+func collectSchedules(clusters []unstructured.Unstructured, logger *log.Logger, now time.Time) []ScheduleInfo {
+	var infos []ScheduleInfo
+	for i := range clusters {
+		tc := &clusters[i]
+		schedules, err := scaleSchedules(tc)
+		if err != nil {
+			logger.Warn("skipping cluster", "cluster", tc.GetNamespace()+"/"+tc.GetName(), "error", err)
+			continue
+		}
+		for _, s := range schedules {
+			info := ScheduleInfo{
+				Cluster:   tc.GetName(),
+				Namespace: tc.GetNamespace(),
+				Name:      s.Name,
+				Schedule:  s.Schedule,
+				Timezone:  orDefault(s.Timezone, "UTC"),
+				Workers:   s.Workers,
+			}
+			if cron, err := s.Parsed(); err == nil {
+				info.Next = cron.Next(now)
+			}
+			infos = append(infos, info)
+		}
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		a, b := infos[i], infos[j]
+		if a.Namespace != b.Namespace || a.Cluster != b.Cluster {
+			return a.Namespace+"/"+a.Cluster < b.Namespace+"/"+b.Cluster
+		}
+		return a.Next.Before(b.Next)
+	})
+	return infos
+}
+
+type scheduleRemoveOptions struct {
+	namespace  string
+	kubeconfig string
+	all        bool
+}
+
+func newScheduleRemoveCmd(logger *log.Logger) *cobra.Command {
+	opts := &scheduleRemoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove NAME [SCHEDULE]",
+		Short: "Remove a scale schedule",
+		Long: `Remove a scale schedule from a cluster, or all of them with --all.
+
+The cluster keeps its current worker count.
+
+Examples:
+  butlerctl cluster schedule remove my-cluster night
+  butlerctl cluster schedule remove my-cluster --all`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (len(args) == 2) == opts.all {
+				return fmt.Errorf("give either a SCHEDULE name or --all")
+			}
+			schedule := ""
+			if len(args) == 2 {
+				schedule = args[1]
+			}
+			return runScheduleRemove(cmd.Context(), logger, args[0], schedule, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", DefaultTenantNamespace, "namespace of the TenantCluster")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "remove every schedule of the cluster")
+
+	return cmd
+}
+
+func runScheduleRemove(ctx context.Context, logger *log.Logger, name, schedule string, opts *scheduleRemoveOptions) error {
+	c, err := scheduleClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+	tc, err := getScheduledCluster(ctx, c, opts.namespace, name)
+	if err != nil {
+		return err
+	}
+	schedules, err := scaleSchedules(tc)
+	if err != nil && !opts.all {
+		return err
+	}
+
+	var kept []ScaleSchedule
+	if !opts.all {
+		found := false
+		var names []string
+		for _, s := range schedules {
+			names = append(names, s.Name)
+			if s.Name == schedule {
+				found = true
+				continue
+			}
+			kept = append(kept, s)
+		}
+		if !found {
+			if len(names) == 0 {
+				return fmt.Errorf("cluster %s has no scale schedules", name)
+			}
+			return fmt.Errorf("cluster %s has no scale schedule %q (have: %s)", name, schedule, strings.Join(names, ", "))
+		}
+	}
+
+	var annotations map[string]interface{}
+	if len(kept) == 0 {
+		annotations = map[string]interface{}{ScaleScheduleAppliedAnnotation: nil}
+	}
+	if err := patchScaleSchedules(ctx, c, tc, kept, annotations); err != nil {
+		return err
+	}
+
+	if opts.all {
+		logger.Success("scale schedules removed", "cluster", name, "count", len(schedules))
+	} else {
+		logger.Success("scale schedule removed", "cluster", name, "schedule", schedule)
+	}
+	return nil
+}
+
+type scheduleRunOptions struct {
+	nsFlags    NamespaceFlags
+	kubeconfig string
+	dryRun     bool
+}
+
+func newScheduleRunCmd(logger *log.Logger) *cobra.Command {
+	opts := &scheduleRunOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Apply scale schedules that have fired",
+		Long: `Scale clusters whose schedules have fired since they were last applied.
+
+Of a cluster's schedules, the one that fired most recently wins. Each
+firing is applied once, so running repeatedly is safe; this is what the
+scheduler CronJob runs every few minutes. Schedules that fired while it
+wasn't running are caught up on the next run.
+
+Examples:
+  # What the CronJob runs
+  butlerctl cluster schedule run -A
+
+  # Show what would be scaled
+  butlerctl cluster schedule run -A --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runScheduleRun(cmd.Context(), logger, opts)
+		},
+	}
+
+	AddNamespaceFlags(cmd, &opts.nsFlags)
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "show what would be scaled without changing anything")
+
+	return cmd
+}
+
+func runScheduleRun(ctx context.Context, logger *log.Logger, opts *scheduleRunOptions) error {
+	c, err := scheduleClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+	namespace, allNamespaces := opts.nsFlags.ResolveNamespace()
+	if allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing TenantClusters: %w", err)
+	}
+
+	now := time.Now()
+	var failed []string
+	for i := range list.Items {
+		tc := &list.Items[i]
+		if _, ok := tc.GetAnnotations()[ScaleSchedulesAnnotation]; !ok || tc.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := applyDueSchedule(ctx, c, logger, tc, now, opts.dryRun); err != nil {
+			logger.Error("applying scale schedule failed", "cluster", tc.GetNamespace()+"/"+tc.GetName(), "error", err)
+			failed = append(failed, tc.GetNamespace()+"/"+tc.GetName())
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("scale schedules failed for %d cluster(s): %s", len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// applyDueSchedule scales a cluster to its most recently fired schedule
+// if that firing hasn't been applied yet
+func applyDueSchedule(ctx context.Context, c *client.Client, logger *log.Logger, tc *unstructured.Unstructured, now time.Time, dryRun bool) error {
+	if lifecycle.Adopted(tc.GetAnnotations()) {
+		return fmt.Errorf("cluster was adopted; Butler does not manage its machines")
+	}
+	schedules, err := scaleSchedules(tc)
+	if err != nil {
+		return err
+	}
+
+	var due *ScaleSchedule
+	var firedAt time.Time
+	for i := range schedules {
+		cron, err := schedules[i].Parsed()
+		if err != nil {
+			return fmt.Errorf("schedule %s: %w", schedules[i].Name, err)
+		}
+		if t := cron.Prev(now); !t.IsZero() && t.After(firedAt) {
+			due, firedAt = &schedules[i], t
+		}
+	}
+	if due == nil || !firedAt.After(lastApplied(tc)) {
+		return nil
+	}
+
+	current := GetNestedInt64(tc.Object, "spec", "workers", "replicas")
+	if current == 0 {
+		current = 1
+	}
+	key := tc.GetNamespace() + "/" + tc.GetName()
+	if dryRun {
+		logger.Info("would scale (dry run)", "cluster", key, "schedule", due.Name, "from", current, "to", due.Workers)
+		return nil
+	}
+
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				ScaleScheduleAppliedAnnotation: due.Name + "@" + firedAt.UTC().Format(time.RFC3339),
+			},
+		},
+	}
+	if current != due.Workers {
+		patch["spec"] = map[string]interface{}{
+			"workers": map[string]interface{}{"replicas": due.Workers},
+		}
+	}
+	if err := mergePatchCluster(ctx, c, tc, patch); err != nil {
+		return err
+	}
+
+	if current != due.Workers {
+		logger.Success("scaled on schedule", "cluster", key, "schedule", due.Name, "from", current, "to", due.Workers)
+	} else {
+		logger.Info("already at scheduled size", "cluster", key, "schedule", due.Name, "workers", current)
+	}
+	return nil
+}
+
+// lastApplied returns when the last applied firing happened, or the zero
+// time if none was recorded
+func lastApplied(tc *unstructured.Unstructured) time.Time {
+	value := tc.GetAnnotations()[ScaleScheduleAppliedAnnotation]
+	at := value[strings.LastIndex(value, "@")+1:]
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+type scheduleInstallOptions struct {
+	kubeconfig string
+	image      string
+	remove     bool
+	yes        bool
+}
+
+func newScheduleInstallCmd(logger *log.Logger) *cobra.Command {
+	opts := &scheduleInstallOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "Install the scheduler CronJob on the management cluster",
+		Long: `Install the CronJob that applies scale schedules.
+
+The CronJob runs 'butlerctl cluster schedule run -A' every 5 minutes in
+butler-system, with a ServiceAccount that can only read and patch
+TenantClusters. It is shared by every cluster's schedules, so it needs
+installing once, by a platform admin. Running it again updates the image.
+
+Examples:
+  butlerctl cluster schedule install
+
+  # Pin the image
+  butlerctl cluster schedule install --image ghcr.io/butlerdotdev/butlerctl:v0.4.0
+
+  # Stop applying schedules
+  butlerctl cluster schedule install --remove`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runScheduleInstall(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.image, "image", DefaultSchedulerImage, "butlerctl image the CronJob runs")
+	cmd.Flags().BoolVar(&opts.remove, "remove", false, "remove the scheduler CronJob and its RBAC")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "skip the confirmation prompt for --remove")
+
+	return cmd
+}
+
+func runScheduleInstall(ctx context.Context, logger *log.Logger, opts *scheduleInstallOptions) error {
+	c, err := scheduleClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+	if opts.remove {
+		return removeScheduler(ctx, c, logger, opts.yes)
+	}
+
+	for _, obj := range schedulerObjects(opts.image) {
+		data, err := json.Marshal(obj.obj)
+		if err != nil {
+			return fmt.Errorf("encoding %s %s: %w", obj.gvr.Resource, obj.obj.GetName(), err)
+		}
+		_, err = c.Dynamic.Resource(obj.gvr).Namespace(obj.namespace).Patch(ctx, obj.obj.GetName(), types.ApplyPatchType, data,
+			metav1.PatchOptions{FieldManager: schedulerFieldManager, Force: ptrTo(true)})
+		if err != nil {
+			return fmt.Errorf("applying %s %s: %w", obj.gvr.Resource, obj.obj.GetName(), err)
+		}
+	}
+
+	logger.Success("scale scheduler installed", "cron", schedulerCron, "image", opts.image, "namespace", ButlerSystemNamespace)
+	return nil
+}
+
+// removeScheduler deletes the CronJob and its RBAC. Schedules stay on the
+// clusters but are no longer applied.
+func removeScheduler(ctx context.Context, c *client.Client, logger *log.Logger, yes bool) error {
+	if !yes {
+		ok, err := prompt.Confirm("Remove the scale scheduler? Schedules stop being applied to every cluster.")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted")
+		}
+	}
+
+	ns := ButlerSystemNamespace
+	deletes := []struct {
+		kind string
+		fn   func() error
+	}{
+		{"CronJob", func() error {
+			propagation := metav1.DeletePropagationBackground
+			return c.Clientset.BatchV1().CronJobs(ns).Delete(ctx, schedulerName, metav1.DeleteOptions{PropagationPolicy: &propagation})
+		}},
+		{"ClusterRoleBinding", func() error {
+			return c.Clientset.RbacV1().ClusterRoleBindings().Delete(ctx, schedulerName, metav1.DeleteOptions{})
+		}},
+		{"ClusterRole", func() error {
+			return c.Clientset.RbacV1().ClusterRoles().Delete(ctx, schedulerName, metav1.DeleteOptions{})
+		}},
+		{"ServiceAccount", func() error {
+			return c.Clientset.CoreV1().ServiceAccounts(ns).Delete(ctx, schedulerName, metav1.DeleteOptions{})
+		}},
+	}
+	for _, d := range deletes {
+		if err := d.fn(); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("deleting %s %s: %w", d.kind, schedulerName, err)
+		}
+	}
+
+	logger.Success("scale scheduler removed")
+	return nil
+}
+
+// warnSchedulerMissing points out when no scheduler will apply schedules.
+// Users who can't read butler-system get no warning.
+func warnSchedulerMissing(ctx context.Context, c *client.Client, logger *log.Logger) {
+	_, err := c.Clientset.BatchV1().CronJobs(ButlerSystemNamespace).Get(ctx, schedulerName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		logger.Warn("the scale scheduler is not installed, so schedules won't be applied; ask a platform admin to run 'butlerctl cluster schedule install'")
+	}
+}
+
+// schedulerObject is a resource installed by schedule install
+type schedulerObject struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+	obj       interface{ GetName() string }
+}
+
+// schedulerObjects builds the ServiceAccount, RBAC and CronJob
+func schedulerObjects(image string) []schedulerObject {
+	ns := ButlerSystemNamespace
+	labels := map[string]string{
+		"app.kubernetes.io/name":       schedulerName,
+		"app.kubernetes.io/managed-by": schedulerFieldManager,
+	}
+	meta := func(name, namespace string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}
+	}
+
+	sa := &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+		ObjectMeta: meta(schedulerName, ns),
+	}
+
+	role := &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+		ObjectMeta: meta(schedulerName, ""),
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{client.TenantClusterGVR.Group},
+			Resources: []string{client.TenantClusterGVR.Resource},
+			Verbs:     []string{"get", "list", "patch"},
+		}},
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding"},
+		ObjectMeta: meta(schedulerName, ""),
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     schedulerName,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      schedulerName,
+			Namespace: ns,
+		}},
+	}
+
+	cronJob := &batchv1.CronJob{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+		ObjectMeta: meta(schedulerName, ns),
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   schedulerCron,
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: ptrTo[int32](1),
+			FailedJobsHistoryLimit:     ptrTo[int32](3),
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: batchv1.JobSpec{
+					BackoffLimit:          ptrTo[int32](1),
+					ActiveDeadlineSeconds: ptrTo[int64](240),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: labels},
+						Spec: corev1.PodSpec{
+							ServiceAccountName: schedulerName,
+							RestartPolicy:      corev1.RestartPolicyNever,
+							SecurityContext: &corev1.PodSecurityContext{
+								RunAsNonRoot:   ptrTo(true),
+								RunAsUser:      ptrTo[int64](schedulerUID),
+								SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+							},
+							Containers: []corev1.Container{{
+								Name:  "scheduler",
+								Image: image,
+								Args:  []string{"cluster", "schedule", "run", "--all-namespaces"},
+								Env: []corev1.EnvVar{
+									{Name: prompt.EnvNonInteractive, Value: "true"},
+								},
+								SecurityContext: &corev1.SecurityContext{
+									AllowPrivilegeEscalation: ptrTo(false),
+									ReadOnlyRootFilesystem:   ptrTo(true),
+									Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return []schedulerObject{
+		{gvr: corev1.SchemeGroupVersion.WithResource("serviceaccounts"), namespace: ns, obj: sa},
+		{gvr: rbacv1.SchemeGroupVersion.WithResource("clusterroles"), obj: role},
+		{gvr: rbacv1.SchemeGroupVersion.WithResource("clusterrolebindings"), obj: binding},
+		{gvr: batchv1.SchemeGroupVersion.WithResource("cronjobs"), namespace: ns, obj: cronJob},
+	}
+}
+
+// scaleSchedules reads a TenantCluster's scale schedules
+func scaleSchedules(tc *unstructured.Unstructured) ([]ScaleSchedule, error) {
+	value, ok := tc.GetAnnotations()[ScaleSchedulesAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var schedules []ScaleSchedule
+	if err := json.Unmarshal([]byte(value), &schedules); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", ScaleSchedulesAnnotation, err)
+	}
+	return schedules, nil
+}
+
+// patchScaleSchedules stores a cluster's schedules along with any other
+// annotation changes; nil values remove annotations
+func patchScaleSchedules(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, schedules []ScaleSchedule, annotations map[string]interface{}) error {
+	if annotations == nil {
+		annotations = map[string]interface{}{}
+	}
+	if len(schedules) == 0 {
+		annotations[ScaleSchedulesAnnotation] = nil
+	} else {
+		data, err := json.Marshal(schedules)
+		if err != nil {
+			return fmt.Errorf("encoding schedules: %w", err)
+		}
+		annotations[ScaleSchedulesAnnotation] = string(data)
+	}
+	return mergePatchCluster(ctx, c, tc, map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+}
+
+// mergePatchCluster applies a merge patch to a TenantCluster, failing if
+// it changed since it was read
+func mergePatchCluster(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, patch map[string]interface{}) error {
+	metadata, _ := patch["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+		patch["metadata"] = metadata
+	}
+	metadata["resourceVersion"] = tc.GetResourceVersion()
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("marshaling patch: %w", err)
+	}
+	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(tc.GetNamespace()).Patch(ctx, tc.GetName(), types.MergePatchType, data, metav1.PatchOptions{})
+	if errors.IsConflict(err) {
+		return fmt.Errorf("TenantCluster %s/%s changed meanwhile; try again", tc.GetNamespace(), tc.GetName())
+	}
+	if err != nil {
+		return fmt.Errorf("patching TenantCluster: %w", err)
+	}
+	return nil
+}
+
+func getScheduledCluster(ctx context.Context, c *client.Client, namespace, name string) (*unstructured.Unstructured, error) {
+	tc, err := c.GetTenantCluster(ctx, namespace, name)
+	if errors.IsNotFound(err) {
+		return nil, ClusterNotFoundError(ctx, c, namespace, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("getting TenantCluster %s/%s: %w", namespace, name, err)
+	}
+	return tc, nil
+}
+
+func scheduleClient(kubeconfig string) (*client.Client, error) {
+	var c *client.Client
+	var err error
+	if kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to management cluster: %w", err)
+	}
+	return c, nil
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
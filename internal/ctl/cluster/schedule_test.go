@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func annotatedCluster(namespace, name string, annotations map[string]string) unstructured.Unstructured {
+	tc := unstructured.Unstructured{Object: map[string]interface{}{}}
+	tc.SetNamespace(namespace)
+	tc.SetName(name)
+	tc.SetAnnotations(annotations)
+	return tc
+}
+
+func TestLastApplied(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"none", "", ""},
+		{"name and time", "business-hours@2026-10-16T08:00:00Z", "2026-10-16T08:00:00Z"},
+		{"name containing @", "a@b@2026-10-16T08:00:00+02:00", "2026-10-16T06:00:00Z"},
+		{"time only", "2026-10-16T08:00:00Z", "2026-10-16T08:00:00Z"},
+		{"unparsable time", "business-hours@yesterday", ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := annotatedCluster("team-a", "alpha", map[string]string{ScaleScheduleAppliedAnnotation: tt.value})
+			got := lastApplied(&tc)
+			if tt.want == "" {
+				if !got.IsZero() {
+					t.Errorf("lastApplied = %s, want the zero time", got)
+				}
+				return
+			}
+			if want := mustTime(t, tt.want); !got.Equal(want) {
+				t.Errorf("lastApplied = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScaleSchedules(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"none", "", 0, false},
+		{"two", `[{"name":"day","schedule":"0 8 * * *","workers":5},{"name":"night","schedule":"0 20 * * *","timezone":"Europe/Berlin","workers":1}]`, 2, false},
+		{"empty list", `[]`, 0, false},
+		{"not JSON", `day=0 8 * * *`, 0, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := annotatedCluster("team-a", "alpha", map[string]string{ScaleSchedulesAnnotation: tt.value})
+			got, err := scaleSchedules(&tc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("scaleSchedules error = %v, want error %t", err, tt.wantErr)
+			}
+			if len(got) != tt.want {
+				t.Errorf("scaleSchedules returned %d schedules, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectSchedules(t *testing.T) {
+	now := mustTime(t, "2026-10-16T12:00:00Z")
+	clusters := []unstructured.Unstructured{
+		annotatedCluster("team-b", "bravo", map[string]string{
+			ScaleSchedulesAnnotation: `[{"name":"down","schedule":"0 20 * * *","workers":1}]`,
+		}),
+		annotatedCluster("team-a", "alpha", map[string]string{
+			ScaleSchedulesAnnotation: `[{"name":"up","schedule":"0 8 * * *","workers":5},{"name":"down","schedule":"0 20 * * *","workers":1},{"name":"broken","schedule":"0 25 * * *","workers":2}]`,
+		}),
+		annotatedCluster("team-a", "unreadable", map[string]string{ScaleSchedulesAnnotation: `{`}),
+	}
+
+	got := collectSchedules(clusters, log.New("test"), now)
+
+	want := []struct {
+		cluster, name string
+		next          string
+	}{
+		// Unparsable schedules have no next firing and sort first
+		{"alpha", "broken", ""},
+		{"alpha", "down", "2026-10-16T20:00:00Z"},
+		{"alpha", "up", "2026-10-17T08:00:00Z"},
+		{"bravo", "down", "2026-10-16T20:00:00Z"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("collectSchedules returned %d schedules, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Cluster != w.cluster || g.Name != w.name {
+			t.Errorf("schedule %d = %s/%s, want %s/%s", i, g.Cluster, g.Name, w.cluster, w.name)
+		}
+		if w.next == "" {
+			if !g.Next.IsZero() {
+				t.Errorf("schedule %d next = %s, want the zero time", i, g.Next)
+			}
+		} else if !g.Next.Equal(mustTime(t, w.next)) {
+			t.Errorf("schedule %d next = %s, want %s", i, g.Next, w.next)
+		}
+		if g.Timezone != "UTC" {
+			t.Errorf("schedule %d timezone = %q, want UTC", i, g.Timezone)
+		}
+	}
+}
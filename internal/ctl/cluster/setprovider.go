@@ -0,0 +1,389 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/policy"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/common/providerapi"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Values accepted by set-provider --rollover
+const (
+	RolloverAuto   = "auto"
+	RolloverAlways = "always"
+	RolloverNever  = "never"
+)
+
+// SetProviderOptions holds options for the set-provider command.
+type SetProviderOptions struct {
+	Name      string
+	Namespace string
+	Provider  string
+	Rollover  string
+	Wait      bool
+	Timeout   time.Duration
+	Yes       bool
+	Policy    policy.Options
+	Logger    *log.Logger
+}
+
+// DefaultSetProviderOptions returns SetProviderOptions with sensible defaults.
+func DefaultSetProviderOptions(logger *log.Logger) *SetProviderOptions {
+	return &SetProviderOptions{
+		Namespace: DefaultTenantNamespace,
+		Rollover:  RolloverAuto,
+		Timeout:   30 * time.Minute,
+		Logger:    logger,
+	}
+}
+
+// Validate checks that all required options are set and valid.
+func (o *SetProviderOptions) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("cluster name is required")
+	}
+	if o.Provider == "" {
+		return fmt.Errorf("--provider is required")
+	}
+	switch o.Rollover {
+	case RolloverAuto, RolloverAlways, RolloverNever:
+	default:
+		return fmt.Errorf("invalid --rollover %q: must be one of %s, %s, %s", o.Rollover, RolloverAuto, RolloverAlways, RolloverNever)
+	}
+	if o.Timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+	return nil
+}
+
+// NewSetProviderCmd creates the cluster set-provider command.
+func NewSetProviderCmd(logger *log.Logger) *cobra.Command {
+	opts := DefaultSetProviderOptions(logger)
+
+	cmd := &cobra.Command{
+		Use:   "set-provider NAME --provider PROVIDER",
+		Short: "Move a cluster to another ProviderConfig of the same type",
+		Long: `Point a tenant cluster at a different ProviderConfig of the same type.
+
+Used when the infrastructure endpoint behind a provider is replaced, e.g. a
+new Prism Central managing the same Nutanix clusters. The new ProviderConfig
+must be of the same provider type.
+
+Before switching, the cluster's VMs are looked up through the new
+ProviderConfig. If it can see all of them the controller simply carries on
+managing them there. If some are missing, or the lookup isn't possible, the
+workers must be recreated: a rolling replacement of the MachineDeployment
+brings up each new worker through the new provider before removing an old
+one.
+
+Rollover:
+  auto    Replace workers only when the new provider can't see them all
+  always  Replace workers regardless
+  never   Only switch the reference
+
+Examples:
+  # Switch to the ProviderConfig for the new Prism Central
+  butlerctl cluster set-provider my-cluster --provider nutanix-pc2
+
+  # Switch, replace all workers and wait for the rollover
+  butlerctl cluster set-provider my-cluster --provider nutanix-pc2 --rollover always --wait`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Name = args[0]
+			return runSetProvider(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Provider, "provider", "", "ProviderConfig to move the cluster to (required)")
+	cmd.Flags().StringVarP(&opts.Namespace, "namespace", "n", opts.Namespace, "Namespace of the TenantCluster")
+	cmd.Flags().StringVar(&opts.Rollover, "rollover", opts.Rollover, "Replace workers: auto, always or never")
+	cmd.Flags().BoolVar(&opts.Wait, "wait", false, "Wait for the worker rollover to complete")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", opts.Timeout, "Timeout when using --wait")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt")
+	policy.AddFlags(cmd, &opts.Policy)
+
+	_ = cmd.MarkFlagRequired("provider")
+
+	return cmd
+}
+
+// runSetProvider executes the set-provider operation.
+func runSetProvider(ctx context.Context, opts *SetProviderOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	// Verify we're connected to a management cluster
+	if err := RequireManagementCluster(ctx); err != nil {
+		return err
+	}
+
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	tc, err := c.GetTenantCluster(ctx, opts.Namespace, opts.Name)
+	if errors.IsNotFound(err) {
+		return ClusterNotFoundError(ctx, c, opts.Namespace, opts.Name)
+	} else if err != nil {
+		return fmt.Errorf("getting TenantCluster: %w", err)
+	}
+	if lifecycle.Adopted(tc.GetAnnotations()) {
+		return fmt.Errorf("TenantCluster %q was adopted; Butler does not manage its machines", opts.Name)
+	}
+
+	current := GetNestedString(tc.Object, "spec", "providerConfigRef", "name")
+	if current == opts.Provider {
+		opts.Logger.Info("cluster already uses this provider", "name", opts.Name, "provider", opts.Provider)
+		return nil
+	}
+
+	target, err := c.GetProviderConfig(ctx, ButlerSystemNamespace, opts.Provider)
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("ProviderConfig %q not found in %s", opts.Provider, ButlerSystemNamespace)
+	} else if err != nil {
+		return fmt.Errorf("getting ProviderConfig %s: %w", opts.Provider, err)
+	}
+	targetType := GetNestedString(target.Object, "spec", "provider")
+	if current != "" {
+		source, err := c.GetProviderConfig(ctx, ButlerSystemNamespace, current)
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("getting ProviderConfig %s: %w", current, err)
+		}
+		if err == nil {
+			if sourceType := GetNestedString(source.Object, "spec", "provider"); sourceType != targetType {
+				return fmt.Errorf("ProviderConfig %s is a %s provider but the cluster runs on %s (%s); clusters can only move between providers of the same type",
+					opts.Provider, orDefault(targetType, "unknown"), current, orDefault(sourceType, "unknown"))
+			}
+		}
+	}
+
+	// Evaluate platform policies against the moved cluster
+	updated := tc.DeepCopy()
+	if err := unstructured.SetNestedField(updated.Object, opts.Provider, "spec", "providerConfigRef", "name"); err != nil {
+		return fmt.Errorf("building updated TenantCluster: %w", err)
+	}
+	if err := policy.Enforce(ctx, c, opts.Logger, opts.Policy, policy.Input{
+		Operation: policy.OperationUpdate,
+		Object:    updated.Object,
+		OldObject: tc.Object,
+	}); err != nil {
+		return err
+	}
+
+	// Look the cluster's VMs up through the new provider
+	var machines []MachineInfo
+	if tenantNS := GetNestedString(tc.Object, "status", "tenantNamespace"); tenantNS != "" {
+		if machines, err = listMachines(ctx, c, tenantNS, opts.Name); err != nil {
+			return err
+		}
+	}
+	missing, checked := missingVMs(ctx, c, opts.Logger, target, machines)
+
+	rollover := opts.Rollover == RolloverAlways
+	if opts.Rollover == RolloverAuto {
+		rollover = len(missing) > 0 || !checked && len(machines) > 0
+	}
+
+	printProviderPlan(opts, current, machines, missing, checked, rollover)
+	if !opts.Yes {
+		ok, err := prompt.Confirm("\nMove " + opts.Name + " to " + opts.Provider + "?")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted")
+		}
+	}
+
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"providerConfigRef": map[string]interface{}{
+				"name": opts.Provider,
+			},
+		},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("marshaling patch: %w", err)
+	}
+	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Patch(
+		ctx,
+		opts.Name,
+		types.MergePatchType,
+		patchBytes,
+		metav1.PatchOptions{},
+	)
+	if err != nil {
+		return fmt.Errorf("patching TenantCluster: %w", err)
+	}
+	opts.Logger.Success("provider changed", "name", opts.Name, "from", orDefault(current, "-"), "to", opts.Provider)
+
+	if !rollover {
+		return nil
+	}
+
+	started := time.Now()
+	if err := rolloutWorkers(ctx, c, updated, started); err != nil {
+		return err
+	}
+	opts.Logger.Success("worker rollover started", "name", opts.Name, "workers", len(machines))
+
+	if !opts.Wait {
+		opts.Logger.Info("follow the rollover with 'butlerctl cluster machines " + opts.Name + "'")
+		return nil
+	}
+	return waitForRollover(ctx, c, opts, GetNestedString(tc.Object, "status", "tenantNamespace"), len(machines), started)
+}
+
+// missingVMs returns the machines whose VMs the ProviderConfig can't see.
+// checked is false when the provider couldn't be asked.
+func missingVMs(ctx context.Context, c *client.Client, logger *log.Logger, pc *unstructured.Unstructured, machines []MachineInfo) (missing []string, checked bool) {
+	if len(machines) == 0 {
+		return nil, true
+	}
+	names, err := providerapi.ListVMNames(ctx, c, pc, 30*time.Second)
+	if err != nil {
+		logger.Warn("could not list VMs through the new provider", "provider", pc.GetName(), "error", err)
+		return nil, false
+	}
+	for _, m := range machines {
+		if !names[m.Name] && !names[m.MachineRequest] {
+			missing = append(missing, m.Name)
+		}
+	}
+	return missing, true
+}
+
+// printProviderPlan shows the machines and what will happen to them
+func printProviderPlan(opts *SetProviderOptions, current string, machines []MachineInfo, missing []string, checked, rollover bool) {
+	fmt.Fprintf(os.Stderr, "\nMoving TenantCluster %s:\n", output.ColorizePhase(opts.Name))
+	fmt.Fprintf(os.Stderr, "  Namespace:  %s\n", opts.Namespace)
+	fmt.Fprintf(os.Stderr, "  Provider:   %s -> %s\n", orDefault(current, "-"), opts.Provider)
+
+	if len(machines) > 0 {
+		fmt.Fprintln(os.Stderr)
+		isMissing := map[string]bool{}
+		for _, m := range missing {
+			isMissing[m] = true
+		}
+		table := output.NewTable(os.Stderr, "MACHINE", "ROLE", "PHASE", "VISIBLE")
+		for _, m := range machines {
+			visible := "yes"
+			switch {
+			case !checked:
+				visible = "unknown"
+			case isMissing[m.Name]:
+				visible = "no"
+			}
+			table.AddRow(m.Name, m.Role, orDefault(m.Phase, "Unknown"), visible)
+		}
+		_ = table.Flush()
+	}
+
+	fmt.Fprintln(os.Stderr)
+	switch {
+	case rollover:
+		fmt.Fprintf(os.Stderr, "  Workers will be replaced one at a time through %s.\n", opts.Provider)
+	case opts.Rollover == RolloverNever && (len(missing) > 0 || !checked):
+		fmt.Fprintf(os.Stderr, "  %s\n", output.Warning("Some VMs may not be visible to the new provider and --rollover=never was given; the controller may fail to manage them."))
+	default:
+		fmt.Fprintf(os.Stderr, "  The new provider sees every VM; no workers are replaced.\n")
+	}
+}
+
+// rolloutWorkers asks CAPI to replace every worker of the cluster by
+// setting rolloutAfter on its MachineDeployments
+func rolloutWorkers(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, at time.Time) error {
+	tenantNS := GetNestedString(tc.Object, "status", "tenantNamespace")
+	if tenantNS == "" {
+		return nil
+	}
+	mds, err := c.Dynamic.Resource(client.MachineDeploymentGVR).Namespace(tenantNS).List(ctx, metav1.ListOptions{
+		LabelSelector: capiClusterNameLabel + "=" + tc.GetName(),
+	})
+	if err != nil {
+		return fmt.Errorf("listing MachineDeployments: %w", err)
+	}
+	if len(mds.Items) == 0 {
+		return fmt.Errorf("no MachineDeployments found for %s in %s; replace the workers by hand", tc.GetName(), tenantNS)
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"rolloutAfter":%q}}`, at.UTC().Format(time.RFC3339)))
+	for _, md := range mds.Items {
+		if _, err := c.Dynamic.Resource(client.MachineDeploymentGVR).Namespace(tenantNS).Patch(ctx, md.GetName(), types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return fmt.Errorf("rolling out MachineDeployment %s: %w", md.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// waitForRollover polls until as many workers as before are running, all
+// created after the rollover started
+func waitForRollover(ctx context.Context, c *client.Client, opts *SetProviderOptions, tenantNS string, workers int, started time.Time) error {
+	opts.Logger.Info("waiting for workers to be replaced", "workers", workers, "timeout", opts.Timeout)
+
+	err := waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("cluster %s workers to be replaced", opts.Name),
+		Interval:    10 * time.Second,
+		Timeout:     opts.Timeout,
+		Progress: func(status string, elapsed time.Duration) {
+			opts.Logger.Info("rollover progress", "replaced", status, "elapsed", elapsed)
+		},
+	}, func(ctx context.Context) (bool, string, error) {
+		machines, err := listMachines(ctx, c, tenantNS, opts.Name)
+		if err != nil {
+			return false, fmt.Sprintf("error: %v", err), nil
+		}
+		replaced, old := 0, 0
+		for _, m := range machines {
+			created, _ := time.Parse(time.RFC3339, m.CreationTime)
+			if created.Before(started.Truncate(time.Second)) {
+				old++
+			} else if strings.EqualFold(m.Phase, "Running") {
+				replaced++
+			}
+		}
+		return old == 0 && replaced >= workers, fmt.Sprintf("%d/%d", replaced, workers), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.Logger.Success("worker rollover complete", "name", opts.Name, "provider", opts.Provider, "elapsed", time.Since(started).Round(time.Second))
+	return nil
+}
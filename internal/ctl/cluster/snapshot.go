@@ -0,0 +1,608 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/etcdkv"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/objectstore"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// snapshotSuffix is the extension of snapshot objects
+	snapshotSuffix = ".etcd.json.gz"
+
+	// snapshotTimeLayout is the time format embedded in snapshot names
+	snapshotTimeLayout = "20060102-150405"
+)
+
+// Snapshot is the stored form of a tenant control plane snapshot: every
+// etcd key of the cluster as of one revision
+type Snapshot struct {
+	Cluster           string      `json:"cluster"`
+	Namespace         string      `json:"namespace"`
+	DataStore         string      `json:"dataStore"`
+	Prefix            string      `json:"prefix"`
+	Revision          int64       `json:"revision"`
+	Created           time.Time   `json:"created"`
+	KubernetesVersion string      `json:"kubernetesVersion,omitempty"`
+	Keys              []etcdkv.KV `json:"keys"`
+}
+
+// SnapshotObject is a snapshot held in the snapshot location
+type SnapshotObject struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+	Size    int64     `json:"size"`
+}
+
+// snapshotStoreOptions locate a cluster and its snapshots
+type snapshotStoreOptions struct {
+	namespace  string
+	kubeconfig string
+	location   string
+	storage    objectstore.Options
+}
+
+func addSnapshotStoreFlags(cmd *cobra.Command, o *snapshotStoreOptions) {
+	cmd.Flags().StringVarP(&o.namespace, "namespace", "n", DefaultTenantNamespace, "namespace of the TenantCluster")
+	cmd.Flags().StringVar(&o.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&o.location, "location", "", "snapshot location: a directory, s3://bucket/path or gs://bucket/path (default: snapshots.location in the platform config)")
+	o.storage.AddFlags(cmd)
+}
+
+// newSnapshotCmd creates the cluster snapshot command
+func newSnapshotCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Snapshot and restore a cluster's control plane state",
+		Long: `Snapshot and restore the etcd state of a tenant cluster's hosted control plane.
+
+A snapshot holds every etcd key of the cluster as of one revision, read
+from the Steward DataStore through a port-forward, and is written to the
+platform's snapshot storage (snapshots in the butler-platform ConfigMap) or
+--location. Restoring one rolls the cluster's API objects back to that
+point, e.g. after a bad deployment, without recreating the cluster.
+Workloads' persistent volumes are not part of a snapshot.
+
+Snapshots need read access to the DataStore and its TLS Secrets and
+pods/portforward on its etcd pods, so they are an operator task.
+
+Commands:
+  create   Take a snapshot
+  list     List a cluster's snapshots
+  restore  Roll a cluster back to a snapshot
+
+Examples:
+  # Snapshot before a risky change
+  butlerctl cluster snapshot create my-cluster
+
+  # Roll back
+  butlerctl cluster snapshot list my-cluster
+  butlerctl cluster snapshot restore my-cluster --snapshot latest`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newSnapshotCreateCmd(logger))
+	cmd.AddCommand(newSnapshotListCmd(logger))
+	cmd.AddCommand(newSnapshotRestoreCmd(logger))
+
+	return cmd
+}
+
+func newSnapshotCreateCmd(logger *log.Logger) *cobra.Command {
+	opts := &snapshotStoreOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Take a snapshot of a cluster's control plane state",
+		Long: `Take a point-in-time snapshot of a tenant cluster's etcd keys.
+
+The cluster keeps running; the snapshot is consistent as of the etcd
+revision it was read at.
+
+Examples:
+  # Snapshot to the platform's snapshot storage
+  butlerctl cluster snapshot create my-cluster
+
+  # Snapshot to a bucket of your own
+  butlerctl cluster snapshot create my-cluster --location s3://my-bucket/snapshots`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSnapshotCreate(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	addSnapshotStoreFlags(cmd, opts)
+
+	return cmd
+}
+
+func newSnapshotListCmd(logger *log.Logger) *cobra.Command {
+	opts := &snapshotStoreOptions{}
+	var outputFormat string
+
+	cmd := &cobra.Command{
+		Use:   "list NAME",
+		Short: "List a cluster's snapshots",
+		Long: `List the snapshots of a tenant cluster, newest first.
+
+Examples:
+  butlerctl cluster snapshot list my-cluster
+  butlerctl cluster snapshot list my-cluster -o json`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := output.ParseFormat(outputFormat)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			c, err := snapshotClient(opts)
+			if err != nil {
+				return err
+			}
+			store, err := openSnapshotStore(ctx, c, args[0], opts)
+			if err != nil {
+				return err
+			}
+			snapshots, err := listSnapshots(ctx, store)
+			if err != nil {
+				return err
+			}
+			return output.NewPrinter(format, os.Stdout).Print(snapshots, func(w io.Writer) error {
+				if len(snapshots) == 0 {
+					fmt.Fprintf(w, "No snapshots of %s in %s\n", args[0], store)
+					return nil
+				}
+				table := output.NewTable(w, "NAME", "CREATED", "SIZE")
+				for _, s := range snapshots {
+					table.AddRow(s.Name, s.Created.Local().Format(time.RFC3339), formatBytes(s.Size))
+				}
+				return table.Flush()
+			})
+		},
+	}
+
+	addSnapshotStoreFlags(cmd, opts)
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+type snapshotRestoreOptions struct {
+	snapshotStoreOptions
+	snapshot string
+	timeout  time.Duration
+	yes      bool
+}
+
+func newSnapshotRestoreCmd(logger *log.Logger) *cobra.Command {
+	opts := &snapshotRestoreOptions{timeout: 10 * time.Minute}
+
+	cmd := &cobra.Command{
+		Use:   "restore NAME --snapshot SNAPSHOT",
+		Short: "Roll a cluster's control plane back to a snapshot",
+		Long: `Restore a tenant cluster's etcd keys from a snapshot.
+
+The cluster's CAPI Cluster is paused and its control plane scaled to zero,
+its keys are replaced with the snapshot's, then the control plane is
+brought back. The API is unavailable meanwhile; worker nodes and running
+pods carry on and converge on the restored state when it returns.
+Everything written since the snapshot is lost.
+
+Examples:
+  # Roll back to the newest snapshot
+  butlerctl cluster snapshot restore my-cluster --snapshot latest
+
+  # Roll back to a specific snapshot without prompting
+  butlerctl cluster snapshot restore my-cluster --snapshot my-cluster-20260101-120000.etcd.json.gz -y`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSnapshotRestore(cmd.Context(), logger, args[0], opts)
+		},
+	}
+
+	addSnapshotStoreFlags(cmd, &opts.snapshotStoreOptions)
+	cmd.Flags().StringVar(&opts.snapshot, "snapshot", "", "snapshot to restore, or latest (required)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", opts.timeout, "how long to wait for the control plane to stop")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "skip the confirmation prompt")
+	_ = cmd.MarkFlagRequired("snapshot")
+
+	return cmd
+}
+
+func runSnapshotCreate(ctx context.Context, logger *log.Logger, name string, opts *snapshotStoreOptions) error {
+	c, err := snapshotClient(opts)
+	if err != nil {
+		return err
+	}
+	tenantNS, k8sVersion, err := snapshotTarget(ctx, c, opts.namespace, name)
+	if err != nil {
+		return err
+	}
+	store, err := openSnapshotStore(ctx, c, name, opts)
+	if err != nil {
+		return err
+	}
+	ds, err := resolveDatastore(ctx, c, tenantNS, name)
+	if err != nil {
+		return err
+	}
+
+	kv, stop, err := ds.connect(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	logger.Info("reading control plane state", "cluster", name, "dataStore", ds.name, "prefix", ds.prefix)
+	keys, revision, err := kv.Range(ctx, []byte(ds.prefix))
+	if err != nil {
+		return fmt.Errorf("reading keys: %w", err)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no keys found under %s in DataStore %s", ds.prefix, ds.name)
+	}
+
+	snap := &Snapshot{
+		Cluster:           name,
+		Namespace:         opts.namespace,
+		DataStore:         ds.name,
+		Prefix:            ds.prefix,
+		Revision:          revision,
+		Created:           time.Now().UTC(),
+		KubernetesVersion: k8sVersion,
+		Keys:              keys,
+	}
+	data, err := encodeSnapshot(snap)
+	if err != nil {
+		return err
+	}
+	key := snapshotName(name, snap.Created)
+	if err := store.Put(ctx, key, data); err != nil {
+		return fmt.Errorf("writing snapshot: %w", err)
+	}
+
+	logger.Success("snapshot created", "cluster", name, "snapshot", key, "keys", len(keys), "revision", revision, "location", store.String())
+	return nil
+}
+
+func runSnapshotRestore(ctx context.Context, logger *log.Logger, name string, opts *snapshotRestoreOptions) error {
+	c, err := snapshotClient(&opts.snapshotStoreOptions)
+	if err != nil {
+		return err
+	}
+	tenantNS, _, err := snapshotTarget(ctx, c, opts.namespace, name)
+	if err != nil {
+		return err
+	}
+	store, err := openSnapshotStore(ctx, c, name, &opts.snapshotStoreOptions)
+	if err != nil {
+		return err
+	}
+
+	key := opts.snapshot
+	if key == "latest" {
+		snapshots, err := listSnapshots(ctx, store)
+		if err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			return fmt.Errorf("no snapshots of %s in %s", name, store)
+		}
+		key = snapshots[0].Name
+	}
+	data, err := store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("reading snapshot %s: %w", key, err)
+	}
+	snap, err := decodeSnapshot(data)
+	if err != nil {
+		return fmt.Errorf("snapshot %s: %w", key, err)
+	}
+
+	ds, err := resolveDatastore(ctx, c, tenantNS, name)
+	if err != nil {
+		return err
+	}
+	if snap.Prefix != ds.prefix {
+		return fmt.Errorf("snapshot %s was taken under %s, but the cluster now stores its state under %s", key, snap.Prefix, ds.prefix)
+	}
+
+	fmt.Printf("\nRestoring TenantCluster %s:\n", name)
+	fmt.Printf("  Snapshot:  %s\n", key)
+	fmt.Printf("  Taken:     %s (revision %d)\n", snap.Created.Local().Format(time.RFC3339), snap.Revision)
+	fmt.Printf("  Keys:      %d\n", len(snap.Keys))
+	fmt.Printf("  DataStore: %s\n", ds.name)
+	fmt.Println()
+	fmt.Println(output.Warning("Changes made to the cluster since the snapshot will be lost."))
+	fmt.Println()
+
+	if !opts.yes {
+		if err := prompt.ConfirmExact("To confirm the restore, type the cluster name: ", name); err != nil {
+			return fmt.Errorf("restore cancelled: %w", err)
+		}
+	}
+
+	resume, err := stopControlPlane(ctx, c, logger, tenantNS, name, opts.timeout)
+	if resume != nil {
+		defer resume()
+	}
+	if err != nil {
+		return err
+	}
+
+	kv, stop, err := ds.connect(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	deleted, err := kv.DeletePrefix(ctx, []byte(ds.prefix))
+	if err != nil {
+		return fmt.Errorf("clearing keys: %w", err)
+	}
+	logger.Info("cleared current state", "keys", deleted)
+	for i, pair := range snap.Keys {
+		if err := kv.Put(ctx, pair.Key, pair.Value); err != nil {
+			return fmt.Errorf("restoring key %d of %d (%s): %w", i+1, len(snap.Keys), pair.Key, err)
+		}
+	}
+
+	logger.Success("snapshot restored", "cluster", name, "snapshot", key, "keys", len(snap.Keys))
+	logger.Info("the control plane is restarting; follow it with 'butlerctl cluster wait " + name + "'")
+	return nil
+}
+
+// stopControlPlane pauses the CAPI Cluster so nothing scales the control
+// plane back up, then scales the TenantControlPlane to zero and waits for
+// its pods to go. The returned function undoes both; it is set whenever
+// anything was changed, even on error.
+func stopControlPlane(ctx context.Context, c *client.Client, logger *log.Logger, tenantNS, name string, timeout time.Duration) (func(), error) {
+	var undo []func()
+	resume := func() {
+		for i := len(undo) - 1; i >= 0; i-- {
+			undo[i]()
+		}
+	}
+
+	capi := c.Dynamic.Resource(client.ClusterGVR).Namespace(tenantNS)
+	if cluster, err := capi.Get(ctx, name, metav1.GetOptions{}); err == nil {
+		if paused, _, _ := unstructured.NestedBool(cluster.Object, "spec", "paused"); !paused {
+			if err := patchMerge(ctx, capi, name, `{"spec":{"paused":true}}`); err != nil {
+				return nil, fmt.Errorf("pausing CAPI Cluster: %w", err)
+			}
+			undo = append(undo, func() {
+				if err := patchMerge(context.Background(), capi, name, `{"spec":{"paused":false}}`); err != nil {
+					logger.Warn("could not unpause CAPI Cluster; set spec.paused to false", "cluster", tenantNS+"/"+name, "error", err)
+				}
+			})
+		}
+	} else if !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("getting CAPI Cluster: %w", err)
+	}
+
+	tcps := c.Dynamic.Resource(client.TenantControlPlaneGVR).Namespace(tenantNS)
+	tcp, err := tcps.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return resume, fmt.Errorf("getting TenantControlPlane: %w", err)
+	}
+	replicas := "null"
+	if r := GetNestedInt64(tcp.Object, "spec", "controlPlane", "deployment", "replicas"); r > 0 {
+		replicas = fmt.Sprint(r)
+	}
+	if err := patchMerge(ctx, tcps, name, `{"spec":{"controlPlane":{"deployment":{"replicas":0}}}}`); err != nil {
+		return resume, fmt.Errorf("scaling control plane down: %w", err)
+	}
+	undo = append(undo, func() {
+		patch := fmt.Sprintf(`{"spec":{"controlPlane":{"deployment":{"replicas":%s}}}}`, replicas)
+		if err := patchMerge(context.Background(), tcps, name, patch); err != nil {
+			logger.Warn("could not scale the control plane back up", "tenantControlPlane", tenantNS+"/"+name, "replicas", replicas, "error", err)
+		}
+	})
+
+	logger.Info("stopping control plane", "cluster", name)
+	err = waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("control plane of %s to stop", name),
+		Interval:    3 * time.Second,
+		Timeout:     timeout,
+	}, func(ctx context.Context) (bool, string, error) {
+		d, err := c.Clientset.AppsV1().Deployments(tenantNS).Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return true, "", nil
+		}
+		if err != nil {
+			return false, fmt.Sprintf("error: %v", err), nil
+		}
+		return d.Status.Replicas == 0, fmt.Sprintf("%d pods left", d.Status.Replicas), nil
+	})
+	return resume, err
+}
+
+// patchMerge applies a JSON merge patch
+func patchMerge(ctx context.Context, r dynamic.ResourceInterface, name, patch string) error {
+	_, err := r.Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
+func snapshotClient(opts *snapshotStoreOptions) (*client.Client, error) {
+	var c *client.Client
+	var err error
+	if opts.kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to management cluster: %w", err)
+	}
+	return c, nil
+}
+
+// snapshotTarget returns the tenant namespace and Kubernetes version of a
+// TenantCluster
+func snapshotTarget(ctx context.Context, c *client.Client, namespace, name string) (string, string, error) {
+	tc, err := c.GetTenantCluster(ctx, namespace, name)
+	if errors.IsNotFound(err) {
+		return "", "", ClusterNotFoundError(ctx, c, namespace, name)
+	} else if err != nil {
+		return "", "", fmt.Errorf("getting TenantCluster %s/%s: %w", namespace, name, err)
+	}
+	tenantNS := GetNestedString(tc.Object, "status", "tenantNamespace")
+	if tenantNS == "" {
+		return "", "", fmt.Errorf("TenantCluster %s/%s has no tenant namespace yet; is it provisioned?", namespace, name)
+	}
+	return tenantNS, GetNestedString(tc.Object, "spec", "kubernetesVersion"), nil
+}
+
+// openSnapshotStore opens the snapshot location of a cluster, taking
+// unset settings from the platform config
+func openSnapshotStore(ctx context.Context, c *client.Client, name string, opts *snapshotStoreOptions) (objectstore.Store, error) {
+	location := opts.location
+	cfg, err := platform.Load(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("loading platform config: %w", err)
+	}
+	if location == "" {
+		location = cfg.Snapshots.Location
+	}
+	if location == "" {
+		return nil, fmt.Errorf("no snapshot location; pass --location or set snapshots.location in the %s ConfigMap", platform.ConfigMapName)
+	}
+	cfg.Snapshots.ApplyTo(&opts.storage)
+	if err := opts.storage.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.storage.CredentialsSecret != "" && opts.storage.Credentials == nil {
+		if err := opts.storage.LoadCredentials(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return objectstore.Open(strings.TrimSuffix(location, "/")+"/"+opts.namespace+"/"+name, &opts.storage)
+}
+
+// listSnapshots returns the snapshots in a location, newest first
+func listSnapshots(ctx context.Context, store objectstore.Store) ([]SnapshotObject, error) {
+	objects, err := store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing snapshots: %w", err)
+	}
+
+	var snapshots []SnapshotObject
+	for _, o := range objects {
+		created, ok := parseSnapshotName(o.Key)
+		if !ok {
+			continue
+		}
+		snapshots = append(snapshots, SnapshotObject{Name: o.Key, Created: created, Size: o.Size})
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		if !snapshots[i].Created.Equal(snapshots[j].Created) {
+			return snapshots[i].Created.After(snapshots[j].Created)
+		}
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return snapshots, nil
+}
+
+// snapshotName returns the object name for a snapshot of cluster taken at t
+func snapshotName(cluster string, t time.Time) string {
+	return cluster + "-" + t.UTC().Format(snapshotTimeLayout) + snapshotSuffix
+}
+
+// parseSnapshotName returns the time a snapshot was taken from its name.
+// It returns false for objects that aren't snapshots.
+func parseSnapshotName(name string) (time.Time, bool) {
+	base := strings.TrimSuffix(name, snapshotSuffix)
+	if base == name || len(base) < len(snapshotTimeLayout)+2 {
+		return time.Time{}, false
+	}
+	created, err := time.Parse(snapshotTimeLayout, base[len(base)-len(snapshotTimeLayout):])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return created, true
+}
+
+func encodeSnapshot(snap *Snapshot) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(snap); err != nil {
+		return nil, fmt.Errorf("encoding snapshot: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("compressing snapshot: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeSnapshot(data []byte) (*Snapshot, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	defer zr.Close()
+	snap := &Snapshot{}
+	if err := json.NewDecoder(zr).Decode(snap); err != nil {
+		return nil, fmt.Errorf("decoding: %w", err)
+	}
+	if snap.Prefix == "" {
+		return nil, fmt.Errorf("not a cluster snapshot")
+	}
+	return snap, nil
+}
+
+// formatBytes renders a byte count for humans
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
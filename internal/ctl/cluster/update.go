@@ -0,0 +1,303 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/policy"
+	"github.com/butlerdotdev/butler/internal/ctl/queue"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// UpdateOptions holds options for the update command.
+type UpdateOptions struct {
+	Name      string
+	Namespace string
+	OIDC      OIDCOptions
+	ClearOIDC bool
+
+	// APIServer sets feature gates and extra API server flags; the Remove
+	// lists drop them again
+	APIServer          APIServerOptions
+	RemoveFeatureGates []string
+	RemoveExtraArgs    []string
+
+	Policy policy.Options
+	Logger *log.Logger
+}
+
+// DefaultUpdateOptions returns UpdateOptions with sensible defaults.
+func DefaultUpdateOptions(logger *log.Logger) *UpdateOptions {
+	return &UpdateOptions{
+		Namespace: DefaultTenantNamespace,
+		Logger:    logger,
+	}
+}
+
+// Validate checks that all required options are set and valid.
+func (o *UpdateOptions) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("cluster name is required")
+	}
+	if o.ClearOIDC && o.OIDC.IsSet() {
+		return fmt.Errorf("--clear-oidc cannot be combined with --oidc-* flags")
+	}
+	if !o.ClearOIDC && !o.OIDC.IsSet() && !o.APIServer.IsSet() && len(o.RemoveFeatureGates) == 0 && len(o.RemoveExtraArgs) == 0 {
+		return fmt.Errorf("nothing to update; see 'butlerctl cluster update --help'")
+	}
+	return o.APIServer.Validate()
+}
+
+// NewUpdateCmd creates the cluster update command.
+func NewUpdateCmd(logger *log.Logger) *cobra.Command {
+	opts := DefaultUpdateOptions(logger)
+
+	cmd := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Change settings of an existing cluster",
+		// Every setting it changes is in optionFields; see hideControllerFlags
+		Hidden: true,
+		Long: `Change settings of an existing tenant cluster.
+
+--oidc-issuer-url, --oidc-client-id and --oidc-groups-claim configure the
+hosted API server to accept tokens from an OIDC provider. Settings not
+given keep their current values, so a cluster already using SSO can change
+just its groups claim. --clear-oidc turns OIDC authentication off.
+
+--feature-gates and --apiserver-extra-arg add or change API server
+settings, subject to the same platform allowlist as 'cluster create';
+--remove-feature-gate and --remove-apiserver-extra-arg unset them.
+
+The control plane rolls out the new API server settings; existing
+certificate-based kubeconfigs keep working throughout.
+
+Examples:
+  # Plug a cluster into corporate SSO
+  butlerctl cluster update my-cluster \
+    --oidc-issuer-url https://sso.example.com/realms/corp \
+    --oidc-client-id kubernetes --oidc-groups-claim groups
+
+  # Map groups from another claim
+  butlerctl cluster update my-cluster --oidc-groups-claim roles
+
+  # Try an alpha feature, then turn it off again
+  butlerctl cluster update my-cluster --feature-gates InPlacePodVerticalScaling=true
+  butlerctl cluster update my-cluster --remove-feature-gate InPlacePodVerticalScaling
+
+  # Turn OIDC off
+  butlerctl cluster update my-cluster --clear-oidc`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Name = args[0]
+			return runUpdate(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Namespace, "namespace", "n", opts.Namespace, "Namespace of the TenantCluster")
+	addOIDCFlags(cmd, &opts.OIDC)
+	cmd.Flags().BoolVar(&opts.ClearOIDC, "clear-oidc", false, "Remove the OIDC configuration")
+	addAPIServerFlags(cmd, &opts.APIServer)
+	cmd.Flags().StringArrayVar(&opts.RemoveFeatureGates, "remove-feature-gate", nil, "Feature gate to unset (repeatable)")
+	cmd.Flags().StringArrayVar(&opts.RemoveExtraArgs, "remove-apiserver-extra-arg", nil, "Extra API server flag to unset (repeatable)")
+	policy.AddFlags(cmd, &opts.Policy)
+
+	queue.Enable(cmd, logger)
+
+	return cmd
+}
+
+// runUpdate executes the update operation.
+func runUpdate(ctx context.Context, opts *UpdateOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	// Verify we're connected to a management cluster
+	if err := RequireManagementCluster(ctx); err != nil {
+		return err
+	}
+
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	tc, err := c.GetTenantCluster(ctx, opts.Namespace, opts.Name)
+	if errors.IsNotFound(err) {
+		return ClusterNotFoundError(ctx, c, opts.Namespace, opts.Name)
+	} else if err != nil {
+		return fmt.Errorf("getting TenantCluster: %w", err)
+	}
+
+	// Collect the controlPlane changes; null values remove settings
+	changes := map[string]interface{}{}
+	if err := opts.oidcChanges(tc, changes); err != nil {
+		return err
+	}
+	if opts.APIServer.IsSet() || len(opts.RemoveFeatureGates) > 0 || len(opts.RemoveExtraArgs) > 0 {
+		platformCfg, err := platform.Load(ctx, c)
+		if err != nil {
+			return err
+		}
+		if err := opts.APIServer.Check(&platformCfg.APIServer); err != nil {
+			return err
+		}
+		opts.apiServerChanges(tc, changes)
+	}
+	if len(changes) == 0 {
+		opts.Logger.Info("cluster already has these settings", "name", opts.Name)
+		return nil
+	}
+
+	// Evaluate platform policies against the updated cluster
+	updated := tc.DeepCopy()
+	controlPlane, _, _ := unstructured.NestedMap(updated.Object, "spec", "controlPlane")
+	if controlPlane == nil {
+		controlPlane = map[string]interface{}{}
+	}
+	mergePatch(controlPlane, changes)
+	if err := unstructured.SetNestedMap(updated.Object, controlPlane, "spec", "controlPlane"); err != nil {
+		return fmt.Errorf("building updated TenantCluster: %w", err)
+	}
+	if err := policy.Enforce(ctx, c, opts.Logger, opts.Policy, policy.Input{
+		Operation: policy.OperationUpdate,
+		Object:    updated.Object,
+		OldObject: tc.Object,
+	}); err != nil {
+		return err
+	}
+
+	// Build the patch
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"controlPlane": changes,
+		},
+	}
+
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("marshaling patch: %w", err)
+	}
+
+	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(opts.Namespace).Patch(
+		ctx,
+		opts.Name,
+		types.MergePatchType,
+		patchBytes,
+		metav1.PatchOptions{FieldValidation: fieldValidation},
+	)
+	if err != nil {
+		return fmt.Errorf("patching TenantCluster: %w", unsupportedOptionsError(err))
+	}
+
+	opts.Logger.Success("cluster updated", "name", opts.Name, "settings", strings.Join(sortedKeys(changes), ","))
+	return nil
+}
+
+// oidcChanges adds the new controlPlane.oidc section to changes, or null
+// to remove it.
+func (o *UpdateOptions) oidcChanges(tc *unstructured.Unstructured, changes map[string]interface{}) error {
+	current := currentOIDC(tc)
+	if o.ClearOIDC {
+		if current.IsSet() {
+			changes["oidc"] = nil
+		}
+		return nil
+	}
+	if !o.OIDC.IsSet() {
+		return nil
+	}
+	merged := o.OIDC.merge(current)
+	if err := merged.Validate(); err != nil {
+		return err
+	}
+	if merged != current {
+		changes["oidc"] = merged.spec()
+	}
+	return nil
+}
+
+// apiServerChanges adds the feature gates and extra API server flags that
+// differ from the cluster's to changes. Values are expected to have passed
+// Check.
+func (o *UpdateOptions) apiServerChanges(tc *unstructured.Unstructured, changes map[string]interface{}) {
+	gates, args, _ := o.APIServer.parse()
+	currentGates, currentArgs := currentAPIServerSettings(tc)
+
+	gateChanges := map[string]interface{}{}
+	for name, enabled := range gates {
+		if current, ok := currentGates[name]; !ok || current != enabled {
+			gateChanges[name] = enabled
+		}
+	}
+	for _, name := range o.RemoveFeatureGates {
+		if _, ok := currentGates[name]; ok {
+			gateChanges[name] = nil
+		}
+	}
+	if len(gateChanges) > 0 {
+		changes["featureGates"] = gateChanges
+	}
+
+	argChanges := map[string]interface{}{}
+	for name, value := range args {
+		if current, ok := currentArgs[name]; !ok || current != value {
+			argChanges[name] = value
+		}
+	}
+	for _, name := range o.RemoveExtraArgs {
+		name = strings.TrimLeft(name, "-")
+		if _, ok := currentArgs[name]; ok {
+			argChanges[name] = nil
+		}
+	}
+	if len(argChanges) > 0 {
+		changes["apiServerExtraArgs"] = argChanges
+	}
+}
+
+// mergePatch applies a JSON merge patch to dst: null values delete keys and
+// nested maps are merged.
+func mergePatch(dst, patch map[string]interface{}) {
+	for k, v := range patch {
+		switch val := v.(type) {
+		case nil:
+			delete(dst, k)
+		case map[string]interface{}:
+			existing, ok := dst[k].(map[string]interface{})
+			if !ok {
+				existing = map[string]interface{}{}
+			}
+			mergePatch(existing, val)
+			dst[k] = existing
+		default:
+			dst[k] = v
+		}
+	}
+}
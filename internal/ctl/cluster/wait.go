@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Conditions accepted by cluster wait --for
+const (
+	WaitForReady   = "Ready"
+	WaitForDeleted = "Deleted"
+	WaitForScaled  = "Scaled"
+)
+
+// WaitOptions holds configuration for cluster wait.
+type WaitOptions struct {
+	Name       string
+	Namespace  string
+	For        string
+	Timeout    time.Duration
+	Interval   time.Duration
+	Kubeconfig string
+	Logger     *log.Logger
+}
+
+// DefaultWaitOptions returns WaitOptions with sensible defaults.
+func DefaultWaitOptions(logger *log.Logger) *WaitOptions {
+	return &WaitOptions{
+		Namespace: DefaultTenantNamespace,
+		For:       WaitForReady,
+		Timeout:   30 * time.Minute,
+		Interval:  10 * time.Second,
+		Logger:    logger,
+	}
+}
+
+// Validate checks that all required options are set and valid.
+func (o *WaitOptions) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("cluster name is required")
+	}
+
+	switch strings.ToLower(o.For) {
+	case "ready":
+		o.For = WaitForReady
+	case "deleted":
+		o.For = WaitForDeleted
+	case "scaled":
+		o.For = WaitForScaled
+	default:
+		return fmt.Errorf("invalid --for %q: must be one of %s, %s, %s", o.For, WaitForReady, WaitForDeleted, WaitForScaled)
+	}
+
+	if o.Timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+	if o.Interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	return nil
+}
+
+// NewWaitCmd creates the cluster wait command.
+func NewWaitCmd(logger *log.Logger) *cobra.Command {
+	opts := DefaultWaitOptions(logger)
+
+	cmd := &cobra.Command{
+		Use:   "wait NAME",
+		Short: "Wait for a cluster to reach a condition",
+		Long: `Wait until a tenant cluster reaches a condition.
+
+Useful when clusters are created or changed outside butlerctl, e.g. by
+GitOps. A cluster that doesn't exist yet is waited for rather than treated
+as an error, except with --for=Deleted where that is the goal.
+
+Conditions:
+  Ready    The cluster phase is Ready (fails early if it becomes Failed)
+  Deleted  The TenantCluster no longer exists
+  Scaled   Ready workers match spec.workers.replicas
+
+Exits non-zero if the timeout expires first.
+
+Examples:
+  # Block a pipeline until a GitOps-created cluster is up
+  butlerctl cluster wait my-cluster --for=Ready --timeout 45m
+
+  # Wait for a deletion to finish
+  butlerctl cluster wait my-cluster --for=Deleted
+
+  # Wait for a replica change applied through Git
+  butlerctl cluster wait my-cluster --for=Scaled --interval 5s`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeClusterNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Name = args[0]
+			return runWait(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.For, "for", opts.For, "Condition to wait for: Ready, Deleted or Scaled")
+	cmd.Flags().StringVarP(&opts.Namespace, "namespace", "n", opts.Namespace, "Namespace of the TenantCluster")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", opts.Timeout, "How long to wait before giving up")
+	cmd.Flags().DurationVar(&opts.Interval, "interval", opts.Interval, "How often to check the cluster")
+	cmd.Flags().StringVar(&opts.Kubeconfig, "kubeconfig", "", "Path to management cluster kubeconfig")
+
+	return cmd
+}
+
+// runWait polls the TenantCluster until the condition holds.
+func runWait(ctx context.Context, opts *WaitOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	var c *client.Client
+	var err error
+	if opts.Kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(opts.Kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+
+	opts.Logger.Info("waiting for cluster", "name", opts.Name, "for", opts.For, "timeout", opts.Timeout)
+
+	startTime := time.Now()
+
+	err = waiter.Until(ctx, waiter.Options{
+		Description: fmt.Sprintf("cluster %s to be %s", opts.Name, opts.For),
+		Interval:    opts.Interval,
+		Timeout:     opts.Timeout,
+		Progress: func(status string, elapsed time.Duration) {
+			opts.Logger.Info("waiting", "status", status, "elapsed", elapsed)
+		},
+	}, func(ctx context.Context) (bool, string, error) {
+		return checkWaitCondition(ctx, c, opts)
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.Logger.Success("condition met", "name", opts.Name, "for", opts.For, "elapsed", time.Since(startTime).Round(time.Second))
+	return nil
+}
+
+// checkWaitCondition reports whether the condition holds, along with a short
+// status line for progress output. Transient API errors are reported as
+// progress so a flaky connection doesn't end the wait.
+func checkWaitCondition(ctx context.Context, c *client.Client, opts *WaitOptions) (bool, string, error) {
+	tc, err := c.GetTenantCluster(ctx, opts.Namespace, opts.Name)
+	if errors.IsNotFound(err) {
+		if opts.For == WaitForDeleted {
+			return true, "", nil
+		}
+		return false, "not found", nil
+	}
+	if err != nil {
+		return false, fmt.Sprintf("error: %v", err), nil
+	}
+
+	phase := orDefault(GetNestedString(tc.Object, "status", "phase"), "Pending")
+
+	switch opts.For {
+	case WaitForReady:
+		if phase == "Failed" {
+			return false, "", fmt.Errorf("cluster provisioning failed: %s", readyConditionMessage(tc))
+		}
+		return phase == "Ready", "phase " + phase, nil
+
+	case WaitForDeleted:
+		if tc.GetDeletionTimestamp() == nil {
+			return false, "phase " + phase + " (not being deleted)", nil
+		}
+		return false, "phase " + phase, nil
+
+	default:
+		target := GetNestedInt64(tc.Object, "spec", "workers", "replicas")
+		if target == 0 {
+			target = 1
+		}
+		info := ExtractTenantClusterInfo(tc)
+		EnrichWithMachineDeploymentStatus(ctx, c, &info)
+		progress := fmt.Sprintf("%d/%d workers ready", info.WorkersReady, target)
+		return info.WorkersReady == target && info.WorkersDesired == target, progress, nil
+	}
+}
+
+// readyConditionMessage returns the message of a False Ready condition
+func readyConditionMessage(tc *unstructured.Unstructured) string {
+	conditions, _, _ := unstructured.NestedSlice(tc.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if ok && cond["type"] == "Ready" && cond["status"] == "False" {
+			if msg, ok := cond["message"].(string); ok {
+				return msg
+			}
+		}
+	}
+	return "unknown error"
+}
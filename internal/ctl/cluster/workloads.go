@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/workload"
+)
+
+// loadWorkloads reads the --apply-on-create manifests and the --profile
+// workloads up front, so mistakes surface before anything is created.
+// Workloads are applied once the cluster is Ready, so they imply --wait.
+func (o *CreateOptions) loadWorkloads(ctx context.Context, c *client.Client, cfg *platform.Config) error {
+	if o.Profile != "" {
+		profile, err := cfg.Profile(o.Profile)
+		if err != nil {
+			return err
+		}
+		objs, err := profile.Workloads(ctx, c, o.Profile)
+		if err != nil {
+			return err
+		}
+		o.workloads = append(o.workloads, objs...)
+	}
+
+	if len(o.ApplyOnCreate) > 0 {
+		objs, err := workload.LoadFiles(o.ApplyOnCreate)
+		if err != nil {
+			return fmt.Errorf("--apply-on-create: %w", err)
+		}
+		o.workloads = append(o.workloads, objs...)
+	}
+
+	if len(o.workloads) > 0 {
+		o.Wait = true
+	}
+	return nil
+}
+
+// applyWorkloads applies the loaded workloads into the Ready cluster
+func applyWorkloads(ctx context.Context, c *client.Client, opts *CreateOptions) error {
+	if len(opts.workloads) == 0 {
+		return nil
+	}
+
+	opts.Logger.Info("applying workloads", "objects", len(opts.workloads))
+	tenant, err := c.NewForTenant(ctx, opts.Namespace, opts.Name)
+	if err != nil {
+		return fmt.Errorf("cluster is Ready but connecting to it to apply workloads failed: %w", err)
+	}
+	if err := workload.Apply(ctx, tenant, opts.workloads); err != nil {
+		return fmt.Errorf("cluster is Ready but applying workloads failed: %w", err)
+	}
+	opts.Logger.Success("workloads applied", "objects", len(opts.workloads))
+	return nil
+}
+
+// workloadSummary describes the workloads for the creation summary
+func workloadSummary(opts *CreateOptions) string {
+	var sources []string
+	if opts.Profile != "" {
+		sources = append(sources, "profile "+opts.Profile)
+	}
+	sources = append(sources, opts.ApplyOnCreate...)
+	return fmt.Sprintf("%d objects from %s", len(opts.workloads), strings.Join(sources, ", "))
+}
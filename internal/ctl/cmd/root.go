@@ -18,20 +18,51 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/kubecache"
 	"github.com/butlerdotdev/butler/internal/common/log"
 	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/common/redact"
+	"github.com/butlerdotdev/butler/internal/common/suggest"
+	"github.com/butlerdotdev/butler/internal/common/version"
+	"github.com/butlerdotdev/butler/internal/common/waiter"
+	"github.com/butlerdotdev/butler/internal/ctl/apply"
+	"github.com/butlerdotdev/butler/internal/ctl/cache"
 	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/butlerdotdev/butler/internal/ctl/cost"
+	"github.com/butlerdotdev/butler/internal/ctl/fleet"
+	"github.com/butlerdotdev/butler/internal/ctl/images"
+	"github.com/butlerdotdev/butler/internal/ctl/mesh"
+	"github.com/butlerdotdev/butler/internal/ctl/queue"
+	"github.com/butlerdotdev/butler/internal/ctl/versions"
 	"github.com/spf13/cobra"
 )
 
 var (
-	verbose bool
+	verbose        int
+	noCache        bool
+	nonInteractive bool
+	showSecrets    bool
+	noTruncate     bool
+	plainLogs      bool
 )
 
 // Execute runs the butlerctl CLI
 func Execute(logger *log.Logger) error {
+	ctx, cancel := waiter.InterruptContext(context.Background(), func() {
+		logger.Warn("interrupted, stopping (press Ctrl-C again to force)")
+	})
+	defer cancel()
+
 	rootCmd := NewRootCmd(logger)
-	return rootCmd.Execute()
+	err := rootCmd.ExecuteContext(ctx)
+	if stats := client.RequestStats(); stats.Calls > 0 {
+		logger.Debug(stats.String())
+	}
+	return err
 }
 
 // NewRootCmd creates the root command for butlerctl
@@ -47,6 +78,8 @@ It is designed for developers and platform users who work with tenant clusters:
   • Scale worker nodes up and down
   • Get kubeconfig for cluster access
   • Export cluster configs for GitOps
+  • Apply manifest directories as fleet-as-code
+  • Upgrade and scale fleets of clusters in bulk
 
 Butler provides Kubernetes-as-a-Service with hosted control planes (Steward)
 and infrastructure-agnostic worker provisioning.
@@ -67,8 +100,30 @@ Examples:
   # Destroy a cluster
   butlerctl cluster destroy my-cluster`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			if verbose {
-				logger.SetVerbose(true)
+			logger.SetVerbosity(verbose)
+			if f := client.FactoryFrom(cmd.Context()); f != nil {
+				client.UseFactory(f)
+			}
+			if verbose >= 2 {
+				client.TraceRequests(logger)
+			}
+			if noCache {
+				kubecache.Disable()
+			}
+			if nonInteractive {
+				prompt.SetNonInteractive()
+			}
+			if showSecrets {
+				redact.ShowSecrets()
+			}
+			if noTruncate {
+				output.DisableTruncation()
+			}
+			if plainLogs {
+				log.SetPlain()
+			}
+			if client.Flags.As != "" {
+				logger.Debug("impersonating", "user", client.Flags.As, "groups", client.Flags.AsGroups)
 			}
 			return nil
 		},
@@ -80,12 +135,33 @@ Examples:
 	output.ConfigureHelp(cmd)
 
 	// Global flags
-	cmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose output")
+	cmd.PersistentFlags().CountVarP(&verbose, "verbose", "v", "enable verbose output; -vv also logs every API request")
+	cmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "bypass locally cached kubeconfigs and cluster checks")
+	cmd.PersistentFlags().BoolVar(&nonInteractive, "non-interactive", false, "fail instead of prompting; confirmations need --yes (env: "+prompt.EnvNonInteractive+")")
+	cmd.PersistentFlags().BoolVar(&showSecrets, "show-secrets", false, "print passwords, tokens and other credentials instead of redacting them")
+	cmd.PersistentFlags().BoolVar(&noTruncate, "no-truncate", false, "print table cells in full instead of fitting tables to the terminal width")
+	cmd.PersistentFlags().BoolVar(&plainLogs, "plain-logs", false, "write logs as plain text without colors (env: "+log.EnvPlain+")")
+	client.Flags.AddFlags(cmd.PersistentFlags())
 
 	// Register subcommands
 	cmd.AddCommand(cluster.NewClusterCmd(logger))
+	cmd.AddCommand(apply.NewApplyCmd(logger))
+	cmd.AddCommand(fleet.NewFleetCmd(logger))
+	cmd.AddCommand(mesh.NewMeshCmd(logger))
+	cmd.AddCommand(versions.NewVersionsCmd(logger))
+	cmd.AddCommand(images.NewImagesCmd(logger))
+	cmd.AddCommand(cache.NewCacheCmd(logger))
+	cmd.AddCommand(cost.NewCostCmd(logger))
+	cmd.AddCommand(queue.NewQueueCmd(logger, func(ctx context.Context, args []string) error {
+		replay := NewRootCmd(logger)
+		replay.SetArgs(args)
+		return replay.ExecuteContext(ctx)
+	}))
 	cmd.AddCommand(NewVersionCmd())
 
+	// Suggest near matches for mistyped subcommands at every level
+	suggest.RegisterCommands(cmd)
+
 	return cmd
 }
 
@@ -95,7 +171,7 @@ func NewVersionCmd() *cobra.Command {
 		Use:   "version",
 		Short: "Print version information",
 		Run: func(cmd *cobra.Command, args []string) {
-			cmd.Println(output.Binary("butlerctl") + " version v0.1.0-dev")
+			cmd.Println(output.Binary("butlerctl") + " version " + version.Version + output.Dim(" ("+version.GitCommit()+")"))
 			cmd.Println("Butler Kubernetes-as-a-Service Platform")
 			cmd.Println(output.Dim("https://github.com/butlerdotdev/butler"))
 		},
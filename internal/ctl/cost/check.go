@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/cost"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// eventBudgetExceeded is the Notification event for budget overruns
+const eventBudgetExceeded = "budget-exceeded"
+
+type checkOptions struct {
+	kubeconfig string
+	webhook    string
+	dryRun     bool
+}
+
+// Notification is posted to the webhook when projected spend exceeds a
+// budget. Text makes it directly usable with Slack-compatible incoming
+// webhooks.
+type Notification struct {
+	Text        string  `json:"text"`
+	Event       string  `json:"event"`
+	Kind        string  `json:"kind"`
+	Name        string  `json:"name"`
+	Namespace   string  `json:"namespace,omitempty"`
+	Owner       string  `json:"owner,omitempty"`
+	Contact     string  `json:"contact,omitempty"`
+	Budget      string  `json:"budget"`
+	Projected   float64 `json:"projected"`
+	MonthToDate float64 `json:"monthToDate"`
+	Currency    string  `json:"currency"`
+}
+
+func newCheckCmd(logger *log.Logger) *cobra.Command {
+	opts := &checkOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Notify owners whose projected spend exceeds their budget",
+		Long: `Notify the owners of clusters and Teams whose spend projected by the end
+of the month exceeds their budget.
+
+Each overrun is announced once a month, and again after the budget is
+changed. Notifications are recorded as Kubernetes Events on the
+TenantCluster and, with --webhook (or BUTLER_COST_WEBHOOK), posted as JSON
+to a chat or alerting webhook. Every namespace is checked, so this is meant
+to run from cron or a CronJob with read access to TenantClusters and Teams
+and permission to annotate them.
+
+Examples:
+  # Preview
+  butlerctl cost check --dry-run
+
+  # Run daily from cron
+  butlerctl cost check --webhook https://hooks.slack.com/services/...`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCheck(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.webhook, "webhook", os.Getenv("BUTLER_COST_WEBHOOK"), "URL to POST notifications to")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "show overruns without notifying")
+
+	return cmd
+}
+
+func runCheck(ctx context.Context, logger *log.Logger, opts *checkOptions) error {
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+	rates, err := loadRates(ctx, c)
+	if err != nil {
+		return err
+	}
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing TenantClusters: %w", err)
+	}
+	teams, err := c.Dynamic.Resource(client.TeamGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing Teams: %w", err)
+	}
+
+	now := time.Now()
+	report := buildReport(ctx, c, logger, rates, list.Items, now)
+	month := cost.Month(now)
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	var failures []string
+	overruns := 0
+
+	alert := func(obj *unstructured.Unstructured, gvr schema.GroupVersionResource, n *Notification) {
+		overruns++
+		key := strings.TrimPrefix(n.Namespace+"/"+n.Name, "/")
+		logger.Info("budget exceeded", "kind", n.Kind, "name", key, "projected", cost.Format(n.Projected, n.Currency), "budget", n.Budget)
+		if opts.dryRun || obj.GetAnnotations()[cost.BudgetAlertAnnotation] == month {
+			return
+		}
+		notify(ctx, c, httpClient, logger, opts.webhook, obj, n)
+		patch := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{cost.BudgetAlertAnnotation: month},
+			},
+		}
+		if err := patchAnnotations(ctx, c, gvr, obj.GetNamespace(), obj.GetName(), patch); err != nil {
+			failures = append(failures, fmt.Sprintf("%s %s: recording alert: %v", n.Kind, key, err))
+		}
+	}
+
+	clusters := map[string]*unstructured.Unstructured{}
+	for i := range list.Items {
+		clusters[list.Items[i].GetNamespace()+"/"+list.Items[i].GetName()] = &list.Items[i]
+	}
+	for _, cc := range report.Clusters {
+		if cc.Status != StatusOver {
+			continue
+		}
+		tc := clusters[cc.Namespace+"/"+cc.Cluster]
+		alert(tc, client.TenantClusterGVR, &Notification{
+			Kind:        "TenantCluster",
+			Name:        cc.Cluster,
+			Namespace:   cc.Namespace,
+			Owner:       cc.Owner,
+			Contact:     tc.GetAnnotations()[lifecycle.ContactAnnotation],
+			Budget:      cc.Budget,
+			Projected:   cc.Estimate.Projected,
+			MonthToDate: cc.Estimate.MonthToDate,
+			Currency:    report.Currency,
+		})
+	}
+
+	teamObjects := map[string]*unstructured.Unstructured{}
+	for i := range teams.Items {
+		teamObjects[teams.Items[i].GetName()] = &teams.Items[i]
+	}
+	for _, t := range report.Teams {
+		team := teamObjects[t.Team]
+		if t.Status != StatusOver || team == nil {
+			continue
+		}
+		alert(team, client.TeamGVR, &Notification{
+			Kind:        "Team",
+			Name:        t.Team,
+			Owner:       t.Team,
+			Budget:      t.Budget,
+			Projected:   t.Estimate.Projected,
+			MonthToDate: t.Estimate.MonthToDate,
+			Currency:    report.Currency,
+		})
+	}
+
+	if opts.dryRun {
+		logger.Info("dry run complete", "overBudget", overruns)
+	} else {
+		logger.Info("budget check complete", "overBudget", overruns)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("budget check finished with %d error(s):\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+// notify records an Event on a cluster and posts to the webhook. Failures
+// are logged rather than returned so a broken webhook doesn't stop the
+// remaining checks.
+func notify(ctx context.Context, c *client.Client, httpClient *http.Client, logger *log.Logger, webhook string, obj *unstructured.Unstructured, n *Notification) {
+	n.Event = eventBudgetExceeded
+	n.Text = fmt.Sprintf("%s %s is projected to spend %s this month, over its budget of %s (%s so far).",
+		n.Kind, strings.TrimPrefix(n.Namespace+"/"+n.Name, "/"), cost.Format(n.Projected, n.Currency), n.Budget, cost.Format(n.MonthToDate, n.Currency))
+	if n.Owner != "" && n.Kind != "Team" {
+		n.Text += " Owner: " + n.Owner + "."
+	}
+
+	if obj.GetNamespace() != "" {
+		if err := recordEvent(ctx, c, obj, "BudgetExceeded", n.Text); err != nil {
+			logger.Warn("could not record event", "name", n.Name, "error", err)
+		}
+	}
+
+	if webhook == "" {
+		return
+	}
+	if err := postWebhook(ctx, httpClient, webhook, n); err != nil {
+		logger.Warn("could not send notification", "name", n.Name, "error", err)
+	}
+}
+
+func recordEvent(ctx context.Context, c *client.Client, obj *unstructured.Unstructured, reason, message string) error {
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: obj.GetName() + "-",
+			Namespace:    obj.GetNamespace(),
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Name:       obj.GetName(),
+			Namespace:  obj.GetNamespace(),
+			UID:        obj.GetUID(),
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           corev1.EventTypeWarning,
+		Source:         corev1.EventSource{Component: "butlerctl-cost"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	_, err := c.Clientset.CoreV1().Events(obj.GetNamespace()).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
+
+func postWebhook(ctx context.Context, httpClient *http.Client, url string, n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("encoding notification: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting notification: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("posting notification: status %d", resp.StatusCode)
+	}
+	return nil
+}
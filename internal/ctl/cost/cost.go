@@ -0,0 +1,478 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cost implements butlerctl commands for cluster cost estimates
+// and budgets.
+package cost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/cost"
+	"github.com/butlerdotdev/butler/internal/common/lifecycle"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Budget states
+const (
+	StatusOK       = "OK"
+	StatusOver     = "Over"
+	StatusNoBudget = "-"
+)
+
+// ClusterCost is the estimated spend of one cluster
+type ClusterCost struct {
+	Cluster   string        `json:"cluster"`
+	Namespace string        `json:"namespace"`
+	Owner     string        `json:"owner,omitempty"`
+	Size      cost.Size     `json:"size"`
+	Estimate  cost.Estimate `json:"estimate"`
+	Budget    string        `json:"budget,omitempty"`
+	Monthly   float64       `json:"monthlyBudget,omitempty"`
+	Status    string        `json:"status"`
+}
+
+// TeamCost is the estimated spend of a team's clusters
+type TeamCost struct {
+	Team     string        `json:"team"`
+	Clusters int           `json:"clusters"`
+	Estimate cost.Estimate `json:"estimate"`
+	Budget   string        `json:"budget,omitempty"`
+	Monthly  float64       `json:"monthlyBudget,omitempty"`
+	Status   string        `json:"status"`
+}
+
+// Report is the output of cost report
+type Report struct {
+	Month    string        `json:"month"`
+	Currency string        `json:"currency"`
+	Clusters []ClusterCost `json:"clusters"`
+	Teams    []TeamCost    `json:"teams,omitempty"`
+}
+
+// NewCostCmd creates the cost parent command
+func NewCostCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cost",
+		Short: "Estimate cluster costs and track budgets",
+		Long: `Estimate what tenant clusters cost and track spend against budgets.
+
+Costs are estimated from the rates in the costs section of the
+butler-platform ConfigMap and what each cluster requests: worker CPU,
+memory and disk, plus its hosted control plane. They are estimates, not
+bills; a cluster is assumed to have had its current size all month.
+
+Budgets are set per cluster or per Team. A Team's budget covers every
+cluster it owns.
+
+Commands:
+  report  Show burn rate and spend against budget
+  budget  Set or clear a cluster's or team's budget
+  check   Notify owners whose projected spend exceeds their budget
+
+Examples:
+  # Set budgets
+  butlerctl cost budget my-cluster --budget 500/month
+  butlerctl cost budget --team payments --budget 2000/month
+
+  # Spend this month across all namespaces
+  butlerctl cost report -A`,
+	}
+
+	cmd.AddCommand(newReportCmd(logger))
+	cmd.AddCommand(newBudgetCmd(logger))
+	cmd.AddCommand(newCheckCmd(logger))
+
+	return cmd
+}
+
+type reportOptions struct {
+	nsFlags      cluster.NamespaceFlags
+	kubeconfig   string
+	outputFormat string
+	team         string
+}
+
+func newReportCmd(logger *log.Logger) *cobra.Command {
+	opts := &reportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Show burn rate and spend against budget",
+		Long: `Show each cluster's burn rate, estimated spend this month and the spend
+projected by the end of the month, against its budget. Teams with clusters
+in the report are summed up below it; with -n, only that namespace's
+clusters count towards them.
+
+Examples:
+  # Clusters in the default namespace
+  butlerctl cost report
+
+  # Everything, as JSON for a dashboard
+  butlerctl cost report -A -o json
+
+  # One team's clusters
+  butlerctl cost report -A --team payments`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReport(cmd.Context(), logger, opts)
+		},
+	}
+
+	cluster.AddNamespaceFlags(cmd, &opts.nsFlags)
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.Flags().StringVar(&opts.team, "team", "", "only include clusters owned by this team")
+
+	return cmd
+}
+
+func runReport(ctx context.Context, logger *log.Logger, opts *reportOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+	rates, err := loadRates(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	namespace, allNamespaces := opts.nsFlags.ResolveNamespace()
+	if allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	list, err := c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing TenantClusters: %w", err)
+	}
+	var clusters []unstructured.Unstructured
+	for _, tc := range list.Items {
+		if opts.team == "" || tc.GetAnnotations()[lifecycle.OwnerAnnotation] == opts.team {
+			clusters = append(clusters, tc)
+		}
+	}
+
+	report := buildReport(ctx, c, logger, rates, clusters, time.Now())
+	return output.NewPrinter(format, os.Stdout).Print(report, func(w io.Writer) error {
+		return printReport(w, report, allNamespaces)
+	})
+}
+
+// buildReport estimates the clusters' spend and sums it up per owning Team
+func buildReport(ctx context.Context, c *client.Client, logger *log.Logger, rates *platform.Costs, clusters []unstructured.Unstructured, now time.Time) *Report {
+	report := &Report{Month: cost.Month(now), Currency: rates.CurrencyOrDefault()}
+	teams := map[string]*TeamCost{}
+
+	for i := range clusters {
+		tc := &clusters[i]
+		owner := tc.GetAnnotations()[lifecycle.OwnerAnnotation]
+		cc := ClusterCost{
+			Cluster:   tc.GetName(),
+			Namespace: tc.GetNamespace(),
+			Owner:     owner,
+			Size:      cost.ClusterSize(tc),
+			Estimate:  cost.EstimateCluster(tc, rates, now),
+		}
+		budget, ok, err := cost.BudgetOf(tc.GetAnnotations())
+		if err != nil {
+			logger.Warn("ignoring budget", "cluster", tc.GetNamespace()+"/"+tc.GetName(), "error", err)
+		}
+		cc.Budget, cc.Monthly, cc.Status = budgetStatus(budget, ok, cc.Estimate.Projected, now)
+		report.Clusters = append(report.Clusters, cc)
+
+		if owner == "" {
+			continue
+		}
+		team, seen := teams[owner]
+		if !seen {
+			t, err := c.Dynamic.Resource(client.TeamGVR).Get(ctx, owner, metav1.GetOptions{})
+			if err != nil {
+				// Owner is a user, or Teams aren't readable
+				logger.Debug("not summing up owner as a team", "owner", owner, "error", err)
+				teams[owner] = nil
+				continue
+			}
+			team = &TeamCost{Team: owner}
+			budget, ok, err := cost.BudgetOf(t.GetAnnotations())
+			if err != nil {
+				logger.Warn("ignoring budget", "team", owner, "error", err)
+			}
+			if ok {
+				team.Budget, team.Monthly = budget.String(), budget.Monthly(now)
+			}
+			teams[owner] = team
+		}
+		if team == nil {
+			continue
+		}
+		team.Clusters++
+		team.Estimate.Hourly += cc.Estimate.Hourly
+		team.Estimate.MonthToDate += cc.Estimate.MonthToDate
+		team.Estimate.Projected += cc.Estimate.Projected
+	}
+
+	for _, team := range teams {
+		if team == nil {
+			continue
+		}
+		team.Status = StatusNoBudget
+		if team.Monthly > 0 {
+			team.Status = StatusOK
+			if team.Estimate.Projected > team.Monthly {
+				team.Status = StatusOver
+			}
+		}
+		report.Teams = append(report.Teams, *team)
+	}
+
+	sort.Slice(report.Clusters, func(i, j int) bool {
+		a, b := report.Clusters[i], report.Clusters[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Cluster < b.Cluster
+	})
+	sort.Slice(report.Teams, func(i, j int) bool {
+		return report.Teams[i].Team < report.Teams[j].Team
+	})
+	return report
+}
+
+// budgetStatus compares projected spend with a budget
+func budgetStatus(budget cost.Budget, ok bool, projected float64, now time.Time) (string, float64, string) {
+	if !ok {
+		return "", 0, StatusNoBudget
+	}
+	monthly := budget.Monthly(now)
+	if projected > monthly {
+		return budget.String(), monthly, StatusOver
+	}
+	return budget.String(), monthly, StatusOK
+}
+
+func printReport(w io.Writer, report *Report, allNamespaces bool) error {
+	if len(report.Clusters) == 0 {
+		fmt.Fprintln(w, "No clusters found")
+		return nil
+	}
+	money := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	}
+	status := func(s string) string {
+		if s == StatusOver {
+			return output.Danger(s)
+		}
+		return s
+	}
+
+	headers := []string{"CLUSTER", "OWNER", "WORKERS", "PER DAY", "MONTH TO DATE", "PROJECTED", "BUDGET", "STATUS"}
+	if allNamespaces {
+		headers = append([]string{"NAMESPACE"}, headers...)
+	}
+	table := output.NewTable(w, headers...)
+	for _, cc := range report.Clusters {
+		row := []string{
+			cc.Cluster,
+			orDash(cc.Owner),
+			strconv.FormatInt(cc.Size.Workers, 10),
+			money(cc.Estimate.Hourly * 24),
+			money(cc.Estimate.MonthToDate),
+			money(cc.Estimate.Projected),
+			orDash(cc.Budget),
+			status(cc.Status),
+		}
+		if allNamespaces {
+			row = append([]string{cc.Namespace}, row...)
+		}
+		table.AddRow(row...)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	if len(report.Teams) > 0 {
+		fmt.Fprintln(w)
+		table := output.NewTable(w, "TEAM", "CLUSTERS", "PER DAY", "MONTH TO DATE", "PROJECTED", "BUDGET", "STATUS")
+		for _, t := range report.Teams {
+			table.AddRow(t.Team, strconv.Itoa(t.Clusters), money(t.Estimate.Hourly*24), money(t.Estimate.MonthToDate),
+				money(t.Estimate.Projected), orDash(t.Budget), status(t.Status))
+		}
+		if err := table.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, output.Dim(fmt.Sprintf("Amounts in %s for %s, estimated from the platform's rates.", report.Currency, report.Month)))
+	return nil
+}
+
+type budgetOptions struct {
+	namespace  string
+	kubeconfig string
+	team       string
+	budget     string
+	clear      bool
+}
+
+func newBudgetCmd(logger *log.Logger) *cobra.Command {
+	opts := &budgetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "budget [NAME] --budget AMOUNT/PERIOD",
+		Short: "Set or clear a cluster's or team's budget",
+		Long: `Set the budget of a cluster, or with --team of a Team.
+
+Budgets are AMOUNT/PERIOD in the platform's currency, where PERIOD is day,
+week or month; a bare amount is per month. They are stored as the
+butler.butlerlabs.dev/budget annotation, so they can also be set in
+manifests applied by GitOps.
+
+Examples:
+  butlerctl cost budget my-cluster --budget 500/month
+  butlerctl cost budget --team payments --budget 2000/month
+
+  # Remove a budget
+  butlerctl cost budget my-cluster --clear`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			return runBudget(cmd.Context(), logger, name, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", cluster.DefaultTenantNamespace, "namespace of the TenantCluster")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "path to management cluster kubeconfig")
+	cmd.Flags().StringVar(&opts.team, "team", "", "set the budget of this Team instead of a cluster")
+	cmd.Flags().StringVar(&opts.budget, "budget", "", "budget, e.g. 500/month")
+	cmd.Flags().BoolVar(&opts.clear, "clear", false, "remove the budget")
+
+	return cmd
+}
+
+func runBudget(ctx context.Context, logger *log.Logger, name string, opts *budgetOptions) error {
+	if (name == "") == (opts.team == "") {
+		return fmt.Errorf("give either a cluster NAME or --team")
+	}
+	if (opts.budget == "") == !opts.clear {
+		return fmt.Errorf("give either --budget or --clear")
+	}
+	var value interface{}
+	if !opts.clear {
+		budget, err := cost.ParseBudget(opts.budget)
+		if err != nil {
+			return err
+		}
+		value = budget.String()
+	}
+
+	c, err := getClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	gvr, namespace, kind, target := client.TenantClusterGVR, opts.namespace, "TenantCluster", name
+	if opts.team != "" {
+		gvr, namespace, kind, target = client.TeamGVR, "", "Team", opts.team
+	}
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				cost.BudgetAnnotation:      value,
+				cost.BudgetAlertAnnotation: nil,
+			},
+		},
+	}
+	if err := patchAnnotations(ctx, c, gvr, namespace, target, patch); err != nil {
+		if errors.IsNotFound(err) && kind == "TenantCluster" {
+			return cluster.ClusterNotFoundError(ctx, c, namespace, name)
+		}
+		return fmt.Errorf("updating %s %s: %w", kind, target, err)
+	}
+
+	if opts.clear {
+		logger.Success("budget removed", "kind", kind, "name", target)
+	} else {
+		logger.Success("budget set", "kind", kind, "name", target, "budget", value)
+	}
+	return nil
+}
+
+func patchAnnotations(ctx context.Context, c *client.Client, gvr schema.GroupVersionResource, namespace, name string, patch map[string]interface{}) error {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("marshaling patch: %w", err)
+	}
+	_, err = c.Dynamic.Resource(gvr).Namespace(namespace).Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
+	return err
+}
+
+// loadRates returns the platform's cost rates
+func loadRates(ctx context.Context, c *client.Client) (*platform.Costs, error) {
+	cfg, err := platform.Load(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("loading platform config: %w", err)
+	}
+	if !cfg.Costs.Configured() {
+		return nil, fmt.Errorf("no cost rates configured; set costs in the %s ConfigMap", platform.ConfigMapName)
+	}
+	return &cfg.Costs, nil
+}
+
+func getClient(kubeconfig string) (*client.Client, error) {
+	var c *client.Client
+	var err error
+	if kubeconfig != "" {
+		c, err = client.NewFromKubeconfig(kubeconfig)
+	} else {
+		c, err = client.NewFromDefault()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to management cluster: %w", err)
+	}
+	return c, nil
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
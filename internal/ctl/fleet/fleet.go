@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fleet implements butlerctl fleet commands that act on many clusters.
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DefaultConcurrency is how many clusters are acted on at once
+const DefaultConcurrency = 5
+
+// NewFleetCmd creates the fleet parent command
+func NewFleetCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fleet",
+		Short: "Run operations across groups of tenant clusters",
+		Long: `Run operations across a fleet of tenant clusters.
+
+A fleet is selected either with a label selector (--selector) or an explicit
+list of cluster names (--clusters). Operations run with bounded concurrency
+and finish with a consolidated report; a failure on one cluster does not
+stop the others.
+
+Commands:
+  list     Show the clusters a selection resolves to
+  upgrade  Upgrade the Kubernetes version of every cluster
+  scale    Set or adjust worker counts of every cluster
+
+Examples:
+  # Preview which clusters a selector matches
+  butlerctl fleet list --selector env=dev -A
+
+  # Upgrade all dev clusters, 3 at a time
+  butlerctl fleet upgrade --selector env=dev --k8s-version v1.31.0 --concurrency 3
+
+  # Add one worker to two specific clusters
+  butlerctl fleet scale --clusters payments,orders --workers +1`,
+	}
+
+	cmd.AddCommand(newListCmd(logger))
+	cmd.AddCommand(newUpgradeCmd(logger))
+	cmd.AddCommand(newScaleCmd(logger))
+
+	return cmd
+}
+
+// selectionFlags identifies the clusters in a fleet
+type selectionFlags struct {
+	nsFlags  cluster.NamespaceFlags
+	selector string
+	clusters []string
+}
+
+// addSelectionFlags registers the fleet selection flags on a command
+func addSelectionFlags(cmd *cobra.Command, flags *selectionFlags) {
+	cluster.AddNamespaceFlags(cmd, &flags.nsFlags)
+	cmd.Flags().StringVarP(&flags.selector, "selector", "l", "", "label selector for the fleet (e.g. env=dev)")
+	cmd.Flags().StringSliceVar(&flags.clusters, "clusters", nil, "explicit comma-separated list of cluster names")
+}
+
+// validate requires exactly one way of selecting clusters
+func (f *selectionFlags) validate() error {
+	if f.selector == "" && len(f.clusters) == 0 {
+		return fmt.Errorf("select a fleet with --selector or --clusters")
+	}
+	if f.selector != "" && len(f.clusters) > 0 {
+		return fmt.Errorf("--selector and --clusters are mutually exclusive")
+	}
+	return nil
+}
+
+// resolve returns the TenantClusters in the fleet, sorted by namespace and name
+func (f *selectionFlags) resolve(ctx context.Context, c *client.Client) ([]unstructured.Unstructured, error) {
+	namespace, allNamespaces := f.nsFlags.ResolveNamespace()
+
+	var items []unstructured.Unstructured
+	if len(f.clusters) > 0 {
+		if allNamespaces {
+			return nil, fmt.Errorf("--clusters cannot be combined with --all-namespaces")
+		}
+		for _, name := range f.clusters {
+			tc, err := c.GetTenantCluster(ctx, namespace, name)
+			if err != nil {
+				return nil, fmt.Errorf("getting TenantCluster %s/%s: %w", namespace, name, err)
+			}
+			items = append(items, *tc)
+		}
+	} else {
+		listOpts := metav1.ListOptions{LabelSelector: f.selector}
+		var list *unstructured.UnstructuredList
+		var err error
+		if allNamespaces {
+			list, err = c.Dynamic.Resource(client.TenantClusterGVR).List(ctx, listOpts)
+		} else {
+			list, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(namespace).List(ctx, listOpts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing TenantClusters: %w", err)
+		}
+		items = list.Items
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].GetNamespace() != items[j].GetNamespace() {
+			return items[i].GetNamespace() < items[j].GetNamespace()
+		}
+		return items[i].GetName() < items[j].GetName()
+	})
+	return items, nil
+}
+
+// result is the outcome of an operation on one cluster
+type result struct {
+	namespace string
+	name      string
+	detail    string
+	skipped   bool
+	err       error
+}
+
+// operation acts on a single cluster and describes what it did.
+// Returning skipped=true means the cluster was already in the desired state.
+type operation func(ctx context.Context, tc *unstructured.Unstructured) (detail string, skipped bool, err error)
+
+// runBulk applies op to every cluster with at most concurrency in flight,
+// logging progress as each cluster finishes.
+func runBulk(ctx context.Context, logger *log.Logger, clusters []unstructured.Unstructured, concurrency int, op operation) []result {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]result, len(clusters))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	done := 0
+
+	for i := range clusters {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			tc := &clusters[i]
+			detail, skipped, err := op(ctx, tc)
+			results[i] = result{
+				namespace: tc.GetNamespace(),
+				name:      tc.GetName(),
+				detail:    detail,
+				skipped:   skipped,
+				err:       err,
+			}
+
+			mu.Lock()
+			done++
+			progress := fmt.Sprintf("[%d/%d]", done, len(clusters))
+			mu.Unlock()
+
+			switch {
+			case err != nil:
+				logger.Warn(progress+" failed", "cluster", tc.GetNamespace()+"/"+tc.GetName(), "error", err)
+			case skipped:
+				logger.Info(progress+" unchanged", "cluster", tc.GetNamespace()+"/"+tc.GetName())
+			default:
+				logger.Success(progress+" "+detail, "cluster", tc.GetNamespace()+"/"+tc.GetName())
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// printReport writes the results table followed by a consolidated error list
+func printReport(w io.Writer, results []result) error {
+	table := output.NewTable(w, "NAMESPACE", "NAME", "RESULT", "DETAIL")
+	var failed []result
+	for _, r := range results {
+		status := output.Success("ok")
+		switch {
+		case r.err != nil:
+			status = output.Danger("failed")
+			failed = append(failed, r)
+		case r.skipped:
+			status = output.Dim("unchanged")
+		}
+		detail := r.detail
+		if detail == "" {
+			detail = "-"
+		}
+		table.AddRow(r.namespace, r.name, status, detail)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		fmt.Fprintf(w, "\nErrors (%d of %d clusters):\n", len(failed), len(results))
+		for _, r := range failed {
+			fmt.Fprintf(w, "  %s/%s: %v\n", r.namespace, r.name, r.err)
+		}
+		return fmt.Errorf("%d of %d clusters failed", len(failed), len(results))
+	}
+	return nil
+}
+
+// confirmFleet asks before running a mutating operation on the fleet.
+func confirmFleet(action string, clusters []unstructured.Unstructured) error {
+	fmt.Fprintf(os.Stderr, "\nAbout to %s on %d cluster(s):\n", action, len(clusters))
+	for _, tc := range clusters {
+		fmt.Fprintf(os.Stderr, "  • %s/%s\n", tc.GetNamespace(), tc.GetName())
+	}
+	ok, err := prompt.Confirm("\nProceed?")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s cancelled", action)
+	}
+	return nil
+}
+
+// connect verifies the management cluster and returns a client
+func connect(ctx context.Context) (*client.Client, error) {
+	if err := cluster.RequireManagementCluster(ctx); err != nil {
+		return nil, err
+	}
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return nil, fmt.Errorf("creating client: %w", err)
+	}
+	return c, nil
+}
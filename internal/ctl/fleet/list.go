@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+	"os"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/spf13/cobra"
+)
+
+// newListCmd creates the fleet list command
+func newListCmd(logger *log.Logger) *cobra.Command {
+	var sel selectionFlags
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Show the clusters in a fleet",
+		Long: `Show the clusters a fleet selection resolves to, without changing anything.
+
+Examples:
+  # Clusters labeled env=dev across all namespaces
+  butlerctl fleet list --selector env=dev -A`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(cmd.Context(), logger, &sel)
+		},
+	}
+
+	addSelectionFlags(cmd, &sel)
+
+	return cmd
+}
+
+func runList(ctx context.Context, logger *log.Logger, sel *selectionFlags) error {
+	if err := sel.validate(); err != nil {
+		return err
+	}
+
+	c, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := sel.resolve(ctx, c)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		logger.Warn("no clusters match the selection")
+		return nil
+	}
+
+	table := output.NewTable(os.Stdout, "NAMESPACE", "NAME", "PHASE", "K8S VERSION", "WORKERS")
+	for i := range clusters {
+		info := cluster.ExtractTenantClusterInfo(&clusters[i])
+		table.AddRow(info.Namespace, info.Name, output.ColorizePhase(info.Phase), info.KubernetesVersion,
+			output.FormatWorkers(info.WorkersReady, info.WorkersDesired))
+	}
+	return table.Flush()
+}
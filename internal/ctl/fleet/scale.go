@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/policy"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/butlerdotdev/butler/internal/ctl/queue"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type scaleOptions struct {
+	sel     selectionFlags
+	bulk    bulkFlags
+	workers string
+}
+
+// newScaleCmd creates the fleet scale command
+func newScaleCmd(logger *log.Logger) *cobra.Command {
+	opts := &scaleOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "scale --workers COUNT",
+		Short: "Scale worker counts across a fleet",
+		Long: `Scale the worker count of every cluster in a fleet.
+
+--workers takes an absolute count (3) or a relative change (+1, -2).
+Results outside the allowed worker range fail for that cluster only.
+
+Examples:
+  # Set every dev cluster to 2 workers
+  butlerctl fleet scale --selector env=dev --workers 2
+
+  # Add a worker to each listed cluster
+  butlerctl fleet scale --clusters payments,orders --workers +1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runScale(cmd.Context(), logger, opts)
+		},
+	}
+
+	addSelectionFlags(cmd, &opts.sel)
+	addBulkFlags(cmd, &opts.bulk)
+	cmd.Flags().StringVarP(&opts.workers, "workers", "w", "", "target worker count, or +N/-N to adjust (required)")
+	_ = cmd.MarkFlagRequired("workers")
+
+	queue.Enable(cmd, logger)
+
+	return cmd
+}
+
+// parseWorkers parses an absolute or relative (+N/-N) worker count
+func parseWorkers(s string) (value int64, relative bool, err error) {
+	s = strings.TrimSpace(s)
+	relative = strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-")
+	value, err = strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid --workers value %q: expected N, +N or -N", s)
+	}
+	return value, relative, nil
+}
+
+func runScale(ctx context.Context, logger *log.Logger, opts *scaleOptions) error {
+	if err := opts.sel.validate(); err != nil {
+		return err
+	}
+	delta, relative, err := parseWorkers(opts.workers)
+	if err != nil {
+		return err
+	}
+
+	c, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	limits, err := platform.LoadLimits(ctx, c)
+	if err != nil {
+		return err
+	}
+	if !relative {
+		if err := limits.CheckWorkers(delta); err != nil {
+			return err
+		}
+	}
+
+	clusters, err := opts.sel.resolve(ctx, c)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		logger.Warn("no clusters match the selection")
+		return nil
+	}
+
+	if !opts.bulk.yes && !opts.bulk.dryRun {
+		if err := confirmFleet("scale workers to "+opts.workers, clusters); err != nil {
+			return err
+		}
+	}
+
+	results := runBulk(ctx, logger, clusters, opts.bulk.concurrency, func(ctx context.Context, tc *unstructured.Unstructured) (string, bool, error) {
+		current := cluster.GetNestedInt64(tc.Object, "spec", "workers", "replicas")
+		if current == 0 {
+			current = 1 // Default if not set
+		}
+
+		target := delta
+		if relative {
+			target = current + delta
+		}
+		if err := limits.CheckWorkers(target); err != nil {
+			return "", false, err
+		}
+		if target == current {
+			return fmt.Sprintf("%d workers", current), true, nil
+		}
+
+		scaled := tc.DeepCopy()
+		if err := unstructured.SetNestedField(scaled.Object, target, "spec", "workers", "replicas"); err != nil {
+			return "", false, err
+		}
+		if err := policy.Enforce(ctx, c, logger, opts.bulk.policy, policy.Input{
+			Operation: policy.OperationScale,
+			Object:    scaled.Object,
+			OldObject: tc.Object,
+		}); err != nil {
+			return "", false, err
+		}
+
+		patch := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"workers": map[string]interface{}{
+					"replicas": target,
+				},
+			},
+		}
+		if err := patchCluster(ctx, c, tc, patch, opts.bulk.dryRun); err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("%d → %d workers", current, target), false, nil
+	})
+
+	return printReport(os.Stdout, results)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/common/policy"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/butlerdotdev/butler/internal/ctl/queue"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// bulkFlags holds the flags shared by mutating fleet commands
+type bulkFlags struct {
+	concurrency int
+	yes         bool
+	dryRun      bool
+	policy      policy.Options
+}
+
+// addBulkFlags registers the shared flags on a mutating fleet command
+func addBulkFlags(cmd *cobra.Command, flags *bulkFlags) {
+	cmd.Flags().IntVar(&flags.concurrency, "concurrency", DefaultConcurrency, "maximum clusters to act on at once")
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip the confirmation prompt")
+	cmd.Flags().BoolVar(&flags.dryRun, "dry-run", false, "send patches as server-side dry runs")
+	policy.AddFlags(cmd, &flags.policy)
+}
+
+type upgradeOptions struct {
+	sel               selectionFlags
+	bulk              bulkFlags
+	kubernetesVersion string
+}
+
+// newUpgradeCmd creates the fleet upgrade command
+func newUpgradeCmd(logger *log.Logger) *cobra.Command {
+	opts := &upgradeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade --k8s-version VERSION",
+		Short: "Upgrade the Kubernetes version across a fleet",
+		Long: `Upgrade the Kubernetes version of every cluster in a fleet.
+
+Each cluster's spec.kubernetesVersion is patched; the controllers then roll
+the control plane and workers. Clusters already at the target version are
+reported as unchanged. Versions the platform doesn't support (see
+'butlerctl versions list') are rejected before any cluster is touched.
+
+Examples:
+  # Upgrade all dev clusters
+  butlerctl fleet upgrade --selector env=dev --k8s-version v1.31.0
+
+  # Upgrade across all namespaces, two at a time, without prompting
+  butlerctl fleet upgrade -l tier=edge -A --k8s-version v1.31.0 --concurrency 2 -y`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUpgrade(cmd.Context(), logger, opts)
+		},
+	}
+
+	addSelectionFlags(cmd, &opts.sel)
+	addBulkFlags(cmd, &opts.bulk)
+	cmd.Flags().StringVar(&opts.kubernetesVersion, "k8s-version", "", "target Kubernetes version (required)")
+	_ = cmd.MarkFlagRequired("k8s-version")
+	_ = cmd.RegisterFlagCompletionFunc("k8s-version", cluster.CompleteKubernetesVersions)
+
+	queue.Enable(cmd, logger)
+
+	return cmd
+}
+
+func runUpgrade(ctx context.Context, logger *log.Logger, opts *upgradeOptions) error {
+	if err := opts.sel.validate(); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(opts.kubernetesVersion, "v") {
+		return fmt.Errorf("kubernetes version must start with 'v', got %q", opts.kubernetesVersion)
+	}
+
+	c, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	versions, err := platform.LoadKubernetesVersions(ctx, c)
+	if err != nil {
+		return err
+	}
+	if err := versions.Check(opts.kubernetesVersion); err != nil {
+		return err
+	}
+
+	clusters, err := opts.sel.resolve(ctx, c)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		logger.Warn("no clusters match the selection")
+		return nil
+	}
+
+	if !opts.bulk.yes && !opts.bulk.dryRun {
+		if err := confirmFleet("upgrade to "+opts.kubernetesVersion, clusters); err != nil {
+			return err
+		}
+	}
+
+	results := runBulk(ctx, logger, clusters, opts.bulk.concurrency, func(ctx context.Context, tc *unstructured.Unstructured) (string, bool, error) {
+		current := cluster.GetNestedString(tc.Object, "spec", "kubernetesVersion")
+		if current == opts.kubernetesVersion {
+			return current, true, nil
+		}
+
+		upgraded := tc.DeepCopy()
+		if err := unstructured.SetNestedField(upgraded.Object, opts.kubernetesVersion, "spec", "kubernetesVersion"); err != nil {
+			return "", false, err
+		}
+		if err := policy.Enforce(ctx, c, logger, opts.bulk.policy, policy.Input{
+			Operation: policy.OperationUpdate,
+			Object:    upgraded.Object,
+			OldObject: tc.Object,
+		}); err != nil {
+			return "", false, err
+		}
+
+		patch := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"kubernetesVersion": opts.kubernetesVersion,
+			},
+		}
+		if err := patchCluster(ctx, c, tc, patch, opts.bulk.dryRun); err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("%s → %s", current, opts.kubernetesVersion), false, nil
+	})
+
+	return printReport(os.Stdout, results)
+}
+
+// patchCluster merge-patches a TenantCluster
+func patchCluster(ctx context.Context, c *client.Client, tc *unstructured.Unstructured, patch map[string]interface{}, dryRun bool) error {
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("marshaling patch: %w", err)
+	}
+
+	patchOpts := metav1.PatchOptions{}
+	if dryRun {
+		patchOpts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	_, err = c.Dynamic.Resource(client.TenantClusterGVR).Namespace(tc.GetNamespace()).Patch(
+		ctx, tc.GetName(), types.MergePatchType, patchBytes, patchOpts)
+	if err != nil {
+		return fmt.Errorf("patching TenantCluster: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package images implements butlerctl commands for provider OS images.
+package images
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/providerapi"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+type listOptions struct {
+	provider          string
+	kubernetesVersion string
+	outputFormat      string
+	timeout           time.Duration
+}
+
+// NewImagesCmd creates the images parent command
+func NewImagesCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "images",
+		Short: "Show OS images available for tenant clusters",
+		Long: `Show the OS images workers can boot from.
+
+Images are read from the infrastructure behind a ProviderConfig: the
+Nutanix image service, Harvester VirtualMachineImages or Proxmox VM
+templates. The REF column is the value to pass to 'cluster create --image'.
+
+Commands:
+  list  List images for a provider
+
+Examples:
+  # Images for the only ProviderConfig
+  butlerctl images list`,
+	}
+
+	cmd.AddCommand(newListCmd(logger))
+
+	return cmd
+}
+
+// newListCmd creates the images list command
+func newListCmd(logger *log.Logger) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List images for a provider",
+		Long: `List the OS images available for a ProviderConfig.
+
+Talos images are recognized by name, e.g. talos-v1.8.3-nocloud-amd64, and
+annotated with the Kubernetes versions that Talos release supports.
+--k8s-version hides images that can't run the given version.
+
+Reading images needs the provider's credentials Secret in butler-system.
+
+Examples:
+  # Images for a specific provider
+  butlerctl images list --provider nutanix-prod
+
+  # Only images that can run Kubernetes v1.31
+  butlerctl images list --provider nutanix-prod --k8s-version v1.31.4
+
+  # Use the reference when creating a cluster
+  butlerctl cluster create my-cluster --lb-pool 10.127.14.40 \
+    --image 41720566-c4a7-4300-a60a-b2786ebfa8bd`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(cmd.Context(), logger, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.provider, "provider", "p", "", "ProviderConfig name (auto-detected if only one exists)")
+	cmd.Flags().StringVar(&opts.kubernetesVersion, "k8s-version", "", "only show images that support this Kubernetes version")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 30*time.Second, "provider API timeout")
+	_ = cmd.RegisterFlagCompletionFunc("k8s-version", cluster.CompleteKubernetesVersions)
+
+	return cmd
+}
+
+func runList(ctx context.Context, logger *log.Logger, opts *listOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	if err := cluster.RequireManagementCluster(ctx); err != nil {
+		return err
+	}
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	if opts.provider == "" {
+		if opts.provider, err = cluster.AutoDetectProvider(ctx, c, logger); err != nil {
+			return err
+		}
+	}
+	pc, err := c.GetProviderConfig(ctx, cluster.ButlerSystemNamespace, opts.provider)
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("ProviderConfig %q not found in %s namespace", opts.provider, cluster.ButlerSystemNamespace)
+	}
+	if err != nil {
+		return fmt.Errorf("getting ProviderConfig %s: %w", opts.provider, err)
+	}
+
+	images, err := providerapi.ListImages(ctx, c, pc, opts.timeout)
+	if err != nil {
+		return fmt.Errorf("listing images for %s: %w", opts.provider, err)
+	}
+	if opts.kubernetesVersion != "" {
+		compatible := images[:0]
+		for _, img := range images {
+			if img.SupportsKubernetes(opts.kubernetesVersion) {
+				compatible = append(compatible, img)
+			}
+		}
+		images = compatible
+	}
+	if images == nil {
+		images = []providerapi.Image{}
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(images, func(w io.Writer) error {
+		if len(images) == 0 {
+			logger.Warn("no images found", "provider", opts.provider)
+			return nil
+		}
+		table := output.NewTable(w, "NAME", "REF", "SIZE", "TALOS", "KUBERNETES")
+		for _, img := range images {
+			table.AddRow(img.Name, img.Ref, formatSize(img.SizeBytes), orDash(img.TalosVersion), orDash(img.Kubernetes))
+		}
+		return table.Flush()
+	})
+}
+
+// formatSize renders a byte count in binary units
+func formatSize(n int64) string {
+	if n <= 0 {
+		return "-"
+	}
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
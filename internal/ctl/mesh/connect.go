@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesh
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/prompt"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type connectOptions struct {
+	nsFlags cluster.NamespaceFlags
+	yes     bool
+}
+
+// newConnectCmd creates the mesh connect command
+func newConnectCmd(logger *log.Logger) *cobra.Command {
+	opts := &connectOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "connect CLUSTER-A CLUSTER-B",
+		Short: "Connect two tenant clusters with Cilium ClusterMesh",
+		Long: `Connect two tenant clusters with Cilium ClusterMesh.
+
+Each cluster's clustermesh-apiserver endpoint and client certificate are
+written to the other's cilium-clustermesh Secret, and a host alias for the
+remote endpoint is added to the cilium DaemonSet. Updating the DaemonSet
+restarts the Cilium agents one node at a time.
+
+Clusters are looked up in --namespace; use NAMESPACE/NAME for a cluster in
+another namespace. Running connect again refreshes the configuration, e.g.
+after a clustermesh-apiserver address change.
+
+Examples:
+  # Connect two clusters in butler-tenants
+  butlerctl mesh connect payments orders
+
+  # Connect across tenant namespaces without prompting
+  butlerctl mesh connect team-a/payments team-b/orders --yes`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConnect(cmd.Context(), logger, args[0], args[1], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.nsFlags.Namespace, "namespace", "n", "", "namespace of the TenantClusters (default: butler-tenants)")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "skip the confirmation prompt")
+
+	return cmd
+}
+
+func runConnect(ctx context.Context, logger *log.Logger, a, b string, opts *connectOptions) error {
+	c, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	namespace, _ := opts.nsFlags.ResolveNamespace()
+
+	var members []*member
+	for _, ref := range []string{a, b} {
+		ns, name := splitRef(ref, namespace)
+		m, err := loadMember(ctx, c, ns, name)
+		if err != nil {
+			return err
+		}
+		if len(m.Problems) > 0 {
+			return fmt.Errorf("cluster %s is not ready for ClusterMesh: %s", name, strings.Join(m.Problems, "; "))
+		}
+		members = append(members, m)
+	}
+	left, right := members[0], members[1]
+
+	switch {
+	case left.Namespace == right.Namespace && left.Cluster == right.Cluster:
+		return fmt.Errorf("cannot connect cluster %s to itself", left.Cluster)
+	case left.Name == right.Name:
+		return fmt.Errorf("clusters %s and %s share the Cilium cluster name %q; names must be unique", left.Cluster, right.Cluster, left.Name)
+	case left.ID == right.ID:
+		return fmt.Errorf("clusters %s and %s share the Cilium cluster ID %d; IDs must be unique", left.Cluster, right.Cluster, left.ID)
+	}
+
+	if !opts.yes {
+		ok, err := prompt.Confirm(fmt.Sprintf("Connecting %s and %s restarts their Cilium agents. Continue?", left.Cluster, right.Cluster))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("connect cancelled")
+		}
+	}
+
+	for _, pair := range [][2]*member{{left, right}, {right, left}} {
+		local, remote := pair[0], pair[1]
+		logger.Info("configuring peer", "cluster", local.Cluster, "peer", remote.Cluster, "endpoint", remote.Endpoint)
+		if err := addPeer(ctx, local, remote); err != nil {
+			return fmt.Errorf("configuring %s on %s: %w", remote.Cluster, local.Cluster, err)
+		}
+	}
+
+	logger.Success("clusters connected", "clusters", left.Cluster+","+right.Cluster)
+	logger.Info("check progress with: butlerctl mesh status " + a + " " + b)
+	return nil
+}
+
+// splitRef splits NAMESPACE/NAME, falling back to the default namespace
+func splitRef(ref, namespace string) (string, string) {
+	if ns, name, ok := strings.Cut(ref, "/"); ok {
+		return ns, name
+	}
+	return namespace, ref
+}
+
+// addPeer writes remote's endpoint and credentials to local's
+// cilium-clustermesh Secret and points local's agents at it
+func addPeer(ctx context.Context, local, remote *member) error {
+	ip, err := resolveAddress(ctx, remote.address)
+	if err != nil {
+		return err
+	}
+
+	secrets := local.client.Clientset.CoreV1().Secrets(ciliumNamespace)
+	secret, err := secrets.Get(ctx, clusterMeshSecret, metav1.GetOptions{})
+	create := errors.IsNotFound(err)
+	if create {
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: clusterMeshSecret, Namespace: ciliumNamespace}}
+	} else if err != nil {
+		return fmt.Errorf("reading %s Secret: %w", clusterMeshSecret, err)
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[remote.Name] = []byte(etcdConfig(remote.Name, remote.port))
+	secret.Data[remote.Name+".etcd-client-ca.crt"] = remote.ca
+	secret.Data[remote.Name+".etcd-client.crt"] = remote.cert
+	secret.Data[remote.Name+".etcd-client.key"] = remote.key
+
+	if create {
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+	} else {
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("writing %s Secret: %w", clusterMeshSecret, err)
+	}
+
+	daemonSets := local.client.Clientset.AppsV1().DaemonSets(ciliumNamespace)
+	ds, err := daemonSets.Get(ctx, ciliumDaemonSet, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("reading %s DaemonSet: %w", ciliumDaemonSet, err)
+	}
+	host := meshHost(remote.Name)
+	var aliases []corev1.HostAlias
+	for _, alias := range ds.Spec.Template.Spec.HostAliases {
+		if alias.IP == ip && len(alias.Hostnames) == 1 && alias.Hostnames[0] == host {
+			return nil
+		}
+		if !slices.Contains(alias.Hostnames, host) {
+			aliases = append(aliases, alias)
+		}
+	}
+	ds.Spec.Template.Spec.HostAliases = append(aliases, corev1.HostAlias{IP: ip, Hostnames: []string{host}})
+	if _, err := daemonSets.Update(ctx, ds, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating %s DaemonSet: %w", ciliumDaemonSet, err)
+	}
+	return nil
+}
+
+// etcdConfig is the agent's etcd client config for a remote cluster
+func etcdConfig(name string, port int32) string {
+	return fmt.Sprintf(`endpoints:
+- https://%s:%d
+trusted-ca-file: %s/%s.etcd-client-ca.crt
+key-file: %s/%s.etcd-client.key
+cert-file: %s/%s.etcd-client.crt
+`, meshHost(name), port, meshConfigDir, name, meshConfigDir, name, meshConfigDir, name)
+}
+
+// resolveAddress returns an IP for a LoadBalancer address, since host
+// aliases can't point at host names
+func resolveAddress(ctx context.Context, address string) (string, error) {
+	if net.ParseIP(address) != nil {
+		return address, nil
+	}
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, address)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", address, err)
+	}
+	if len(ips) == 0 {
+		return "", fmt.Errorf("%s has no addresses", address)
+	}
+	return ips[0].IP.String(), nil
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mesh implements butlerctl mesh commands that wire tenant clusters
+// together with Cilium ClusterMesh.
+package mesh
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Names of the Cilium objects ClusterMesh is built from. They match the
+// defaults of the Cilium Helm chart that tenant clusters are installed with.
+const (
+	ciliumNamespace        = "kube-system"
+	ciliumConfigMap        = "cilium-config"
+	ciliumDaemonSet        = "cilium"
+	ciliumCASecret         = "cilium-ca"
+	clusterMeshSecret      = "cilium-clustermesh"
+	clusterMeshAPIServer   = "clustermesh-apiserver"
+	clusterMeshRemoteCerts = "clustermesh-apiserver-remote-cert"
+
+	// meshDomain is the suffix of the host aliases agents use to reach
+	// remote clustermesh-apiservers
+	meshDomain = "mesh.cilium.io"
+
+	// meshConfigDir is where the agent mounts the cilium-clustermesh Secret
+	meshConfigDir = "/var/lib/cilium/clustermesh"
+)
+
+// NewMeshCmd creates the mesh parent command
+func NewMeshCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mesh",
+		Short: "Connect tenant clusters with Cilium ClusterMesh",
+		Long: `Connect tenant clusters with Cilium ClusterMesh for cross-cluster services.
+
+Both clusters must run Cilium with a unique cluster name and ID and an
+exposed clustermesh-apiserver (Helm values cluster.name, cluster.id and
+clustermesh.useAPIServer=true). butlerctl exchanges the certificates and
+endpoints between them, so the cilium CLI is not needed.
+
+Commands:
+  connect  Connect two tenant clusters
+  status   Show the ClusterMesh configuration of tenant clusters
+
+Examples:
+  # Connect two clusters
+  butlerctl mesh connect payments orders
+
+  # Check which clusters are connected
+  butlerctl mesh status payments orders`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newConnectCmd(logger))
+	cmd.AddCommand(newStatusCmd(logger))
+
+	return cmd
+}
+
+// member is a tenant cluster's Cilium ClusterMesh identity and endpoint
+type member struct {
+	Cluster   string   `json:"cluster"`
+	Namespace string   `json:"namespace"`
+	Name      string   `json:"ciliumName"`
+	ID        int      `json:"ciliumID"`
+	Endpoint  string   `json:"apiServer,omitempty"`
+	Agents    string   `json:"agents,omitempty"`
+	Peers     []string `json:"peers"`
+	Problems  []string `json:"problems,omitempty"`
+
+	client  *client.Client
+	address string
+	port    int32
+	ca      []byte
+	cert    []byte
+	key     []byte
+}
+
+// connect returns a management cluster client
+func connect(ctx context.Context) (*client.Client, error) {
+	if err := cluster.RequireManagementCluster(ctx); err != nil {
+		return nil, err
+	}
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return nil, fmt.Errorf("creating client: %w", err)
+	}
+	return c, nil
+}
+
+// loadMember reads a tenant cluster's Cilium configuration. Missing
+// ClusterMesh prerequisites are recorded in Problems rather than returned,
+// so status can still report on the cluster.
+func loadMember(ctx context.Context, c *client.Client, namespace, name string) (*member, error) {
+	tenant, err := c.NewForTenant(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to cluster %s: %w", name, err)
+	}
+	m := &member{Cluster: name, Namespace: namespace, Peers: []string{}, client: tenant}
+	core := tenant.Clientset.CoreV1()
+
+	cm, err := core.ConfigMaps(ciliumNamespace).Get(ctx, ciliumConfigMap, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, fmt.Errorf("cluster %s does not run Cilium (no %s/%s ConfigMap)", name, ciliumNamespace, ciliumConfigMap)
+	} else if err != nil {
+		return nil, fmt.Errorf("reading Cilium config of %s: %w", name, err)
+	}
+	m.Name = cm.Data["cluster-name"]
+	m.ID, _ = strconv.Atoi(cm.Data["cluster-id"])
+	if m.Name == "" || m.Name == "default" {
+		m.Problems = append(m.Problems, "Cilium cluster.name is unset")
+	}
+	if m.ID == 0 {
+		m.Problems = append(m.Problems, "Cilium cluster.id is unset")
+	}
+
+	if ds, err := tenant.Clientset.AppsV1().DaemonSets(ciliumNamespace).Get(ctx, ciliumDaemonSet, metav1.GetOptions{}); err == nil {
+		m.Agents = fmt.Sprintf("%d/%d", ds.Status.NumberReady, ds.Status.DesiredNumberScheduled)
+	}
+
+	if secret, err := core.Secrets(ciliumNamespace).Get(ctx, clusterMeshSecret, metav1.GetOptions{}); err == nil {
+		for key := range secret.Data {
+			if !strings.Contains(key, ".") {
+				m.Peers = append(m.Peers, key)
+			}
+		}
+		sort.Strings(m.Peers)
+	}
+
+	if err := m.loadEndpoint(ctx); err != nil {
+		m.Problems = append(m.Problems, err.Error())
+	}
+	if err := m.loadCertificates(ctx); err != nil {
+		m.Problems = append(m.Problems, err.Error())
+	}
+	return m, nil
+}
+
+// loadEndpoint finds the address other clusters reach the
+// clustermesh-apiserver on
+func (m *member) loadEndpoint(ctx context.Context) error {
+	svc, err := m.client.Clientset.CoreV1().Services(ciliumNamespace).Get(ctx, clusterMeshAPIServer, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("no %s Service; install Cilium with clustermesh.useAPIServer=true", clusterMeshAPIServer)
+	} else if err != nil {
+		return fmt.Errorf("reading %s Service: %w", clusterMeshAPIServer, err)
+	}
+	if len(svc.Spec.Ports) == 0 {
+		return fmt.Errorf("%s Service has no ports", clusterMeshAPIServer)
+	}
+
+	switch svc.Spec.Type {
+	case corev1.ServiceTypeLoadBalancer:
+		for _, ing := range svc.Status.LoadBalancer.Ingress {
+			if ing.IP != "" {
+				m.address = ing.IP
+			} else if ing.Hostname != "" {
+				m.address = ing.Hostname
+			}
+			if m.address != "" {
+				break
+			}
+		}
+		if m.address == "" {
+			return fmt.Errorf("%s LoadBalancer has no address yet", clusterMeshAPIServer)
+		}
+		m.port = svc.Spec.Ports[0].Port
+	case corev1.ServiceTypeNodePort:
+		nodes, err := m.client.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("listing nodes: %w", err)
+		}
+		for _, node := range nodes.Items {
+			for _, addr := range node.Status.Addresses {
+				if addr.Type == corev1.NodeInternalIP && m.address == "" {
+					m.address = addr.Address
+				}
+			}
+		}
+		if m.address == "" {
+			return fmt.Errorf("no node address to reach the %s NodePort on", clusterMeshAPIServer)
+		}
+		m.port = svc.Spec.Ports[0].NodePort
+	default:
+		return fmt.Errorf("%s Service is %s and not reachable from other clusters; set clustermesh.apiserver.service.type", clusterMeshAPIServer, svc.Spec.Type)
+	}
+
+	m.Endpoint = net.JoinHostPort(m.address, strconv.Itoa(int(m.port)))
+	return nil
+}
+
+// loadCertificates reads the CA and the client certificate remote agents
+// authenticate to this cluster's clustermesh-apiserver with
+func (m *member) loadCertificates(ctx context.Context) error {
+	core := m.client.Clientset.CoreV1()
+	remote, err := core.Secrets(ciliumNamespace).Get(ctx, clusterMeshRemoteCerts, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("reading %s Secret: %w", clusterMeshRemoteCerts, err)
+	}
+	m.cert = remote.Data[corev1.TLSCertKey]
+	m.key = remote.Data[corev1.TLSPrivateKeyKey]
+	m.ca = remote.Data["ca.crt"]
+	if len(m.ca) == 0 {
+		ca, err := core.Secrets(ciliumNamespace).Get(ctx, ciliumCASecret, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("reading %s Secret: %w", ciliumCASecret, err)
+		}
+		m.ca = ca.Data["ca.crt"]
+	}
+	if len(m.cert) == 0 || len(m.key) == 0 || len(m.ca) == 0 {
+		return fmt.Errorf("%s Secret is incomplete", clusterMeshRemoteCerts)
+	}
+	return nil
+}
+
+// meshHost is the host name agents use for a remote cluster
+func meshHost(name string) string {
+	return name + "." + meshDomain
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesh
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/spf13/cobra"
+)
+
+type statusOptions struct {
+	nsFlags      cluster.NamespaceFlags
+	outputFormat string
+}
+
+// newStatusCmd creates the mesh status command
+func newStatusCmd(logger *log.Logger) *cobra.Command {
+	opts := &statusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status CLUSTER...",
+		Short: "Show the ClusterMesh configuration of tenant clusters",
+		Long: `Show the Cilium ClusterMesh configuration of tenant clusters.
+
+For each cluster this lists its Cilium cluster name and ID, the address
+other clusters reach its clustermesh-apiserver on, how many Cilium agents
+are ready, and the peers it is connected to. Anything that would stop
+'butlerctl mesh connect' is listed under PROBLEMS.
+
+Examples:
+  # Check two connected clusters
+  butlerctl mesh status payments orders
+
+  # Machine-readable output
+  butlerctl mesh status payments -o json`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(cmd.Context(), logger, args, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.nsFlags.Namespace, "namespace", "n", "", "namespace of the TenantClusters (default: butler-tenants)")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+func runStatus(ctx context.Context, logger *log.Logger, refs []string, opts *statusOptions) error {
+	format, err := output.ParseFormat(opts.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	c, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	namespace, _ := opts.nsFlags.ResolveNamespace()
+
+	var members []*member
+	for _, ref := range refs {
+		ns, name := splitRef(ref, namespace)
+		m, err := loadMember(ctx, c, ns, name)
+		if err != nil {
+			logger.Warn("skipping cluster", "cluster", name, "error", err)
+			continue
+		}
+		members = append(members, m)
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(members, func(w io.Writer) error {
+		table := output.NewTable(w, "CLUSTER", "CILIUM NAME", "ID", "API SERVER", "AGENTS", "PEERS", "PROBLEMS")
+		for _, m := range members {
+			problems := output.Success("none")
+			if len(m.Problems) > 0 {
+				problems = output.Warning(strings.Join(m.Problems, "; "))
+			}
+			id := "-"
+			if m.ID != 0 {
+				id = strconv.Itoa(m.ID)
+			}
+			table.AddRow(m.Cluster, orDash(m.Name), id, orDash(m.Endpoint), orDash(m.Agents),
+				orDash(strings.Join(m.Peers, ",")), problems)
+		}
+		return table.Flush()
+	})
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
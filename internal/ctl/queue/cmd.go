@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/spf13/cobra"
+)
+
+// Executor runs a butlerctl command line in-process
+type Executor func(ctx context.Context, args []string) error
+
+// NewQueueCmd creates the queue parent command
+func NewQueueCmd(logger *log.Logger, execute Executor) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue",
+		Short: "Manage operations queued while offline",
+		Long: `Manage operations queued while the management cluster was unreachable.
+
+Mutating commands (cluster create/scale/destroy, apply, fleet upgrade/scale)
+accept --queue. When the management cluster cannot be reached, the command
+line is saved under ~/.butler/queue/ instead of failing. Flushing replays
+queued operations in order against the same management cluster, stopping at
+the first failure so later operations never run out of order.
+
+Commands:
+  list   Show queued operations
+  flush  Run queued operations
+  drop   Discard a queued operation
+
+Examples:
+  # Queue a scale-up from an edge site with an unreliable link
+  butlerctl cluster scale edge-01 --workers 3 --queue
+
+  # See what is pending
+  butlerctl queue list
+
+  # Apply pending operations once the link is back
+  butlerctl queue flush`,
+	}
+
+	cmd.AddCommand(newListCmd())
+	cmd.AddCommand(newFlushCmd(logger, execute))
+	cmd.AddCommand(newDropCmd(logger))
+
+	return cmd
+}
+
+// newListCmd creates the queue list command
+func newListCmd() *cobra.Command {
+	var outputFormat string
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "Show queued operations",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := output.ParseFormat(outputFormat)
+			if err != nil {
+				return err
+			}
+			entries, err := List()
+			if err != nil {
+				return err
+			}
+			if entries == nil {
+				entries = []Entry{}
+			}
+			return output.NewPrinter(format, os.Stdout).Print(entries, func(w io.Writer) error {
+				table := output.NewTable(w, "ID", "QUEUED", "SERVER", "COMMAND")
+				for _, e := range entries {
+					table.AddRow(e.ID, output.FormatAge(e.QueuedAt), e.Server, e.Command())
+				}
+				return table.Flush()
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+
+	return cmd
+}
+
+// newFlushCmd creates the queue flush command
+func newFlushCmd(logger *log.Logger, execute Executor) *cobra.Command {
+	return &cobra.Command{
+		Use:   "flush",
+		Short: "Run queued operations",
+		Long: `Run queued operations in the order they were queued.
+
+Each operation is replayed from the directory it was queued in. Operations
+queued for a different management cluster than the current one are left in
+the queue. Flushing stops at the first failed operation; fix the cause and
+flush again, or discard it with 'butlerctl queue drop ID'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFlush(cmd.Context(), logger, execute)
+		},
+	}
+}
+
+func runFlush(ctx context.Context, logger *log.Logger, execute Executor) error {
+	entries, err := List()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		logger.Info("no queued operations")
+		return nil
+	}
+
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return fmt.Errorf("connecting to management cluster: %w", err)
+	}
+	if err := Probe(c.Config); err != nil {
+		return fmt.Errorf("management cluster %s is still unreachable: %w", c.Config.Host, err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+	defer func() { _ = os.Chdir(wd) }()
+
+	flushed, skipped := 0, 0
+	for _, e := range entries {
+		if e.Server != c.Config.Host {
+			logger.Warn("skipping operation queued for another management cluster", "id", e.ID, "server", e.Server)
+			skipped++
+			continue
+		}
+
+		logger.Info("running queued operation", "id", e.ID, "command", e.Command())
+		if err := os.Chdir(e.Dir); err != nil {
+			return fmt.Errorf("queued operation %s: %w", e.ID, err)
+		}
+		if err := execute(ctx, e.Args); err != nil {
+			return fmt.Errorf("queued operation %s failed (%d flushed, remaining left in queue): %w", e.ID, flushed, err)
+		}
+		if err := Remove(e.ID); err != nil {
+			return err
+		}
+		flushed++
+	}
+
+	logger.Success("queue flushed", "operations", flushed, "skipped", skipped)
+	return nil
+}
+
+// newDropCmd creates the queue drop command
+func newDropCmd(logger *log.Logger) *cobra.Command {
+	return &cobra.Command{
+		Use:   "drop ID",
+		Short: "Discard a queued operation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := Remove(args[0]); err != nil {
+				return err
+			}
+			logger.Success("queued operation dropped", "id", args[0])
+			return nil
+		},
+	}
+}
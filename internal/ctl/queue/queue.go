@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queue defers mutating butlerctl commands while the management
+// cluster is unreachable.
+//
+// Commands registered with Enable accept --queue. When it is set and the
+// management cluster cannot be reached, the invocation is saved under
+// ~/.butler/queue/ instead of failing, and `butlerctl queue flush` replays
+// saved invocations in order once connectivity returns.
+package queue
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// probeTimeout bounds the reachability check made before a queued command
+const probeTimeout = 10 * time.Second
+
+// Entry is a queued butlerctl invocation
+type Entry struct {
+	ID       string    `json:"id"`
+	Args     []string  `json:"args"`
+	Dir      string    `json:"dir"`
+	Server   string    `json:"server"`
+	QueuedAt time.Time `json:"queuedAt"`
+}
+
+// Command returns the invocation as typed on the command line
+func (e *Entry) Command() string {
+	return "butlerctl " + strings.Join(e.Args, " ")
+}
+
+// Enable adds --queue to a mutating command. With --queue, the management
+// cluster is probed before the command runs; if it cannot be reached, the
+// invocation is saved for 'butlerctl queue flush' and the command succeeds.
+func Enable(cmd *cobra.Command, logger *log.Logger) {
+	var enabled bool
+	cmd.Flags().BoolVar(&enabled, "queue", false, "queue the operation locally if the management cluster is unreachable")
+
+	run := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		// Dry runs have nothing worth replaying
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); !enabled || dryRun {
+			return run(cmd, args)
+		}
+
+		c, err := client.NewFromDefault()
+		if err != nil {
+			return run(cmd, args)
+		}
+		probeErr := Probe(c.Config)
+		if probeErr == nil {
+			return run(cmd, args)
+		}
+		logger.Debug("management cluster unreachable", "server", c.Config.Host, "error", probeErr)
+
+		entry, err := Add(invocationArgs(), c.Config.Host)
+		if err != nil {
+			return err
+		}
+		logger.Warn("management cluster unreachable, operation queued", "id", entry.ID, "server", entry.Server)
+		logger.Info("Run 'butlerctl queue flush' once connectivity is restored")
+		return nil
+	}
+}
+
+// Probe returns an error if the API server at config cannot be reached.
+// Responses from the server, even errors, count as reachable.
+func Probe(config *rest.Config) error {
+	cfg := rest.CopyConfig(config)
+	cfg.Timeout = probeTimeout
+
+	cs, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	if _, err := cs.Discovery().ServerVersion(); err != nil {
+		var status apierrors.APIStatus
+		if errors.As(err, &status) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// Add saves an invocation for the management cluster at server
+func Add(args []string, server string) (*Entry, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("getting working directory: %w", err)
+	}
+
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("generating queue ID: %w", err)
+	}
+	now := time.Now()
+	entry := &Entry{
+		ID:       now.UTC().Format("20060102-150405") + "-" + hex.EncodeToString(suffix),
+		Args:     args,
+		Dir:      wd,
+		Server:   server,
+		QueuedAt: now,
+	}
+
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding queue entry: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("creating queue directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, entry.ID+".json"), data, 0600); err != nil {
+		return nil, fmt.Errorf("writing queue entry: %w", err)
+	}
+	return entry, nil
+}
+
+// List returns queued invocations, oldest first
+func List() ([]Entry, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading queue directory: %w", err)
+	}
+
+	var entries []Entry
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading queue entry: %w", err)
+		}
+		var entry Entry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return nil, fmt.Errorf("parsing queue entry %s: %w", f.Name(), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+	return entries, nil
+}
+
+// Remove deletes a queued invocation
+func Remove(id string) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	err = os.Remove(filepath.Join(dir, filepath.Base(id)+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("no queued operation with ID %q", id)
+	}
+	if err != nil {
+		return fmt.Errorf("removing queue entry: %w", err)
+	}
+	return nil
+}
+
+// Dir returns the queue directory, ~/.butler/queue
+func Dir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting home directory: %w", err)
+	}
+	return filepath.Join(home, ".butler", "queue"), nil
+}
+
+// invocationArgs returns the command line without the --queue flag
+func invocationArgs() []string {
+	var args []string
+	for _, arg := range os.Args[1:] {
+		if arg == "--queue" || arg == "--queue=true" {
+			continue
+		}
+		args = append(args, arg)
+	}
+	return args
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package versions implements butlerctl commands for supported Kubernetes versions.
+package versions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/common/output"
+	"github.com/butlerdotdev/butler/internal/common/platform"
+	"github.com/butlerdotdev/butler/internal/ctl/cluster"
+	"github.com/spf13/cobra"
+)
+
+// NewVersionsCmd creates the versions parent command
+func NewVersionsCmd(logger *log.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versions",
+		Short: "Show supported Kubernetes versions",
+		Long: `Show the Kubernetes versions tenant clusters can run.
+
+butler-controller reports the versions supported by the installed Steward
+and CAPI providers in the status of the butler ButlerConfig. A version is
+supported when every component supports it and it falls within the
+kubernetesVersions range of the butler-platform ConfigMap.
+
+'cluster create' and 'fleet upgrade' reject other versions before anything
+is submitted, and complete --k8s-version from this list.
+
+Commands:
+  list  List supported Kubernetes versions
+
+Examples:
+  # Show supported versions
+  butlerctl versions list`,
+	}
+
+	cmd.AddCommand(newListCmd(logger))
+
+	return cmd
+}
+
+// newListCmd creates the versions list command
+func newListCmd(logger *log.Logger) *cobra.Command {
+	var outputFormat string
+	var wide bool
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List supported Kubernetes versions",
+		Long: `List the Kubernetes versions tenant clusters can run, newest first.
+
+Examples:
+  # Supported versions, with the platform default marked
+  butlerctl versions list
+
+  # Include what each component reports
+  butlerctl versions list --wide
+
+  # Machine-readable
+  butlerctl versions list -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(cmd.Context(), logger, outputFormat, wide)
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	cmd.Flags().BoolVar(&wide, "wide", false, "include versions only some components support")
+
+	return cmd
+}
+
+func runList(ctx context.Context, logger *log.Logger, outputFormat string, wide bool) error {
+	format, err := output.ParseFormat(outputFormat)
+	if err != nil {
+		return err
+	}
+
+	if err := cluster.RequireManagementCluster(ctx); err != nil {
+		return err
+	}
+	c, err := client.NewFromDefault()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	kv, err := platform.LoadKubernetesVersions(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	return output.NewPrinter(format, os.Stdout).Print(kv, func(w io.Writer) error {
+		if !kv.Reported() {
+			logger.Warn("the platform does not report supported Kubernetes versions; butler-controller may be too old",
+				"range", kv.Range.String(), "default", orDash(kv.Default))
+			return nil
+		}
+		if len(kv.Supported) == 0 {
+			logger.Warn("no Kubernetes version is supported by every component within the configured range",
+				"range", kv.Range.String())
+		}
+
+		if wide {
+			return printWide(w, kv)
+		}
+		table := output.NewTable(w, "VERSION", "DEFAULT")
+		for _, v := range kv.Supported {
+			table.AddRow(v, defaultMark(kv, v))
+		}
+		return table.Flush()
+	})
+}
+
+// printWide shows every reported version and which components support it
+func printWide(w io.Writer, kv *platform.KubernetesVersions) error {
+	headers := []string{"VERSION", "SUPPORTED", "DEFAULT"}
+	for _, comp := range kv.Components {
+		headers = append(headers, strings.ToUpper(comp.Component))
+	}
+	table := output.NewTable(w, headers...)
+	for _, v := range kv.All() {
+		supported := output.Dim("no")
+		if kv.IsSupported(v) {
+			supported = output.Success("yes")
+		}
+		row := []string{v, supported, defaultMark(kv, v)}
+		for _, comp := range kv.Components {
+			if comp.Supports(v) {
+				row = append(row, "yes")
+			} else {
+				row = append(row, output.Dim("no"))
+			}
+		}
+		table.AddRow(row...)
+	}
+	return table.Flush()
+}
+
+// defaultMark flags the platform default version
+func defaultMark(kv *platform.KubernetesVersions, v string) string {
+	if strings.TrimPrefix(v, "v") == strings.TrimPrefix(kv.Default, "v") {
+		return output.Success("*")
+	}
+	return ""
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package e2e runs the real butlerctl and butleradm binaries against a
+// throwaway KIND cluster with the Butler CRDs installed. No provider
+// controller runs there; a Simulator stands in for one, so provider
+// independent behaviors such as create, scale, export and destroy can be
+// verified continuously. 'make e2e' runs every Scenario.
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/adm/bootstrap/manifests"
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// DefaultClusterName is the KIND cluster created for a run
+	DefaultClusterName = "butler-e2e"
+
+	// ProviderConfigName is the simulated ProviderConfig clusters use
+	ProviderConfigName = "e2e"
+
+	// TenantNamespace holds the TenantClusters of a run
+	TenantNamespace = "butler-tenants"
+
+	systemNamespace = "butler-system"
+)
+
+// Options configures an Env
+type Options struct {
+	// ClusterName is the KIND cluster to create
+	ClusterName string
+
+	// Kubeconfig reuses an existing cluster instead of creating one
+	Kubeconfig string
+
+	// Keep leaves a created cluster running after Teardown, for debugging
+	Keep bool
+
+	// RepoRoot is the module root the binaries are built from
+	RepoRoot string
+
+	Logger *log.Logger
+}
+
+// Env is a running e2e cluster and the binaries that test it
+type Env struct {
+	Kubeconfig string
+	Client     *client.Client
+
+	opts      Options
+	dir       string
+	created   bool
+	simulator *Simulator
+	cancel    context.CancelFunc
+}
+
+// Setup builds the binaries, creates the KIND cluster unless one is given,
+// installs the Butler CRDs and the simulated provider, and starts the
+// Simulator
+func Setup(ctx context.Context, opts Options) (*Env, error) {
+	if opts.ClusterName == "" {
+		opts.ClusterName = DefaultClusterName
+	}
+	dir, err := os.MkdirTemp("", "butler-e2e-")
+	if err != nil {
+		return nil, fmt.Errorf("creating work directory: %w", err)
+	}
+	env := &Env{opts: opts, dir: dir, Kubeconfig: opts.Kubeconfig}
+
+	if err := env.build(ctx); err != nil {
+		return env, err
+	}
+
+	if env.Kubeconfig == "" {
+		if _, err := exec.LookPath("kind"); err != nil {
+			return env, fmt.Errorf("kind is required to create the e2e cluster (or pass an existing kubeconfig): %w", err)
+		}
+		env.Kubeconfig = filepath.Join(dir, "kubeconfig")
+		opts.Logger.Info("creating KIND cluster", "name", opts.ClusterName)
+		if _, err := run(ctx, "kind", "create", "cluster", "--name", opts.ClusterName, "--kubeconfig", env.Kubeconfig, "--wait", "120s"); err != nil {
+			return env, err
+		}
+		env.created = true
+	}
+
+	env.Client, err = client.NewFromKubeconfig(env.Kubeconfig)
+	if err != nil {
+		return env, fmt.Errorf("connecting to e2e cluster: %w", err)
+	}
+	if err := env.install(ctx); err != nil {
+		return env, err
+	}
+
+	simCtx, cancel := context.WithCancel(ctx)
+	env.cancel = cancel
+	env.simulator = NewSimulator(env.Client, opts.Logger)
+	go env.simulator.Run(simCtx)
+	return env, nil
+}
+
+// Teardown stops the Simulator and deletes the cluster Setup created
+func (e *Env) Teardown(ctx context.Context) error {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	defer os.RemoveAll(e.dir)
+
+	if !e.created {
+		return nil
+	}
+	if e.opts.Keep {
+		e.opts.Logger.Info("keeping KIND cluster", "name", e.opts.ClusterName, "kubeconfig", e.Kubeconfig)
+		// The kubeconfig lives in the work directory, so keep a copy
+		data, err := os.ReadFile(e.Kubeconfig)
+		if err == nil {
+			kept := filepath.Join(os.TempDir(), e.opts.ClusterName+"-kubeconfig")
+			if err := os.WriteFile(kept, data, 0600); err == nil {
+				e.opts.Logger.Info("kubeconfig saved", "file", kept)
+			}
+		}
+		return nil
+	}
+	_, err := run(ctx, "kind", "delete", "cluster", "--name", e.opts.ClusterName)
+	return err
+}
+
+// Ctl runs butlerctl against the e2e cluster and returns its stdout
+func (e *Env) Ctl(ctx context.Context, args ...string) (string, error) {
+	return e.exec(ctx, "butlerctl", args...)
+}
+
+// Adm runs butleradm against the e2e cluster and returns its stdout
+func (e *Env) Adm(ctx context.Context, args ...string) (string, error) {
+	return e.exec(ctx, "butleradm", args...)
+}
+
+// exec runs a built binary with a clean home directory, so kubeconfigs
+// and caches of the person running the tests never leak in
+func (e *Env) exec(ctx context.Context, binary string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, filepath.Join(e.dir, "bin", binary), args...)
+	cmd.Env = append(os.Environ(),
+		"KUBECONFIG="+e.Kubeconfig,
+		"HOME="+filepath.Join(e.dir, "home"),
+		"BUTLER_NON_INTERACTIVE=true",
+		"NO_COLOR=1",
+	)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	e.opts.Logger.Debug("running", "command", binary+" "+strings.Join(args, " "))
+	if err := cmd.Run(); err != nil {
+		return stdout.String(), fmt.Errorf("%s %s: %w\n%s", binary, strings.Join(args, " "), err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+// build compiles both CLIs into the work directory
+func (e *Env) build(ctx context.Context) error {
+	for _, binary := range []string{"butlerctl", "butleradm"} {
+		e.opts.Logger.Info("building", "binary", binary)
+		cmd := exec.CommandContext(ctx, "go", "build", "-o", filepath.Join(e.dir, "bin", binary), "./cmd/"+binary)
+		cmd.Dir = e.opts.RepoRoot
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("building %s: %w\n%s", binary, err, out)
+		}
+	}
+	return os.MkdirAll(filepath.Join(e.dir, "home"), 0700)
+}
+
+// install applies the Butler CRDs, the namespaces the CLIs expect and a
+// ProviderConfig for the simulated provider
+func (e *Env) install(ctx context.Context) error {
+	e.opts.Logger.Info("installing Butler CRDs")
+	deployer := manifests.NewDeployer(e.Client.Clientset, e.Client.Dynamic)
+	if err := deployer.DeployCRDs(ctx); err != nil {
+		return fmt.Errorf("installing CRDs: %w", err)
+	}
+	if err := deployer.WaitForCRDs(ctx, []string{"tenantclusters.butler.butlerlabs.dev", "providerconfigs.butler.butlerlabs.dev"}); err != nil {
+		return err
+	}
+
+	for _, name := range []string{systemNamespace, TenantNamespace} {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if _, err := e.Client.Clientset.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("creating namespace %s: %w", name, err)
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: ProviderConfigName + "-credentials", Namespace: systemNamespace},
+		StringData: map[string]string{"kubeconfig": "simulated"},
+	}
+	if _, err := e.Client.Clientset.CoreV1().Secrets(systemNamespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating provider credentials: %w", err)
+	}
+
+	pc := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": client.ButlerAPIGroup + "/" + client.ButlerAPIVersion,
+		"kind":       "ProviderConfig",
+		"metadata": map[string]interface{}{
+			"name":      ProviderConfigName,
+			"namespace": systemNamespace,
+		},
+		"spec": map[string]interface{}{
+			"provider":       "harvester",
+			"credentialsRef": map[string]interface{}{"name": secret.Name, "namespace": systemNamespace},
+			"harvester":      map[string]interface{}{"networkName": "default/e2e"},
+		},
+	}}
+	_, err := e.Client.Dynamic.Resource(client.ProviderConfigGVR).Namespace(systemNamespace).Create(ctx, pc, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating ProviderConfig: %w", err)
+	}
+	return nil
+}
+
+// run executes a helper tool such as kind
+func run(ctx context.Context, name string, args ...string) (string, error) {
+	out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("%s %s: %w\n%s", name, strings.Join(args, " "), err, out)
+	}
+	return string(out), nil
+}
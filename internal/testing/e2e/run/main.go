@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command run executes the e2e scenarios; see 'make e2e'.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/log"
+	"github.com/butlerdotdev/butler/internal/testing/e2e"
+)
+
+func main() {
+	opts := e2e.Options{Logger: log.New("e2e")}
+	var pattern string
+	var verbose bool
+	flag.StringVar(&opts.ClusterName, "cluster-name", e2e.DefaultClusterName, "KIND cluster to create")
+	flag.StringVar(&opts.Kubeconfig, "kubeconfig", "", "run against an existing cluster instead of creating one")
+	flag.BoolVar(&opts.Keep, "keep", false, "leave the KIND cluster running afterwards")
+	flag.StringVar(&opts.RepoRoot, "repo", ".", "module root to build the CLIs from")
+	flag.StringVar(&pattern, "run", "", "only run scenarios matching this regexp (later ones may need earlier ones)")
+	flag.BoolVar(&verbose, "v", false, "log every command and simulator update")
+	flag.Parse()
+	opts.Logger.SetVerbose(verbose)
+
+	filter, err := regexp.Compile(pattern)
+	if err != nil {
+		opts.Logger.Error("invalid -run", "error", err)
+		os.Exit(2)
+	}
+	os.Exit(run(opts, filter))
+}
+
+func run(opts e2e.Options, filter *regexp.Regexp) int {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Minute)
+	defer cancel()
+
+	env, err := e2e.Setup(ctx, opts)
+	if env != nil {
+		defer func() {
+			if err := env.Teardown(context.Background()); err != nil {
+				opts.Logger.Warn("teardown failed", "error", err)
+			}
+		}()
+	}
+	if err != nil {
+		opts.Logger.Error("setup failed", "error", err)
+		return 1
+	}
+
+	failed := 0
+	for _, s := range e2e.Scenarios {
+		if !filter.MatchString(s.Name) {
+			continue
+		}
+		start := time.Now()
+		if err := s.Run(ctx, env); err != nil {
+			opts.Logger.Error("FAIL", "scenario", s.Name, "error", err)
+			failed++
+			continue
+		}
+		opts.Logger.Success("PASS", "scenario", s.Name, "elapsed", time.Since(start).Round(time.Second))
+	}
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
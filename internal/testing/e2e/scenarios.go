@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// clusterName is the TenantCluster the scenarios work on, in order
+const clusterName = "e2e-lifecycle"
+
+// Scenario is one verified behavior. Scenarios run in order and may build
+// on the clusters earlier ones leave behind.
+type Scenario struct {
+	Name string
+	Run  func(ctx context.Context, e *Env) error
+}
+
+// Scenarios are the behaviors 'make e2e' verifies
+var Scenarios = []Scenario{
+	{Name: "create", Run: testCreate},
+	{Name: "scale", Run: testScale},
+	{Name: "export", Run: testExport},
+	{Name: "destroy", Run: testDestroy},
+}
+
+func testCreate(ctx context.Context, e *Env) error {
+	_, err := e.Ctl(ctx, "cluster", "create", clusterName,
+		"--provider", ProviderConfigName, "--workers", "2", "--cpu", "2", "--memory", "4Gi",
+		"--disable-metallb", "--label", "suite=e2e", "--wait", "--timeout", "2m")
+	if err != nil {
+		return err
+	}
+
+	tc, err := e.tenantCluster(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+	return expectAll(
+		expectField(tc, int64(2), "spec", "workers", "replicas"),
+		expectField(tc, int64(2), "spec", "workers", "machineTemplate", "cpu"),
+		expectField(tc, ProviderConfigName, "spec", "providerConfigRef", "name"),
+		expectField(tc, "e2e", "metadata", "labels", "suite"),
+		expectField(tc, "Ready", "status", "phase"),
+	)
+}
+
+func testScale(ctx context.Context, e *Env) error {
+	if _, err := e.Ctl(ctx, "cluster", "scale", clusterName, "--workers", "3", "--force"); err != nil {
+		return err
+	}
+	if _, err := e.Ctl(ctx, "cluster", "wait", clusterName, "--for", "Scaled", "--timeout", "1m", "--interval", "1s"); err != nil {
+		return err
+	}
+
+	tc, err := e.tenantCluster(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+	return expectField(tc, int64(3), "spec", "workers", "replicas")
+}
+
+func testExport(ctx context.Context, e *Env) error {
+	out, err := e.Ctl(ctx, "cluster", "export", clusterName)
+	if err != nil {
+		return err
+	}
+
+	exported := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(out), &exported.Object); err != nil {
+		return fmt.Errorf("parsing export: %w\n%s", err, out)
+	}
+	if _, found := exported.Object["status"]; found {
+		return fmt.Errorf("export includes status")
+	}
+	for _, field := range []string{"uid", "resourceVersion", "creationTimestamp", "managedFields"} {
+		if _, found := exported.Object["metadata"].(map[string]interface{})[field]; found {
+			return fmt.Errorf("export includes metadata.%s", field)
+		}
+	}
+	return expectAll(
+		expectField(exported, "TenantCluster", "kind"),
+		expectField(exported, clusterName, "metadata", "name"),
+		expectField(exported, int64(3), "spec", "workers", "replicas"),
+	)
+}
+
+func testDestroy(ctx context.Context, e *Env) error {
+	if _, err := e.Ctl(ctx, "cluster", "destroy", clusterName, "--yes", "--timeout", "1m"); err != nil {
+		return err
+	}
+	_, err := e.tenantCluster(ctx, clusterName)
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("TenantCluster %s still exists after destroy (error: %v)", clusterName, err)
+	}
+	return nil
+}
+
+// tenantCluster reads a TenantCluster straight from the API server
+func (e *Env) tenantCluster(ctx context.Context, name string) (*unstructured.Unstructured, error) {
+	return e.Client.Dynamic.Resource(client.TenantClusterGVR).Namespace(TenantNamespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// expectField checks the value at path, comparing numbers as int64
+func expectField(obj *unstructured.Unstructured, want interface{}, path ...string) error {
+	got, found, err := unstructured.NestedFieldNoCopy(obj.Object, path...)
+	if err != nil || !found {
+		return fmt.Errorf("%s: not set", strings.Join(path, "."))
+	}
+	switch n := got.(type) {
+	case float64:
+		got = int64(n)
+	case int:
+		got = int64(n)
+	}
+	if got != want {
+		return fmt.Errorf("%s: got %v, want %v", strings.Join(path, "."), got, want)
+	}
+	return nil
+}
+
+// expectAll joins the failed expectations
+func expectAll(errs ...error) error {
+	var failed []string
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%s", strings.Join(failed, "; "))
+	}
+	return nil
+}
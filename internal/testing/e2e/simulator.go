@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/butlerdotdev/butler/internal/common/client"
+	"github.com/butlerdotdev/butler/internal/common/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Simulator plays the Butler controllers for TenantClusters: every cluster
+// becomes Ready with an endpoint, and its ready workers follow
+// spec.workers.replicas. Nothing is provisioned.
+type Simulator struct {
+	client   *client.Client
+	logger   *log.Logger
+	interval time.Duration
+}
+
+// NewSimulator creates a Simulator polling every second
+func NewSimulator(c *client.Client, logger *log.Logger) *Simulator {
+	return &Simulator{client: c, logger: logger, interval: time.Second}
+}
+
+// Run reconciles until ctx is done
+func (s *Simulator) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.reconcile(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Debug("simulator", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Simulator) reconcile(ctx context.Context) error {
+	list, err := s.client.Dynamic.Resource(client.TenantClusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing TenantClusters: %w", err)
+	}
+	for i := range list.Items {
+		tc := &list.Items[i]
+		if tc.GetDeletionTimestamp() != nil || !simulate(tc) {
+			continue
+		}
+		_, err := s.client.Dynamic.Resource(client.TenantClusterGVR).Namespace(tc.GetNamespace()).UpdateStatus(ctx, tc, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("updating %s/%s: %w", tc.GetNamespace(), tc.GetName(), err)
+		}
+		s.logger.Debug("simulated cluster status", "cluster", tc.GetName())
+	}
+	return nil
+}
+
+// simulate sets the status the controllers would report, returning false
+// when it is already up to date
+func simulate(tc *unstructured.Unstructured) bool {
+	replicas, found, _ := unstructured.NestedInt64(tc.Object, "spec", "workers", "replicas")
+	if !found {
+		replicas = 1
+	}
+	endpoint := fmt.Sprintf("https://%s.%s.e2e.invalid:6443", tc.GetName(), tc.GetNamespace())
+
+	phase, _, _ := unstructured.NestedString(tc.Object, "status", "phase")
+	current, _, _ := unstructured.NestedString(tc.Object, "status", "controlPlaneEndpoint")
+	ready, _, _ := unstructured.NestedInt64(tc.Object, "status", "observedState", "workers", "ready")
+	desired, _, _ := unstructured.NestedInt64(tc.Object, "status", "observedState", "workers", "desired")
+	if phase == "Ready" && current == endpoint && ready == replicas && desired == replicas {
+		return false
+	}
+
+	_ = unstructured.SetNestedField(tc.Object, "Ready", "status", "phase")
+	_ = unstructured.SetNestedField(tc.Object, endpoint, "status", "controlPlaneEndpoint")
+	_ = unstructured.SetNestedField(tc.Object, replicas, "status", "observedState", "workers", "ready")
+	_ = unstructured.SetNestedField(tc.Object, replicas, "status", "observedState", "workers", "desired")
+	return true
+}